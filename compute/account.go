@@ -4,6 +4,30 @@ import (
 	"encoding/xml"
 	"fmt"
 	"net/http"
+	"strings"
+)
+
+const (
+	// RolePrimaryAdministrator represents the primary administrator role.
+	RolePrimaryAdministrator = "primary administrator"
+
+	// RoleServer represents the role that permits management of servers.
+	RoleServer = "server"
+
+	// RoleNetwork represents the role that permits management of networking.
+	RoleNetwork = "network"
+
+	// RoleBackup represents the role that permits management of cloud backup.
+	RoleBackup = "backup"
+
+	// RoleCreateImage represents the role that permits creation of customer images.
+	RoleCreateImage = "create image"
+
+	// RoleStorage represents the role that permits management of storage.
+	RoleStorage = "storage"
+
+	// RoleReports represents the role that permits access to reports.
+	RoleReports = "reports"
 )
 
 // Account represents the details for a compute account.
@@ -45,15 +69,112 @@ type Role struct {
 	Name string `xml:"name"`
 }
 
-// GetAccount retrieves the current user's account information
+// HasRole determines whether the account has been assigned the specified role (case-insensitive).
+func (account *Account) HasRole(roleName string) bool {
+	for _, role := range account.AssignedRoles {
+		if strings.EqualFold(role.Name, roleName) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetRoleNames gets the names of the roles assigned to the account.
+func (account *Account) GetRoleNames() (roleNames []string) {
+	for _, role := range account.AssignedRoles {
+		roleNames = append(roleNames, role.Name)
+	}
+
+	return
+}
+
+// GetMyAccount retrieves the current user's account information.
+//
+// This is equivalent to GetAccount.
+func (client *Client) GetMyAccount() (*Account, error) {
+	return client.GetAccount()
+}
+
+// GetAccount retrieves the current user's account information.
+//
+// The account information is retrieved once and then cached by the Client (use ForceRefreshAccount to retrieve it again).
 func (client *Client) GetAccount() (*Account, error) {
+	credentials, err := client.getCredentials()
+	if err != nil {
+		return nil, err
+	}
+
 	client.stateLock.Lock()
-	defer client.stateLock.Unlock()
+	account := client.account
+	if account == nil {
+		// Account details may have already been retrieved by another Client sharing the same cache.
+		account = client.accountCache.Get(client.baseAddress, credentials.Username)
+		client.account = account
+	}
+	client.stateLock.Unlock()
 
-	if client.account != nil {
-		return client.account, nil
+	if account != nil {
+		return account, nil
 	}
 
+	return client.fetchAccount()
+}
+
+// ForceRefreshAccount discards the Client's cached account information (if any) and retrieves it again.
+//
+// Use this if the current user's details (e.g. assigned roles) may have changed.
+func (client *Client) ForceRefreshAccount() (*Account, error) {
+	client.stateLock.Lock()
+	client.account = nil
+	client.stateLock.Unlock()
+
+	return client.fetchAccount()
+}
+
+// GetOrganizationID retrieves the Id of the current user's organisation.
+func (client *Client) GetOrganizationID() (string, error) {
+	return client.getOrganizationID()
+}
+
+// accountFetch represents an in-progress retrieval of the current user's account information.
+type accountFetch struct {
+	done    chan struct{}
+	account *Account
+	err     error
+}
+
+// fetchAccount retrieves the current user's account information from CloudControl and caches it.
+//
+// The state lock is not held while the request is in progress; concurrent callers wait for (and share the result of) the retrieval already in progress.
+func (client *Client) fetchAccount() (*Account, error) {
+	client.stateLock.Lock()
+	fetch := client.accountFetch
+	if fetch != nil {
+		client.stateLock.Unlock()
+		<-fetch.done
+
+		return fetch.account, fetch.err
+	}
+
+	fetch = &accountFetch{
+		done: make(chan struct{}),
+	}
+	client.accountFetch = fetch
+	client.stateLock.Unlock()
+
+	fetch.account, fetch.err = client.retrieveAccount()
+
+	client.stateLock.Lock()
+	client.accountFetch = nil
+	client.stateLock.Unlock()
+	close(fetch.done)
+
+	return fetch.account, fetch.err
+}
+
+// retrieveAccount retrieves the current user's account information from CloudControl and caches it.
+func (client *Client) retrieveAccount() (*Account, error) {
 	request, err := client.newRequestV1("myaccount", http.MethodGet, nil)
 	if err != nil {
 		return nil, err
@@ -74,7 +195,15 @@ func (client *Client) GetAccount() (*Account, error) {
 		return nil, err
 	}
 
+	client.stateLock.Lock()
 	client.account = account
+	client.stateLock.Unlock()
+
+	credentials, err := client.getCredentials()
+	if err != nil {
+		return nil, err
+	}
+	client.accountCache.Set(client.baseAddress, credentials.Username, account)
 
 	return account, nil
 }
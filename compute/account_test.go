@@ -4,7 +4,10 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 // Get user account details (successful).
@@ -27,6 +30,83 @@ func TestClient_GetAccount_Success(test *testing.T) {
 	verifyAccountTestResponse(test, account)
 }
 
+// Get user account details (cached, then refreshed).
+func TestClient_ForceRefreshAccount(test *testing.T) {
+	expect := expect(test)
+
+	requestCount := 0
+	testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		requestCount++
+
+		writer.Header().Set("Content-Type", "text/xml")
+		writer.WriteHeader(http.StatusOK)
+
+		fmt.Fprintln(writer, accountTestResponse)
+	}))
+	defer testServer.Close()
+
+	client := NewClientWithBaseAddress(testServer.URL, "user1", "password")
+
+	organizationID, err := client.GetOrganizationID()
+	if err != nil {
+		test.Fatal(err)
+	}
+	expect.EqualsString("OrganizationID", "cc309bfe-1234-43b7-a6a6-2b7a1965cf63", organizationID)
+
+	_, err = client.GetAccount()
+	if err != nil {
+		test.Fatal(err)
+	}
+	expect.EqualsInt("RequestCount", 1, requestCount)
+
+	account, err := client.ForceRefreshAccount()
+	if err != nil {
+		test.Fatal(err)
+	}
+	expect.EqualsInt("RequestCount", 2, requestCount)
+	verifyAccountTestResponse(test, account)
+}
+
+// Get user account details (concurrent first calls share a single request).
+func TestClient_GetAccount_Concurrent(test *testing.T) {
+	expect := expect(test)
+
+	var requestCount int32
+	testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		atomic.AddInt32(&requestCount, 1)
+		time.Sleep(50 * time.Millisecond)
+
+		writer.Header().Set("Content-Type", "text/xml")
+		writer.WriteHeader(http.StatusOK)
+
+		fmt.Fprintln(writer, accountTestResponse)
+	}))
+	defer testServer.Close()
+
+	client := NewClientWithBaseAddress(testServer.URL, "user1", "password")
+
+	var waitGroup sync.WaitGroup
+	errors := make(chan error, 5)
+	for index := 0; index < 5; index++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+
+			_, err := client.GetAccount()
+			errors <- err
+		}()
+	}
+	waitGroup.Wait()
+	close(errors)
+
+	for err := range errors {
+		if err != nil {
+			test.Fatal(err)
+		}
+	}
+	expect.EqualsInt("RequestCount", 1, int(atomic.LoadInt32(&requestCount)))
+}
+
 // Get user account details (access denied).
 func TestClient_GetAccount_AccessDenied(test *testing.T) {
 	testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
@@ -0,0 +1,108 @@
+package compute
+
+import "fmt"
+
+// IsAddressInUseError determines if an error is an AddressInUseError.
+func IsAddressInUseError(err error) bool {
+	_, isAddressInUseError := err.(*AddressInUseError)
+
+	return isAddressInUseError
+}
+
+// AddressInUseError is the error returned when creating a NAT rule or virtual listener would use an external IP address that is already in use in the same network domain.
+type AddressInUseError struct {
+	// The IP address that is already in use.
+	Address string
+
+	// The type of resource that is using the address (ResourceTypeNATRule or ResourceTypeVirtualListener).
+	OwnerType ResourceType
+
+	// The Id of the resource that is using the address.
+	OwnerID string
+
+	// The name (if any) of the resource that is using the address.
+	OwnerName string
+}
+
+// Get a string representation of the error.
+func (err *AddressInUseError) Error() string {
+	ownerDescription, _ := GetResourceDescription(err.OwnerType)
+
+	if err.OwnerName != "" {
+		return fmt.Sprintf("IP address '%s' is already in use by %s '%s' ('%s').", err.Address, ownerDescription, err.OwnerName, err.OwnerID)
+	}
+
+	return fmt.Sprintf("IP address '%s' is already in use by %s '%s'.", err.Address, ownerDescription, err.OwnerID)
+}
+
+var _ error = &AddressInUseError{}
+
+// checkExternalAddressNotInUse verifies that the specified external IP address is not already used by a NAT rule or virtual listener in the network domain.
+//
+// If port is not nil, virtual listeners that use the same address on a different port are not considered to be in conflict.
+// Returns an AddressInUseError if the address is already in use.
+func (client *Client) checkExternalAddressNotInUse(networkDomainID string, address string, port *int) error {
+	var conflict *AddressInUseError
+
+	err := ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		rules, err := client.ListNATRules(networkDomainID, paging)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, rule := range rules.Rules {
+			if rule.ExternalIPAddress == address {
+				conflict = &AddressInUseError{
+					Address:   address,
+					OwnerType: ResourceTypeNATRule,
+					OwnerID:   rule.ID,
+				}
+
+				return nil, nil
+			}
+		}
+
+		return &rules.PagedResult, nil
+	})
+	if err != nil {
+		return err
+	}
+	if conflict != nil {
+		return conflict
+	}
+
+	err = ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		listeners, err := client.ListVirtualListenersInNetworkDomain(networkDomainID, paging)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, listener := range listeners.Items {
+			if listener.ListenerIPAddress != address {
+				continue
+			}
+			if port != nil && listener.Port != 0 && *port != 0 && listener.Port != *port {
+				continue // Virtual listeners can share an address, as long as they use different ports.
+			}
+
+			conflict = &AddressInUseError{
+				Address:   address,
+				OwnerType: ResourceTypeVirtualListener,
+				OwnerID:   listener.ID,
+				OwnerName: listener.Name,
+			}
+
+			return nil, nil
+		}
+
+		return &listeners.PagedResult, nil
+	})
+	if err != nil {
+		return err
+	}
+	if conflict != nil {
+		return conflict
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package compute
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// Add NAT rule (external IP address already used by another NAT rule).
+func TestClient_AddNATRule_AddressInUseByNATRule(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			_, err := client.AddNATRule("484174a2-ae74-4658-9e56-50fc90e086cf", "10.0.0.16", stringToPtr("165.180.12.12"))
+			expect.IsTrue("IsAddressInUseError", IsAddressInUseError(err))
+
+			addressInUseError := err.(*AddressInUseError)
+			expect.EqualsString("AddressInUseError.Address", "165.180.12.12", addressInUseError.Address)
+			expect.EqualsInt("AddressInUseError.OwnerType", int(ResourceTypeNATRule), int(addressInUseError.OwnerType))
+			expect.EqualsString("AddressInUseError.OwnerID", "2169a38e-5692-497e-a22a-701a838a6539", addressInUseError.OwnerID)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			if request.Method != http.MethodGet {
+				test.Fatalf("Unexpected '%s' request to '%s' (NAT rule should not have been created).", request.Method, request.URL.Path)
+			}
+
+			return respondAddressConflictTestRequest(test, request)
+		},
+	})
+}
+
+// Create virtual listener (listener IP address already used by a virtual listener on the same port).
+func TestClient_CreateVirtualListener_AddressInUseByVirtualListener(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			_, err := client.CreateVirtualListener(NewVirtualListenerConfiguration{
+				NetworkDomainID:   "484174a2-ae74-4658-9e56-50fc90e086cf",
+				Name:              "Another.Load.Balancer",
+				ListenerIPAddress: stringToPtr("165.180.12.22"),
+				Port:              80,
+			})
+			expect.IsTrue("IsAddressInUseError", IsAddressInUseError(err))
+
+			addressInUseError := err.(*AddressInUseError)
+			expect.EqualsInt("AddressInUseError.OwnerType", int(ResourceTypeVirtualListener), int(addressInUseError.OwnerType))
+			expect.EqualsString("AddressInUseError.OwnerID", "6115469d-a8bb-445b-bb23-d23b5283f2b9", addressInUseError.OwnerID)
+			expect.EqualsString("AddressInUseError.OwnerName", "Production.Load.Balancer", addressInUseError.OwnerName)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			if request.Method != http.MethodGet {
+				test.Fatalf("Unexpected '%s' request to '%s' (virtual listener should not have been created).", request.Method, request.URL.Path)
+			}
+
+			return respondAddressConflictTestRequest(test, request)
+		},
+	})
+}
+
+// Create virtual listener (listener IP address shared with a virtual listener on a different port).
+func TestClient_CreateVirtualListener_AddressSharedOnDifferentPort(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			virtualListenerID, err := client.CreateVirtualListener(NewVirtualListenerConfiguration{
+				NetworkDomainID:   "484174a2-ae74-4658-9e56-50fc90e086cf",
+				Name:              "Production.Load.Balancer.HTTPS",
+				ListenerIPAddress: stringToPtr("165.180.12.22"),
+				Port:              443,
+			})
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsString("VirtualListenerID", "43a445f1-9ac9-4f13-8b0d-a2d1fad231c3", virtualListenerID)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			if request.Method == http.MethodPost {
+				return http.StatusOK, createVirtualListenerTestResponse
+			}
+
+			return respondAddressConflictTestRequest(test, request)
+		},
+	})
+}
+
+// testRespondNoAddressConflicts wraps a responder so that the list requests made when checking for address conflicts receive empty results.
+func testRespondNoAddressConflicts(respond ClientTestResponder) ClientTestResponder {
+	return func(test *testing.T, request *http.Request) (int, string) {
+		if request.Method == http.MethodGet && strings.HasSuffix(request.URL.Path, "/network/natRule") {
+			return http.StatusOK, emptyNATRulesTestResponse
+		}
+		if request.Method == http.MethodGet && strings.HasSuffix(request.URL.Path, "/networkDomainVip/virtualListener") {
+			return http.StatusOK, emptyVirtualListenersTestResponse
+		}
+
+		return respond(test, request)
+	}
+}
+
+func respondAddressConflictTestRequest(test *testing.T, request *http.Request) (int, string) {
+	if strings.HasSuffix(request.URL.Path, "/network/natRule") {
+		return http.StatusOK, listNATRulesForAddressConflictTestResponse
+	}
+	if strings.HasSuffix(request.URL.Path, "/networkDomainVip/virtualListener") {
+		return http.StatusOK, listVirtualListenersForAddressConflictTestResponse
+	}
+
+	test.Fatalf("Unexpected request to '%s'.", request.URL.Path)
+
+	return http.StatusNotFound, ""
+}
+
+/*
+ * Test responses.
+ */
+
+const listNATRulesForAddressConflictTestResponse = `
+{
+	"natRule": [
+		{
+			"id": "2169a38e-5692-497e-a22a-701a838a6539",
+			"networkDomainId": "484174a2-ae74-4658-9e56-50fc90e086cf",
+			"internalIp": "10.0.0.15",
+			"externalIp": "165.180.12.12",
+			"createTime": "2015-03-06T13:45:10.000Z",
+			"state": "NORMAL",
+			"datacenterId": "NA9"
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 1,
+	"totalCount": 1,
+	"pageSize": 250
+}
+`
+
+const listVirtualListenersForAddressConflictTestResponse = `
+{
+	"virtualListener": [
+		{
+			"id": "6115469d-a8bb-445b-bb23-d23b5283f2b9",
+			"name": "Production.Load.Balancer",
+			"listenerIpAddress": "165.180.12.22",
+			"port": 80,
+			"state": "NORMAL",
+			"networkDomainId": "484174a2-ae74-4658-9e56-50fc90e086cf",
+			"datacenterId": "NA9"
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 1,
+	"totalCount": 1,
+	"pageSize": 250
+}
+`
+
+const emptyNATRulesTestResponse = `
+{
+	"natRule": [],
+	"pageNumber": 1,
+	"pageCount": 0,
+	"totalCount": 0,
+	"pageSize": 250
+}
+`
+
+const emptyVirtualListenersTestResponse = `
+{
+	"virtualListener": [],
+	"pageNumber": 1,
+	"pageCount": 0,
+	"totalCount": 0,
+	"pageSize": 250
+}
+`
@@ -14,7 +14,7 @@ type IPAddressList struct {
 	Description string               `json:"description"`
 	IPVersion   string               `json:"ipVersion"`
 	State       string               `json:"state"`
-	CreateTime  string               `json:"createTime"`
+	CreateTime  Timestamp            `json:"createTime"`
 	Addresses   []IPAddressListEntry `json:"ipAddress"`
 	ChildLists  []EntityReference    `json:"childIpAddressList"`
 }
@@ -76,7 +76,7 @@ func verifyGetIPAddressListTestResponse(test *testing.T, addressList *IPAddressL
 	expect.EqualsString("IPAddressList.Description", "For our production web servers", addressList.Description)
 	expect.EqualsString("IPAddressList.IPVersion", "IPV4", addressList.IPVersion)
 	expect.EqualsString("IPAddressList.State", ResourceStatusNormal, addressList.State)
-	expect.EqualsString("IPAddressList.CreateTime", "2015-09-29T02:49:45", addressList.CreateTime)
+	expect.EqualsString("IPAddressList.CreateTime", "2015-09-29T02:49:45", addressList.CreateTime.Raw)
 
 	expect.EqualsInt("IPAddressList.Addresses.Length", 3, len(addressList.Addresses))
 
@@ -164,7 +164,7 @@ func verifyListIPAddressListsResponse(test *testing.T, addressLists *IPAddressLi
 	expect.EqualsString("IPAddressLists.AddressLists[0].Name", "ProductionIPAddressList", addressList1.Name)
 	expect.EqualsString("IPAddressLists.AddressLists[0].Name", "ProductionIPAddressList", addressList1.Name)
 	expect.EqualsString("IPAddressLists.AddressLists[0].State", ResourceStatusNormal, addressList1.State)
-	expect.EqualsString("IPAddressLists.AddressLists[0].CreateTime", "2015-09-29T02:49:45", addressList1.CreateTime)
+	expect.EqualsString("IPAddressLists.AddressLists[0].CreateTime", "2015-09-29T02:49:45", addressList1.CreateTime.Raw)
 
 	expect.EqualsInt("IPAddressLists.AddressLists[0].Addresses.Length", 3, len(addressList1.Addresses))
 
@@ -0,0 +1,121 @@
+package compute
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+const (
+	// MinAdministratorPasswordLength is the minimum length of a server administrator password.
+	MinAdministratorPasswordLength = 8
+
+	// MaxAdministratorPasswordLength is the maximum length of a server administrator password.
+	MaxAdministratorPasswordLength = 64
+
+	// DefaultAdministratorPasswordLength is the length of administrator passwords generated by GenerateAdministratorPassword.
+	DefaultAdministratorPasswordLength = 16
+)
+
+const (
+	administratorPasswordUpper   = "ABCDEFGHJKLMNPQRSTUVWXYZ"
+	administratorPasswordLower   = "abcdefghijkmnopqrstuvwxyz"
+	administratorPasswordDigits  = "23456789"
+	administratorPasswordSpecial = "!#$%*+-=?@^_"
+
+	// Characters that CloudControl (or guest OS customisation) does not accept in administrator passwords.
+	administratorPasswordDisallowed = " \"'\\/<>&`"
+)
+
+// GenerateAdministratorPassword generates a random server administrator password that satisfies CloudControl's complexity rules.
+//
+// The password contains at least one upper-case letter, lower-case letter, digit, and special character (visually-ambiguous characters are excluded).
+func GenerateAdministratorPassword(length int) (string, error) {
+	if length < MinAdministratorPasswordLength || length > MaxAdministratorPasswordLength {
+		return "", fmt.Errorf("Invalid administrator password length %d (must be between %d and %d)", length, MinAdministratorPasswordLength, MaxAdministratorPasswordLength)
+	}
+
+	characterSets := []string{
+		administratorPasswordUpper,
+		administratorPasswordLower,
+		administratorPasswordDigits,
+		administratorPasswordSpecial,
+	}
+	allCharacters := strings.Join(characterSets, "")
+
+	password := make([]byte, length)
+	for index := range password {
+		characterSet := allCharacters
+		if index < len(characterSets) {
+			characterSet = characterSets[index] // Guarantee at least one character from each set.
+		}
+
+		character, err := randomCharacter(characterSet)
+		if err != nil {
+			return "", err
+		}
+		password[index] = character
+	}
+
+	// Shuffle so the guaranteed characters are not always at the start.
+	for index := len(password) - 1; index > 0; index-- {
+		swapIndex, err := randomInt(index + 1)
+		if err != nil {
+			return "", err
+		}
+		password[index], password[swapIndex] = password[swapIndex], password[index]
+	}
+
+	return string(password), nil
+}
+
+// ValidateAdministratorPassword determines whether the specified server administrator password satisfies CloudControl's length and complexity rules.
+func ValidateAdministratorPassword(password string) error {
+	if len(password) < MinAdministratorPasswordLength || len(password) > MaxAdministratorPasswordLength {
+		return fmt.Errorf("Administrator password must be between %d and %d characters long", MinAdministratorPasswordLength, MaxAdministratorPasswordLength)
+	}
+
+	if strings.ContainsAny(password, administratorPasswordDisallowed) {
+		return fmt.Errorf("Administrator password must not contain spaces or any of the following characters: %s", strings.TrimSpace(administratorPasswordDisallowed))
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSpecial bool
+	for _, character := range password {
+		switch {
+		case character >= 'A' && character <= 'Z':
+			hasUpper = true
+		case character >= 'a' && character <= 'z':
+			hasLower = true
+		case character >= '0' && character <= '9':
+			hasDigit = true
+		default:
+			hasSpecial = true
+		}
+	}
+	if !(hasUpper && hasLower && hasDigit && hasSpecial) {
+		return fmt.Errorf("Administrator password must contain at least one upper-case letter, lower-case letter, digit, and special character")
+	}
+
+	return nil
+}
+
+// randomCharacter selects a random character from the specified set.
+func randomCharacter(characterSet string) (byte, error) {
+	index, err := randomInt(len(characterSet))
+	if err != nil {
+		return 0, err
+	}
+
+	return characterSet[index], nil
+}
+
+// randomInt generates a cryptographically-random integer in the range [0, max).
+func randomInt(max int) (int, error) {
+	value, err := rand.Int(rand.Reader, big.NewInt(int64(max)))
+	if err != nil {
+		return 0, fmt.Errorf("Unable to generate random value: %s", err.Error())
+	}
+
+	return int(value.Int64()), nil
+}
@@ -0,0 +1,69 @@
+package compute
+
+import (
+	"testing"
+)
+
+// Generate administrator password.
+func TestGenerateAdministratorPassword(test *testing.T) {
+	expect := expect(test)
+
+	for iteration := 0; iteration < 100; iteration++ {
+		password, err := GenerateAdministratorPassword(MinAdministratorPasswordLength)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		expect.EqualsInt("Password.Length", MinAdministratorPasswordLength, len(password))
+
+		err = ValidateAdministratorPassword(password)
+		if err != nil {
+			test.Fatalf("Generated password '%s' is invalid: %s", password, err)
+		}
+	}
+
+	_, err := GenerateAdministratorPassword(MinAdministratorPasswordLength - 1)
+	expect.NotNil("Error (too short)", err)
+}
+
+// Validate administrator password.
+func TestValidateAdministratorPassword(test *testing.T) {
+	expect := expect(test)
+
+	err := ValidateAdministratorPassword("sn4u$ag3S!")
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	expect.NotNil("TooShort", ValidateAdministratorPassword("sN4$"))
+	expect.NotNil("NoUpper", ValidateAdministratorPassword("sn4u$ag3s!"))
+	expect.NotNil("NoSpecial", ValidateAdministratorPassword("sn4uSag3s1"))
+	expect.NotNil("Disallowed", ValidateAdministratorPassword("sn4u$ag3S!<"))
+}
+
+// Deploy server with generated administrator password (successful).
+func TestClient_DeployServerWithOptions_GeneratePassword(test *testing.T) {
+	expect := expect(test)
+
+	var sentPassword string
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			serverID, password, err := client.DeployServerWithOptions(ServerDeploymentConfiguration{
+				Name:    "Production FTPS Server",
+				ImageID: "02250336-de2b-4e99-ab96-78511b7f8f4b",
+			}, DeployServerOptions{
+				GenerateAdministratorPassword: true,
+			})
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsString("ServerID", "7b62aae5-bdbe-4595-b58d-c78f95db2a7f", serverID)
+			expect.EqualsInt("Password.Length", DefaultAdministratorPasswordLength, len(password))
+			expect.EqualsString("Password", sentPassword, password)
+		},
+		Respond: testValidateJSONRequestAndRespondOK(deployServerTestResponse, &ServerDeploymentConfiguration{}, func(test *testing.T, requestBody interface{}) {
+			sentPassword = requestBody.(*ServerDeploymentConfiguration).AdministratorPassword
+		}),
+	})
+}
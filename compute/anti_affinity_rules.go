@@ -24,7 +24,7 @@ type ServerAntiAffinityRule struct {
 	State string `json:"state"`
 
 	// The network domain's creation timestamp.
-	CreateTime string `json:"created"`
+	CreateTime Timestamp `json:"created"`
 
 	// The Id of the data centre in which the network domain is located.
 	DatacenterID string `json:"datacenterId"`
@@ -32,6 +32,10 @@ type ServerAntiAffinityRule struct {
 
 // GetID returns the server anti-affinity rule's Id.
 func (rule *ServerAntiAffinityRule) GetID() string {
+	if rule == nil {
+		return ""
+	}
+
 	return rule.ID
 }
 
@@ -42,11 +46,19 @@ func (rule *ServerAntiAffinityRule) GetResourceType() ResourceType {
 
 // GetName returns the server anti-affinity rule's name.
 func (rule *ServerAntiAffinityRule) GetName() string {
+	if rule == nil {
+		return ""
+	}
+
 	return rule.ID
 }
 
 // GetState returns the server anti-affinity rule's current state.
 func (rule *ServerAntiAffinityRule) GetState() string {
+	if rule == nil {
+		return ""
+	}
+
 	return rule.State
 }
 
@@ -57,6 +69,10 @@ func (rule *ServerAntiAffinityRule) IsDeleted() bool {
 
 // ToEntityReference creates an EntityReference representing the CustomerImage.
 func (rule *ServerAntiAffinityRule) ToEntityReference() EntityReference {
+	if rule == nil {
+		return EntityReference{}
+	}
+
 	name := ""
 	if len(rule.Servers) == 2 {
 		name = fmt.Sprintf("%s/%s",
@@ -142,6 +158,11 @@ func (client *Client) ListServerAntiAffinityRules(networkDomainID string, paging
 		return nil, err
 	}
 
+	err = paging.Validate()
+	if err != nil {
+		return nil, err
+	}
+
 	requestURI := fmt.Sprintf("%s/server/antiAffinityRule?networkDomainId=%s&%s",
 		url.QueryEscape(organizationID),
 		url.QueryEscape(networkDomainID),
@@ -177,6 +198,46 @@ func (client *Client) ListServerAntiAffinityRules(networkDomainID string, paging
 	return rules, nil
 }
 
+// AppliesToServers determines whether the anti-affinity rule applies to the 2 specified servers (in either order).
+func (rule *ServerAntiAffinityRule) AppliesToServers(server1ID string, server2ID string) bool {
+	if len(rule.Servers) != 2 {
+		return false
+	}
+
+	if rule.Servers[0].ID == server1ID && rule.Servers[1].ID == server2ID {
+		return true
+	}
+
+	return rule.Servers[0].ID == server2ID && rule.Servers[1].ID == server1ID
+}
+
+// FindServerAntiAffinityRule finds the anti-affinity rule (if any) that applies to the 2 specified servers in the specified network domain.
+//
+// Returns nil if no matching rule was found.
+func (client *Client) FindServerAntiAffinityRule(server1ID string, server2ID string, networkDomainID string) (rule *ServerAntiAffinityRule, err error) {
+	err = ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		rules, err := client.ListServerAntiAffinityRules(networkDomainID, paging)
+		if err != nil {
+			return nil, err
+		}
+
+		for index := range rules.Items {
+			if rules.Items[index].AppliesToServers(server1ID, server2ID) {
+				rule = &rules.Items[index]
+
+				return nil, nil // Found it; stop paging.
+			}
+		}
+
+		return &rules.PagedResult, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
 // CreateServerAntiAffinityRule creates an anti-affinity rule for the 2 specified servers.
 // server1Id is the Id of the first server.
 // server2Id is the Id of the second server.
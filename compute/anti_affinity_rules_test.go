@@ -1,6 +1,10 @@
 package compute
 
-import "testing"
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
 
 // List anti-affinity rules (successful).
 func TestClient_ListAntityAffinityRules_Success(test *testing.T) {
@@ -51,6 +55,69 @@ func TestClient_CreateAntityAffinityRule_Success(test *testing.T) {
 	})
 }
 
+// Find anti-affinity rule for servers (successful).
+func TestClient_FindServerAntiAffinityRule_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			rule, err := client.FindServerAntiAffinityRule(
+				"5783e93f-5370-44fc-a772-cd3c29a2ecaa",
+				"681a6db2-9c7c-4d98-a0c4-7b3d7c1619ba",
+				"553f26b6-2a73-42c3-a78b-6116f11291d0",
+			)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.NotNil("ServerAntiAffinityRule", rule)
+			expect.EqualsString("ServerAntiAffinityRule.ID", "d4ebfdd1-ec03-45c7-b0be-fbcc0861e9bf", rule.ID)
+		},
+		Respond: testRespondOK(listServerAntiAffinityRulesTestResponse),
+	})
+}
+
+// Find anti-affinity rule for servers (no matching rule).
+func TestClient_FindServerAntiAffinityRule_NotFound(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			rule, err := client.FindServerAntiAffinityRule(
+				"681a6db2-9c7c-4d98-a0c4-7b3d7c1619ba",
+				"40285f24-300f-11e2-b574-1a6dd6e90d84",
+				"553f26b6-2a73-42c3-a78b-6116f11291d0",
+			)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.IsTrue("ServerAntiAffinityRule == nil", rule == nil)
+		},
+		Respond: testRespondOK(listServerAntiAffinityRulesTestResponse),
+	})
+}
+
+// Delete anti-affinity rule (successful).
+func TestClient_DeleteAntiAffinityRule_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			err := client.DeleteServerAntiAffinityRule("20ce6bee-a4ed-11e1-a91c-0030487e0302", "553f26b6-2a73-42c3-a78b-6116f11291d0")
+			if err != nil {
+				test.Fatal(err)
+			}
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.EqualsString("Request.Method", http.MethodGet, request.Method)
+			expect.IsTrue("Request.URL", strings.HasSuffix(request.URL.Path, "/antiAffinityRule/20ce6bee-a4ed-11e1-a91c-0030487e0302"))
+
+			return http.StatusOK, deleteServerAntiAffinityRuleTestResponse
+		},
+	})
+}
+
 /*
  * Test requests.
  */
@@ -160,3 +227,12 @@ const createServerAntiAffinityRuleTestResponse = `
 	</additionalInformation>
 </Status>
 `
+
+const deleteServerAntiAffinityRuleTestResponse = `
+<Status>
+	<operation>Delete Anti Affinity Rule</operation>
+	<result>SUCCESS</result>
+	<resultDetail>Success message</resultDetail>
+	<resultCode>RESULT_0</resultCode>
+</Status>
+`
@@ -0,0 +1,250 @@
+package compute
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// APIVersion represents a version of the CloudControl (MCP 2.x) API.
+type APIVersion struct {
+	// The major version number.
+	Major int
+
+	// The minor version number.
+	Minor int
+}
+
+var (
+	// APIVersion22 represents version 2.2 of the CloudControl API.
+	APIVersion22 = APIVersion{Major: 2, Minor: 2}
+
+	// APIVersion23 represents version 2.3 of the CloudControl API.
+	APIVersion23 = APIVersion{Major: 2, Minor: 3}
+
+	// APIVersion24 represents version 2.4 of the CloudControl API.
+	APIVersion24 = APIVersion{Major: 2, Minor: 4}
+
+	// APIVersion27 represents version 2.7 of the CloudControl API.
+	APIVersion27 = APIVersion{Major: 2, Minor: 7}
+
+	// APIVersion29 represents version 2.9 of the CloudControl API.
+	APIVersion29 = APIVersion{Major: 2, Minor: 9}
+
+	// MaxAPIVersion is the highest version of the CloudControl API supported by the client.
+	MaxAPIVersion = APIVersion29
+)
+
+// ParseAPIVersion parses an API version (e.g. "2.4").
+func ParseAPIVersion(version string) (APIVersion, error) {
+	parts := strings.SplitN(strings.TrimSpace(version), ".", 2)
+	if len(parts) != 2 {
+		return APIVersion{}, fmt.Errorf("Invalid API version '%s' (expected 'major.minor')", version)
+	}
+
+	major, err := strconv.Atoi(parts[0])
+	if err != nil || major < 0 {
+		return APIVersion{}, fmt.Errorf("Invalid API version '%s' (invalid major version)", version)
+	}
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil || minor < 0 {
+		return APIVersion{}, fmt.Errorf("Invalid API version '%s' (invalid minor version)", version)
+	}
+
+	return APIVersion{Major: major, Minor: minor}, nil
+}
+
+// String returns the API version in "major.minor" format.
+func (version APIVersion) String() string {
+	return fmt.Sprintf("%d.%d", version.Major, version.Minor)
+}
+
+// Less determines whether the API version is earlier than the other API version.
+func (version APIVersion) Less(other APIVersion) bool {
+	if version.Major != other.Major {
+		return version.Major < other.Major
+	}
+
+	return version.Minor < other.Minor
+}
+
+// APIVersionInfo represents information about the API versions supported by a CloudControl end-point.
+type APIVersionInfo struct {
+	// The current (latest) API version.
+	Current string `json:"current"`
+
+	// All API versions supported by the end-point.
+	Supported []string `json:"supported"`
+
+	// Supported API versions that are deprecated (and will be removed in future).
+	Deprecated []string `json:"deprecated"`
+
+	// Capability flags advertised by the end-point.
+	Capabilities []string `json:"capability"`
+}
+
+// SupportsVersion determines whether the end-point supports the specified API version.
+func (info *APIVersionInfo) SupportsVersion(version APIVersion) bool {
+	for _, supported := range info.Supported {
+		supportedVersion, err := ParseAPIVersion(supported)
+		if err == nil && supportedVersion == version {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsDeprecated determines whether the specified API version is deprecated.
+func (info *APIVersionInfo) IsDeprecated(version APIVersion) bool {
+	for _, deprecated := range info.Deprecated {
+		deprecatedVersion, err := ParseAPIVersion(deprecated)
+		if err == nil && deprecatedVersion == version {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HasCapability determines whether the end-point advertises the specified capability flag (case-insensitive).
+func (info *APIVersionInfo) HasCapability(capability string) bool {
+	for _, advertised := range info.Capabilities {
+		if strings.EqualFold(advertised, capability) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// HighestSupportedVersion returns the highest MCP 2.x version supported by both the end-point and the client.
+//
+// Returns false if the end-point and client have no MCP 2.x version in common.
+func (info *APIVersionInfo) HighestSupportedVersion() (version APIVersion, ok bool) {
+	for _, supported := range info.Supported {
+		supportedVersion, err := ParseAPIVersion(supported)
+		if err != nil || supportedVersion.Major != 2 || MaxAPIVersion.Less(supportedVersion) {
+			continue
+		}
+
+		if !ok || version.Less(supportedVersion) {
+			version = supportedVersion
+			ok = true
+		}
+	}
+
+	return
+}
+
+// GetAPIVersionInfo retrieves information about the API versions supported by the CloudControl end-point.
+func (client *Client) GetAPIVersionInfo() (versionInfo *APIVersionInfo, err error) {
+	request, err := http.NewRequest(http.MethodGet, client.baseAddress+"/caas/apiVersion", nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Add("Accept", "application/json")
+	client.setRequestHeaders(request)
+
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode != http.StatusOK {
+		var apiResponse *APIResponseV2
+
+		apiResponse, err = readAPIResponseAsJSON(responseBody, statusCode)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, apiResponse.ToError("Request to retrieve API version information failed with status code %d (%s): %s", statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	versionInfo = &APIVersionInfo{}
+	err = json.Unmarshal(responseBody, versionInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return versionInfo, nil
+}
+
+// NegotiateAPIVersion determines the highest MCP 2.x version supported by both the CloudControl end-point and the client.
+//
+// Once negotiated, each end-point is invoked using the highest version supported by both the end-point and the client
+// (i.e. the negotiated version, capped at the highest version whose responses the client handles correctly for that end-point, or the minimum version the end-point requires, if that is higher).
+// Raw requests (see Client.Do) are invoked using the negotiated version.
+// Has no effect on end-point selection while an API version is pinned (see PinAPIVersion).
+func (client *Client) NegotiateAPIVersion() (version APIVersion, err error) {
+	versionInfo, err := client.GetAPIVersionInfo()
+	if err != nil {
+		return APIVersion{}, err
+	}
+
+	version, ok := versionInfo.HighestSupportedVersion()
+	if !ok {
+		return APIVersion{}, fmt.Errorf("CloudControl end-point '%s' supports no API version in common with the client (supported versions: %s)", client.baseAddress, strings.Join(versionInfo.Supported, ", "))
+	}
+
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	client.negotiatedAPIVersion = &version
+
+	return version, nil
+}
+
+// PinAPIVersion configures the client to invoke all MCP 2.x end-points using the specified API version (for compatibility).
+//
+// Requests to end-points that require a later version will fail.
+func (client *Client) PinAPIVersion(version APIVersion) error {
+	if version.Major != 2 || MaxAPIVersion.Less(version) {
+		return fmt.Errorf("Cannot pin API version %s (the client supports MCP 2.x versions up to %s)", version, MaxAPIVersion)
+	}
+
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	client.pinnedAPIVersion = &version
+
+	return nil
+}
+
+// UnpinAPIVersion removes the pinned API version (if any).
+func (client *Client) UnpinAPIVersion() {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	client.pinnedAPIVersion = nil
+}
+
+// selectAPIVersion determines the API version to use for an end-point that requires the specified minimum version, and whose responses the client handles correctly up to the specified maximum version.
+func (client *Client) selectAPIVersion(minimumVersion APIVersion, maximumVersion APIVersion) (APIVersion, error) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if client.pinnedAPIVersion != nil {
+		if client.pinnedAPIVersion.Less(minimumVersion) {
+			return APIVersion{}, fmt.Errorf("This operation requires CloudControl API version %s or later, but the client is pinned to version %s", minimumVersion, client.pinnedAPIVersion)
+		}
+
+		return *client.pinnedAPIVersion, nil
+	}
+
+	if client.negotiatedAPIVersion == nil || !minimumVersion.Less(*client.negotiatedAPIVersion) {
+		return minimumVersion, nil
+	}
+	if maximumVersion.Less(*client.negotiatedAPIVersion) {
+		if maximumVersion.Less(minimumVersion) {
+			return minimumVersion, nil
+		}
+
+		return maximumVersion, nil
+	}
+
+	return *client.negotiatedAPIVersion, nil
+}
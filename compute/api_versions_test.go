@@ -0,0 +1,169 @@
+package compute
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// Parse API version (successful).
+func TestParseAPIVersion_Success(test *testing.T) {
+	expect := expect(test)
+
+	version, err := ParseAPIVersion("2.10")
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	expect.EqualsInt("Version.Major", 2, version.Major)
+	expect.EqualsInt("Version.Minor", 10, version.Minor)
+	expect.EqualsString("Version.String", "2.10", version.String())
+	expect.IsTrue("2.9 < 2.10", APIVersion29.Less(version))
+
+	_, err = ParseAPIVersion("2")
+	expect.NotNil("Error (missing minor version)", err)
+
+	_, err = ParseAPIVersion("two.four")
+	expect.NotNil("Error (non-numeric)", err)
+}
+
+// Negotiate API version (successful).
+func TestClient_NegotiateAPIVersion_Success(test *testing.T) {
+	expect := expect(test)
+
+	requestPaths := []string{}
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			version, err := client.NegotiateAPIVersion()
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			// The end-point supports 2.10, but the client only supports up to 2.9.
+			expect.EqualsString("Version", "2.9", version.String())
+
+			_, err = client.GetVLAN("0e56433f-d808-4669-821d-812769517ff8")
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("RequestPaths.Length", 2, len(requestPaths))
+			expect.EqualsString("RequestPaths[0]", "/caas/apiVersion", requestPaths[0])
+			expect.IsTrue("RequestPaths[1]", strings.HasPrefix(requestPaths[1], "/caas/2.9/"))
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			requestPaths = append(requestPaths, request.URL.Path)
+
+			if request.URL.Path == "/caas/apiVersion" {
+				return http.StatusOK, apiVersionInfoTestResponse
+			}
+
+			return http.StatusOK, getVLANForAPIVersionTestResponse
+		},
+	})
+}
+
+// Negotiate API version (end-points are not invoked using versions later than they support).
+func TestClient_NegotiateAPIVersion_GetServer(test *testing.T) {
+	expect := expect(test)
+
+	requestPaths := []string{}
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			_, err := client.NegotiateAPIVersion()
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			server, err := client.GetServer("5a32d6e4-9707-4813-a269-56ab4d989f4d")
+			if err != nil {
+				test.Fatal(err)
+			}
+			verifyGetServerV27TestResponse(test, server)
+
+			expect.EqualsInt("RequestPaths.Length", 2, len(requestPaths))
+			expect.IsTrue("RequestPaths[1]", strings.HasPrefix(requestPaths[1], "/caas/2.7/"))
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			requestPaths = append(requestPaths, request.URL.Path)
+
+			if request.URL.Path == "/caas/apiVersion" {
+				return http.StatusOK, apiVersionInfoTestResponse
+			}
+
+			return http.StatusOK, getServerV27TestResponse
+		},
+	})
+}
+
+// Pinned API version.
+func TestClient_PinAPIVersion(test *testing.T) {
+	expect := expect(test)
+
+	client := NewClientWithBaseAddress("https://api.example.com", "user1", "password")
+
+	err := client.PinAPIVersion(APIVersion{Major: 2, Minor: 10})
+	expect.NotNil("Error (pin unsupported version)", err)
+
+	err = client.PinAPIVersion(APIVersion23)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	request, err := client.newRequestV22("my-organization-id/network/vlan", http.MethodGet, nil)
+	if err != nil {
+		test.Fatal(err)
+	}
+	expect.EqualsString("Request.URL.Path", "/caas/2.3/my-organization-id/network/vlan", request.URL.Path)
+
+	_, err = client.newRequestV24("my-organization-id/network/vlan", http.MethodGet, nil)
+	expect.NotNil("Error (end-point requires later version)", err)
+
+	client.UnpinAPIVersion()
+
+	request, err = client.newRequestV24("my-organization-id/network/vlan", http.MethodGet, nil)
+	if err != nil {
+		test.Fatal(err)
+	}
+	expect.EqualsString("Request.URL.Path", "/caas/2.4/my-organization-id/network/vlan", request.URL.Path)
+}
+
+// API version info capabilities.
+func TestAPIVersionInfo_Capabilities(test *testing.T) {
+	expect := expect(test)
+
+	versionInfo := &APIVersionInfo{
+		Supported:    []string{"2.4", "2.5"},
+		Deprecated:   []string{"2.4"},
+		Capabilities: []string{"SECURITY_GROUPS"},
+	}
+
+	expect.IsTrue("SupportsVersion(2.4)", versionInfo.SupportsVersion(APIVersion24))
+	expect.IsFalse("SupportsVersion(2.2)", versionInfo.SupportsVersion(APIVersion22))
+	expect.IsTrue("IsDeprecated(2.4)", versionInfo.IsDeprecated(APIVersion24))
+	expect.IsTrue("HasCapability(security_groups)", versionInfo.HasCapability("security_groups"))
+	expect.IsFalse("HasCapability(DRS)", versionInfo.HasCapability("DRS"))
+}
+
+/*
+ * Test responses.
+ */
+
+const apiVersionInfoTestResponse = `
+{
+	"current": "2.10",
+	"supported": ["2.2", "2.3", "2.4", "2.5", "2.6", "2.7", "2.8", "2.9", "2.10"],
+	"deprecated": ["2.2", "2.3"],
+	"capability": ["DRS", "SECURITY_GROUPS"]
+}
+`
+
+const getVLANForAPIVersionTestResponse = `
+{
+	"id": "0e56433f-d808-4669-821d-812769517ff8",
+	"name": "Production VLAN",
+	"state": "NORMAL"
+}
+`
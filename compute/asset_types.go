@@ -1,5 +1,7 @@
 package compute
 
+import "fmt"
+
 const (
 	// AssetTypeServer is an asset type representing a server.
 	AssetTypeServer = "SERVER"
@@ -11,11 +13,34 @@ const (
 	AssetTypeVLAN = "VLAN"
 
 	// AssetTypeCustomerImage is an asset type representing a customer image.
-	AssetTypeCustomerImage = "VLAN"
+	AssetTypeCustomerImage = "CUSTOMER_IMAGE"
 
 	// AssetTypePublicIPBlock is an asset type representing a public IP block.
 	AssetTypePublicIPBlock = "PUBLIC_IP_BLOCK"
 
 	// AssetTypeUser is an asset type representing a user.
-	AssetTypeUser = "PUBLIC_IP_BLOCK"
+	AssetTypeUser = "USER"
 )
+
+// getAssetType gets the asset type (used for tagging) that corresponds to the specified resource type.
+func getAssetType(resourceType ResourceType) (string, error) {
+	switch resourceType {
+	case ResourceTypeServer:
+		return AssetTypeServer, nil
+
+	case ResourceTypeNetworkDomain:
+		return AssetTypeNetworkDomain, nil
+
+	case ResourceTypeVLAN:
+		return AssetTypeVLAN, nil
+
+	case ResourceTypeCustomerImage:
+		return AssetTypeCustomerImage, nil
+
+	case ResourceTypePublicIPBlock:
+		return AssetTypePublicIPBlock, nil
+
+	default:
+		return "", fmt.Errorf("Resource type %d does not support tags.", resourceType)
+	}
+}
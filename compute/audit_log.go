@@ -0,0 +1,217 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// The date / time formats that may appear in audit log entries.
+var auditLogTimeFormats = []string{
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05.000Z",
+	time.RFC3339,
+}
+
+// AuditLogEntry represents an entry in the organisation's audit log.
+type AuditLogEntry struct {
+	// The entry's unique Id.
+	ID string
+
+	// The time at which the audited action occurred (UTC).
+	Time time.Time
+
+	// The name of the user who performed the action.
+	User string
+
+	// The type of item that the action was performed on.
+	Type string
+
+	// The name of the item that the action was performed on.
+	Name string
+
+	// The action that was performed.
+	Action string
+
+	// Additional details for the action (if any).
+	Details string
+
+	// The action's response code.
+	ResponseCode string
+
+	// All fields from the audit log entry (keyed by column name).
+	Fields ReportRecord
+}
+
+// GetAuditLog retrieves all audit log entries for the specified range of dates (inclusive).
+//
+// Entries are returned in chronological order (use StreamAuditLogReport to process entries as they are received).
+func (client *Client) GetAuditLog(startDate time.Time, endDate time.Time) (entries []AuditLogEntry, err error) {
+	entries, err = client.GetAuditLogReport(startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(entries, func(index1 int, index2 int) bool {
+		return entries[index1].Time.Before(entries[index2].Time)
+	})
+
+	return entries, nil
+}
+
+// AuditLogTail retrieves new audit log entries as they appear.
+//
+// Create an AuditLogTail by calling Client.TailAuditLog.
+type AuditLogTail struct {
+	client   *Client
+	ctx      context.Context
+	interval time.Duration
+	since    time.Time
+	pending  []AuditLogEntry
+	seen     map[string]time.Time
+	polled   bool
+}
+
+// TailAuditLog creates an AuditLogTail that returns audit log entries (starting from the specified time), polling for new entries at the specified interval.
+//
+// Each entry is only returned once. Cancel the context to stop tailing.
+func (client *Client) TailAuditLog(ctx context.Context, since time.Time, interval time.Duration) *AuditLogTail {
+	return &AuditLogTail{
+		client:   client,
+		ctx:      ctx,
+		interval: interval,
+		since:    since.UTC(),
+		seen:     make(map[string]time.Time),
+	}
+}
+
+// Next returns the next audit log entry, waiting for one to appear if necessary.
+//
+// Returns the context's error once the context has been cancelled.
+func (tail *AuditLogTail) Next() (*AuditLogEntry, error) {
+	for len(tail.pending) == 0 {
+		if tail.polled {
+			select {
+			case <-tail.ctx.Done():
+				return nil, tail.ctx.Err()
+			case <-time.After(tail.interval):
+			}
+		} else if err := tail.ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		err := tail.poll(time.Now().UTC())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	entry := tail.pending[0]
+	tail.pending = tail.pending[1:]
+
+	return &entry, nil
+}
+
+// StreamAuditLog tails the audit log (starting from the specified time), emitting each new entry on the returned channel (e.g. for forwarding to a SIEM).
+//
+// New entries are polled for at the specified interval. Cancel the context to stop streaming; both channels are then closed.
+// If polling fails, the error is sent on the error channel and both channels are closed.
+func (client *Client) StreamAuditLog(ctx context.Context, since time.Time, interval time.Duration) (<-chan AuditLogEntry, <-chan error) {
+	entries := make(chan AuditLogEntry)
+	errors := make(chan error, 1)
+
+	tail := client.TailAuditLog(ctx, since, interval)
+	go func() {
+		defer close(errors)
+		defer close(entries)
+
+		for {
+			entry, err := tail.Next()
+			if err != nil {
+				if ctx.Err() == nil {
+					errors <- err
+				}
+
+				return
+			}
+
+			select {
+			case entries <- *entry:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return entries, errors
+}
+
+// poll retrieves audit log entries that have appeared since the last poll.
+func (tail *AuditLogTail) poll(now time.Time) error {
+	tail.polled = true
+
+	entries, err := tail.client.GetAuditLog(tail.since, now)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if entry.Time.Before(tail.since) {
+			continue
+		}
+		if _, seen := tail.seen[entry.ID]; seen {
+			continue
+		}
+
+		tail.seen[entry.ID] = entry.Time
+		tail.pending = append(tail.pending, entry)
+	}
+
+	if len(tail.pending) == 0 {
+		return nil
+	}
+
+	// Subsequent polls only need to start from the most recent entry (entries with the same timestamp are de-duplicated by Id).
+	tail.since = tail.pending[len(tail.pending)-1].Time
+	for id, entryTime := range tail.seen {
+		if entryTime.Before(tail.since) {
+			delete(tail.seen, id)
+		}
+	}
+
+	return nil
+}
+
+// newAuditLogEntry creates an AuditLogEntry from a record in the audit log.
+func newAuditLogEntry(record ReportRecord) (entry *AuditLogEntry, err error) {
+	entry = &AuditLogEntry{
+		ID:           record.Get("UUID", "Id"),
+		User:         record.Get("User", "User Name"),
+		Type:         record.Get("Type"),
+		Name:         record.Get("Name"),
+		Action:       record.Get("Action"),
+		Details:      record.Get("Details"),
+		ResponseCode: record.Get("Response Code"),
+		Fields:       record,
+	}
+	entry.Time, err = parseAuditLogTime(record.Get("Date/Time", "Date", "Time"))
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// parseAuditLogTime parses the date / time of an audit log entry.
+func parseAuditLogTime(value string) (time.Time, error) {
+	for _, format := range auditLogTimeFormats {
+		parsed, err := time.Parse(format, strings.TrimSpace(value))
+		if err == nil {
+			return parsed.UTC(), nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("Invalid date / time '%s' in audit log", value)
+}
@@ -0,0 +1,141 @@
+package compute
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// Get audit log (successful).
+func TestClient_GetAuditLog_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			entries, err := client.GetAuditLog(
+				time.Date(2017, 3, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2017, 3, 2, 0, 0, 0, 0, time.UTC),
+			)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("Entries.Length", 2, len(entries))
+			expect.EqualsString("Entries[0].ID", "1d3f5c7e-0a1b-4c2d-8e3f-4a5b6c7d8e9f", entries[0].ID)
+			expect.EqualsString("Entries[0].User", "user1", entries[0].User)
+			expect.EqualsString("Entries[0].Action", "Deploy Server", entries[0].Action)
+			expect.IsTrue("Entries[0].Time", entries[0].Time.Equal(time.Date(2017, 3, 1, 10, 15, 0, 0, time.UTC)))
+			expect.EqualsString("Entries[1].ID", "2e4a6b8c-1d2e-4f3a-9b4c-5d6e7f8a9b0c", entries[1].ID)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			query := request.URL.Query()
+			expect.EqualsString("Request.StartDate", "2017-03-01", query.Get("startDate"))
+			expect.EqualsString("Request.EndDate", "2017-03-02", query.Get("endDate"))
+
+			return http.StatusOK, getAuditLogTestResponse
+		},
+	})
+}
+
+// Tail audit log (entries are returned once each, then cancellation stops tailing).
+func TestClient_TailAuditLog(test *testing.T) {
+	expect := expect(test)
+
+	pollCount := 0
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			tail := client.TailAuditLog(ctx, time.Date(2017, 3, 1, 10, 0, 0, 0, time.UTC), 10*time.Millisecond)
+
+			var ids []string
+			for len(ids) < 3 {
+				entry, err := tail.Next()
+				if err != nil {
+					test.Fatal(err)
+				}
+				ids = append(ids, entry.ID)
+			}
+
+			expect.EqualsString("IDs[0]", "1d3f5c7e-0a1b-4c2d-8e3f-4a5b6c7d8e9f", ids[0])
+			expect.EqualsString("IDs[1]", "2e4a6b8c-1d2e-4f3a-9b4c-5d6e7f8a9b0c", ids[1])
+			expect.EqualsString("IDs[2]", "3f5b7c9d-2e3f-4a4b-8c5d-6e7f8a9b0c1d", ids[2])
+
+			cancel()
+			_, err := tail.Next()
+			expect.IsTrue("Error is context.Canceled", err == context.Canceled)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			pollCount++
+			if pollCount == 1 {
+				return http.StatusOK, getAuditLogTestResponse
+			}
+
+			return http.StatusOK, getAuditLogTestResponse + getAuditLogAdditionalTestResponse
+		},
+	})
+}
+
+// Stream audit log (entries are emitted over a channel until cancelled).
+func TestClient_StreamAuditLog(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			entries, errors := client.StreamAuditLog(ctx, time.Date(2017, 3, 1, 10, 0, 0, 0, time.UTC), 10*time.Millisecond)
+
+			var ids []string
+			for entry := range entries {
+				ids = append(ids, entry.ID)
+				if len(ids) == 2 {
+					cancel()
+				}
+			}
+
+			expect.EqualsInt("IDs.Length", 2, len(ids))
+			expect.EqualsString("IDs[0]", "1d3f5c7e-0a1b-4c2d-8e3f-4a5b6c7d8e9f", ids[0])
+			expect.EqualsString("IDs[1]", "2e4a6b8c-1d2e-4f3a-9b4c-5d6e7f8a9b0c", ids[1])
+
+			err, ok := <-errors
+			expect.IsFalse("Error channel received an error", ok && err != nil)
+		},
+		Respond: testRespondOK(getAuditLogTestResponse),
+	})
+}
+
+// Stream audit log (polling fails).
+func TestClient_StreamAuditLog_Error(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			entries, errors := client.StreamAuditLog(context.Background(), time.Date(2017, 3, 1, 10, 0, 0, 0, time.UTC), 10*time.Millisecond)
+
+			for range entries {
+				test.Fatal("Unexpected audit log entry.")
+			}
+
+			expect.NotNil("Error", <-errors)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			return http.StatusOK, "UUID,Name,User,Type,Action,Details,Date/Time,Response Code\n1,web-01,user1,Server,Deploy Server,,not-a-date,SUCCESS\n"
+		},
+	})
+}
+
+/*
+ * Test responses.
+ */
+
+const getAuditLogTestResponse = `UUID,Name,User,Type,Action,Details,Date/Time,Response Code
+2e4a6b8c-1d2e-4f3a-9b4c-5d6e7f8a9b0c,web-01,user1,Server,Start Server,,2017-03-01 10:20:00,SUCCESS
+1d3f5c7e-0a1b-4c2d-8e3f-4a5b6c7d8e9f,web-01,user1,Server,Deploy Server,"Image ""Ubuntu 16.04""",2017-03-01 10:15:00,SUCCESS
+`
+
+const getAuditLogAdditionalTestResponse = `3f5b7c9d-2e3f-4a4b-8c5d-6e7f8a9b0c1d,web-01,user2,Server,Shutdown Server,,2017-03-01 10:30:00,SUCCESS
+`
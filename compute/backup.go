@@ -0,0 +1,387 @@
+package compute
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const (
+	// BackupServicePlanEssentials represents the Essentials service plan for cloud backup.
+	BackupServicePlanEssentials = "Essentials"
+
+	// BackupServicePlanAdvanced represents the Advanced service plan for cloud backup.
+	BackupServicePlanAdvanced = "Advanced"
+
+	// BackupServicePlanEnterprise represents the Enterprise service plan for cloud backup.
+	BackupServicePlanEnterprise = "Enterprise"
+)
+
+const (
+	// BackupClientTypeLinuxFileSystem represents the backup client type for Linux file systems.
+	BackupClientTypeLinuxFileSystem = "FA.Linux"
+
+	// BackupClientTypeWindowsFileSystem represents the backup client type for Windows file systems.
+	BackupClientTypeWindowsFileSystem = "FA.Win"
+
+	// BackupClientTypeMySQL represents the backup client type for MySQL databases.
+	BackupClientTypeMySQL = "MySQL"
+
+	// BackupClientTypePostgreSQL represents the backup client type for PostgreSQL databases.
+	BackupClientTypePostgreSQL = "PostgreSQL"
+
+	// BackupClientTypeSQLServer represents the backup client type for Microsoft SQL Server databases.
+	BackupClientTypeSQLServer = "MSSQL"
+)
+
+const (
+	// BackupAlertTriggerOnFailure indicates that alerts should be sent when a backup fails.
+	BackupAlertTriggerOnFailure = "ON_FAILURE"
+
+	// BackupAlertTriggerOnSuccess indicates that alerts should be sent when a backup succeeds.
+	BackupAlertTriggerOnSuccess = "ON_SUCCESS"
+
+	// BackupAlertTriggerOnSuccessOrFailure indicates that alerts should be sent when a backup succeeds or fails.
+	BackupAlertTriggerOnSuccessOrFailure = "ON_SUCCESS_OR_FAILURE"
+)
+
+// ServerBackup represents the summary of a server's cloud backup configuration (as returned with the server's details).
+type ServerBackup struct {
+	// The server's backup asset Id.
+	AssetID string `json:"assetId"`
+
+	// The server's backup service plan.
+	ServicePlan string `json:"servicePlan"`
+
+	// The current state of the server's backup configuration.
+	State string `json:"state"`
+}
+
+// ServerBackupDetails represents the cloud backup configuration for a server.
+type ServerBackupDetails struct {
+	// The XML name for the "ServerBackupDetails" data contract
+	XMLName xml.Name `xml:"BackupDetails"`
+
+	// The server's backup asset Id.
+	AssetID string `xml:"assetId,attr"`
+
+	// The server's backup service plan.
+	ServicePlan string `xml:"servicePlan,attr"`
+
+	// The current state of the server's backup configuration.
+	State string `xml:"state,attr"`
+
+	// The server's backup clients (if any).
+	Clients []BackupClientDetail `xml:"backupClient"`
+}
+
+// GetClient retrieves the backup client with the specified Id.
+//
+// Returns nil if no backup client was found with the specified Id.
+func (backupDetails *ServerBackupDetails) GetClient(clientID string) *BackupClientDetail {
+	for index := range backupDetails.Clients {
+		if backupDetails.Clients[index].ID == clientID {
+			return &backupDetails.Clients[index]
+		}
+	}
+
+	return nil
+}
+
+// BackupClientDetail represents a cloud backup client on a server.
+type BackupClientDetail struct {
+	// The backup client Id.
+	ID string `xml:"id,attr"`
+
+	// The backup client type (e.g. FA.Linux).
+	Type string `xml:"type,attr"`
+
+	// Is the backup client a file-system client?
+	IsFileSystem bool `xml:"isFileSystem,attr"`
+
+	// The backup client's current status.
+	Status string `xml:"status,attr"`
+
+	// The backup client description.
+	Description string `xml:"description"`
+
+	// The name of the backup client's schedule policy.
+	SchedulePolicyName string `xml:"schedulePolicyName"`
+
+	// The name of the backup client's storage policy.
+	StoragePolicyName string `xml:"storagePolicyName"`
+
+	// The backup client's alerting configuration (if any).
+	Alerting *BackupClientAlerting `xml:"alerting,omitempty"`
+
+	// The URL from which the backup client agent can be downloaded.
+	DownloadURL string `xml:"downloadUrl"`
+}
+
+// BackupClientAlerting represents the alerting configuration for a cloud backup client.
+type BackupClientAlerting struct {
+	// When alerts should be sent (ON_FAILURE, ON_SUCCESS, or ON_SUCCESS_OR_FAILURE).
+	Trigger string `xml:"trigger,attr"`
+
+	// The e-mail addresses to which alerts should be sent.
+	EmailAddresses []string `xml:"emailAddress"`
+}
+
+// Request body when enabling cloud backup for a server.
+type newBackup struct {
+	// The XML name for the "newBackup" data contract
+	XMLName xml.Name `xml:"http://oec.api.opsource.net/schemas/backup NewBackup"`
+
+	// The backup service plan.
+	ServicePlan string `xml:"servicePlan,attr"`
+}
+
+// Request body when adding a backup client to a server.
+type newBackupClient struct {
+	// The XML name for the "newBackupClient" data contract
+	XMLName xml.Name `xml:"http://oec.api.opsource.net/schemas/backup NewBackupClient"`
+
+	// The backup client type.
+	Type string `xml:"type"`
+
+	// The name of the backup client's storage policy.
+	StoragePolicyName string `xml:"storagePolicyName"`
+
+	// The name of the backup client's schedule policy.
+	SchedulePolicyName string `xml:"schedulePolicyName"`
+
+	// The backup client's alerting configuration (if any).
+	Alerting *BackupClientAlerting `xml:"alerting,omitempty"`
+}
+
+// Request body when modifying a server's backup client.
+type modifyBackupClient struct {
+	// The XML name for the "modifyBackupClient" data contract
+	XMLName xml.Name `xml:"http://oec.api.opsource.net/schemas/backup ModifyBackupClient"`
+
+	// The name of the backup client's storage policy.
+	StoragePolicyName string `xml:"storagePolicyName"`
+
+	// The name of the backup client's schedule policy.
+	SchedulePolicyName string `xml:"schedulePolicyName"`
+
+	// The backup client's alerting configuration (if any).
+	Alerting *BackupClientAlerting `xml:"alerting,omitempty"`
+}
+
+// EnableServerBackup enables cloud backup for the specified server, using the specified service plan.
+func (client *Client) EnableServerBackup(serverID string, servicePlan string) error {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return err
+	}
+
+	requestURI := fmt.Sprintf("%s/server/%s/backup",
+		url.QueryEscape(organizationID),
+		url.QueryEscape(serverID),
+	)
+	request, err := client.newRequestV1(requestURI, http.MethodPost, &newBackup{
+		ServicePlan: servicePlan,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = client.executeBackupRequest(request, "enable backup for server '%s'", serverID)
+
+	return err
+}
+
+// DisableServerBackup disables cloud backup for the specified server.
+//
+// All backup clients must be removed from the server before backup can be disabled.
+func (client *Client) DisableServerBackup(serverID string) error {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return err
+	}
+
+	requestURI := fmt.Sprintf("%s/server/%s/backup?disable",
+		url.QueryEscape(organizationID),
+		url.QueryEscape(serverID),
+	)
+	request, err := client.newRequestV1(requestURI, http.MethodGet, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.executeBackupRequest(request, "disable backup for server '%s'", serverID)
+
+	return err
+}
+
+// GetServerBackupDetails retrieves the cloud backup configuration for the specified server.
+func (client *Client) GetServerBackupDetails(serverID string) (backupDetails *ServerBackupDetails, err error) {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return nil, err
+	}
+
+	requestURI := fmt.Sprintf("%s/server/%s/backup",
+		url.QueryEscape(organizationID),
+		url.QueryEscape(serverID),
+	)
+	request, err := client.newRequestV1(requestURI, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode != http.StatusOK {
+		var apiResponse *APIResponseV1
+
+		apiResponse, err = readAPIResponseV1(responseBody, statusCode)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, apiResponse.ToError("Request to retrieve backup details for server '%s' failed with status code %d (%s): %s", serverID, statusCode, apiResponse.ResultCode, apiResponse.Message)
+	}
+
+	backupDetails = &ServerBackupDetails{}
+	err = xml.Unmarshal(responseBody, backupDetails)
+	if err != nil {
+		return nil, err
+	}
+
+	return backupDetails, nil
+}
+
+// AddServerBackupClient adds a backup client to the specified server.
+//
+// alerting is optional (pass nil to disable alerting for the backup client).
+//
+// Returns the Id of the new backup client, and the URL from which the backup client agent can be downloaded.
+func (client *Client) AddServerBackupClient(serverID string, clientType string, storagePolicyName string, schedulePolicyName string, alerting *BackupClientAlerting) (clientID string, downloadURL string, err error) {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return "", "", err
+	}
+
+	requestURI := fmt.Sprintf("%s/server/%s/backup/client",
+		url.QueryEscape(organizationID),
+		url.QueryEscape(serverID),
+	)
+	request, err := client.newRequestV1(requestURI, http.MethodPost, &newBackupClient{
+		Type:               clientType,
+		StoragePolicyName:  storagePolicyName,
+		SchedulePolicyName: schedulePolicyName,
+		Alerting:           alerting,
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	apiResponse, err := client.executeBackupRequest(request, "add backup client to server '%s'", serverID)
+	if err != nil {
+		return "", "", err
+	}
+
+	newClientID := apiResponse.GetAdditionalInformation("backupClient.id")
+	if newClientID == nil {
+		return "", "", apiResponse.ToError("Invalid response (missing 'backupClient.id')")
+	}
+
+	newClientDownloadURL := apiResponse.GetAdditionalInformation("backupClient.downloadUrl")
+	if newClientDownloadURL != nil {
+		downloadURL = *newClientDownloadURL
+	}
+
+	return *newClientID, downloadURL, nil
+}
+
+// ModifyServerBackupClient modifies the configuration of a server's backup client.
+//
+// alerting is optional (pass nil to disable alerting for the backup client).
+func (client *Client) ModifyServerBackupClient(serverID string, clientID string, storagePolicyName string, schedulePolicyName string, alerting *BackupClientAlerting) error {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return err
+	}
+
+	requestURI := fmt.Sprintf("%s/server/%s/backup/client/%s/modify",
+		url.QueryEscape(organizationID),
+		url.QueryEscape(serverID),
+		url.QueryEscape(clientID),
+	)
+	request, err := client.newRequestV1(requestURI, http.MethodPost, &modifyBackupClient{
+		StoragePolicyName:  storagePolicyName,
+		SchedulePolicyName: schedulePolicyName,
+		Alerting:           alerting,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = client.executeBackupRequest(request, "modify backup client '%s' on server '%s'", clientID, serverID)
+
+	return err
+}
+
+// RemoveServerBackupClient removes a backup client from the specified server.
+func (client *Client) RemoveServerBackupClient(serverID string, clientID string) error {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return err
+	}
+
+	requestURI := fmt.Sprintf("%s/server/%s/backup/client/%s?remove",
+		url.QueryEscape(organizationID),
+		url.QueryEscape(serverID),
+		url.QueryEscape(clientID),
+	)
+	request, err := client.newRequestV1(requestURI, http.MethodGet, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.executeBackupRequest(request, "remove backup client '%s' from server '%s'", clientID, serverID)
+
+	return err
+}
+
+// GetBackupClientDownloadURL retrieves the URL from which the agent for the specified backup client can be downloaded.
+func (client *Client) GetBackupClientDownloadURL(serverID string, clientID string) (downloadURL string, err error) {
+	backupDetails, err := client.GetServerBackupDetails(serverID)
+	if err != nil {
+		return "", err
+	}
+
+	backupClient := backupDetails.GetClient(clientID)
+	if backupClient == nil {
+		return "", fmt.Errorf("No backup client was found with Id '%s' on server '%s'", clientID, serverID)
+	}
+
+	return backupClient.DownloadURL, nil
+}
+
+// executeBackupRequest executes a request to the cloud backup API and verifies that it was successful.
+func (client *Client) executeBackupRequest(request *http.Request, operationDescriptionOrFormat string, formatArgs ...interface{}) (apiResponse *APIResponseV1, err error) {
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	apiResponse, err = readAPIResponseV1(responseBody, statusCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if apiResponse.Result != ResultSuccess {
+		operationDescription := fmt.Sprintf(operationDescriptionOrFormat, formatArgs...)
+
+		return nil, apiResponse.ToError("Request to %s failed with status code %d (%s): %s", operationDescription, statusCode, apiResponse.ResultCode, apiResponse.Message)
+	}
+
+	return apiResponse, nil
+}
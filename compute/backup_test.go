@@ -0,0 +1,208 @@
+package compute
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// Enable server backup (successful).
+func TestClient_EnableServerBackup_Success(test *testing.T) {
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			err := client.EnableServerBackup("5a32d6e4-9707-4813-a269-56ab4d989f4d", BackupServicePlanEssentials)
+			if err != nil {
+				test.Fatal(err)
+			}
+		},
+		Respond: testValidateXMLRequestAndRespondOK(enableServerBackupTestResponse, &newBackup{}, verifyEnableServerBackupTestRequest),
+	})
+}
+
+// Get server backup details (successful).
+func TestClient_GetServerBackupDetails_Success(test *testing.T) {
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			backupDetails, err := client.GetServerBackupDetails("5a32d6e4-9707-4813-a269-56ab4d989f4d")
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			verifyGetServerBackupDetailsTestResponse(test, backupDetails)
+		},
+		Respond: testRespondOK(getServerBackupDetailsTestResponse),
+	})
+}
+
+// Add server backup client (successful).
+func TestClient_AddServerBackupClient_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			clientID, downloadURL, err := client.AddServerBackupClient("5a32d6e4-9707-4813-a269-56ab4d989f4d",
+				BackupClientTypeLinuxFileSystem,
+				"30 Day Storage Policy",
+				"12AM - 6AM",
+				&BackupClientAlerting{
+					Trigger:        BackupAlertTriggerOnFailure,
+					EmailAddresses: []string{"ops@example.com"},
+				},
+			)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsString("ClientID", "1ec9ceac-5ab2-4ae9-a6e4-31f2a0ca2e49", clientID)
+			expect.EqualsString("DownloadURL", "https://backup.example.com/download?id=1ec9ceac-5ab2-4ae9-a6e4-31f2a0ca2e49", downloadURL)
+		},
+		Respond: testValidateXMLRequestAndRespondOK(addServerBackupClientTestResponse, &newBackupClient{}, verifyAddServerBackupClientTestRequest),
+	})
+}
+
+// Remove server backup client (failed).
+func TestClient_RemoveServerBackupClient_Failed(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			err := client.RemoveServerBackupClient("5a32d6e4-9707-4813-a269-56ab4d989f4d", "1ec9ceac-5ab2-4ae9-a6e4-31f2a0ca2e49")
+			expect.NotNil("Error", err)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.IsTrue("Request.URL", strings.HasSuffix(request.URL.Path, "/backup/client/1ec9ceac-5ab2-4ae9-a6e4-31f2a0ca2e49"))
+			expect.IsTrue("Request.Query", request.URL.RawQuery == "remove")
+
+			return http.StatusBadRequest, removeServerBackupClientFailedTestResponse
+		},
+	})
+}
+
+// Get backup client download URL (successful).
+func TestClient_GetBackupClientDownloadURL_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			downloadURL, err := client.GetBackupClientDownloadURL("5a32d6e4-9707-4813-a269-56ab4d989f4d", "1ec9ceac-5ab2-4ae9-a6e4-31f2a0ca2e49")
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsString("DownloadURL", "https://backup.example.com/download?id=1ec9ceac-5ab2-4ae9-a6e4-31f2a0ca2e49", downloadURL)
+		},
+		Respond: testRespondOK(getServerBackupDetailsTestResponse),
+	})
+}
+
+/*
+ * Test requests.
+ */
+
+const enableServerBackupTestRequest = `
+<NewBackup xmlns="http://oec.api.opsource.net/schemas/backup" servicePlan="Essentials"/>
+`
+
+func verifyEnableServerBackupTestRequest(test *testing.T, requestBody interface{}) {
+	expect := expect(test)
+
+	expect.NotNil("NewBackup", requestBody)
+	request := requestBody.(*newBackup)
+
+	expect.EqualsString("NewBackup.ServicePlan", BackupServicePlanEssentials, request.ServicePlan)
+}
+
+const addServerBackupClientTestRequest = `
+<NewBackupClient xmlns="http://oec.api.opsource.net/schemas/backup">
+	<type>FA.Linux</type>
+	<storagePolicyName>30 Day Storage Policy</storagePolicyName>
+	<schedulePolicyName>12AM - 6AM</schedulePolicyName>
+	<alerting trigger="ON_FAILURE">
+		<emailAddress>ops@example.com</emailAddress>
+	</alerting>
+</NewBackupClient>
+`
+
+func verifyAddServerBackupClientTestRequest(test *testing.T, requestBody interface{}) {
+	expect := expect(test)
+
+	expect.NotNil("NewBackupClient", requestBody)
+	request := requestBody.(*newBackupClient)
+
+	expect.EqualsString("NewBackupClient.Type", BackupClientTypeLinuxFileSystem, request.Type)
+	expect.EqualsString("NewBackupClient.StoragePolicyName", "30 Day Storage Policy", request.StoragePolicyName)
+	expect.EqualsString("NewBackupClient.SchedulePolicyName", "12AM - 6AM", request.SchedulePolicyName)
+	expect.NotNil("NewBackupClient.Alerting", request.Alerting)
+	expect.EqualsString("NewBackupClient.Alerting.Trigger", BackupAlertTriggerOnFailure, request.Alerting.Trigger)
+	expect.EqualsInt("NewBackupClient.Alerting.EmailAddresses.Length", 1, len(request.Alerting.EmailAddresses))
+	expect.EqualsString("NewBackupClient.Alerting.EmailAddresses[0]", "ops@example.com", request.Alerting.EmailAddresses[0])
+}
+
+/*
+ * Test responses.
+ */
+
+const enableServerBackupTestResponse = `
+<Status>
+	<operation>Enable Backup for Server</operation>
+	<result>SUCCESS</result>
+	<resultDetail>Backup enabled for Server - Job submitted</resultDetail>
+	<resultCode>RESULT_0</resultCode>
+</Status>
+`
+
+const getServerBackupDetailsTestResponse = `
+<BackupDetails xmlns="http://oec.api.opsource.net/schemas/backup" assetId="91002e08-8dc1-47a1-ad33-04f501c06f87" servicePlan="Essentials" state="NORMAL">
+	<backupClient id="1ec9ceac-5ab2-4ae9-a6e4-31f2a0ca2e49" type="FA.Linux" isFileSystem="true" status="Unregistered">
+		<description>Linux File system</description>
+		<schedulePolicyName>12AM - 6AM</schedulePolicyName>
+		<storagePolicyName>30 Day Storage Policy</storagePolicyName>
+		<alerting trigger="ON_FAILURE">
+			<emailAddress>ops@example.com</emailAddress>
+		</alerting>
+		<downloadUrl>https://backup.example.com/download?id=1ec9ceac-5ab2-4ae9-a6e4-31f2a0ca2e49</downloadUrl>
+	</backupClient>
+</BackupDetails>
+`
+
+func verifyGetServerBackupDetailsTestResponse(test *testing.T, backupDetails *ServerBackupDetails) {
+	expect := expect(test)
+
+	expect.NotNil("ServerBackupDetails", backupDetails)
+	expect.EqualsString("ServerBackupDetails.AssetID", "91002e08-8dc1-47a1-ad33-04f501c06f87", backupDetails.AssetID)
+	expect.EqualsString("ServerBackupDetails.ServicePlan", BackupServicePlanEssentials, backupDetails.ServicePlan)
+	expect.EqualsString("ServerBackupDetails.State", "NORMAL", backupDetails.State)
+
+	expect.EqualsInt("ServerBackupDetails.Clients.Length", 1, len(backupDetails.Clients))
+	backupClient := backupDetails.Clients[0]
+	expect.EqualsString("ServerBackupDetails.Clients[0].ID", "1ec9ceac-5ab2-4ae9-a6e4-31f2a0ca2e49", backupClient.ID)
+	expect.EqualsString("ServerBackupDetails.Clients[0].Type", BackupClientTypeLinuxFileSystem, backupClient.Type)
+	expect.IsTrue("ServerBackupDetails.Clients[0].IsFileSystem", backupClient.IsFileSystem)
+	expect.EqualsString("ServerBackupDetails.Clients[0].StoragePolicyName", "30 Day Storage Policy", backupClient.StoragePolicyName)
+	expect.NotNil("ServerBackupDetails.Clients[0].Alerting", backupClient.Alerting)
+	expect.EqualsString("ServerBackupDetails.Clients[0].Alerting.Trigger", BackupAlertTriggerOnFailure, backupClient.Alerting.Trigger)
+}
+
+const addServerBackupClientTestResponse = `
+<Status>
+	<operation>Add Backup Client</operation>
+	<result>SUCCESS</result>
+	<resultDetail>Backup Client added</resultDetail>
+	<resultCode>RESULT_0</resultCode>
+	<additionalInformation name="backupClient.id">
+		<value>1ec9ceac-5ab2-4ae9-a6e4-31f2a0ca2e49</value>
+	</additionalInformation>
+	<additionalInformation name="backupClient.downloadUrl">
+		<value>https://backup.example.com/download?id=1ec9ceac-5ab2-4ae9-a6e4-31f2a0ca2e49</value>
+	</additionalInformation>
+</Status>
+`
+
+const removeServerBackupClientFailedTestResponse = `
+<Status>
+	<operation>Remove Backup Client</operation>
+	<result>ERROR</result>
+	<resultDetail>Backup client has a running job and cannot be removed.</resultDetail>
+	<resultCode>REASON_549</resultCode>
+</Status>
+`
@@ -0,0 +1,163 @@
+package compute
+
+import (
+	"fmt"
+	"sync"
+)
+
+// BatchOperation represents an operation to be performed on a single item (usually a resource Id) as part of a batch.
+type BatchOperation func(item string) error
+
+// Batch runs an operation against multiple items (e.g. deleting 50 servers, or tagging 200 assets), with a limit on the number of operations that run concurrently.
+type Batch struct {
+	// The maximum number of operations to run concurrently.
+	Concurrency int
+
+	// Continue processing remaining items after an operation fails?
+	//
+	// If false, no further operations are started once an operation has failed (operations that are already running are allowed to complete).
+	ContinueOnError bool
+}
+
+// BatchItemResult represents the result of a batch operation for a single item.
+type BatchItemResult struct {
+	// The item that the operation was performed on.
+	Item string
+
+	// The error (if any) returned by the operation.
+	Error error
+
+	// Was the operation skipped (because an earlier operation failed)?
+	Skipped bool
+}
+
+// BatchResult represents the aggregated results of a batch.
+type BatchResult struct {
+	// The per-item results (in the same order as the items passed to Batch.Run).
+	Items []BatchItemResult
+}
+
+// BatchError is returned when one or more operations in a batch fail.
+type BatchError struct {
+	// The results for the items whose operations failed.
+	Failed []BatchItemResult
+}
+
+// Error returns the error message associated with the BatchError.
+func (err *BatchError) Error() string {
+	if len(err.Failed) == 1 {
+		return fmt.Sprintf("Batch operation failed for '%s': %s", err.Failed[0].Item, err.Failed[0].Error)
+	}
+
+	return fmt.Sprintf("Batch operation failed for %d items (first failure was for '%s': %s)",
+		len(err.Failed),
+		err.Failed[0].Item,
+		err.Failed[0].Error,
+	)
+}
+
+var _ error = &BatchError{}
+
+// NewBatch creates a new Batch that runs up to the specified number of operations concurrently and continues processing after failures.
+func NewBatch(concurrency int) *Batch {
+	return &Batch{
+		Concurrency:     concurrency,
+		ContinueOnError: true,
+	}
+}
+
+// Run performs the operation on each of the specified items.
+//
+// Returns the per-item results and, if any operation failed, a *BatchError describing the failures.
+func (batch *Batch) Run(items []string, operation BatchOperation) (*BatchResult, error) {
+	concurrency := batch.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	result := &BatchResult{
+		Items: make([]BatchItemResult, len(items)),
+	}
+
+	var (
+		stateLock sync.Mutex
+		failed    bool
+		waitGroup sync.WaitGroup
+	)
+	semaphore := make(chan struct{}, concurrency)
+	for index, item := range items {
+		result.Items[index].Item = item
+
+		semaphore <- struct{}{}
+
+		stateLock.Lock()
+		skip := failed && !batch.ContinueOnError
+		stateLock.Unlock()
+		if skip {
+			<-semaphore
+			result.Items[index].Skipped = true
+
+			continue
+		}
+
+		waitGroup.Add(1)
+		go func(index int, item string) {
+			defer waitGroup.Done()
+			defer func() { <-semaphore }()
+
+			err := operation(item)
+			if err != nil {
+				stateLock.Lock()
+				failed = true
+				stateLock.Unlock()
+			}
+			result.Items[index].Error = err
+		}(index, item)
+	}
+	waitGroup.Wait()
+
+	failures := result.Failed()
+	if len(failures) > 0 {
+		return result, &BatchError{
+			Failed: failures,
+		}
+	}
+
+	return result, nil
+}
+
+// Succeeded gets the items whose operations succeeded.
+func (result *BatchResult) Succeeded() []string {
+	var succeeded []string
+	for _, itemResult := range result.Items {
+		if itemResult.Error == nil && !itemResult.Skipped {
+			succeeded = append(succeeded, itemResult.Item)
+		}
+	}
+
+	return succeeded
+}
+
+// Failed gets the results for the items whose operations failed.
+func (result *BatchResult) Failed() []BatchItemResult {
+	var failed []BatchItemResult
+	for _, itemResult := range result.Items {
+		if itemResult.Error != nil {
+			failed = append(failed, itemResult)
+		}
+	}
+
+	return failed
+}
+
+// Skipped gets the items whose operations were skipped (because an earlier operation failed).
+func (result *BatchResult) Skipped() []string {
+	var skipped []string
+	for _, itemResult := range result.Items {
+		if itemResult.Skipped {
+			skipped = append(skipped, itemResult.Item)
+		}
+	}
+
+	return skipped
+}
@@ -0,0 +1,99 @@
+package compute
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Run batch (successful, concurrency limited).
+func TestBatch_Run_Success(test *testing.T) {
+	expect := expect(test)
+
+	var (
+		stateLock      sync.Mutex
+		running        int
+		maxRunning     int
+		processedItems = make(map[string]bool)
+	)
+
+	items := make([]string, 20)
+	for index := range items {
+		items[index] = fmt.Sprintf("server-%d", index)
+	}
+
+	result, err := NewBatch(3).Run(items, func(item string) error {
+		stateLock.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		stateLock.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+
+		stateLock.Lock()
+		running--
+		processedItems[item] = true
+		stateLock.Unlock()
+
+		return nil
+	})
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	expect.EqualsInt("BatchResult.Items.Length", 20, len(result.Items))
+	expect.EqualsInt("BatchResult.Succeeded.Length", 20, len(result.Succeeded()))
+	expect.EqualsInt("ProcessedItems.Length", 20, len(processedItems))
+	expect.EqualsString("BatchResult.Items[7].Item", "server-7", result.Items[7].Item)
+	expect.IsTrue("MaxRunning <= 3", maxRunning <= 3)
+}
+
+// Run batch (some operations fail).
+func TestBatch_Run_Failures(test *testing.T) {
+	expect := expect(test)
+
+	result, err := NewBatch(2).Run([]string{"a", "b", "c", "d"}, func(item string) error {
+		if item == "b" || item == "d" {
+			return fmt.Errorf("Failed to process '%s'", item)
+		}
+
+		return nil
+	})
+	expect.NotNil("Error", err)
+
+	batchError, ok := err.(*BatchError)
+	expect.IsTrue("Error is BatchError", ok)
+	expect.EqualsInt("BatchError.Failed.Length", 2, len(batchError.Failed))
+	expect.EqualsString("BatchError.Failed[0].Item", "b", batchError.Failed[0].Item)
+	expect.EqualsString("BatchError.Failed[1].Item", "d", batchError.Failed[1].Item)
+
+	succeeded := result.Succeeded()
+	expect.EqualsInt("BatchResult.Succeeded.Length", 2, len(succeeded))
+	expect.EqualsString("BatchResult.Succeeded[0]", "a", succeeded[0])
+	expect.EqualsString("BatchResult.Succeeded[1]", "c", succeeded[1])
+}
+
+// Run batch (stop after first failure).
+func TestBatch_Run_StopOnError(test *testing.T) {
+	expect := expect(test)
+
+	batch := &Batch{
+		Concurrency:     1,
+		ContinueOnError: false,
+	}
+	result, err := batch.Run([]string{"a", "b", "c"}, func(item string) error {
+		if item == "a" {
+			return fmt.Errorf("Failed to process '%s'", item)
+		}
+
+		return nil
+	})
+	expect.NotNil("Error", err)
+
+	skipped := result.Skipped()
+	expect.EqualsInt("BatchResult.Skipped.Length", 2, len(skipped))
+	expect.EqualsInt("BatchResult.Succeeded.Length", 0, len(result.Succeeded()))
+}
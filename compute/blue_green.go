@@ -0,0 +1,431 @@
+package compute
+
+import (
+	"fmt"
+	"log"
+	"time"
+)
+
+// BlueGreenDeployment represents the configuration for a blue / green deployment behind a VIP pool.
+//
+// Replacement ("green") servers are deployed from the new customer image, added to the pool, and then the existing ("blue") pool members are removed and their servers decommissioned.
+type BlueGreenDeployment struct {
+	// The Id of the VIP pool whose members will be replaced.
+	PoolID string
+
+	// The Id of the customer image from which replacement servers will be deployed.
+	ImageID string
+
+	// The template for each replacement server.
+	//
+	// The image's CPU, memory, and disk configuration is applied to the template; each server's name is the template name followed by "-1", "-2", etc.
+	Server ServerDeploymentConfiguration
+
+	// The number of replacement servers to deploy (must be at least 1).
+	ServerCount int
+
+	// The port (if any) on which each replacement server's pool member will receive traffic.
+	Port *int
+
+	// The Id of the health monitor (if any) for each replacement server's VIP node.
+	HealthMonitorID string
+
+	// The connection limit for each replacement server's VIP node.
+	ConnectionLimit int
+
+	// The connection rate limit for each replacement server's VIP node.
+	ConnectionRateLimit int
+
+	// An optional function that determines whether a replacement server is healthy (return an error if it is not).
+	//
+	// Called once the server has been deployed and its VIP node created, before the server is added to the pool.
+	HealthCheck func(server *Server) error
+
+	// The maximum amount of time to wait for each individual operation (deploy, delete, etc) to complete (must be greater than zero).
+	Timeout time.Duration
+}
+
+// Validate determines whether the BlueGreenDeployment is valid.
+func (deployment *BlueGreenDeployment) Validate() error {
+	if deployment.PoolID == "" {
+		return fmt.Errorf("Must specify the Id of the VIP pool whose members will be replaced")
+	}
+	if deployment.ImageID == "" {
+		return fmt.Errorf("Must specify the Id of the customer image from which replacement servers will be deployed")
+	}
+	if deployment.Server.Name == "" {
+		return fmt.Errorf("Must specify the name of the replacement servers")
+	}
+	if deployment.ServerCount < 1 {
+		return fmt.Errorf("Invalid server count %d for VIP pool '%s' (must deploy at least 1 replacement server)", deployment.ServerCount, deployment.PoolID)
+	}
+	if deployment.Timeout <= 0 {
+		return fmt.Errorf("Invalid timeout %s for VIP pool '%s' (must be greater than zero)", deployment.Timeout, deployment.PoolID)
+	}
+
+	return nil
+}
+
+// BlueGreenDeploymentResult represents the resources created and removed by a blue / green deployment.
+type BlueGreenDeploymentResult struct {
+	// The Ids of the replacement servers.
+	NewServerIDs []string
+
+	// The Ids of the replacement servers' VIP nodes.
+	NewNodeIDs []string
+
+	// The Ids of the replacement servers' pool members.
+	NewPoolMemberIDs []string
+
+	// The Ids of the pool members that were removed.
+	RemovedPoolMemberIDs []string
+
+	// The Ids of the VIP nodes that were deleted.
+	DeletedNodeIDs []string
+
+	// The Ids of the VIP nodes (and their servers) that were retained because they are also members of other pools.
+	RetainedNodeIDs []string
+
+	// The Ids of the servers that were decommissioned.
+	DecommissionedServerIDs []string
+
+	// Were the replacement servers (and their VIP nodes and pool members) rolled back because a replacement server could not be brought up?
+	RolledBack bool
+}
+
+// DeployBlueGreen performs a blue / green deployment behind a VIP pool.
+//
+// This is an opinionated workflow:
+//
+// If a replacement server cannot be deployed or fails its health check, the replacement servers (and their VIP nodes and pool members) are rolled back
+// and the existing pool members are left untouched.
+// Once all replacement servers are in the pool, the existing pool members are removed; their VIP nodes are only deleted (and their servers decommissioned)
+// if the nodes are not also members of other pools. If any of these later steps fail, the deployment stops (without rolling back, since the replacement servers are already serving traffic).
+//
+// The result describes the resources created and removed up to that point.
+func (client *Client) DeployBlueGreen(deployment BlueGreenDeployment) (result *BlueGreenDeploymentResult, err error) {
+	result = &BlueGreenDeploymentResult{}
+
+	err = deployment.Validate()
+	if err != nil {
+		return result, err
+	}
+
+	pool, err := client.GetVIPPool(deployment.PoolID)
+	if err != nil {
+		return result, err
+	}
+	if pool == nil {
+		return result, fmt.Errorf("No VIP pool was found with Id '%s'", deployment.PoolID)
+	}
+
+	image, err := client.GetCustomerImage(deployment.ImageID)
+	if err != nil {
+		return result, err
+	}
+	if image == nil {
+		return result, fmt.Errorf("No customer image was found with Id '%s'", deployment.ImageID)
+	}
+
+	var existingMembers []VIPPoolMember
+	err = ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		members, err := client.ListVIPPoolMembers(pool.ID, paging)
+		if err != nil {
+			return nil, err
+		}
+
+		existingMembers = append(existingMembers, members.Items...)
+
+		return &members.PagedResult, nil
+	})
+	if err != nil {
+		return result, err
+	}
+
+	// Bring up the replacement servers (rolling them back if any of them fail).
+	var vlanID string
+	for index := 1; index <= deployment.ServerCount; index++ {
+		serverConfiguration := deployment.Server
+		serverConfiguration.Name = fmt.Sprintf("%s-%d", deployment.Server.Name, index)
+		image.ApplyTo(&serverConfiguration)
+
+		var server *Server
+		server, err = client.deployBlueGreenServer(pool, serverConfiguration, deployment, result)
+		if err != nil {
+			log.Printf("Failed to bring up replacement server '%s' for VIP pool '%s'; rolling back replacement servers...", serverConfiguration.Name, pool.ID)
+
+			rollbackErr := client.rollbackBlueGreen(deployment.Timeout, result)
+			if rollbackErr != nil {
+				return result, fmt.Errorf("%s (rollback of replacement servers also failed: %s)", err, rollbackErr)
+			}
+
+			return result, err
+		}
+
+		if vlanID == "" && server.Network.PrimaryAdapter.VLANID != nil {
+			vlanID = *server.Network.PrimaryAdapter.VLANID
+		}
+	}
+
+	// Determine which of the existing pool members' nodes are used only by this pool (and can therefore be deleted).
+	exclusiveNodeIDs, err := client.findExclusiveVIPNodes(pool)
+	if err != nil {
+		return result, err
+	}
+
+	// Swap out the existing pool members.
+	var existingNodeIDs []string
+	for _, member := range existingMembers {
+		log.Printf("Removing member '%s' (node '%s') from VIP pool '%s'...", member.ID, member.Node.ID, pool.ID)
+
+		err = client.RemoveVIPPoolMember(member.ID)
+		if err != nil {
+			return result, err
+		}
+		result.RemovedPoolMemberIDs = append(result.RemovedPoolMemberIDs, member.ID)
+
+		if !containsString(existingNodeIDs, member.Node.ID) {
+			existingNodeIDs = append(existingNodeIDs, member.Node.ID)
+		}
+	}
+
+	// Decommission the servers behind the old pool members.
+	for _, nodeID := range existingNodeIDs {
+		if !exclusiveNodeIDs[nodeID] {
+			log.Printf("VIP node '%s' is also a member of other pools; it (and its server) will not be decommissioned.", nodeID)
+			result.RetainedNodeIDs = append(result.RetainedNodeIDs, nodeID)
+
+			continue
+		}
+
+		err = client.decommissionBlueGreenNode(pool.NetworkDomainID, vlanID, nodeID, deployment.Timeout, result)
+		if err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+// deployBlueGreenServer deploys a replacement server, creates its VIP node, checks its health, and adds it to the pool.
+func (client *Client) deployBlueGreenServer(pool *VIPPool, serverConfiguration ServerDeploymentConfiguration, deployment BlueGreenDeployment, result *BlueGreenDeploymentResult) (*Server, error) {
+	log.Printf("Deploying replacement server '%s' for VIP pool '%s'...", serverConfiguration.Name, pool.ID)
+
+	serverID, err := client.DeployServer(serverConfiguration)
+	if err != nil {
+		return nil, err
+	}
+	result.NewServerIDs = append(result.NewServerIDs, serverID)
+
+	resource, err := client.WaitForDeploy(ResourceTypeServer, serverID, deployment.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	server := resource.(*Server)
+
+	privateIPv4Address := server.Network.PrimaryAdapter.PrivateIPv4Address
+	if privateIPv4Address == nil {
+		return nil, fmt.Errorf("Server '%s' has no private IPv4 address", serverID)
+	}
+
+	nodeID, err := client.CreateVIPNode(NewVIPNodeConfiguration{
+		Name:                server.Name,
+		Description:         fmt.Sprintf("Server '%s'", server.Name),
+		IPv4Address:         *privateIPv4Address,
+		Status:              VIPNodeStatusEnabled,
+		HealthMonitorID:     deployment.HealthMonitorID,
+		ConnectionLimit:     deployment.ConnectionLimit,
+		ConnectionRateLimit: deployment.ConnectionRateLimit,
+		NetworkDomainID:     pool.NetworkDomainID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result.NewNodeIDs = append(result.NewNodeIDs, nodeID)
+
+	_, err = client.WaitForAdd(ResourceTypeVIPNode, nodeID, "Create", deployment.Timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	if deployment.HealthCheck != nil {
+		log.Printf("Checking health of replacement server '%s'...", server.Name)
+
+		err = deployment.HealthCheck(server)
+		if err != nil {
+			return nil, fmt.Errorf("Replacement server '%s' failed health check: %s", server.Name, err)
+		}
+	}
+
+	poolMemberID, err := client.AddVIPPoolMember(pool.ID, nodeID, VIPNodeStatusEnabled, deployment.Port)
+	if err != nil {
+		return nil, err
+	}
+	result.NewPoolMemberIDs = append(result.NewPoolMemberIDs, poolMemberID)
+
+	return server, nil
+}
+
+// rollbackBlueGreen removes the replacement servers' pool members, deletes their VIP nodes, and then deletes the replacement servers.
+//
+// Rollback continues after a failure; the first error encountered (if any) is returned.
+func (client *Client) rollbackBlueGreen(timeout time.Duration, result *BlueGreenDeploymentResult) (err error) {
+	result.RolledBack = true
+
+	recordError := func(rollbackErr error) {
+		if rollbackErr != nil {
+			log.Printf("Rollback error: %s", rollbackErr)
+
+			if err == nil {
+				err = rollbackErr
+			}
+		}
+	}
+
+	for _, poolMemberID := range result.NewPoolMemberIDs {
+		log.Printf("Rolling back: removing VIP pool member '%s'...", poolMemberID)
+
+		recordError(client.RemoveVIPPoolMember(poolMemberID))
+	}
+	for _, nodeID := range result.NewNodeIDs {
+		log.Printf("Rolling back: deleting VIP node '%s'...", nodeID)
+
+		deleteErr := client.DeleteVIPNode(nodeID)
+		if deleteErr == nil {
+			deleteErr = client.WaitForDelete(ResourceTypeVIPNode, nodeID, timeout)
+		}
+		recordError(deleteErr)
+	}
+	for _, serverID := range result.NewServerIDs {
+		log.Printf("Rolling back: deleting server '%s'...", serverID)
+
+		recordError(client.DeleteServerWithOptions(serverID, DeleteServerOptions{
+			PowerOff: true,
+			Wait:     true,
+			Timeout:  timeout,
+		}))
+	}
+
+	return
+}
+
+// findExclusiveVIPNodes finds the VIP nodes in the pool's network domain that are members of the specified pool (and no other pool).
+func (client *Client) findExclusiveVIPNodes(pool *VIPPool) (exclusiveNodeIDs map[string]bool, err error) {
+	exclusiveNodeIDs = make(map[string]bool)
+	sharedNodeIDs := make(map[string]bool)
+
+	err = ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		memberships, err := client.ListVIPPoolMembershipsInNetworkDomain(pool.NetworkDomainID, paging)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, membership := range memberships.Items {
+			if membership.Pool.ID == pool.ID {
+				exclusiveNodeIDs[membership.Node.ID] = true
+			} else {
+				sharedNodeIDs[membership.Node.ID] = true
+			}
+		}
+
+		return &memberships.PagedResult, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for nodeID := range sharedNodeIDs {
+		delete(exclusiveNodeIDs, nodeID)
+	}
+
+	return exclusiveNodeIDs, nil
+}
+
+// decommissionBlueGreenNode deletes a VIP node that is no longer in use, and then powers off and deletes the server in the replacement servers' VLAN that has the node's IPv4 address (if any).
+func (client *Client) decommissionBlueGreenNode(networkDomainID string, vlanID string, nodeID string, timeout time.Duration, result *BlueGreenDeploymentResult) error {
+	node, err := client.GetVIPNode(nodeID)
+	if err != nil {
+		return err
+	}
+	if node == nil {
+		return nil // Already gone.
+	}
+
+	var server *Server
+	if vlanID != "" && node.IPv4Address != "" {
+		server, err = client.findBlueGreenServer(networkDomainID, vlanID, node.IPv4Address, result.NewServerIDs)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Printf("Deleting VIP node '%s'...", node.ID)
+
+	err = client.DeleteVIPNode(node.ID)
+	if err != nil {
+		return err
+	}
+	err = client.WaitForDelete(ResourceTypeVIPNode, node.ID, timeout)
+	if err != nil {
+		return err
+	}
+	result.DeletedNodeIDs = append(result.DeletedNodeIDs, node.ID)
+
+	if server == nil {
+		log.Printf("No server found with IPv4 address '%s' in VLAN '%s'; nothing to decommission.", node.IPv4Address, vlanID)
+
+		return nil
+	}
+
+	log.Printf("Decommissioning server '%s' ('%s')...", server.ID, server.Name)
+
+	err = client.DeleteServerWithOptions(server.ID, DeleteServerOptions{
+		PowerOff: true,
+		Wait:     true,
+		Timeout:  timeout,
+	})
+	if err != nil {
+		return err
+	}
+	result.DecommissionedServerIDs = append(result.DecommissionedServerIDs, server.ID)
+
+	return nil
+}
+
+// findBlueGreenServer finds the server (other than the replacement servers) in the specified VLAN that has the specified private IPv4 address.
+//
+// Returns nil if no matching server is found, or an error if more than one server matches.
+func (client *Client) findBlueGreenServer(networkDomainID string, vlanID string, privateIPv4Address string, excludeServerIDs []string) (server *Server, err error) {
+	filter := NewServerFilter().
+		WithNetworkDomainID(networkDomainID).
+		WithVLANID(vlanID).
+		WithPrivateIPv4Address(privateIPv4Address)
+
+	var matchingServers []Server
+	err = ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		servers, err := client.ListServers("", filter, paging)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, candidate := range servers.Items {
+			if !containsString(excludeServerIDs, candidate.ID) {
+				matchingServers = append(matchingServers, candidate)
+			}
+		}
+
+		return &servers.PagedResult, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(matchingServers) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &matchingServers[0], nil
+	default:
+		return nil, fmt.Errorf("Found %d servers with IPv4 address '%s' in VLAN '%s'; refusing to decommission any of them", len(matchingServers), privateIPv4Address, vlanID)
+	}
+}
@@ -0,0 +1,435 @@
+package compute
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Blue / green deployment (successful; old node and server are decommissioned).
+func TestClient_DeployBlueGreen_Success(test *testing.T) {
+	expect := expect(test)
+	defer testWithResourceStatusPollInterval(time.Millisecond)()
+
+	cloud := newTestBlueGreenCloud(false)
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			result, err := client.DeployBlueGreen(testBlueGreenDeployment(1))
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.IsFalse("Result.RolledBack", result.RolledBack)
+			expect.EqualsInt("Result.NewServerIDs.Length", 1, len(result.NewServerIDs))
+			expect.EqualsString("Result.NewServerIDs[0]", testBlueGreenNewServerID, result.NewServerIDs[0])
+			expect.EqualsInt("Result.NewPoolMemberIDs.Length", 1, len(result.NewPoolMemberIDs))
+			expect.EqualsInt("Result.RemovedPoolMemberIDs.Length", 1, len(result.RemovedPoolMemberIDs))
+			expect.EqualsString("Result.RemovedPoolMemberIDs[0]", testBlueGreenOldMemberID, result.RemovedPoolMemberIDs[0])
+			expect.EqualsInt("Result.DeletedNodeIDs.Length", 1, len(result.DeletedNodeIDs))
+			expect.EqualsString("Result.DeletedNodeIDs[0]", testBlueGreenOldNodeID, result.DeletedNodeIDs[0])
+			expect.EqualsInt("Result.DecommissionedServerIDs.Length", 1, len(result.DecommissionedServerIDs))
+			expect.EqualsString("Result.DecommissionedServerIDs[0]", testBlueGreenOldServerID, result.DecommissionedServerIDs[0])
+			expect.EqualsInt("Result.RetainedNodeIDs.Length", 0, len(result.RetainedNodeIDs))
+
+			// The old server must be looked up in the replacement servers' VLAN (not across the whole network domain).
+			expect.EqualsString("ListServers.vlanId", testBlueGreenVLANID, cloud.serverQuery.Get("vlanId"))
+			expect.EqualsString("ListServers.privateIpv4", "10.0.1.10", cloud.serverQuery.Get("privateIpv4"))
+
+			// The old pool member must not be removed until the new one has been added.
+			requests := cloud.router.Requests()
+			expect.IsTrue("Member added before old member removed",
+				indexOfString(requests, "POST networkDomainVip/addPoolMember") < indexOfString(requests, "POST networkDomainVip/removePoolMember"),
+			)
+		},
+		Respond: cloud.router.Respond,
+	})
+}
+
+// Blue / green deployment (old node is shared with another pool, so it and its server are retained).
+func TestClient_DeployBlueGreen_SharedNode(test *testing.T) {
+	expect := expect(test)
+	defer testWithResourceStatusPollInterval(time.Millisecond)()
+
+	cloud := newTestBlueGreenCloud(true)
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			result, err := client.DeployBlueGreen(testBlueGreenDeployment(1))
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("Result.RemovedPoolMemberIDs.Length", 1, len(result.RemovedPoolMemberIDs))
+			expect.EqualsInt("Result.RetainedNodeIDs.Length", 1, len(result.RetainedNodeIDs))
+			expect.EqualsString("Result.RetainedNodeIDs[0]", testBlueGreenOldNodeID, result.RetainedNodeIDs[0])
+			expect.EqualsInt("Result.DeletedNodeIDs.Length", 0, len(result.DeletedNodeIDs))
+			expect.EqualsInt("Result.DecommissionedServerIDs.Length", 0, len(result.DecommissionedServerIDs))
+
+			requests := cloud.router.Requests()
+			expect.EqualsInt("DeleteNode.Index", -1, indexOfString(requests, "POST networkDomainVip/deleteNode"))
+			expect.EqualsInt("DeleteServer.Index", -1, indexOfString(requests, "POST server/deleteServer"))
+		},
+		Respond: cloud.router.Respond,
+	})
+}
+
+// Blue / green deployment (replacement server fails its health check, so replacement resources are rolled back and the existing pool members are untouched).
+func TestClient_DeployBlueGreen_Rollback(test *testing.T) {
+	expect := expect(test)
+	defer testWithResourceStatusPollInterval(time.Millisecond)()
+
+	cloud := newTestBlueGreenCloud(false)
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			deployment := testBlueGreenDeployment(1)
+			deployment.HealthCheck = func(server *Server) error {
+				return fmt.Errorf("HTTP 503 from %s", server.Name)
+			}
+
+			result, err := client.DeployBlueGreen(deployment)
+			expect.NotNil("Error", err)
+			expect.IsTrue("Error.Message", strings.Contains(err.Error(), "failed health check"))
+
+			expect.IsTrue("Result.RolledBack", result.RolledBack)
+			expect.EqualsInt("Result.NewPoolMemberIDs.Length", 0, len(result.NewPoolMemberIDs))
+			expect.EqualsInt("Result.RemovedPoolMemberIDs.Length", 0, len(result.RemovedPoolMemberIDs))
+			expect.EqualsInt("Result.DecommissionedServerIDs.Length", 0, len(result.DecommissionedServerIDs))
+
+			expect.IsTrue("New node deleted", cloud.isDeleted(testBlueGreenNewNodeID))
+			expect.IsTrue("New server deleted", cloud.isDeleted(testBlueGreenNewServerID))
+			expect.IsFalse("Old node deleted", cloud.isDeleted(testBlueGreenOldNodeID))
+			expect.IsFalse("Old server deleted", cloud.isDeleted(testBlueGreenOldServerID))
+
+			requests := cloud.router.Requests()
+			expect.EqualsInt("AddPoolMember.Index", -1, indexOfString(requests, "POST networkDomainVip/addPoolMember"))
+			expect.EqualsInt("RemovePoolMember.Index", -1, indexOfString(requests, "POST networkDomainVip/removePoolMember"))
+		},
+		Respond: cloud.router.Respond,
+	})
+}
+
+// Blue / green deployment (old pool member cannot be removed; replacement servers are kept, and nothing is decommissioned).
+func TestClient_DeployBlueGreen_PartialFailure(test *testing.T) {
+	expect := expect(test)
+	defer testWithResourceStatusPollInterval(time.Millisecond)()
+
+	cloud := newTestBlueGreenCloud(false)
+	cloud.router.Handle(http.MethodPost, "networkDomainVip/removePoolMember",
+		testRespond(http.StatusBadRequest, testBlueGreenAPIResponse("REMOVE_POOL_MEMBER", ResponseCodeResourceBusy, "", "")),
+	)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			result, err := client.DeployBlueGreen(testBlueGreenDeployment(1))
+			expect.NotNil("Error", err)
+			expect.IsTrue("IsResourceBusyError", IsResourceBusyError(err))
+
+			expect.IsFalse("Result.RolledBack", result.RolledBack)
+			expect.EqualsInt("Result.NewPoolMemberIDs.Length", 1, len(result.NewPoolMemberIDs))
+			expect.EqualsInt("Result.RemovedPoolMemberIDs.Length", 0, len(result.RemovedPoolMemberIDs))
+			expect.EqualsInt("Result.DeletedNodeIDs.Length", 0, len(result.DeletedNodeIDs))
+			expect.EqualsInt("Result.DecommissionedServerIDs.Length", 0, len(result.DecommissionedServerIDs))
+
+			expect.IsFalse("New server deleted", cloud.isDeleted(testBlueGreenNewServerID))
+			expect.IsFalse("Old server deleted", cloud.isDeleted(testBlueGreenOldServerID))
+		},
+		Respond: cloud.router.Respond,
+	})
+}
+
+// Blue / green deployment (server count must be at least 1).
+func TestClient_DeployBlueGreen_InvalidServerCount(test *testing.T) {
+	expect := expect(test)
+
+	cloud := newTestBlueGreenCloud(false)
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			result, err := client.DeployBlueGreen(testBlueGreenDeployment(0))
+			expect.NotNil("Error", err)
+			expect.IsTrue("Error.Message", strings.Contains(err.Error(), "at least 1"))
+			expect.EqualsInt("Result.RemovedPoolMemberIDs.Length", 0, len(result.RemovedPoolMemberIDs))
+
+			expect.EqualsInt("Requests.Length", 0, len(cloud.router.Requests()))
+		},
+		Respond: cloud.router.Respond,
+	})
+}
+
+// Blue / green deployment (timeout must be greater than zero).
+func TestClient_DeployBlueGreen_InvalidTimeout(test *testing.T) {
+	expect := expect(test)
+
+	cloud := newTestBlueGreenCloud(false)
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			deployment := testBlueGreenDeployment(1)
+			deployment.Timeout = 0
+
+			_, err := client.DeployBlueGreen(deployment)
+			expect.NotNil("Error", err)
+			expect.IsTrue("Error.Message", strings.Contains(err.Error(), "greater than zero"))
+
+			expect.EqualsInt("Requests.Length", 0, len(cloud.router.Requests()))
+		},
+		Respond: cloud.router.Respond,
+	})
+}
+
+/*
+ * Test support.
+ */
+
+const (
+	testBlueGreenNetworkDomainID = "484174a2-ae74-4658-9e56-50fc90e086cf"
+	testBlueGreenVLANID          = "0e56433f-d808-4669-821d-812769517ff8"
+	testBlueGreenPoolID          = "afb1fb1a-eab9-43f4-95c2-36a4cdda6cb8"
+	testBlueGreenOtherPoolID     = "5a0a7f70-4b5c-4a1e-9c57-4d2c8f1e2b3a"
+	testBlueGreenImageID         = "4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b"
+	testBlueGreenOldMemberID     = "3dd806a2-c2c8-4c0c-9a4f-5219ea9266c0"
+	testBlueGreenOldNodeID       = "34de6ed6-46a4-4dae-a753-2f8d3840c6f9"
+	testBlueGreenOldServerID     = "7b62aae5-bdbe-4595-b58d-c78f95db2a7f"
+	testBlueGreenNewServerID     = "b9e8b3a6-0f1d-4c3e-8a2b-1c4d5e6f7a8b"
+	testBlueGreenNewNodeID       = "c1d2e3f4-a5b6-4c7d-8e9f-0a1b2c3d4e5f"
+	testBlueGreenNewMemberID     = "d2e3f4a5-b6c7-4d8e-9f0a-1b2c3d4e5f6a"
+)
+
+func testBlueGreenDeployment(serverCount int) BlueGreenDeployment {
+	return BlueGreenDeployment{
+		PoolID:  testBlueGreenPoolID,
+		ImageID: testBlueGreenImageID,
+		Server: ServerDeploymentConfiguration{
+			Name:                  "web",
+			AdministratorPassword: "sn4u$ag3s",
+			Network: VirtualMachineNetwork{
+				NetworkDomainID: testBlueGreenNetworkDomainID,
+				PrimaryAdapter: VirtualMachineNetworkAdapter{
+					VLANID: stringToPtr(testBlueGreenVLANID),
+				},
+			},
+		},
+		ServerCount: serverCount,
+		Timeout:     time.Second,
+	}
+}
+
+// testBlueGreenCloud simulates the CloudControl resources involved in a blue / green deployment (a pool with one existing member).
+type testBlueGreenCloud struct {
+	router *testRouter
+
+	lock        sync.Mutex
+	deleted     map[string]bool
+	serverQuery url.Values
+}
+
+func newTestBlueGreenCloud(oldNodeIsShared bool) *testBlueGreenCloud {
+	cloud := &testBlueGreenCloud{
+		router:  newTestRouter(),
+		deleted: make(map[string]bool),
+	}
+	router := cloud.router
+
+	router.Handle(http.MethodGet, "networkDomainVip/pool/"+testBlueGreenPoolID, testRespondOK(testBlueGreenPoolResponse))
+	router.Handle(http.MethodGet, "image/customerImage/"+testBlueGreenImageID, testRespondOK(getCustomerImageV24TestResponse))
+	router.Handle(http.MethodGet, "networkDomainVip/poolMember", func(test *testing.T, request *http.Request) (int, string) {
+		if request.URL.Query().Get("poolId") != "" {
+			return http.StatusOK, testBlueGreenMembersResponse(testBlueGreenPoolMember(testBlueGreenOldMemberID, testBlueGreenPoolID, testBlueGreenOldNodeID))
+		}
+
+		memberships := []string{
+			testBlueGreenPoolMember(testBlueGreenOldMemberID, testBlueGreenPoolID, testBlueGreenOldNodeID),
+			testBlueGreenPoolMember(testBlueGreenNewMemberID, testBlueGreenPoolID, testBlueGreenNewNodeID),
+		}
+		if oldNodeIsShared {
+			memberships = append(memberships, testBlueGreenPoolMember("e3f4a5b6-c7d8-4e9f-0a1b-2c3d4e5f6a7b", testBlueGreenOtherPoolID, testBlueGreenOldNodeID))
+		}
+
+		return http.StatusOK, testBlueGreenMembersResponse(memberships...)
+	})
+
+	// Replacement server.
+	router.Handle(http.MethodPost, "server/deployServer", testRespondOK(testBlueGreenAPIResponse("DEPLOY_SERVER", ResponseCodeInProgress, "serverId", testBlueGreenNewServerID)))
+	router.Handle(http.MethodGet, "server/server/"+testBlueGreenNewServerID,
+		testRespondUntilDeleted(testBlueGreenServerResponse(testBlueGreenNewServerID, "web-1", "10.0.1.20", true), cloud.isDeletedFunc(testBlueGreenNewServerID)),
+	)
+	router.Handle(http.MethodPost, "networkDomainVip/createNode", testRespondOK(testBlueGreenAPIResponse("CREATE_NODE", ResponseCodeOK, "nodeId", testBlueGreenNewNodeID)))
+	router.Handle(http.MethodGet, "networkDomainVip/node/"+testBlueGreenNewNodeID,
+		testRespondUntilDeleted(testBlueGreenNodeResponse(testBlueGreenNewNodeID, "10.0.1.20"), cloud.isDeletedFunc(testBlueGreenNewNodeID)),
+	)
+	router.Handle(http.MethodPost, "networkDomainVip/addPoolMember", testRespondOK(testBlueGreenAPIResponse("ADD_POOL_MEMBER", ResponseCodeOK, "poolMemberId", testBlueGreenNewMemberID)))
+
+	// Existing server.
+	router.Handle(http.MethodPost, "networkDomainVip/removePoolMember", testRespondOK(testBlueGreenAPIResponse("REMOVE_POOL_MEMBER", ResponseCodeOK, "", "")))
+	router.Handle(http.MethodGet, "networkDomainVip/node/"+testBlueGreenOldNodeID,
+		testRespondUntilDeleted(testBlueGreenNodeResponse(testBlueGreenOldNodeID, "10.0.1.10"), cloud.isDeletedFunc(testBlueGreenOldNodeID)),
+	)
+	router.Handle(http.MethodGet, "server/server", func(test *testing.T, request *http.Request) (int, string) {
+		cloud.lock.Lock()
+		cloud.serverQuery = request.URL.Query()
+		cloud.lock.Unlock()
+
+		return http.StatusOK, testBlueGreenServersResponse(testBlueGreenServerResponse(testBlueGreenOldServerID, "web-1", "10.0.1.10", false))
+	})
+	router.Handle(http.MethodGet, "server/server/"+testBlueGreenOldServerID,
+		testRespondUntilDeleted(testBlueGreenServerResponse(testBlueGreenOldServerID, "web-1", "10.0.1.10", false), cloud.isDeletedFunc(testBlueGreenOldServerID)),
+	)
+
+	router.Handle(http.MethodPost, "networkDomainVip/deleteNode", func(test *testing.T, request *http.Request) (int, string) {
+		requestBody := &deleteVIPNode{}
+		err := readRequestBodyAsJSON(request, requestBody)
+		if err != nil {
+			test.Fatal(err)
+		}
+		cloud.markDeleted(requestBody.ID)
+
+		return http.StatusOK, testBlueGreenAPIResponse("DELETE_NODE", ResponseCodeOK, "", "")
+	})
+	router.Handle(http.MethodPost, "server/deleteServer", func(test *testing.T, request *http.Request) (int, string) {
+		requestBody := &deleteServer{}
+		err := readRequestBodyAsJSON(request, requestBody)
+		if err != nil {
+			test.Fatal(err)
+		}
+		cloud.markDeleted(requestBody.ID)
+
+		return http.StatusOK, testBlueGreenAPIResponse("DELETE_SERVER", ResponseCodeInProgress, "", "")
+	})
+	router.Handle(http.MethodPost, "server/powerOffServer", testRespondOK(testBlueGreenAPIResponse("POWER_OFF_SERVER", ResponseCodeInProgress, "", "")))
+
+	return cloud
+}
+
+func (cloud *testBlueGreenCloud) markDeleted(id string) {
+	cloud.lock.Lock()
+	defer cloud.lock.Unlock()
+
+	cloud.deleted[id] = true
+}
+
+func (cloud *testBlueGreenCloud) isDeleted(id string) bool {
+	cloud.lock.Lock()
+	defer cloud.lock.Unlock()
+
+	return cloud.deleted[id]
+}
+
+func (cloud *testBlueGreenCloud) isDeletedFunc(id string) func() bool {
+	return func() bool {
+		return cloud.isDeleted(id)
+	}
+}
+
+func testBlueGreenAPIResponse(operation string, responseCode string, infoName string, infoValue string) string {
+	info := "[]"
+	if infoName != "" {
+		info = fmt.Sprintf(`[{ "name": "%s", "value": "%s" }]`, infoName, infoValue)
+	}
+
+	return fmt.Sprintf(`
+{
+	"operation": "%s",
+	"responseCode": "%s",
+	"message": "Request processed.",
+	"info": %s,
+	"warning": [],
+	"error": [],
+	"requestId": "na9_20170321T074626030-0400_7e9fffe7-190b-46f2-9107-9d52fe57d0ad"
+}
+`, operation, responseCode, info)
+}
+
+func testBlueGreenServerResponse(id string, name string, privateIPv4Address string, started bool) string {
+	return fmt.Sprintf(`
+{
+	"id": "%s",
+	"name": "%s",
+	"cpu": { "count": 2, "speed": "STANDARD", "coresPerSocket": 1 },
+	"memoryGb": 4,
+	"networkInfo": {
+		"primaryNic": {
+			"id": "5e869800-df7b-4626-bcbf-8643b8be11fd",
+			"privateIpv4": "%s",
+			"vlanId": "%s",
+			"state": "NORMAL"
+		},
+		"additionalNic": [],
+		"networkDomainId": "%s"
+	},
+	"deployed": true,
+	"started": %t,
+	"state": "NORMAL",
+	"datacenterId": "NA9"
+}
+`, id, name, privateIPv4Address, testBlueGreenVLANID, testBlueGreenNetworkDomainID, started)
+}
+
+func testBlueGreenServersResponse(servers ...string) string {
+	return fmt.Sprintf(`
+{
+	"server": [%s],
+	"pageNumber": 1,
+	"pageCount": %d,
+	"totalCount": %d,
+	"pageSize": 250
+}
+`, strings.Join(servers, ","), len(servers), len(servers))
+}
+
+func testBlueGreenNodeResponse(id string, ipv4Address string) string {
+	return fmt.Sprintf(`
+{
+	"id": "%s",
+	"name": "web-node",
+	"description": "",
+	"ipv4Address": "%s",
+	"status": "ENABLED",
+	"connectionLimit": 10000,
+	"connectionRateLimit": 2000,
+	"networkDomainId": "%s",
+	"datacenterId": "NA9",
+	"state": "NORMAL"
+}
+`, id, ipv4Address, testBlueGreenNetworkDomainID)
+}
+
+func testBlueGreenPoolMember(id string, poolID string, nodeID string) string {
+	return fmt.Sprintf(`
+		{
+			"id": "%s",
+			"pool": { "id": "%s", "name": "web-pool" },
+			"node": { "id": "%s", "name": "web-node", "status": "ENABLED" },
+			"status": "ENABLED",
+			"state": "NORMAL",
+			"networkDomainId": "%s",
+			"datacenterId": "NA9"
+		}`, id, poolID, nodeID, testBlueGreenNetworkDomainID)
+}
+
+func testBlueGreenMembersResponse(members ...string) string {
+	return fmt.Sprintf(`
+{
+	"poolMember": [%s],
+	"pageNumber": 1,
+	"pageCount": %d,
+	"totalCount": %d,
+	"pageSize": 250
+}
+`, strings.Join(members, ","), len(members), len(members))
+}
+
+const testBlueGreenPoolResponse = `
+{
+	"id": "afb1fb1a-eab9-43f4-95c2-36a4cdda6cb8",
+	"name": "web-pool",
+	"description": "",
+	"loadBalanceMethod": "ROUND_ROBIN",
+	"healthMonitor": [],
+	"serviceDownAction": "NONE",
+	"slowRampTime": 10,
+	"state": "NORMAL",
+	"networkDomainID": "484174a2-ae74-4658-9e56-50fc90e086cf",
+	"datacenterId": "NA9"
+}
+`
@@ -0,0 +1,107 @@
+package compute
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+)
+
+// The name of the datacenter networking property that indicates whether SSL offload is supported.
+const datacenterPropertySSLOffload = "SSL_OFFLOAD"
+
+// DatacenterCapabilities represents the features supported by an MCP datacenter.
+type DatacenterCapabilities struct {
+	// Is server monitoring supported?
+	Monitoring bool `json:"monitoring"`
+
+	// Are server snapshots supported?
+	Snapshots bool `json:"snapshots"`
+
+	// Is Disaster Recovery (DRS) supported?
+	DRS bool `json:"drs"`
+
+	// Is SSL offload supported for virtual listeners?
+	SSLOffload bool `json:"sslOffload"`
+
+	// Is cloud backup supported?
+	Backup bool `json:"backup"`
+
+	// Is console access supported?
+	ConsoleAccess bool `json:"consoleAccess"`
+}
+
+// CapabilitiesMatrix represents the features supported by each datacenter in one or more geos.
+//
+// The matrix is keyed by geo (region) name, then by datacenter Id.
+type CapabilitiesMatrix map[string]map[string]DatacenterCapabilities
+
+// GetCapabilities determines which features are supported by the datacenter.
+func (datacenter *Datacenter) GetCapabilities() DatacenterCapabilities {
+	return DatacenterCapabilities{
+		Monitoring:    datacenter.Monitoring != nil,
+		Snapshots:     datacenter.Snapshot != nil,
+		DRS:           datacenter.DRS != nil,
+		SSLOffload:    strings.EqualFold(datacenter.Networking.GetProperty(datacenterPropertySSLOffload), "true"),
+		Backup:        datacenter.Backup != nil,
+		ConsoleAccess: datacenter.ConsoleAccess != nil,
+	}
+}
+
+// GetCapabilities retrieves the features supported by each datacenter in the client's geo.
+// The capabilities are keyed by datacenter Id.
+func (client *Client) GetCapabilities() (capabilities map[string]DatacenterCapabilities, err error) {
+	capabilities = make(map[string]DatacenterCapabilities)
+
+	err = ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		datacenters, err := client.ListDatacenters(paging)
+		if err != nil {
+			return nil, err
+		}
+
+		for index := range datacenters.Items {
+			datacenter := &datacenters.Items[index]
+			capabilities[datacenter.ID] = datacenter.GetCapabilities()
+		}
+
+		return &datacenters.PagedResult, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return capabilities, nil
+}
+
+// CapabilitiesReport builds a JSON matrix of the features supported by each datacenter in each geo (one client per geo).
+//
+// This is useful when planning deployments that span multiple regions.
+func CapabilitiesReport(clients ...*Client) ([]byte, error) {
+	matrix := make(CapabilitiesMatrix)
+	for _, client := range clients {
+		capabilities, err := client.GetCapabilities()
+		if err != nil {
+			return nil, err
+		}
+
+		matrix[client.getGeoName()] = capabilities
+	}
+
+	return json.MarshalIndent(matrix, "", "  ")
+}
+
+// getGeoName gets the name of the geo (region) that the client connects to.
+//
+// For standard end-points ("https://api-xxx.dimensiondata.com") this is the region identifier ("xxx"); otherwise, it is the end-point host name.
+func (client *Client) getGeoName() string {
+	baseURL, err := url.Parse(client.baseAddress)
+	if err != nil || baseURL.Host == "" {
+		return client.baseAddress
+	}
+
+	host := baseURL.Host
+	if strings.HasPrefix(host, "api-") && strings.HasSuffix(host, ".dimensiondata.com") {
+		return strings.TrimSuffix(strings.TrimPrefix(host, "api-"), ".dimensiondata.com")
+	}
+
+	return host
+}
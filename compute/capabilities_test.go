@@ -0,0 +1,118 @@
+package compute
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// Capabilities report for a single geo (successful).
+func TestCapabilitiesReport_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			report, err := CapabilitiesReport(client)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			matrix := CapabilitiesMatrix{}
+			err = json.Unmarshal(report, &matrix)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("Matrix.Length", 1, len(matrix))
+
+			capabilities := matrix[client.getGeoName()]
+			expect.EqualsInt("Capabilities.Length", 2, len(capabilities))
+
+			na9 := capabilities["NA9"]
+			expect.IsTrue("NA9.Monitoring", na9.Monitoring)
+			expect.IsTrue("NA9.Snapshots", na9.Snapshots)
+			expect.IsTrue("NA9.DRS", na9.DRS)
+			expect.IsTrue("NA9.SSLOffload", na9.SSLOffload)
+
+			na3 := capabilities["NA3"]
+			expect.IsTrue("NA3.Monitoring", na3.Monitoring)
+			expect.IsFalse("NA3.Snapshots", na3.Snapshots)
+			expect.IsFalse("NA3.DRS", na3.DRS)
+			expect.IsFalse("NA3.SSLOffload", na3.SSLOffload)
+		},
+		Respond: testRespondOK(listDatacentersTestResponse),
+	})
+}
+
+// Geo name for standard and custom end-points.
+func TestClient_GetGeoName(test *testing.T) {
+	expect := expect(test)
+
+	expect.EqualsString("Geo(au)", "au", NewClient("au", "user1", "password").getGeoName())
+	expect.EqualsString("Geo(custom)", "cloudcontrol.example.com", NewClientWithBaseAddress("https://cloudcontrol.example.com", "user1", "password").getGeoName())
+}
+
+/*
+ * Test responses.
+ */
+
+const listDatacentersTestResponse = `
+{
+	"datacenter": [
+		{
+			"id": "NA9",
+			"type": "MCP 2.0",
+			"displayName": "US - East 3 - MCP 2.0",
+			"city": "Ashburn",
+			"state": "Virginia",
+			"country": "US",
+			"vpnUrl": "https://na9.cloud-vpn.net",
+			"ftpsHost": "ftps-na9.cloud-vpn.net",
+			"networking": {
+				"type": "2",
+				"maintenanceStatus": "NORMAL",
+				"property": [
+					{
+						"name": "SSL_OFFLOAD",
+						"value": "true"
+					}
+				]
+			},
+			"monitoring": {
+				"maintenanceStatus": "NORMAL",
+				"property": []
+			},
+			"snapshot": {
+				"maintenanceStatus": "NORMAL",
+				"property": []
+			},
+			"drs": {
+				"maintenanceStatus": "NORMAL",
+				"property": []
+			}
+		},
+		{
+			"id": "NA3",
+			"type": "MCP 1.0",
+			"displayName": "US - West",
+			"city": "Santa Clara",
+			"state": "California",
+			"country": "US",
+			"vpnUrl": "https://na3.cloud-vpn.net",
+			"ftpsHost": "ftps-na3.cloud-vpn.net",
+			"networking": {
+				"type": "1",
+				"maintenanceStatus": "NORMAL",
+				"property": []
+			},
+			"monitoring": {
+				"maintenanceStatus": "NORMAL",
+				"property": []
+			}
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 2,
+	"totalCount": 2,
+	"pageSize": 250
+}
+`
@@ -7,7 +7,6 @@ import (
 	"encoding/xml"
 	"fmt"
 	"io"
-	"io/ioutil"
 	"log"
 	"net/http"
 	"os"
@@ -18,23 +17,40 @@ import (
 )
 
 // Client is the client for Dimension Data's cloud compute API.
+//
+// A Client is safe for concurrent use by multiple goroutines; its configuration and cached state are protected by an internal lock,
+// and the underlying HTTP client pools connections across requests (see ClientConfiguration to tune connection pooling).
 type Client struct {
 	baseAddress              string
-	username                 string
-	password                 string
+	credentialsProvider      CredentialsProvider
+	credentials              *Credentials
 	maxRetryCount            int
 	retryDelay               time.Duration
 	stateLock                *sync.Mutex
 	httpClient               *http.Client
+	throttle                 *requestThrottle
+	accountCache             *accountCache
 	account                  *Account
+	accountFetch             *accountFetch
 	isCancellationRequested  bool
 	isExtendedLoggingEnabled bool
+	pinnedAPIVersion         *APIVersion
+	negotiatedAPIVersion     *APIVersion
+	resourceBusyTimeout      time.Duration
+	resourceBusyDelay        time.Duration
+	userAgent                string
+	requestHeaders           func(request *http.Request)
+	responseCache            *responseCacheSettings
+	responseCacheBypassIDs   map[string]int
+	jobRegistry              JobRegistry
+	maxResponseSize          int64
+	isCompressionDisabled    bool
 }
 
 // NewClient creates a new cloud compute API client.
 // region is the cloud compute region identifier.
 func NewClient(region string, username string, password string) *Client {
-	baseAddress := fmt.Sprintf("https://api-%s.dimensiondata.com", region)
+	baseAddress := getRegionBaseAddress(region)
 
 	return NewClientWithBaseAddress(baseAddress, username, password)
 }
@@ -46,15 +62,29 @@ func NewClientWithBaseAddress(baseAddress string, username string, password stri
 
 	return &Client{
 		baseAddress,
-		username,
-		password,
+		NewStaticCredentials(username, password),
+		nil, // credentials
 		0,
 		0 * time.Second,
 		&sync.Mutex{},
 		&http.Client{},
+		nil, // throttle
+		nil, // accountCache
 		nil,
+		nil,   // accountFetch
 		false, // isCancellationRequested
 		isExtendedLoggingEnabled,
+		nil, // pinnedAPIVersion
+		nil, // negotiatedAPIVersion
+		0,   // resourceBusyTimeout
+		0,   // resourceBusyDelay
+		defaultUserAgent,
+		nil,   // requestHeaders
+		nil,   // responseCache
+		nil,   // responseCacheBypassIDs
+		nil,   // jobRegistry
+		0,     // maxResponseSize
+		false, // isCompressionDisabled
 	}
 }
 
@@ -72,6 +102,7 @@ func (client *Client) Reset() {
 	defer client.stateLock.Unlock()
 
 	client.account = nil
+	client.negotiatedAPIVersion = nil
 	client.isCancellationRequested = false
 }
 
@@ -93,9 +124,20 @@ func (client *Client) DisableExtendedLogging() {
 
 // IsExtendedLoggingEnabled determines if logging of HTTP requests and responses is enabled.
 func (client *Client) IsExtendedLoggingEnabled() bool {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
 	return client.isExtendedLoggingEnabled
 }
 
+// IsCancellationRequested determines if cancellation of pending operations has been requested (by calling Cancel).
+func (client *Client) IsCancellationRequested() bool {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	return client.isCancellationRequested
+}
+
 // ConfigureRetry configures the client's retry facility.
 // Set maxRetryCount to 0 (the default) to disable retry.
 func (client *Client) ConfigureRetry(maxRetryCount int, retryDelay time.Duration) {
@@ -114,6 +156,14 @@ func (client *Client) ConfigureRetry(maxRetryCount int, retryDelay time.Duration
 	client.retryDelay = retryDelay
 }
 
+// getMaxRetryCount gets the maximum number of times a failed request will be retried.
+func (client *Client) getMaxRetryCount() int {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	return client.maxRetryCount
+}
+
 // getOrganizationID gets the current user's organisation Id.
 func (client *Client) getOrganizationID() (organizationID string, err error) {
 	account, err := client.GetAccount()
@@ -126,7 +176,13 @@ func (client *Client) getOrganizationID() (organizationID string, err error) {
 
 // executeRequest performs the specified request and returns the entire response body, together with the HTTP status code.
 func (client *Client) executeRequest(request *http.Request) (responseBody []byte, statusCode int, err error) {
+	return client.executeCachedRequest(request, client.executeUncachedRequest)
+}
+
+// executeUncachedRequest performs the specified request (bypassing the response cache) and returns the response body and status code.
+func (client *Client) executeUncachedRequest(request *http.Request) (responseBody []byte, statusCode int, err error) {
 	haveRequestBody := request.Body != nil
+	client.setAcceptEncoding(request)
 
 	// Cache request to enable retry.
 	var snapshot *requests.Snapshot
@@ -157,6 +213,37 @@ func (client *Client) executeRequest(request *http.Request) (responseBody []byte
 		}
 	}
 
+	requestTime := time.Now()
+	responseBody, statusCode, err = client.executeSnapshot(snapshot, haveRequestBody)
+	if err == nil && statusCode == http.StatusUnauthorized {
+		// Credentials may have been rotated; if so, try again with the new credentials.
+		var credentialsChanged bool
+		credentialsChanged, err = client.refreshCredentials()
+		if err != nil {
+			return
+		}
+
+		if credentialsChanged {
+			log.Printf("Credentials were rejected for '%s' request to '%s'; retrying with refreshed credentials.",
+				request.Method,
+				request.URL.String(),
+			)
+
+			responseBody, statusCode, err = client.executeSnapshot(snapshot, haveRequestBody)
+		}
+	}
+	if err != nil || !client.shouldRetryResourceBusy(request, responseBody, statusCode) {
+		return
+	}
+
+	return client.retryResourceBusy(request, requestTime, responseBody, statusCode, func() ([]byte, int, error) {
+		return client.executeSnapshot(snapshot, haveRequestBody)
+	})
+}
+
+// executeSnapshot performs a single attempt (with retry for transport-level errors, if configured) of the request captured by the specified snapshot.
+func (client *Client) executeSnapshot(snapshot *requests.Snapshot, haveRequestBody bool) (responseBody []byte, statusCode int, err error) {
+	var request *http.Request
 	request, err = snapshot.Copy()
 	if err != nil {
 		return
@@ -164,7 +251,12 @@ func (client *Client) executeRequest(request *http.Request) (responseBody []byte
 	if haveRequestBody {
 		defer request.Body.Close()
 	}
+	err = client.authenticateRequest(request)
+	if err != nil {
+		return
+	}
 
+	client.throttle.Wait()
 	response, err := client.httpClient.Do(request)
 	if err != nil {
 		log.Printf("Unexpected error while performing '%s' request to '%s': %s.",
@@ -173,16 +265,17 @@ func (client *Client) executeRequest(request *http.Request) (responseBody []byte
 			err.Error(),
 		)
 
-		for retryCount := 0; retryCount < client.maxRetryCount; retryCount++ {
+		maxRetryCount := client.getMaxRetryCount()
+		for retryCount := 0; retryCount < maxRetryCount; retryCount++ {
 			if client.IsExtendedLoggingEnabled() {
 				log.Printf("Retrying '%s' request to '%s' (%d retries remaining)...",
 					request.Method,
 					request.URL.String(),
-					retryCount-client.maxRetryCount,
+					retryCount-maxRetryCount,
 				)
 			}
 
-			if client.isCancellationRequested {
+			if client.IsCancellationRequested() {
 				log.Printf("Client indicates that cancellation of pending requests has been requested.")
 
 				err = &OperationCancelledError{
@@ -203,7 +296,12 @@ func (client *Client) executeRequest(request *http.Request) (responseBody []byte
 			if haveRequestBody {
 				defer request.Body.Close()
 			}
+			err = client.authenticateRequest(request)
+			if err != nil {
+				return
+			}
 
+			client.throttle.Wait()
 			response, err = client.httpClient.Do(request)
 			if err != nil {
 				if client.IsExtendedLoggingEnabled() {
@@ -237,13 +335,18 @@ func (client *Client) executeRequest(request *http.Request) (responseBody []byte
 			return
 		}
 	}
+	if response == nil {
+		err = fmt.Errorf("No response was received for '%s' request to '%s'", request.Method, request.URL.String())
+
+		return
+	}
 	defer response.Body.Close()
 
 	statusCode = response.StatusCode
 
-	responseBody, err = ioutil.ReadAll(response.Body)
+	responseBody, err = client.readResponseBody(request, response)
 	if err != nil {
-		err = fmt.Errorf("Error reading response body for '%s': %s", request.URL.String(), err.Error())
+		return
 	}
 
 	if client.IsExtendedLoggingEnabled() {
@@ -254,6 +357,8 @@ func (client *Client) executeRequest(request *http.Request) (responseBody []byte
 		)
 	}
 
+	err = checkResponseContent(request, response, responseBody)
+
 	return
 }
 
@@ -277,8 +382,8 @@ func (client *Client) newRequestV1(relativeURI string, method string, body inter
 		return nil, err
 	}
 
-	request.SetBasicAuth(client.username, client.password)
 	request.Header.Set("Accept", "text/xml")
+	client.setRequestHeaders(request)
 
 	if bodyReader != nil {
 		request.Header.Set("Content-Type", "text/xml")
@@ -288,73 +393,55 @@ func (client *Client) newRequestV1(relativeURI string, method string, body inter
 }
 
 // Create a basic request for the compute API (V2.2, JSON).
+//
+// V2.2 is the version required by the end-point; a later version is only used if one has been pinned (see PinAPIVersion).
 func (client *Client) newRequestV22(relativeURI string, method string, body interface{}) (*http.Request, error) {
-	requestURI := fmt.Sprintf("%s/caas/2.2/%s", client.baseAddress, relativeURI)
-
-	var (
-		request    *http.Request
-		bodyReader io.Reader
-		err        error
-	)
-
-	bodyReader, err = newReaderFromJSON(body)
-	if err != nil {
-		return nil, err
-	}
-
-	request, err = http.NewRequest(method, requestURI, bodyReader)
-	if err != nil {
-		return nil, err
-	}
-
-	request.SetBasicAuth(client.username, client.password)
-	request.Header.Add("Accept", "application/json")
-
-	if bodyReader != nil {
-		request.Header.Set("Content-Type", "application/json")
-	}
-
-	return request, nil
+	return client.newRequestV2(APIVersion22, APIVersion22, relativeURI, method, body)
 }
 
 // Create a basic request for the compute API (V2.3, JSON).
+//
+// V2.3 is the version required by the end-point; a later version is only used if one has been pinned (see PinAPIVersion).
 func (client *Client) newRequestV23(relativeURI string, method string, body interface{}) (*http.Request, error) {
-	requestURI := fmt.Sprintf("%s/caas/2.3/%s", client.baseAddress, relativeURI)
+	return client.newRequestV2(APIVersion23, APIVersion23, relativeURI, method, body)
+}
 
-	var (
-		request    *http.Request
-		bodyReader io.Reader
-		err        error
-	)
+// Create a basic request for the compute API (V2.4, JSON).
+//
+// V2.4 is the version required by the end-point; a later version is only used if one has been pinned (see PinAPIVersion).
+func (client *Client) newRequestV24(relativeURI string, method string, body interface{}) (*http.Request, error) {
+	return client.newRequestV2(APIVersion24, APIVersion24, relativeURI, method, body)
+}
 
-	bodyReader, err = newReaderFromJSON(body)
-	if err != nil {
-		return nil, err
-	}
+// Create a basic request for the compute API (V2.7, JSON).
+//
+// V2.7 is the version required by the end-point; a later version is only used if one has been pinned (see PinAPIVersion).
+func (client *Client) newRequestV27(relativeURI string, method string, body interface{}) (*http.Request, error) {
+	return client.newRequestV2(APIVersion27, APIVersion27, relativeURI, method, body)
+}
 
-	request, err = http.NewRequest(method, requestURI, bodyReader)
+// Create a basic request for the compute API (V2.9, JSON).
+//
+// V2.9 is the version required by the end-point; a later version is only used if one has been pinned (see PinAPIVersion).
+func (client *Client) newRequestV29(relativeURI string, method string, body interface{}) (*http.Request, error) {
+	return client.newRequestV2(APIVersion29, APIVersion29, relativeURI, method, body)
+}
+
+// Create a basic request for the compute API (V2.x, JSON).
+//
+// minimumVersion is the minimum API version required by the end-point, and maximumVersion is the highest API version whose responses the client handles correctly for the end-point
+// (a negotiated version is only used if it falls between the two; see NegotiateAPIVersion).
+func (client *Client) newRequestV2(minimumVersion APIVersion, maximumVersion APIVersion, relativeURI string, method string, body interface{}) (*http.Request, error) {
+	apiVersion, err := client.selectAPIVersion(minimumVersion, maximumVersion)
 	if err != nil {
 		return nil, err
 	}
 
-	request.SetBasicAuth(client.username, client.password)
-	request.Header.Add("Accept", "application/json")
-
-	if bodyReader != nil {
-		request.Header.Set("Content-Type", "application/json")
-	}
-
-	return request, nil
-}
-
-// Create a basic request for the compute API (V2.4, JSON).
-func (client *Client) newRequestV24(relativeURI string, method string, body interface{}) (*http.Request, error) {
-	requestURI := fmt.Sprintf("%s/caas/2.4/%s", client.baseAddress, relativeURI)
+	requestURI := fmt.Sprintf("%s/caas/%s/%s", client.baseAddress, apiVersion, relativeURI)
 
 	var (
 		request    *http.Request
 		bodyReader io.Reader
-		err        error
 	)
 
 	bodyReader, err = newReaderFromJSON(body)
@@ -367,8 +454,8 @@ func (client *Client) newRequestV24(relativeURI string, method string, body inte
 		return nil, err
 	}
 
-	request.SetBasicAuth(client.username, client.password)
 	request.Header.Add("Accept", "application/json")
+	client.setRequestHeaders(request)
 
 	if bodyReader != nil {
 		request.Header.Set("Content-Type", "application/json")
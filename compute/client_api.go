@@ -0,0 +1,187 @@
+package compute
+
+import (
+	"time"
+)
+
+// AccountClient represents the account-related operations of the CloudControl API.
+type AccountClient interface {
+	// GetAccount retrieves the current user's account information.
+	GetAccount() (*Account, error)
+
+	// GetOrganizationID retrieves the Id of the current user's organisation.
+	GetOrganizationID() (string, error)
+}
+
+// DatacenterClient represents the datacenter-related operations of the CloudControl API.
+type DatacenterClient interface {
+	// ListDatacenters retrieves a list of all datacenters.
+	ListDatacenters(paging *Paging) (*Datacenters, error)
+
+	// GetDatacenter retrieves the datacenter with the specified Id.
+	GetDatacenter(id string) (*Datacenter, error)
+}
+
+// NetworkDomainClient represents the network-domain-related operations of the CloudControl API.
+type NetworkDomainClient interface {
+	// ListNetworkDomains retrieves a list of all network domains.
+	ListNetworkDomains(paging *Paging) (*NetworkDomains, error)
+
+	// GetNetworkDomain retrieves the network domain with the specified Id.
+	GetNetworkDomain(id string) (*NetworkDomain, error)
+
+	// GetNetworkDomainByName retrieves the network domain (if any) with the specified name in the specified datacenter.
+	GetNetworkDomainByName(name string, dataCenterID string) (*NetworkDomain, error)
+
+	// DeployNetworkDomain deploys a new network domain.
+	DeployNetworkDomain(name string, description string, plan string, datacenter string) (string, error)
+
+	// EditNetworkDomain updates an existing network domain.
+	EditNetworkDomain(id string, name *string, description *string, plan *string) error
+
+	// DeleteNetworkDomain deletes an existing network domain.
+	DeleteNetworkDomain(id string) error
+}
+
+// VLANClient represents the VLAN-related operations of the CloudControl API.
+type VLANClient interface {
+	// GetVLAN retrieves the VLAN with the specified Id.
+	GetVLAN(id string) (*VLAN, error)
+
+	// GetVLANByName retrieves the VLAN (if any) with the specified name in the specified network domain.
+	GetVLANByName(name string, networkDomainID string) (*VLAN, error)
+
+	// ListVLANs retrieves a list of all VLANs in the specified network domain.
+	ListVLANs(networkDomainID string, paging *Paging) (*VLANs, error)
+
+	// DeployVLAN deploys a new VLAN.
+	DeployVLAN(networkDomainID string, name string, description string, ipv4BaseAddress string, ipv4PrefixSize int) (string, error)
+
+	// EditVLAN updates an existing VLAN.
+	EditVLAN(id string, name *string, description *string) error
+
+	// DeleteVLAN deletes an existing VLAN.
+	DeleteVLAN(id string) error
+}
+
+// ServerClient represents the server-related operations of the CloudControl API.
+type ServerClient interface {
+	// GetServer retrieves the server with the specified Id.
+	GetServer(id string) (*Server, error)
+
+	// ListServersInNetworkDomain retrieves a page of servers in the specified network domain.
+	ListServersInNetworkDomain(networkDomainID string, paging *Paging) (Servers, error)
+
+	// ListServersInVLAN retrieves a page of servers with a network adapter attached to the specified VLAN.
+	ListServersInVLAN(vlanID string, paging *Paging) (Servers, error)
+
+	// DeployServer deploys a new server.
+	DeployServer(serverConfiguration ServerDeploymentConfiguration) (string, error)
+
+	// EditServerMetadata modifies a server's name and / or description.
+	EditServerMetadata(serverID string, name *string, description *string) error
+
+	// ReconfigureServer updates the configuration for a server.
+	ReconfigureServer(serverID string, memoryGB *int, cpuCount *int, cpuCoresPerSocket *int, cpuSpeed *string) error
+
+	// StartServer requests that the specified server be started.
+	StartServer(id string) error
+
+	// ShutdownServer requests that the specified server be shut down (gracefully).
+	ShutdownServer(id string) error
+
+	// PowerOffServer requests that the specified server be powered off (non-gracefully).
+	PowerOffServer(id string) error
+
+	// DeleteServer deletes an existing server.
+	DeleteServer(id string) error
+}
+
+// ImageClient represents the image-related operations of the CloudControl API.
+type ImageClient interface {
+	// GetOSImage retrieves a specific OS image by Id.
+	GetOSImage(id string) (*OSImage, error)
+
+	// FindOSImage finds an OS image by name in a given data centre.
+	FindOSImage(name string, dataCenterID string) (*OSImage, error)
+
+	// GetCustomerImage retrieves a specific customer image by Id.
+	GetCustomerImage(id string) (*CustomerImage, error)
+
+	// FindCustomerImage finds a customer image by name in a given data centre.
+	FindCustomerImage(name string, dataCenterID string) (*CustomerImage, error)
+
+	// ListCustomerImagesInDatacenter lists all customer images in a given data centre.
+	ListCustomerImagesInDatacenter(dataCenterID string, paging *Paging) (*CustomerImages, error)
+
+	// ListCustomerImages lists the customer images in all datacenters.
+	ListCustomerImages(paging *Paging) (*CustomerImages, error)
+
+	// EditCustomerImage updates the name and / or description of the specified customer image.
+	EditCustomerImage(id string, name string, description string) error
+
+	// DeleteCustomerImage deletes the specified customer image.
+	DeleteCustomerImage(id string) error
+
+	// CopyCustomerImage copies the specified customer image to another datacenter.
+	CopyCustomerImage(sourceImageID string, targetDatacenterID string, newName string) (string, error)
+
+	// CloneServer clones a server to create a customer image.
+	CloneServer(serverID string, imageName string, imageDescription string, preventGuestOSCustomisation bool) (string, error)
+
+	// CloneServerWithOptions clones a server to create a customer image, using the specified options.
+	CloneServerWithOptions(serverID string, options *CloneOptions) (string, error)
+}
+
+// TagClient represents the tag-related operations of the CloudControl API.
+type TagClient interface {
+	// GetAssetTags gets a page of tags applied to the specified asset.
+	GetAssetTags(assetID string, assetType string, paging *Paging) (*TagDetails, error)
+
+	// ApplyAssetTags applies the specified tags to an asset.
+	ApplyAssetTags(assetID string, assetType string, tags ...Tag) (*APIResponseV2, error)
+
+	// RemoveAssetTags removes the specified tags from an asset.
+	RemoveAssetTags(assetID string, assetType string, tagNames ...string) (*APIResponseV2, error)
+}
+
+// WaitClient represents the operations used to wait for asynchronous CloudControl operations to complete.
+type WaitClient interface {
+	// GetResource retrieves a compute resource of the specified type by Id.
+	GetResource(id string, resourceType ResourceType) (Resource, error)
+
+	// WaitForDeploy waits for a resource's pending deployment operation to complete.
+	WaitForDeploy(resourceType ResourceType, id string, timeout time.Duration) (Resource, error)
+
+	// WaitForEdit waits for a resource's pending edit operation to complete.
+	WaitForEdit(resourceType ResourceType, id string, timeout time.Duration) (Resource, error)
+
+	// WaitForChange waits for a resource's pending change operation to complete.
+	WaitForChange(resourceType ResourceType, id string, actionDescription string, timeout time.Duration) (Resource, error)
+
+	// WaitForDelete waits for a resource's pending deletion to complete.
+	WaitForDelete(resourceType ResourceType, id string, timeout time.Duration) error
+
+	// WaitForServerClone waits for a server's pending clone operation to complete.
+	WaitForServerClone(customerImageID string, timeout time.Duration) (Resource, error)
+
+	// WaitForCustomerImageCopy waits for a customer image's pending copy operation to complete.
+	WaitForCustomerImageCopy(customerImageID string, timeout time.Duration) (Resource, error)
+}
+
+// ClientAPI represents the commonly-used operations of the CloudControl API.
+//
+// Code that depends on ClientAPI (or one of the narrower per-resource interfaces it is composed of) can be unit-tested
+// using the in-memory fake in the compute/fake package instead of a real Client.
+type ClientAPI interface {
+	AccountClient
+	DatacenterClient
+	NetworkDomainClient
+	VLANClient
+	ServerClient
+	ImageClient
+	TagClient
+	WaitClient
+}
+
+var _ ClientAPI = &Client{}
@@ -0,0 +1,192 @@
+package compute
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"runtime"
+	"time"
+)
+
+// ClientConfiguration represents the HTTP transport configuration for a Client.
+//
+// High-throughput callers (e.g. controllers that manage many resources concurrently) should raise MaxIdleConnsPerHost
+// so that connections to the CloudControl end-point are reused rather than being repeatedly opened and closed.
+type ClientConfiguration struct {
+	// The maximum number of idle (keep-alive) connections across all hosts (0 means no limit).
+	MaxIdleConns int
+
+	// The maximum number of idle (keep-alive) connections to keep per host.
+	MaxIdleConnsPerHost int
+
+	// The maximum number of connections (idle or active) per host (0 means no limit).
+	MaxConnsPerHost int
+
+	// The maximum amount of time an idle connection will remain idle before being closed (0 means no limit).
+	IdleConnTimeout time.Duration
+
+	// The maximum amount of time to wait for a connection to be established.
+	DialTimeout time.Duration
+
+	// The interval between keep-alive probes for active connections (a negative value disables keep-alive probes).
+	KeepAlive time.Duration
+
+	// The maximum amount of time to wait for a TLS handshake.
+	TLSHandshakeTimeout time.Duration
+
+	// The maximum amount of time to wait for a server's response headers after sending a request (0 means no limit).
+	ResponseHeaderTimeout time.Duration
+
+	// The maximum amount of time for an entire request, including reading the response body (0 means no limit).
+	RequestTimeout time.Duration
+
+	// The HTTP / HTTPS proxy (if any) through which requests are sent.
+	//
+	// If nil, the proxy (if any) is determined by the HTTP_PROXY, HTTPS_PROXY, and NO_PROXY environment variables.
+	Proxy *url.URL
+
+	// The certificate authorities used to verify the CloudControl end-point's TLS certificate (e.g. when egress traffic passes through a TLS interception appliance).
+	//
+	// If nil, the host's root certificate authorities are used (see LoadCACertificates to load a custom CA bundle).
+	RootCAs *x509.CertPool
+
+	// The minimum TLS version (e.g. tls.VersionTLS12) used to connect to the CloudControl end-point (0 means use Go's default).
+	MinTLSVersion uint16
+
+	// An optional suffix (e.g. "terraform-provider-ddcloud/1.3.0") appended to the User-Agent header sent with each request.
+	//
+	// This enables CloudControl support to correlate API calls with the tooling (and version) that made them.
+	UserAgentSuffix string
+
+	// An optional function that is called to add custom headers (e.g. a correlation Id) to each request before it is sent.
+	RequestHeaders func(request *http.Request)
+
+	// The maximum size (in bytes, after decompression) of a response body that the client will read (0 means no limit).
+	MaxResponseSize int64
+
+	// Ask CloudControl not to compress its responses?
+	DisableCompression bool
+}
+
+// DefaultClientConfiguration creates a ClientConfiguration with sensible defaults for use with the CloudControl API.
+func DefaultClientConfiguration() ClientConfiguration {
+	return ClientConfiguration{
+		MaxIdleConns:        100,
+		MaxIdleConnsPerHost: runtime.GOMAXPROCS(0) + 1,
+		IdleConnTimeout:     90 * time.Second,
+		DialTimeout:         30 * time.Second,
+		KeepAlive:           30 * time.Second,
+		TLSHandshakeTimeout: 10 * time.Second,
+	}
+}
+
+// NewHTTPClient creates a new HTTP client (with its own connection pool) using the configuration.
+func (configuration ClientConfiguration) NewHTTPClient() *http.Client {
+	proxy := http.ProxyFromEnvironment
+	if configuration.Proxy != nil {
+		proxy = http.ProxyURL(configuration.Proxy)
+	}
+
+	var tlsConfig *tls.Config
+	if configuration.RootCAs != nil || configuration.MinTLSVersion != 0 {
+		tlsConfig = &tls.Config{
+			RootCAs:    configuration.RootCAs,
+			MinVersion: configuration.MinTLSVersion,
+		}
+	}
+
+	return &http.Client{
+		Timeout: configuration.RequestTimeout,
+		Transport: &http.Transport{
+			Proxy:           proxy,
+			TLSClientConfig: tlsConfig,
+			DialContext: (&net.Dialer{
+				Timeout:   configuration.DialTimeout,
+				KeepAlive: configuration.KeepAlive,
+			}).DialContext,
+			MaxIdleConns:          configuration.MaxIdleConns,
+			MaxIdleConnsPerHost:   configuration.MaxIdleConnsPerHost,
+			MaxConnsPerHost:       configuration.MaxConnsPerHost,
+			IdleConnTimeout:       configuration.IdleConnTimeout,
+			TLSHandshakeTimeout:   configuration.TLSHandshakeTimeout,
+			ResponseHeaderTimeout: configuration.ResponseHeaderTimeout,
+			ExpectContinueTimeout: 1 * time.Second,
+		},
+	}
+}
+
+// LoadCACertificates loads a bundle of PEM-encoded CA certificates (for use as ClientConfiguration.RootCAs).
+//
+// If includeSystemRoots is true, the certificates are added to the host's root certificate authorities; otherwise, only the certificates in the bundle are trusted.
+func LoadCACertificates(bundleFile string, includeSystemRoots bool) (*x509.CertPool, error) {
+	bundle, err := ioutil.ReadFile(bundleFile)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to read CA bundle '%s': %s", bundleFile, err.Error())
+	}
+
+	certificates := x509.NewCertPool()
+	if includeSystemRoots {
+		certificates, err = x509.SystemCertPool()
+		if err != nil {
+			return nil, fmt.Errorf("Unable to load system root certificate authorities: %s", err.Error())
+		}
+	}
+
+	if !certificates.AppendCertsFromPEM(bundle) {
+		return nil, fmt.Errorf("CA bundle '%s' does not contain any valid PEM-encoded certificates", bundleFile)
+	}
+
+	return certificates, nil
+}
+
+// NewClientWithConfiguration creates a new cloud compute API client whose HTTP transport uses the specified configuration.
+// region is the cloud compute region identifier.
+func NewClientWithConfiguration(region string, username string, password string, configuration ClientConfiguration) *Client {
+	client := NewClientWithHTTPClient(region, username, password, configuration.NewHTTPClient())
+	configuration.applyRequestOptions(client)
+
+	return client
+}
+
+// NewClientWithBaseAddressAndConfiguration creates a new cloud compute API client (using a custom end-point base address) whose HTTP transport uses the specified configuration.
+// baseAddress is the base URL of the CloudControl API end-point.
+func NewClientWithBaseAddressAndConfiguration(baseAddress string, username string, password string, configuration ClientConfiguration) *Client {
+	client := NewClientWithBaseAddressAndHTTPClient(baseAddress, username, password, configuration.NewHTTPClient())
+	configuration.applyRequestOptions(client)
+
+	return client
+}
+
+// applyRequestOptions applies the configuration's request options (User-Agent suffix, custom headers, and response handling) to the specified client.
+func (configuration ClientConfiguration) applyRequestOptions(client *Client) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	client.userAgent = defaultUserAgent
+	if configuration.UserAgentSuffix != "" {
+		client.userAgent += " " + configuration.UserAgentSuffix
+	}
+	client.requestHeaders = configuration.RequestHeaders
+	client.maxResponseSize = configuration.MaxResponseSize
+	client.isCompressionDisabled = configuration.DisableCompression
+}
+
+// The User-Agent header sent with each request (unless a suffix has been configured).
+const defaultUserAgent = "go-dd-cloud-compute"
+
+// setRequestHeaders sets the User-Agent header (and any custom headers) for a request.
+func (client *Client) setRequestHeaders(request *http.Request) {
+	client.stateLock.Lock()
+	userAgent := client.userAgent
+	requestHeaders := client.requestHeaders
+	client.stateLock.Unlock()
+
+	request.Header.Set("User-Agent", userAgent)
+	if requestHeaders != nil {
+		requestHeaders(request)
+	}
+}
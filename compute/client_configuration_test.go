@@ -0,0 +1,163 @@
+package compute
+
+import (
+	"crypto/tls"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Client configuration is applied to the HTTP transport.
+func TestClientConfiguration_NewHTTPClient(test *testing.T) {
+	expect := expect(test)
+
+	configuration := DefaultClientConfiguration()
+	configuration.MaxIdleConnsPerHost = 50
+	configuration.RequestTimeout = 2 * time.Minute
+
+	client := NewClientWithBaseAddressAndConfiguration("https://api-na.example.com", "user1", "password", configuration)
+	expect.IsTrue("Client.HTTPClient.Timeout", client.httpClient.Timeout == 2*time.Minute)
+
+	transport, ok := client.httpClient.Transport.(*http.Transport)
+	expect.IsTrue("Client.HTTPClient.Transport is *http.Transport", ok)
+	expect.EqualsInt("Transport.MaxIdleConnsPerHost", 50, transport.MaxIdleConnsPerHost)
+	expect.EqualsInt("Transport.MaxIdleConns", 100, transport.MaxIdleConns)
+	expect.IsTrue("Transport.IdleConnTimeout", transport.IdleConnTimeout == 90*time.Second)
+}
+
+// Client can be used from multiple goroutines at once.
+func TestClient_ConcurrentUse(test *testing.T) {
+	expect := expect(test)
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.Header().Set("Content-Type", "text/xml")
+		writer.WriteHeader(http.StatusOK)
+
+		fmt.Fprintln(writer, accountTestResponse)
+	}))
+	defer testServer.Close()
+
+	client := NewClientWithBaseAddressAndConfiguration(testServer.URL, "user1", "password", DefaultClientConfiguration())
+
+	var waitGroup sync.WaitGroup
+	errors := make([]error, 20)
+	for index := range errors {
+		waitGroup.Add(1)
+
+		go func(index int) {
+			defer waitGroup.Done()
+
+			if index%2 == 0 {
+				client.EnableExtendedLogging()
+				client.DisableExtendedLogging()
+			}
+
+			_, errors[index] = client.GetOrganizationID()
+		}(index)
+	}
+	waitGroup.Wait()
+
+	for _, err := range errors {
+		if err != nil {
+			test.Fatal(err)
+		}
+	}
+	expect.IsFalse("Client.IsCancellationRequested", client.IsCancellationRequested())
+}
+
+// Client configuration adds the User-Agent suffix and custom headers to each request.
+func TestClientConfiguration_RequestOptions(test *testing.T) {
+	expect := expect(test)
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		expect.EqualsString("Request.UserAgent", "go-dd-cloud-compute terraform-provider-ddcloud/1.3.0", request.UserAgent())
+		expect.EqualsString("Request.Header[X-Correlation-Id]", "run-42", request.Header.Get("X-Correlation-Id"))
+
+		writer.Header().Set("Content-Type", "text/xml")
+		writer.WriteHeader(http.StatusOK)
+
+		fmt.Fprintln(writer, accountTestResponse)
+	}))
+	defer testServer.Close()
+
+	configuration := DefaultClientConfiguration()
+	configuration.UserAgentSuffix = "terraform-provider-ddcloud/1.3.0"
+	configuration.RequestHeaders = func(request *http.Request) {
+		request.Header.Set("X-Correlation-Id", "run-42")
+	}
+
+	client := NewClientWithBaseAddressAndConfiguration(testServer.URL, "user1", "password", configuration)
+	_, err := client.GetOrganizationID()
+	if err != nil {
+		test.Fatal(err)
+	}
+}
+
+// Client configuration applies proxy and TLS settings to the HTTP transport.
+func TestClientConfiguration_ProxyAndTLS(test *testing.T) {
+	expect := expect(test)
+
+	configuration := DefaultClientConfiguration()
+	configuration.Proxy, _ = url.Parse("http://proxy.example.com:3128")
+	configuration.MinTLSVersion = tls.VersionTLS12
+
+	transport := configuration.NewHTTPClient().Transport.(*http.Transport)
+	expect.NotNil("Transport.TLSClientConfig", transport.TLSClientConfig)
+	expect.IsTrue("Transport.TLSClientConfig.MinVersion", transport.TLSClientConfig.MinVersion == tls.VersionTLS12)
+
+	request, _ := http.NewRequest(http.MethodGet, "https://api-au.dimensiondata.com/oec/0.9/myaccount", nil)
+	proxyURL, err := transport.Proxy(request)
+	if err != nil {
+		test.Fatal(err)
+	}
+	expect.EqualsString("Transport.Proxy", "http://proxy.example.com:3128", proxyURL.String())
+}
+
+// Client trusts certificates from a custom CA bundle.
+func TestClientConfiguration_CustomCABundle(test *testing.T) {
+	testServer := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.Header().Set("Content-Type", "text/xml")
+		writer.WriteHeader(http.StatusOK)
+
+		fmt.Fprintln(writer, accountTestResponse)
+	}))
+	defer testServer.Close()
+
+	bundleFile, err := ioutil.TempFile("", "ca-bundle")
+	if err != nil {
+		test.Fatal(err)
+	}
+	defer os.Remove(bundleFile.Name())
+
+	err = pem.Encode(bundleFile, &pem.Block{Type: "CERTIFICATE", Bytes: testServer.Certificate().Raw})
+	bundleFile.Close()
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	configuration := DefaultClientConfiguration()
+	configuration.RootCAs, err = LoadCACertificates(bundleFile.Name(), false)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	client := NewClientWithBaseAddressAndConfiguration(testServer.URL, "user1", "password", configuration)
+	_, err = client.GetOrganizationID()
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	// Without the custom CA bundle, the server's certificate is not trusted.
+	client = NewClientWithBaseAddressAndConfiguration(testServer.URL, "user1", "password", DefaultClientConfiguration())
+	_, err = client.GetOrganizationID()
+	if err == nil {
+		test.Fatal("Expected certificate verification to fail without the custom CA bundle.")
+	}
+}
@@ -0,0 +1,167 @@
+package compute
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ClientFactory creates Clients that share a single underlying HTTP transport, request throttle, and account cache.
+//
+// Use a ClientFactory when a process needs clients for several regions (or organisations) so that they don't each maintain their own connection pool,
+// and so that throttling is applied consistently across all of them.
+type ClientFactory struct {
+	httpClient   *http.Client
+	throttle     *requestThrottle
+	accountCache *accountCache
+}
+
+// NewClientFactory creates a new ClientFactory.
+//
+// maxRequestsPerSecond is the maximum number of requests per second (across all clients created by the factory); 0 means no limit.
+func NewClientFactory(maxRequestsPerSecond int) *ClientFactory {
+	return &ClientFactory{
+		httpClient:   &http.Client{},
+		throttle:     newRequestThrottle(maxRequestsPerSecond),
+		accountCache: newAccountCache(),
+	}
+}
+
+// NewClientFactoryWithHTTPClient creates a new ClientFactory whose clients all use the specified HTTP client.
+//
+// maxRequestsPerSecond is the maximum number of requests per second (across all clients created by the factory); 0 means no limit.
+func NewClientFactoryWithHTTPClient(httpClient *http.Client, maxRequestsPerSecond int) *ClientFactory {
+	factory := NewClientFactory(maxRequestsPerSecond)
+	if httpClient != nil {
+		factory.httpClient = httpClient
+	}
+
+	return factory
+}
+
+// NewClient creates a new cloud compute API client that uses the factory's shared resources.
+// region is the cloud compute region identifier.
+func (factory *ClientFactory) NewClient(region string, username string, password string) *Client {
+	baseAddress := getRegionBaseAddress(region)
+
+	return factory.NewClientWithBaseAddress(baseAddress, username, password)
+}
+
+// NewClientWithBaseAddress creates a new cloud compute API client (using a custom end-point base address) that uses the factory's shared resources.
+// baseAddress is the base URL of the CloudControl API end-point.
+func (factory *ClientFactory) NewClientWithBaseAddress(baseAddress string, username string, password string) *Client {
+	client := NewClientWithBaseAddress(baseAddress, username, password)
+	client.httpClient = factory.httpClient
+	client.throttle = factory.throttle
+	client.accountCache = factory.accountCache
+
+	return client
+}
+
+// NewClientWithCredentialsProvider creates a new cloud compute API client (using a custom end-point base address) that uses the factory's shared resources
+// and retrieves its credentials from the specified provider.
+// baseAddress is the base URL of the CloudControl API end-point.
+func (factory *ClientFactory) NewClientWithCredentialsProvider(baseAddress string, credentialsProvider CredentialsProvider) *Client {
+	client := factory.NewClientWithBaseAddress(baseAddress, "", "")
+	client.credentialsProvider = credentialsProvider
+
+	return client
+}
+
+// requestThrottle limits the rate at which requests are made.
+type requestThrottle struct {
+	lock        sync.Mutex
+	interval    time.Duration
+	nextRequest time.Time
+}
+
+// newRequestThrottle creates a new requestThrottle (returns nil if maxRequestsPerSecond is 0 or less).
+func newRequestThrottle(maxRequestsPerSecond int) *requestThrottle {
+	if maxRequestsPerSecond <= 0 {
+		return nil
+	}
+
+	return &requestThrottle{
+		interval: time.Second / time.Duration(maxRequestsPerSecond),
+	}
+}
+
+// Wait blocks until the next request is permitted.
+//
+// A nil requestThrottle never blocks.
+func (throttle *requestThrottle) Wait() {
+	if throttle == nil {
+		return
+	}
+
+	throttle.lock.Lock()
+	now := time.Now()
+	if throttle.nextRequest.Before(now) {
+		throttle.nextRequest = now
+	}
+	delay := throttle.nextRequest.Sub(now)
+	throttle.nextRequest = throttle.nextRequest.Add(throttle.interval)
+	throttle.lock.Unlock()
+
+	if delay > 0 {
+		time.Sleep(delay)
+	}
+}
+
+// accountCache holds account details (keyed by end-point base address and user name) so that they only need to be retrieved once.
+//
+// The same user name may refer to different accounts (or organisations) on different end-points, so the base address is part of the key.
+type accountCache struct {
+	lock     sync.Mutex
+	accounts map[accountCacheKey]*Account
+}
+
+// accountCacheKey identifies an entry in an accountCache.
+type accountCacheKey struct {
+	baseAddress string
+	username    string
+}
+
+// newAccountCache creates a new accountCache.
+func newAccountCache() *accountCache {
+	return &accountCache{
+		accounts: make(map[accountCacheKey]*Account),
+	}
+}
+
+// newAccountCacheKey creates a new accountCacheKey for the specified end-point base address and user name.
+func newAccountCacheKey(baseAddress string, username string) accountCacheKey {
+	return accountCacheKey{
+		baseAddress: strings.ToLower(strings.TrimSuffix(baseAddress, "/")),
+		username:    username,
+	}
+}
+
+// Get retrieves the cached account details (if any) for the specified user on the specified end-point.
+//
+// A nil accountCache always returns nil.
+func (cache *accountCache) Get(baseAddress string, username string) *Account {
+	if cache == nil {
+		return nil
+	}
+
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+
+	return cache.accounts[newAccountCacheKey(baseAddress, username)]
+}
+
+// Set caches the account details for the specified user on the specified end-point.
+//
+// A nil accountCache does nothing.
+func (cache *accountCache) Set(baseAddress string, username string, account *Account) {
+	if cache == nil {
+		return
+	}
+
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+
+	cache.accounts[newAccountCacheKey(baseAddress, username)] = account
+}
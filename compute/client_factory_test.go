@@ -0,0 +1,105 @@
+package compute
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// Clients created by the same factory share account details.
+func TestClientFactory_SharedAccountCache(test *testing.T) {
+	expect := expect(test)
+
+	accountRequestCount := 0
+	testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		accountRequestCount++
+
+		writer.Header().Set("Content-Type", "text/xml")
+		writer.WriteHeader(http.StatusOK)
+
+		fmt.Fprintln(writer, accountTestResponse)
+	}))
+	defer testServer.Close()
+
+	factory := NewClientFactory(0)
+	client1 := factory.NewClientWithBaseAddress(testServer.URL, "user1", "password")
+	client2 := factory.NewClientWithBaseAddress(testServer.URL, "user1", "password")
+
+	expect.IsTrue("Client1.HTTPClient == Client2.HTTPClient", client1.httpClient == client2.httpClient)
+
+	account1, err := client1.GetAccount()
+	if err != nil {
+		test.Fatal(err)
+	}
+	account2, err := client2.GetAccount()
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	verifyAccountTestResponse(test, account2)
+	expect.IsTrue("Account1 == Account2", account1 == account2)
+	expect.EqualsInt("AccountRequestCount", 1, accountRequestCount)
+}
+
+// Clients created by the same factory for the same user on different end-points do not share account details.
+func TestClientFactory_AccountCachePerEndPoint(test *testing.T) {
+	expect := expect(test)
+
+	accountRequestCount := 0
+	handler := http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		accountRequestCount++
+
+		writer.Header().Set("Content-Type", "text/xml")
+		writer.WriteHeader(http.StatusOK)
+
+		fmt.Fprintln(writer, accountTestResponse)
+	})
+	testServer1 := httptest.NewServer(handler)
+	defer testServer1.Close()
+	testServer2 := httptest.NewServer(handler)
+	defer testServer2.Close()
+
+	factory := NewClientFactory(0)
+	client1 := factory.NewClientWithBaseAddress(testServer1.URL, "user1", "password")
+	client2 := factory.NewClientWithBaseAddress(testServer2.URL, "user1", "password")
+
+	account1, err := client1.GetAccount()
+	if err != nil {
+		test.Fatal(err)
+	}
+	account2, err := client2.GetAccount()
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	expect.IsFalse("Account1 == Account2", account1 == account2)
+	expect.EqualsInt("AccountRequestCount", 2, accountRequestCount)
+}
+
+// Request throttle spaces out requests.
+func TestRequestThrottle_Wait(test *testing.T) {
+	throttle := newRequestThrottle(50) // 20ms between requests.
+
+	started := time.Now()
+	for index := 0; index < 4; index++ {
+		throttle.Wait()
+	}
+	elapsed := time.Since(started)
+
+	// First request is immediate; the next 3 are delayed.
+	if elapsed < 60*time.Millisecond {
+		test.Fatalf("Expected throttled requests to take at least 60ms (took %s).", elapsed)
+	}
+}
+
+// A nil request throttle never blocks.
+func TestRequestThrottle_Unlimited(test *testing.T) {
+	expect := expect(test)
+
+	throttle := newRequestThrottle(0)
+	expect.IsTrue("Throttle == nil", throttle == nil)
+
+	throttle.Wait()
+}
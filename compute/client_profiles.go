@@ -0,0 +1,198 @@
+package compute
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// DefaultClientProfileName is the name of the profile used by NewClientFromConfigFile when the MCP_PROFILE environment variable is not set.
+const DefaultClientProfileName = "default"
+
+// ClientProfile represents a named set of client settings from a configuration file.
+type ClientProfile struct {
+	// The profile name.
+	Name string
+
+	// The CloudControl region identifier (e.g. "AU").
+	Region string
+
+	// The base address of a custom CloudControl API end-point (if specified, takes precedence over Region).
+	BaseAddress string
+
+	// The CloudControl user name.
+	Username string
+
+	// The CloudControl password.
+	Password string
+}
+
+// NewClient creates a new cloud compute API client using the profile's settings.
+func (profile *ClientProfile) NewClient() (*Client, error) {
+	if profile.Username == "" || profile.Password == "" {
+		return nil, fmt.Errorf("Client profile '%s' must specify both a username and a password", profile.Name)
+	}
+
+	if profile.BaseAddress != "" {
+		err := validateBaseAddress(profile.BaseAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewClientWithBaseAddress(profile.BaseAddress, profile.Username, profile.Password), nil
+	}
+
+	if profile.Region == "" {
+		return nil, fmt.Errorf("Client profile '%s' must specify either a region or a base_address", profile.Name)
+	}
+	baseAddress, err := GetRegionBaseAddress(profile.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewClientWithBaseAddress(baseAddress, profile.Username, profile.Password), nil
+}
+
+// NewClientFromEnvironment creates a new cloud compute API client using the MCP_USER, MCP_PASSWORD, and MCP_REGION environment variables.
+func NewClientFromEnvironment() (*Client, error) {
+	profile := &ClientProfile{
+		Name:     "environment",
+		Region:   os.Getenv("MCP_REGION"),
+		Username: os.Getenv("MCP_USER"),
+		Password: os.Getenv("MCP_PASSWORD"),
+	}
+	if profile.Username == "" || profile.Password == "" || profile.Region == "" {
+		return nil, fmt.Errorf("The MCP_USER, MCP_PASSWORD, and MCP_REGION environment variables must all be set")
+	}
+
+	return profile.NewClient()
+}
+
+// NewClientFromConfigFile creates a new cloud compute API client using a profile from the specified configuration file (see LoadClientProfiles).
+//
+// The profile named by the MCP_PROFILE environment variable is used (or DefaultClientProfileName, if MCP_PROFILE is not set).
+func NewClientFromConfigFile(path string) (*Client, error) {
+	profileName := os.Getenv("MCP_PROFILE")
+	if profileName == "" {
+		profileName = DefaultClientProfileName
+	}
+
+	return NewClientFromConfigFileProfile(path, profileName)
+}
+
+// NewClientFromConfigFileProfile creates a new cloud compute API client using the named profile from the specified configuration file (see LoadClientProfiles).
+func NewClientFromConfigFileProfile(path string, profileName string) (*Client, error) {
+	profiles, err := LoadClientProfiles(path)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, ok := profiles[profileName]
+	if !ok {
+		return nil, fmt.Errorf("Configuration file '%s' does not contain a profile named '%s'", path, profileName)
+	}
+
+	return profile.NewClient()
+}
+
+// LoadClientProfiles reads the named client profiles from a configuration file.
+//
+// Both TOML-style and YAML-style profiles are supported (only simple string values; nested tables, lists, and multi-line values are not):
+//
+//	[default]                      default:
+//	region = "AU"                    region: AU
+//	username = "my-user"             username: my-user
+//	password = "my-password"         password: my-password
+//
+//	[profile staging]              staging:
+//	base_address = "https://..."     base_address: https://...
+//
+// Lines starting with '#' are ignored.
+func LoadClientProfiles(path string) (map[string]*ClientProfile, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to read configuration file '%s': %s", path, err.Error())
+	}
+	defer file.Close()
+
+	profiles := make(map[string]*ClientProfile)
+	var currentProfile *ClientProfile
+
+	lineNumber := 0
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		lineNumber++
+
+		rawLine := scanner.Text()
+		line := strings.TrimSpace(rawLine)
+		if line == "" || strings.HasPrefix(line, "#") || line == "---" {
+			continue
+		}
+
+		// TOML-style section header: [name] or [profile name]
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			name := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line[1:len(line)-1]), "profile "))
+			currentProfile = newClientProfile(profiles, name)
+
+			continue
+		}
+
+		// YAML-style section header: unindented "name:" with no value.
+		if rawLine == strings.TrimLeft(rawLine, " \t") && strings.HasSuffix(line, ":") {
+			currentProfile = newClientProfile(profiles, unquoteProfileValue(strings.TrimSuffix(line, ":")))
+
+			continue
+		}
+
+		separatorIndex := strings.IndexAny(line, "=:")
+		if separatorIndex == -1 {
+			return nil, fmt.Errorf("Invalid configuration file '%s' (line %d): expected 'key = value' or 'key: value'", path, lineNumber)
+		}
+		if currentProfile == nil {
+			return nil, fmt.Errorf("Invalid configuration file '%s' (line %d): setting appears outside of a profile", path, lineNumber)
+		}
+
+		key := strings.ToLower(strings.TrimSpace(line[:separatorIndex]))
+		value := unquoteProfileValue(line[separatorIndex+1:])
+		switch key {
+		case "region":
+			currentProfile.Region = value
+		case "base_address", "baseaddress":
+			currentProfile.BaseAddress = value
+		case "username", "user":
+			currentProfile.Username = value
+		case "password":
+			currentProfile.Password = value
+		default:
+			return nil, fmt.Errorf("Invalid configuration file '%s' (line %d): unknown setting '%s'", path, lineNumber, key)
+		}
+	}
+	err = scanner.Err()
+	if err != nil {
+		return nil, fmt.Errorf("Unable to read configuration file '%s': %s", path, err.Error())
+	}
+
+	return profiles, nil
+}
+
+// newClientProfile creates (or retrieves, if it has already been declared) the named profile.
+func newClientProfile(profiles map[string]*ClientProfile, name string) *ClientProfile {
+	profile, ok := profiles[name]
+	if !ok {
+		profile = &ClientProfile{Name: name}
+		profiles[name] = profile
+	}
+
+	return profile
+}
+
+// unquoteProfileValue trims whitespace and surrounding quotes from a configuration value.
+func unquoteProfileValue(value string) string {
+	value = strings.TrimSpace(value)
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		value = value[1 : len(value)-1]
+	}
+
+	return value
+}
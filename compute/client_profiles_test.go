@@ -0,0 +1,145 @@
+package compute
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+)
+
+// Load client profiles (TOML-style).
+func TestLoadClientProfiles_TOML(test *testing.T) {
+	expect := expect(test)
+
+	profiles := testLoadClientProfiles(test, `
+# Default profile
+[default]
+region = "AU"
+username = "user1"
+password = "pass=word:1"
+
+[profile staging]
+base_address = "https://api-staging.example.com"
+username = 'user2'
+password = "password2"
+`)
+
+	expect.EqualsInt("Profiles.Length", 2, len(profiles))
+
+	profile := profiles["default"]
+	expect.NotNil("Profiles[default]", profile)
+	expect.EqualsString("Profiles[default].Region", "AU", profile.Region)
+	expect.EqualsString("Profiles[default].Username", "user1", profile.Username)
+	expect.EqualsString("Profiles[default].Password", "pass=word:1", profile.Password)
+
+	profile = profiles["staging"]
+	expect.NotNil("Profiles[staging]", profile)
+	expect.EqualsString("Profiles[staging].BaseAddress", "https://api-staging.example.com", profile.BaseAddress)
+	expect.EqualsString("Profiles[staging].Username", "user2", profile.Username)
+
+	client, err := profile.NewClient()
+	if err != nil {
+		test.Fatal(err)
+	}
+	expect.EqualsString("Client.BaseAddress", "https://api-staging.example.com", client.baseAddress)
+}
+
+// Load client profiles (YAML-style).
+func TestLoadClientProfiles_YAML(test *testing.T) {
+	expect := expect(test)
+
+	profiles := testLoadClientProfiles(test, `
+---
+default:
+  region: AU
+  username: user1
+  password: "password1"
+staging:
+  base_address: https://api-staging.example.com
+  username: user2
+  password: pass=word:2
+`)
+
+	expect.EqualsInt("Profiles.Length", 2, len(profiles))
+	expect.EqualsString("Profiles[default].Region", "AU", profiles["default"].Region)
+	expect.EqualsString("Profiles[default].Password", "password1", profiles["default"].Password)
+	expect.EqualsString("Profiles[staging].BaseAddress", "https://api-staging.example.com", profiles["staging"].BaseAddress)
+	expect.EqualsString("Profiles[staging].Password", "pass=word:2", profiles["staging"].Password)
+}
+
+// Load client profiles (invalid file).
+func TestLoadClientProfiles_Invalid(test *testing.T) {
+	expect := expect(test)
+
+	directory, err := ioutil.TempDir("", "profiles")
+	if err != nil {
+		test.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+
+	configFile := path.Join(directory, "config")
+	err = ioutil.WriteFile(configFile, []byte("[default]\nfavourite_colour = \"blue\"\n"), 0600)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	_, err = LoadClientProfiles(configFile)
+	expect.NotNil("Error (unknown setting)", err)
+
+	_, err = NewClientFromConfigFileProfile(path.Join(directory, "missing"), "default")
+	expect.NotNil("Error (missing file)", err)
+}
+
+// Create client from environment variables.
+func TestNewClientFromEnvironment(test *testing.T) {
+	expect := expect(test)
+
+	for _, variable := range []string{"MCP_USER", "MCP_PASSWORD", "MCP_REGION"} {
+		originalValue, isSet := os.LookupEnv(variable)
+		if isSet {
+			defer os.Setenv(variable, originalValue)
+		} else {
+			defer os.Unsetenv(variable)
+		}
+		os.Unsetenv(variable)
+	}
+
+	_, err := NewClientFromEnvironment()
+	expect.NotNil("Error (variables not set)", err)
+
+	os.Setenv("MCP_USER", "user1")
+	os.Setenv("MCP_PASSWORD", "password1")
+	os.Setenv("MCP_REGION", "AU")
+
+	client, err := NewClientFromEnvironment()
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	expectedBaseAddress, err := GetRegionBaseAddress("AU")
+	if err != nil {
+		test.Fatal(err)
+	}
+	expect.EqualsString("Client.BaseAddress", expectedBaseAddress, client.baseAddress)
+}
+
+func testLoadClientProfiles(test *testing.T, configuration string) map[string]*ClientProfile {
+	directory, err := ioutil.TempDir("", "profiles")
+	if err != nil {
+		test.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+
+	configFile := path.Join(directory, "config")
+	err = ioutil.WriteFile(configFile, []byte(configuration), 0600)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	profiles, err := LoadClientProfiles(configFile)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	return profiles
+}
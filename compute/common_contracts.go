@@ -1,6 +1,11 @@
 package compute
 
-import "fmt"
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+)
 
 // Entity represents a Cloud Control entity.
 type Entity interface {
@@ -46,6 +51,11 @@ func (network IPv4Range) ToDisplayString() string {
 	return fmt.Sprintf("%s/%d", network.BaseAddress, network.PrefixSize)
 }
 
+// Contains determines whether the IPv4 range contains the specified address.
+func (network IPv4Range) Contains(address string) bool {
+	return networkContainsAddress(network.ToDisplayString(), address)
+}
+
 // IPv6Range represents an IPv6 network (base address and prefix size)
 type IPv6Range struct {
 	// The network base address.
@@ -59,6 +69,34 @@ func (network IPv6Range) ToDisplayString() string {
 	return fmt.Sprintf("%s/%d", network.BaseAddress, network.PrefixSize)
 }
 
+// Contains determines whether the IPv6 range contains the specified address.
+func (network IPv6Range) Contains(address string) bool {
+	return networkContainsAddress(network.ToDisplayString(), address)
+}
+
+// networkContainsAddress determines whether the specified network (in CIDR notation) contains the specified address.
+func networkContainsAddress(cidr string, address string) bool {
+	_, ipNetwork, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return false
+	}
+
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+
+	return ipNetwork.Contains(ip)
+}
+
+const (
+	// OperatingSystemFamilyUNIX represents the UNIX operating system family (including Linux).
+	OperatingSystemFamilyUNIX = "UNIX"
+
+	// OperatingSystemFamilyWindows represents the Windows operating system family.
+	OperatingSystemFamilyWindows = "WINDOWS"
+)
+
 // OperatingSystem represents a well-known operating system for virtual machines.
 type OperatingSystem struct {
 	// The operating system Id.
@@ -71,6 +109,46 @@ type OperatingSystem struct {
 	DisplayName string `json:"displayName"`
 }
 
+// IsWindows determines whether the operating system is a member of the Windows family.
+func (operatingSystem OperatingSystem) IsWindows() bool {
+	if operatingSystem.Family != "" {
+		return strings.EqualFold(operatingSystem.Family, OperatingSystemFamilyWindows)
+	}
+
+	// Family not populated; fall back to the Id (e.g. "WIN2012R2S64").
+	return strings.HasPrefix(strings.ToUpper(operatingSystem.ID), "WIN")
+}
+
+// IsUnix determines whether the operating system is a member of the UNIX family (including Linux).
+func (operatingSystem OperatingSystem) IsUnix() bool {
+	if operatingSystem.Family != "" {
+		return strings.EqualFold(operatingSystem.Family, OperatingSystemFamilyUNIX)
+	}
+
+	return operatingSystem.ID != "" && !operatingSystem.IsWindows()
+}
+
+// IsLinux determines whether the operating system is a Linux distribution (i.e. a member of the UNIX family other than Solaris or FreeBSD).
+func (operatingSystem OperatingSystem) IsLinux() bool {
+	if !operatingSystem.IsUnix() {
+		return false
+	}
+
+	id := strings.ToUpper(operatingSystem.ID)
+
+	return !strings.HasPrefix(id, "SOLARIS") && !strings.HasPrefix(id, "FREEBSD")
+}
+
+// NeedsWindowsLicense determines whether servers running the operating system consume a Windows license.
+func (operatingSystem OperatingSystem) NeedsWindowsLicense() bool {
+	return operatingSystem.IsWindows()
+}
+
+// RequiresAdministratorPassword determines whether an administrator password must be supplied when deploying a (customised) server running the operating system.
+func (operatingSystem OperatingSystem) RequiresAdministratorPassword() bool {
+	return operatingSystem.IsWindows()
+}
+
 // VirtualMachineCPU represents the CPU configuration for a virtual machine.
 type VirtualMachineCPU struct {
 	Count          int    `json:"count,omitempty"`
@@ -84,6 +162,40 @@ type VirtualMachineDisk struct {
 	SCSIUnitID int     `json:"scsiId"`
 	SizeGB     int     `json:"sizeGb"`
 	Speed      string  `json:"speed"`
+	IOPS       int     `json:"iops,omitempty"` // Only applicable to disks with speed ServerDiskSpeedProvisionedIOPS (CloudControl v2.7 and higher)
+
+	// The bus number of the SCSI controller to which the disk is attached (only populated for images and servers that group their disks by SCSI controller; not sent to CloudControl).
+	SCSIBusNumber int `json:"-"`
+}
+
+// scsiControllerDisks represents the disks attached to a SCSI controller (used when flattening disks that are grouped by SCSI controller).
+type scsiControllerDisks struct {
+	BusNumber int
+	Disks     []VirtualMachineDisk
+}
+
+// flattenSCSIControllerDisks returns the disks attached to the specified SCSI controllers, ordered by bus number, then SCSI unit Id (with each disk's SCSIBusNumber set).
+func flattenSCSIControllerDisks(controllers []scsiControllerDisks) (disks []VirtualMachineDisk) {
+	sortedControllers := make([]scsiControllerDisks, len(controllers))
+	copy(sortedControllers, controllers)
+	sort.SliceStable(sortedControllers, func(index1 int, index2 int) bool {
+		return sortedControllers[index1].BusNumber < sortedControllers[index2].BusNumber
+	})
+
+	for _, controller := range sortedControllers {
+		controllerDisks := make([]VirtualMachineDisk, len(controller.Disks))
+		copy(controllerDisks, controller.Disks)
+		for index := range controllerDisks {
+			controllerDisks[index].SCSIBusNumber = controller.BusNumber
+		}
+		sort.SliceStable(controllerDisks, func(index1 int, index2 int) bool {
+			return controllerDisks[index1].SCSIUnitID < controllerDisks[index2].SCSIUnitID
+		})
+
+		disks = append(disks, controllerDisks...)
+	}
+
+	return
 }
 
 // VirtualMachineNetwork represents the networking configuration for a virtual machine.
@@ -93,6 +205,33 @@ type VirtualMachineNetwork struct {
 	AdditionalNetworkAdapters []VirtualMachineNetworkAdapter `json:"additionalNic"`
 }
 
+// GetNetworkAdapters retrieves all of the network's adapters (primary adapter first).
+func (network *VirtualMachineNetwork) GetNetworkAdapters() []VirtualMachineNetworkAdapter {
+	return append([]VirtualMachineNetworkAdapter{network.PrimaryAdapter}, network.AdditionalNetworkAdapters...)
+}
+
+// GetIPv4Addresses retrieves the private IPv4 addresses of all of the network's adapters (primary adapter first).
+func (network *VirtualMachineNetwork) GetIPv4Addresses() (addresses []string) {
+	for _, networkAdapter := range network.GetNetworkAdapters() {
+		if networkAdapter.PrivateIPv4Address != nil && *networkAdapter.PrivateIPv4Address != "" {
+			addresses = append(addresses, *networkAdapter.PrivateIPv4Address)
+		}
+	}
+
+	return
+}
+
+// GetIPv6Addresses retrieves the IPv6 addresses of all of the network's adapters (primary adapter first).
+func (network *VirtualMachineNetwork) GetIPv6Addresses() (addresses []string) {
+	for _, networkAdapter := range network.GetNetworkAdapters() {
+		if networkAdapter.PrivateIPv6Address != nil && *networkAdapter.PrivateIPv6Address != "" {
+			addresses = append(addresses, *networkAdapter.PrivateIPv6Address)
+		}
+	}
+
+	return
+}
+
 // VirtualMachineNetworkAdapter represents the configuration for a virtual machine's network adapter.
 // If deploying a new VM, exactly one of VLANID / PrivateIPv4Address must be specified.
 //
@@ -107,11 +246,12 @@ type VirtualMachineNetworkAdapter struct {
 	AdapterType        *string `json:"networkAdapter,omitempty"`
 	AdapterKey         *int    `json:"key,omitempty"` // CloudControl v2.4 and higher
 	State              *string `json:"state,omitempty"`
+	Connected          *bool   `json:"connected,omitempty"`
 }
 
 // GetID returns the network adapter's Id.
 func (networkAdapter *VirtualMachineNetworkAdapter) GetID() string {
-	if networkAdapter.ID == nil {
+	if networkAdapter == nil || networkAdapter.ID == nil {
 		return ""
 	}
 
@@ -130,7 +270,7 @@ func (networkAdapter *VirtualMachineNetworkAdapter) GetName() string {
 
 // GetState returns the network adapter's current state.
 func (networkAdapter *VirtualMachineNetworkAdapter) GetState() string {
-	if networkAdapter.State == nil {
+	if networkAdapter == nil || networkAdapter.State == nil {
 		return ""
 	}
 
@@ -144,6 +284,10 @@ func (networkAdapter *VirtualMachineNetworkAdapter) IsDeleted() bool {
 
 // ToEntityReference creates an EntityReference representing the CustomerImage.
 func (networkAdapter *VirtualMachineNetworkAdapter) ToEntityReference() EntityReference {
+	if networkAdapter == nil {
+		return EntityReference{}
+	}
+
 	id := ""
 	if networkAdapter.ID != nil {
 		id = *networkAdapter.ID
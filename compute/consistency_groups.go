@@ -0,0 +1,434 @@
+package compute
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ConsistencyGroupJournalSizeGB represents the size (in GB) of a consistency group's journal.
+type ConsistencyGroupJournalSizeGB int
+
+const (
+	// MinConsistencyGroupJournalSizeGB is the minimum size (in GB) of a consistency group's journal.
+	MinConsistencyGroupJournalSizeGB ConsistencyGroupJournalSizeGB = 10
+
+	// MaxConsistencyGroupJournalSizeGB is the maximum size (in GB) of a consistency group's journal.
+	MaxConsistencyGroupJournalSizeGB ConsistencyGroupJournalSizeGB = 16000
+
+	// ConsistencyGroupJournalExtentSizeGB is the size (in GB) of each extent in a consistency group's journal (journal sizes must be a multiple of this).
+	ConsistencyGroupJournalExtentSizeGB ConsistencyGroupJournalSizeGB = 10
+)
+
+// Validate ensures that the journal size is supported by CloudControl.
+func (size ConsistencyGroupJournalSizeGB) Validate() error {
+	if size < MinConsistencyGroupJournalSizeGB || size > MaxConsistencyGroupJournalSizeGB {
+		return fmt.Errorf("Invalid consistency group journal size %dGB (must be between %dGB and %dGB)", size, MinConsistencyGroupJournalSizeGB, MaxConsistencyGroupJournalSizeGB)
+	}
+
+	if size%ConsistencyGroupJournalExtentSizeGB != 0 {
+		return fmt.Errorf("Invalid consistency group journal size %dGB (must be a multiple of %dGB)", size, ConsistencyGroupJournalExtentSizeGB)
+	}
+
+	return nil
+}
+
+// ConsistencyGroupOperationStatus represents the DRS operation status of a consistency group (i.e. whether it is replicating, previewing a snapshot, or failing over).
+type ConsistencyGroupOperationStatus string
+
+const (
+	// ConsistencyGroupOperationStatusDRSMode indicates that the consistency group is replicating normally.
+	ConsistencyGroupOperationStatusDRSMode ConsistencyGroupOperationStatus = "DRS_MODE"
+
+	// ConsistencyGroupOperationStatusStartingPreview indicates that a failover preview is being started.
+	ConsistencyGroupOperationStatusStartingPreview ConsistencyGroupOperationStatus = "STARTING_PREVIEW"
+
+	// ConsistencyGroupOperationStatusPreviewingSnapshot indicates that the target servers are running from a snapshot (failover preview).
+	ConsistencyGroupOperationStatusPreviewingSnapshot ConsistencyGroupOperationStatus = "PREVIEWING_SNAPSHOT"
+
+	// ConsistencyGroupOperationStatusStoppingPreview indicates that a failover preview is being stopped.
+	ConsistencyGroupOperationStatusStoppingPreview ConsistencyGroupOperationStatus = "STOPPING_PREVIEW"
+
+	// ConsistencyGroupOperationStatusFailingOver indicates that the consistency group is failing over.
+	ConsistencyGroupOperationStatusFailingOver ConsistencyGroupOperationStatus = "FAILING_OVER"
+)
+
+// ConsistencyGroupPDEState represents the PDE (protection) state of a consistency group's DRS infrastructure.
+type ConsistencyGroupPDEState string
+
+const (
+	// ConsistencyGroupPDEStateActive indicates that the consistency group's DRS infrastructure is actively protecting its servers.
+	ConsistencyGroupPDEStateActive ConsistencyGroupPDEState = "ACTIVE"
+)
+
+// ConsistencyGroup represents a DRS (Disaster Recovery) consistency group, which replicates a source server to a target server.
+type ConsistencyGroup struct {
+	// The consistency group Id.
+	ID string `json:"id"`
+
+	// The consistency group name.
+	Name string `json:"name"`
+
+	// The consistency group description.
+	Description string `json:"description"`
+
+	// The consistency group's journal.
+	Journal ConsistencyGroupJournal `json:"journal"`
+
+	// The consistency group's source location.
+	Source ConsistencyGroupLocation `json:"source"`
+
+	// The consistency group's target location.
+	Target ConsistencyGroupLocation `json:"target"`
+
+	// The source and target servers replicated by the consistency group.
+	ServerPairs []ConsistencyGroupServerPair `json:"serverPair"`
+
+	// The consistency group's DRS operation status.
+	OperationStatus ConsistencyGroupOperationStatus `json:"operationStatus"`
+
+	// The consistency group's DRS infrastructure (including its PDE state).
+	DRSInfrastructure ConsistencyGroupDRSInfrastructure `json:"drsInfrastructure"`
+
+	// The consistency group's creation timestamp.
+	CreateTime Timestamp `json:"createTime"`
+
+	// The consistency group's current state.
+	State string `json:"state"`
+}
+
+// GetID returns the consistency group's Id.
+func (group *ConsistencyGroup) GetID() string {
+	return group.ID
+}
+
+// GetName returns the consistency group's name.
+func (group *ConsistencyGroup) GetName() string {
+	return group.Name
+}
+
+// IsPreviewingSnapshot determines whether the consistency group is currently in failover preview.
+func (group *ConsistencyGroup) IsPreviewingSnapshot() bool {
+	return group.OperationStatus == ConsistencyGroupOperationStatusPreviewingSnapshot
+}
+
+// IsProtected determines whether the consistency group's DRS infrastructure is enabled and active.
+func (group *ConsistencyGroup) IsProtected() bool {
+	return group.DRSInfrastructure.Enabled && group.DRSInfrastructure.Status == ConsistencyGroupPDEStateActive
+}
+
+// ConsistencyGroupDRSInfrastructure represents the DRS infrastructure that protects a consistency group.
+type ConsistencyGroupDRSInfrastructure struct {
+	// Is the DRS infrastructure enabled?
+	Enabled bool `json:"enabled"`
+
+	// The PDE state of the DRS infrastructure.
+	Status ConsistencyGroupPDEState `json:"status"`
+
+	// The time at which the PDE state was last updated.
+	UpdateTime Timestamp `json:"updateTime"`
+}
+
+// ConsistencyGroupJournal represents the journal for a consistency group.
+type ConsistencyGroupJournal struct {
+	// The journal size (in GB).
+	SizeGB ConsistencyGroupJournalSizeGB `json:"sizeGb"`
+
+	// The number of extents in the journal.
+	ExtentCount int `json:"extentCount"`
+}
+
+// ConsistencyGroupLocation represents the source or target location of a consistency group.
+type ConsistencyGroupLocation struct {
+	// The datacenter Id.
+	DatacenterID string `json:"datacenterId"`
+
+	// The network domain Id.
+	NetworkDomainID string `json:"networkDomainId"`
+}
+
+// ConsistencyGroupServerPair represents a source server and the target server to which it is replicated.
+type ConsistencyGroupServerPair struct {
+	// The server pair Id.
+	ID string `json:"id"`
+
+	// The server pair's current state.
+	State string `json:"state"`
+
+	// The source server.
+	Source ConsistencyGroupServer `json:"source"`
+
+	// The target server.
+	Target ConsistencyGroupServer `json:"target"`
+}
+
+// ConsistencyGroupServer represents a server in a consistency group.
+type ConsistencyGroupServer struct {
+	// The server Id.
+	ServerID string `json:"serverId"`
+
+	// The server name.
+	Name string `json:"name"`
+}
+
+// ConsistencyGroups represents a page of ConsistencyGroup results.
+type ConsistencyGroups struct {
+	// The current page of consistency groups.
+	Items []ConsistencyGroup `json:"consistencyGroup"`
+
+	PagedResult
+}
+
+// ConsistencyGroupConfiguration represents the configuration for a new consistency group.
+type ConsistencyGroupConfiguration struct {
+	// The consistency group name.
+	Name string `json:"name"`
+
+	// The consistency group description.
+	Description string `json:"description,omitempty"`
+
+	// The size (in GB) of the consistency group's journal.
+	JournalSizeGB ConsistencyGroupJournalSizeGB `json:"journalSizeGb"`
+
+	// The Id of the server to replicate.
+	SourceServerID string `json:"sourceServerId"`
+
+	// The Id of the server to which the source server will be replicated.
+	TargetServerID string `json:"targetServerId"`
+}
+
+// Request body when expanding a consistency group's journal.
+type expandConsistencyGroupJournal struct {
+	ID     string                        `json:"id"`
+	SizeGB ConsistencyGroupJournalSizeGB `json:"sizeGb"`
+}
+
+// Request body when starting a failover preview for a consistency group.
+type startConsistencyGroupPreview struct {
+	ConsistencyGroupID string `json:"consistencyGroupId"`
+	SnapshotID         string `json:"snapshotId"`
+}
+
+// Request body for consistency group operations that only require the consistency group Id.
+type consistencyGroupOperation struct {
+	ConsistencyGroupID string `json:"consistencyGroupId"`
+}
+
+// Request body when deleting a consistency group.
+type deleteConsistencyGroup struct {
+	ID string `json:"id"`
+}
+
+// CreateConsistencyGroup creates a new DRS consistency group.
+//
+// The consistency group's status will be ResourceStatusPendingAdd while it is being created.
+func (client *Client) CreateConsistencyGroup(configuration ConsistencyGroupConfiguration) (consistencyGroupID string, err error) {
+	err = configuration.JournalSizeGB.Validate()
+	if err != nil {
+		return "", err
+	}
+
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return "", err
+	}
+
+	requestURI := fmt.Sprintf("%s/consistencyGroup/createConsistencyGroup",
+		url.QueryEscape(organizationID),
+	)
+	request, err := client.newRequestV27(requestURI, http.MethodPost, &configuration)
+	if err != nil {
+		return "", err
+	}
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return "", err
+	}
+
+	apiResponse, err := readAPIResponseAsJSON(responseBody, statusCode)
+	if err != nil {
+		return "", err
+	}
+
+	if apiResponse.ResponseCode != ResponseCodeInProgress {
+		return "", apiResponse.ToError("Request to create consistency group '%s' failed with status code %d (%s): %s", configuration.Name, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	// Expected: "info" { "name": "consistencyGroupId", "value": "the-Id-of-the-new-consistency-group" }
+	consistencyGroupIDMessage := apiResponse.GetFieldMessage("consistencyGroupId")
+	if consistencyGroupIDMessage == nil {
+		return "", apiResponse.ToError("Received an unexpected response (missing 'consistencyGroupId') with status code %d (%s): %s", statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	return *consistencyGroupIDMessage, nil
+}
+
+// GetConsistencyGroup retrieves the consistency group with the specified Id.
+// Returns nil if no consistency group is found with the specified Id.
+func (client *Client) GetConsistencyGroup(id string) (consistencyGroup *ConsistencyGroup, err error) {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return nil, err
+	}
+
+	requestURI := fmt.Sprintf("%s/consistencyGroup/consistencyGroup/%s",
+		url.QueryEscape(organizationID),
+		url.QueryEscape(id),
+	)
+	request, err := client.newRequestV27(requestURI, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode != http.StatusOK {
+		var apiResponse *APIResponseV2
+
+		apiResponse, err = readAPIResponseAsJSON(responseBody, statusCode)
+		if err != nil {
+			return nil, err
+		}
+
+		if apiResponse.ResponseCode == ResponseCodeResourceNotFound {
+			return nil, nil // Not an error, but was not found.
+		}
+
+		return nil, apiResponse.ToError("Request to retrieve consistency group '%s' failed with status code %d (%s): %s", id, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	consistencyGroup = &ConsistencyGroup{}
+	err = json.Unmarshal(responseBody, consistencyGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	return consistencyGroup, nil
+}
+
+// ListConsistencyGroups retrieves a page of DRS consistency groups.
+func (client *Client) ListConsistencyGroups(paging *Paging) (consistencyGroups *ConsistencyGroups, err error) {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return nil, err
+	}
+
+	err = paging.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	requestURI := fmt.Sprintf("%s/consistencyGroup/consistencyGroup?%s",
+		url.QueryEscape(organizationID),
+		paging.EnsurePaging().toQueryParameters(),
+	)
+	request, err := client.newRequestV27(requestURI, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode != http.StatusOK {
+		var apiResponse *APIResponseV2
+
+		apiResponse, err = readAPIResponseAsJSON(responseBody, statusCode)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, apiResponse.ToError("Request to list consistency groups failed with status code %d (%s): %s", statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	consistencyGroups = &ConsistencyGroups{}
+	err = json.Unmarshal(responseBody, consistencyGroups)
+	if err != nil {
+		return nil, err
+	}
+
+	return consistencyGroups, nil
+}
+
+// ExpandJournal increases the size of the specified consistency group's journal.
+func (client *Client) ExpandJournal(consistencyGroupID string, newSizeGB ConsistencyGroupJournalSizeGB) error {
+	err := newSizeGB.Validate()
+	if err != nil {
+		return err
+	}
+
+	return client.postConsistencyGroupRequest("expandJournal", consistencyGroupID, &expandConsistencyGroupJournal{
+		ID:     consistencyGroupID,
+		SizeGB: newSizeGB,
+	})
+}
+
+// StartFailoverPreview starts a failover preview for the specified consistency group (the target servers are started from the specified snapshot).
+//
+// Call StopFailoverPreview to return to normal replication, or InitiateFailover to fail over to the previewed snapshot.
+func (client *Client) StartFailoverPreview(consistencyGroupID string, snapshotID string) error {
+	return client.postConsistencyGroupRequest("startPreviewSnapshot", consistencyGroupID, &startConsistencyGroupPreview{
+		ConsistencyGroupID: consistencyGroupID,
+		SnapshotID:         snapshotID,
+	})
+}
+
+// StopFailoverPreview stops the failover preview for the specified consistency group.
+func (client *Client) StopFailoverPreview(consistencyGroupID string) error {
+	return client.postConsistencyGroupRequest("stopPreviewSnapshot", consistencyGroupID, &consistencyGroupOperation{
+		ConsistencyGroupID: consistencyGroupID,
+	})
+}
+
+// InitiateFailover fails the specified consistency group over to the snapshot currently being previewed.
+//
+// The consistency group must be in failover preview (see StartFailoverPreview).
+func (client *Client) InitiateFailover(consistencyGroupID string) error {
+	return client.postConsistencyGroupRequest("initiateFailoverForConsistencyGroup", consistencyGroupID, &consistencyGroupOperation{
+		ConsistencyGroupID: consistencyGroupID,
+	})
+}
+
+// DeleteConsistencyGroup deletes the specified consistency group (the source and target servers are not deleted).
+func (client *Client) DeleteConsistencyGroup(consistencyGroupID string) error {
+	return client.postConsistencyGroupRequest("deleteConsistencyGroup", consistencyGroupID, &deleteConsistencyGroup{
+		ID: consistencyGroupID,
+	})
+}
+
+// postConsistencyGroupRequest posts a request to the specified consistency group operation.
+func (client *Client) postConsistencyGroupRequest(operation string, consistencyGroupID string, requestBody interface{}) error {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return err
+	}
+
+	requestURI := fmt.Sprintf("%s/consistencyGroup/%s",
+		url.QueryEscape(organizationID),
+		operation,
+	)
+	request, err := client.newRequestV27(requestURI, http.MethodPost, requestBody)
+	if err != nil {
+		return err
+	}
+
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return err
+	}
+
+	apiResponse, err := readAPIResponseAsJSON(responseBody, statusCode)
+	if err != nil {
+		return err
+	}
+
+	if apiResponse.ResponseCode != ResponseCodeOK && apiResponse.ResponseCode != ResponseCodeInProgress {
+		return apiResponse.ToError("Request to %s for consistency group '%s' failed with status code %d (%s): %s", operation, consistencyGroupID, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	return nil
+}
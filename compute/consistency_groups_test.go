@@ -0,0 +1,208 @@
+package compute
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// Consistency group journal size validation.
+func TestConsistencyGroupJournalSizeGB_Validate(test *testing.T) {
+	expect := expect(test)
+
+	expect.IsTrue("10GB is valid", ConsistencyGroupJournalSizeGB(10).Validate() == nil)
+	expect.IsTrue("150GB is valid", ConsistencyGroupJournalSizeGB(150).Validate() == nil)
+	expect.IsTrue("5GB is invalid", ConsistencyGroupJournalSizeGB(5).Validate() != nil)
+	expect.IsTrue("155GB is invalid", ConsistencyGroupJournalSizeGB(155).Validate() != nil)
+	expect.IsTrue("20000GB is invalid", ConsistencyGroupJournalSizeGB(20000).Validate() != nil)
+}
+
+// Create consistency group (successful).
+func TestClient_CreateConsistencyGroup_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			consistencyGroupID, err := client.CreateConsistencyGroup(ConsistencyGroupConfiguration{
+				Name:           "my-consistency-group",
+				JournalSizeGB:  100,
+				SourceServerID: "f8ef8a2a-2c8c-4b7b-8d9b-0c5b4c3a2b1a",
+				TargetServerID: "0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d",
+			})
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsString("ConsistencyGroupID", "3389ffe8-c3fc-11e3-b29c-001517c4643e", consistencyGroupID)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.IsTrue("Request.URL", strings.HasSuffix(request.URL.Path, "/consistencyGroup/createConsistencyGroup"))
+
+			requestBody := &ConsistencyGroupConfiguration{}
+			err := readRequestBodyAsJSON(request, requestBody)
+			if err != nil {
+				test.Fatal(err)
+			}
+			expect.EqualsInt("Configuration.JournalSizeGB", 100, int(requestBody.JournalSizeGB))
+			expect.EqualsString("Configuration.TargetServerID", "0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d", requestBody.TargetServerID)
+
+			return http.StatusAccepted, createConsistencyGroupTestResponse
+		},
+	})
+}
+
+// Create consistency group (invalid journal size is rejected without calling the API).
+func TestClient_CreateConsistencyGroup_InvalidJournalSize(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			_, err := client.CreateConsistencyGroup(ConsistencyGroupConfiguration{
+				Name:          "my-consistency-group",
+				JournalSizeGB: 15,
+			})
+			expect.NotNil("Error", err)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			test.Fatalf("Unexpected request to '%s'.", request.URL.Path)
+
+			return http.StatusInternalServerError, ""
+		},
+	})
+}
+
+// List consistency groups (successful).
+func TestClient_ListConsistencyGroups_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			consistencyGroups, err := client.ListConsistencyGroups(nil)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("ConsistencyGroups.Items.Length", 1, len(consistencyGroups.Items))
+
+			consistencyGroup := consistencyGroups.Items[0]
+			expect.EqualsString("ConsistencyGroup.Name", "my-consistency-group", consistencyGroup.Name)
+			expect.EqualsInt("ConsistencyGroup.Journal.SizeGB", 100, int(consistencyGroup.Journal.SizeGB))
+			expect.EqualsString("ConsistencyGroup.Target.DatacenterID", "NA12", consistencyGroup.Target.DatacenterID)
+			expect.EqualsInt("ConsistencyGroup.ServerPairs.Length", 1, len(consistencyGroup.ServerPairs))
+			expect.EqualsString("ConsistencyGroup.ServerPairs[0].Source.ServerID", "f8ef8a2a-2c8c-4b7b-8d9b-0c5b4c3a2b1a", consistencyGroup.ServerPairs[0].Source.ServerID)
+			expect.IsTrue("ConsistencyGroup.IsPreviewingSnapshot", consistencyGroup.IsPreviewingSnapshot())
+			expect.EqualsString("ConsistencyGroup.DRSInfrastructure.Status", string(ConsistencyGroupPDEStateActive), string(consistencyGroup.DRSInfrastructure.Status))
+			expect.IsTrue("ConsistencyGroup.IsProtected", consistencyGroup.IsProtected())
+		},
+		Respond: testRespondOK(listConsistencyGroupsTestResponse),
+	})
+}
+
+// Start failover preview (successful).
+func TestClient_StartFailoverPreview_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			err := client.StartFailoverPreview("3389ffe8-c3fc-11e3-b29c-001517c4643e", "12345")
+			if err != nil {
+				test.Fatal(err)
+			}
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.IsTrue("Request.URL", strings.HasSuffix(request.URL.Path, "/consistencyGroup/startPreviewSnapshot"))
+
+			requestBody := &startConsistencyGroupPreview{}
+			err := readRequestBodyAsJSON(request, requestBody)
+			if err != nil {
+				test.Fatal(err)
+			}
+			expect.EqualsString("StartPreview.SnapshotID", "12345", requestBody.SnapshotID)
+
+			return http.StatusAccepted, startFailoverPreviewTestResponse
+		},
+	})
+}
+
+/*
+ * Test responses.
+ */
+
+const createConsistencyGroupTestResponse = `
+{
+	"operation": "CREATE_CONSISTENCY_GROUP",
+	"responseCode": "IN_PROGRESS",
+	"message": "Request to Create Consistency Group has been accepted. Please use appropriate Get or List API for status.",
+	"info": [
+		{
+			"name": "consistencyGroupId",
+			"value": "3389ffe8-c3fc-11e3-b29c-001517c4643e"
+		}
+	],
+	"warning": [],
+	"error": [],
+	"requestId": "na9_20170321T074626030-0400_7e9fffe7-190b-46f2-9107-9d52fe57d0ad"
+}
+`
+
+const listConsistencyGroupsTestResponse = `
+{
+	"consistencyGroup": [
+		{
+			"id": "3389ffe8-c3fc-11e3-b29c-001517c4643e",
+			"name": "my-consistency-group",
+			"description": "",
+			"journal": {
+				"sizeGb": 100,
+				"extentCount": 1
+			},
+			"source": {
+				"datacenterId": "NA9",
+				"networkDomainId": "484174a2-ae74-4658-9e56-50fc90e086cf"
+			},
+			"target": {
+				"datacenterId": "NA12",
+				"networkDomainId": "5e6f7a8b-9c0d-4e1f-8a2b-3c4d5e6f7a8b"
+			},
+			"serverPair": [
+				{
+					"id": "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d",
+					"state": "NORMAL",
+					"source": {
+						"serverId": "f8ef8a2a-2c8c-4b7b-8d9b-0c5b4c3a2b1a",
+						"name": "source-server"
+					},
+					"target": {
+						"serverId": "0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d",
+						"name": "target-server"
+					}
+				}
+			],
+			"operationStatus": "PREVIEWING_SNAPSHOT",
+			"drsInfrastructure": {
+				"enabled": true,
+				"status": "ACTIVE",
+				"updateTime": "2017-03-21T08:12:04.000Z"
+			},
+			"createTime": "2017-03-21T07:46:26.000Z",
+			"state": "NORMAL"
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 1,
+	"totalCount": 1,
+	"pageSize": 250
+}
+`
+
+const startFailoverPreviewTestResponse = `
+{
+	"operation": "START_PREVIEW_SNAPSHOT",
+	"responseCode": "IN_PROGRESS",
+	"message": "Request to Start Preview Snapshot has been accepted. Please use appropriate Get or List API for status.",
+	"info": [],
+	"warning": [],
+	"error": [],
+	"requestId": "na9_20170321T074626030-0400_7e9fffe7-190b-46f2-9107-9d52fe57d0ad"
+}
+`
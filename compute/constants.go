@@ -12,4 +12,38 @@ const (
 
 	// ResourceStatusPendingDelete indicates that a delete operation is pending for the resource.
 	ResourceStatusPendingDelete = "PENDING_DELETE"
+
+	// ResourceStatusFailedAdd indicates that an add operation has failed for the resource.
+	ResourceStatusFailedAdd = "FAILED_ADD"
+
+	// ResourceStatusFailedChange indicates that a change operation has failed for the resource.
+	ResourceStatusFailedChange = "FAILED_CHANGE"
+
+	// ResourceStatusFailedDelete indicates that a delete operation has failed for the resource.
+	ResourceStatusFailedDelete = "FAILED_DELETE"
+
+	// ResourceStatusRequiresSupport indicates that the resource is in an inconsistent state and requires intervention by support staff.
+	ResourceStatusRequiresSupport = "REQUIRES_SUPPORT"
 )
+
+// IsPendingStatus determines whether the specified resource status indicates that an operation is pending (i.e. PENDING_ADD, PENDING_CHANGE, or PENDING_DELETE).
+func IsPendingStatus(status string) bool {
+	switch status {
+	case ResourceStatusPendingAdd, ResourceStatusPendingChange, ResourceStatusPendingDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsFailedStatus determines whether the specified resource status indicates that an operation has failed (i.e. FAILED_ADD, FAILED_CHANGE, FAILED_DELETE, or REQUIRES_SUPPORT).
+//
+// A resource in a failed state will not return to ResourceStatusNormal without intervention.
+func IsFailedStatus(status string) bool {
+	switch status {
+	case ResourceStatusFailedAdd, ResourceStatusFailedChange, ResourceStatusFailedDelete, ResourceStatusRequiresSupport:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,21 @@
+package compute
+
+import "testing"
+
+// Resource status predicates.
+func TestResourceStatusPredicates(test *testing.T) {
+	expect := expect(test)
+
+	expect.IsFalse("IsPendingStatus(NORMAL)", IsPendingStatus(ResourceStatusNormal))
+	expect.IsTrue("IsPendingStatus(PENDING_ADD)", IsPendingStatus(ResourceStatusPendingAdd))
+	expect.IsTrue("IsPendingStatus(PENDING_CHANGE)", IsPendingStatus(ResourceStatusPendingChange))
+	expect.IsTrue("IsPendingStatus(PENDING_DELETE)", IsPendingStatus(ResourceStatusPendingDelete))
+	expect.IsFalse("IsPendingStatus(FAILED_ADD)", IsPendingStatus(ResourceStatusFailedAdd))
+
+	expect.IsFalse("IsFailedStatus(NORMAL)", IsFailedStatus(ResourceStatusNormal))
+	expect.IsFalse("IsFailedStatus(PENDING_ADD)", IsFailedStatus(ResourceStatusPendingAdd))
+	expect.IsTrue("IsFailedStatus(FAILED_ADD)", IsFailedStatus(ResourceStatusFailedAdd))
+	expect.IsTrue("IsFailedStatus(FAILED_CHANGE)", IsFailedStatus(ResourceStatusFailedChange))
+	expect.IsTrue("IsFailedStatus(FAILED_DELETE)", IsFailedStatus(ResourceStatusFailedDelete))
+	expect.IsTrue("IsFailedStatus(REQUIRES_SUPPORT)", IsFailedStatus(ResourceStatusRequiresSupport))
+}
@@ -0,0 +1,223 @@
+// Package cost estimates the usage (and, given a price book, the cost) of CloudControl resources before they are deployed.
+//
+// Usage is expressed in the same units as the CloudControl summary usage report (e.g. CPU hours, RAM GB hours, storage GB hours),
+// so deployment pipelines can enforce budget gates using the rates from their own price book.
+package cost
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/DimensionDataResearch/go-dd-cloud-compute/compute"
+)
+
+// HoursPerMonth is the number of hours in an average month (used to convert hourly usage to monthly usage).
+const HoursPerMonth = 730
+
+// UsageEstimate represents the estimated usage of a resource, in the units used by the CloudControl summary usage report.
+type UsageEstimate struct {
+	// The Id of the datacenter where the resource will be deployed.
+	DatacenterID string
+
+	// The number of hours covered by the estimate.
+	Hours float64
+
+	// The number of standard CPU hours.
+	CPUHours float64
+
+	// The number of high-performance CPU hours.
+	HighPerformanceCPUHours float64
+
+	// The number of RAM (GB) hours.
+	RAMHours float64
+
+	// The number of standard storage (GB) hours.
+	StorageHours float64
+
+	// The number of high-performance storage (GB) hours.
+	HighPerformanceStorageHours float64
+
+	// The number of economy storage (GB) hours.
+	EconomyStorageHours float64
+
+	// The number of provisioned-IOPS storage (GB) hours.
+	ProvisionedIOPSStorageHours float64
+}
+
+// ForHours scales an hourly estimate to cover the specified number of hours.
+func (estimate UsageEstimate) ForHours(hours float64) UsageEstimate {
+	scale := hours
+	if estimate.Hours != 0 {
+		scale = hours / estimate.Hours
+	}
+
+	return UsageEstimate{
+		DatacenterID:                estimate.DatacenterID,
+		Hours:                       hours,
+		CPUHours:                    estimate.CPUHours * scale,
+		HighPerformanceCPUHours:     estimate.HighPerformanceCPUHours * scale,
+		RAMHours:                    estimate.RAMHours * scale,
+		StorageHours:                estimate.StorageHours * scale,
+		HighPerformanceStorageHours: estimate.HighPerformanceStorageHours * scale,
+		EconomyStorageHours:         estimate.EconomyStorageHours * scale,
+		ProvisionedIOPSStorageHours: estimate.ProvisionedIOPSStorageHours * scale,
+	}
+}
+
+// Monthly scales an estimate to cover an average month (HoursPerMonth hours).
+func (estimate UsageEstimate) Monthly() UsageEstimate {
+	return estimate.ForHours(HoursPerMonth)
+}
+
+// Add combines the estimate with another estimate covering the same period (e.g. to estimate the usage of several servers).
+func (estimate UsageEstimate) Add(other UsageEstimate) UsageEstimate {
+	combined := estimate
+	if combined.DatacenterID != other.DatacenterID {
+		combined.DatacenterID = ""
+	}
+	combined.CPUHours += other.CPUHours
+	combined.HighPerformanceCPUHours += other.HighPerformanceCPUHours
+	combined.RAMHours += other.RAMHours
+	combined.StorageHours += other.StorageHours
+	combined.HighPerformanceStorageHours += other.HighPerformanceStorageHours
+	combined.EconomyStorageHours += other.EconomyStorageHours
+	combined.ProvisionedIOPSStorageHours += other.ProvisionedIOPSStorageHours
+
+	return combined
+}
+
+// EstimateServerUsage estimates the hourly usage of a server deployed using the specified configuration in the specified datacenter.
+//
+// CPU and disk speeds that are not specified are assumed to be the datacenter's default speeds.
+// The configuration must specify the server's memory and disks (e.g. by applying the image to it first), since the image's defaults are not known here.
+func EstimateServerUsage(configuration *compute.ServerDeploymentConfiguration, datacenter *compute.Datacenter) (*UsageEstimate, error) {
+	if configuration == nil {
+		return nil, fmt.Errorf("Must specify the server deployment configuration")
+	}
+	if datacenter == nil {
+		return nil, fmt.Errorf("Must specify the datacenter where server '%s' will be deployed", configuration.Name)
+	}
+
+	err := datacenter.ValidateServerDeploymentConfiguration(configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	if configuration.CPU.Count < 1 {
+		return nil, fmt.Errorf("Cannot estimate usage for server '%s' (CPU count is not specified)", configuration.Name)
+	}
+	if configuration.MemoryGB < 1 {
+		return nil, fmt.Errorf("Cannot estimate usage for server '%s' (memory is not specified)", configuration.Name)
+	}
+
+	estimate := &UsageEstimate{
+		DatacenterID: datacenter.ID,
+		Hours:        1,
+		RAMHours:     float64(configuration.MemoryGB),
+	}
+
+	cpuSpeed := configuration.CPU.Speed
+	if cpuSpeed == "" {
+		cpuSpeed = getDefaultSpeed(datacenter.Hypervisor.CPUSpeeds, compute.ServerCPUSpeedStandard)
+	}
+	switch strings.ToUpper(cpuSpeed) {
+	case compute.ServerCPUSpeedHighPerformance:
+		estimate.HighPerformanceCPUHours = float64(configuration.CPU.Count)
+	default:
+		estimate.CPUHours = float64(configuration.CPU.Count)
+	}
+
+	defaultDiskSpeed := getDefaultSpeed(datacenter.Hypervisor.DiskSpeeds, compute.ServerDiskSpeedStandard)
+	for _, disk := range configuration.Disks {
+		diskSpeed := disk.Speed
+		if diskSpeed == "" {
+			diskSpeed = defaultDiskSpeed
+		}
+
+		sizeGB := float64(disk.SizeGB)
+		switch strings.ToUpper(diskSpeed) {
+		case compute.ServerDiskSpeedStandard:
+			estimate.StorageHours += sizeGB
+		case compute.ServerDiskSpeedHighPerformance:
+			estimate.HighPerformanceStorageHours += sizeGB
+		case compute.ServerDiskSpeedEconomy:
+			estimate.EconomyStorageHours += sizeGB
+		case compute.ServerDiskSpeedProvisionedIOPS:
+			estimate.ProvisionedIOPSStorageHours += sizeGB
+		default:
+			return nil, fmt.Errorf("Cannot estimate usage for disk speed '%s' (SCSI unit %d of server '%s')", diskSpeed, disk.SCSIUnitID, configuration.Name)
+		}
+	}
+
+	return estimate, nil
+}
+
+// getDefaultSpeed gets the Id of the default speed from the specified speed options (or the fallback speed, if no default is specified).
+func getDefaultSpeed(speedOptions []compute.DatacenterSpeedOption, fallbackSpeed string) string {
+	for _, speedOption := range speedOptions {
+		if speedOption.IsDefault {
+			return speedOption.ID
+		}
+	}
+
+	return fallbackSpeed
+}
+
+// PriceBook represents the price per unit of usage (e.g. per CPU hour, or per GB hour of RAM or storage).
+//
+// Prices are in whatever currency the price book uses; CloudControl does not publish prices, so these must come from the organisation's own rate card.
+type PriceBook struct {
+	CPUHour                      float64
+	HighPerformanceCPUHour       float64
+	RAMGBHour                    float64
+	StorageGBHour                float64
+	HighPerformanceStorageGBHour float64
+	EconomyStorageGBHour         float64
+	ProvisionedIOPSStorageGBHour float64
+}
+
+// Cost calculates the cost of the estimated usage.
+func (priceBook *PriceBook) Cost(estimate UsageEstimate) float64 {
+	return estimate.CPUHours*priceBook.CPUHour +
+		estimate.HighPerformanceCPUHours*priceBook.HighPerformanceCPUHour +
+		estimate.RAMHours*priceBook.RAMGBHour +
+		estimate.StorageHours*priceBook.StorageGBHour +
+		estimate.HighPerformanceStorageHours*priceBook.HighPerformanceStorageGBHour +
+		estimate.EconomyStorageHours*priceBook.EconomyStorageGBHour +
+		estimate.ProvisionedIOPSStorageHours*priceBook.ProvisionedIOPSStorageGBHour
+}
+
+// BudgetExceededError is returned by CheckBudget when the estimated cost of a deployment exceeds the budget.
+type BudgetExceededError struct {
+	// The estimated cost.
+	EstimatedCost float64
+
+	// The budget.
+	Budget float64
+
+	// The number of hours covered by the estimate and budget.
+	Hours float64
+}
+
+// Error returns the error message associated with the BudgetExceededError.
+func (err *BudgetExceededError) Error() string {
+	return fmt.Sprintf("Estimated cost %.2f exceeds budget %.2f (for %g hours)", err.EstimatedCost, err.Budget, err.Hours)
+}
+
+var _ error = &BudgetExceededError{}
+
+// CheckBudget determines whether the cost of the estimated usage is within the specified budget (covering the same period as the estimate).
+//
+// Returns a *BudgetExceededError if the budget is exceeded.
+func (priceBook *PriceBook) CheckBudget(estimate UsageEstimate, budget float64) error {
+	estimatedCost := priceBook.Cost(estimate)
+	if estimatedCost > budget {
+		return &BudgetExceededError{
+			EstimatedCost: estimatedCost,
+			Budget:        budget,
+			Hours:         estimate.Hours,
+		}
+	}
+
+	return nil
+}
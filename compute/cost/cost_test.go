@@ -0,0 +1,110 @@
+package cost
+
+import (
+	"math"
+	"testing"
+
+	"github.com/DimensionDataResearch/go-dd-cloud-compute/compute"
+)
+
+// Estimate server usage (default speeds come from the datacenter).
+func TestEstimateServerUsage(test *testing.T) {
+	configuration := &compute.ServerDeploymentConfiguration{
+		Name: "web-01",
+		CPU: compute.VirtualMachineCPU{
+			Count: 2,
+		},
+		MemoryGB: 8,
+		Disks: []compute.VirtualMachineDisk{
+			{SCSIUnitID: 0, SizeGB: 50},
+			{SCSIUnitID: 1, SizeGB: 100, Speed: compute.ServerDiskSpeedEconomy},
+		},
+	}
+
+	estimate, err := EstimateServerUsage(configuration, testDatacenter())
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	if estimate.HighPerformanceCPUHours != 2 || estimate.CPUHours != 0 {
+		test.Fatalf("Expected 2 high-performance CPU hours (found %g standard, %g high-performance).", estimate.CPUHours, estimate.HighPerformanceCPUHours)
+	}
+	if estimate.RAMHours != 8 {
+		test.Fatalf("Expected 8 RAM hours (found %g).", estimate.RAMHours)
+	}
+	if estimate.StorageHours != 50 || estimate.EconomyStorageHours != 100 {
+		test.Fatalf("Expected 50 standard and 100 economy storage hours (found %g and %g).", estimate.StorageHours, estimate.EconomyStorageHours)
+	}
+
+	monthly := estimate.Monthly()
+	if monthly.RAMHours != 8*HoursPerMonth {
+		test.Fatalf("Expected %d monthly RAM hours (found %g).", 8*HoursPerMonth, monthly.RAMHours)
+	}
+}
+
+// Estimate server usage (unsupported disk speed).
+func TestEstimateServerUsage_UnsupportedSpeed(test *testing.T) {
+	configuration := &compute.ServerDeploymentConfiguration{
+		Name:     "web-01",
+		CPU:      compute.VirtualMachineCPU{Count: 2},
+		MemoryGB: 8,
+		Disks: []compute.VirtualMachineDisk{
+			{SCSIUnitID: 0, SizeGB: 50, Speed: compute.ServerDiskSpeedHighPerformance},
+		},
+	}
+
+	_, err := EstimateServerUsage(configuration, testDatacenter())
+	if err == nil {
+		test.Fatal("Expected an error for an unsupported disk speed.")
+	}
+}
+
+// Check budget using a price book.
+func TestPriceBook_CheckBudget(test *testing.T) {
+	priceBook := &PriceBook{
+		CPUHour:       0.02,
+		RAMGBHour:     0.01,
+		StorageGBHour: 0.0001,
+	}
+	estimate := UsageEstimate{
+		Hours:        1,
+		CPUHours:     2,
+		RAMHours:     4,
+		StorageHours: 100,
+	}
+
+	hourlyCost := priceBook.Cost(estimate)
+	if math.Abs(hourlyCost-0.09) > 1e-9 {
+		test.Fatalf("Expected hourly cost of 0.09 (found %g).", hourlyCost)
+	}
+
+	err := priceBook.CheckBudget(estimate.Monthly(), 100)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	err = priceBook.CheckBudget(estimate.Monthly(), 50)
+	budgetExceededError, ok := err.(*BudgetExceededError)
+	if !ok {
+		test.Fatalf("Expected BudgetExceededError (found %v).", err)
+	}
+	if math.Abs(budgetExceededError.EstimatedCost-0.09*HoursPerMonth) > 1e-9 {
+		test.Fatalf("Expected estimated cost of %g (found %g).", 0.09*HoursPerMonth, budgetExceededError.EstimatedCost)
+	}
+}
+
+func testDatacenter() *compute.Datacenter {
+	return &compute.Datacenter{
+		ID: "AU9",
+		Hypervisor: compute.DatacenterHypervisor{
+			CPUSpeeds: []compute.DatacenterSpeedOption{
+				{ID: compute.ServerCPUSpeedStandard, IsAvailable: true},
+				{ID: compute.ServerCPUSpeedHighPerformance, IsAvailable: true, IsDefault: true},
+			},
+			DiskSpeeds: []compute.DatacenterSpeedOption{
+				{ID: compute.ServerDiskSpeedStandard, IsAvailable: true, IsDefault: true},
+				{ID: compute.ServerDiskSpeedEconomy, IsAvailable: true},
+			},
+		},
+	}
+}
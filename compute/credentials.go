@@ -0,0 +1,202 @@
+package compute
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+)
+
+// Credentials represents the user name and password used to authenticate to the CloudControl API.
+type Credentials struct {
+	// The CloudControl user name.
+	Username string `json:"username"`
+
+	// The CloudControl password.
+	Password string `json:"password"`
+}
+
+// CredentialsProvider supplies the credentials used by a Client to authenticate to the CloudControl API.
+//
+// The Client calls GetCredentials before its first request, and then again whenever CloudControl rejects its current credentials (HTTP 401);
+// if the provider returns different credentials, the rejected request is retried with them. This enables passwords to be rotated without re-creating clients.
+type CredentialsProvider interface {
+	// GetCredentials retrieves the current credentials.
+	GetCredentials() (*Credentials, error)
+}
+
+// StaticCredentials is a CredentialsProvider that always supplies the same credentials.
+type StaticCredentials Credentials
+
+// NewStaticCredentials creates a CredentialsProvider that always supplies the specified user name and password.
+func NewStaticCredentials(username string, password string) *StaticCredentials {
+	return &StaticCredentials{
+		Username: username,
+		Password: password,
+	}
+}
+
+// GetCredentials retrieves the current credentials.
+func (credentials *StaticCredentials) GetCredentials() (*Credentials, error) {
+	return &Credentials{
+		Username: credentials.Username,
+		Password: credentials.Password,
+	}, nil
+}
+
+var _ CredentialsProvider = &StaticCredentials{}
+
+// EnvironmentCredentials is a CredentialsProvider that reads credentials from environment variables.
+type EnvironmentCredentials struct {
+	// The name of the environment variable containing the user name.
+	UsernameVariable string
+
+	// The name of the environment variable containing the password.
+	PasswordVariable string
+}
+
+// NewEnvironmentCredentials creates a CredentialsProvider that reads credentials from the MCP_USER and MCP_PASSWORD environment variables.
+func NewEnvironmentCredentials() *EnvironmentCredentials {
+	return &EnvironmentCredentials{
+		UsernameVariable: "MCP_USER",
+		PasswordVariable: "MCP_PASSWORD",
+	}
+}
+
+// GetCredentials retrieves the current credentials.
+func (credentials *EnvironmentCredentials) GetCredentials() (*Credentials, error) {
+	username := os.Getenv(credentials.UsernameVariable)
+	if username == "" {
+		return nil, fmt.Errorf("Environment variable '%s' (CloudControl user name) is not set", credentials.UsernameVariable)
+	}
+
+	password := os.Getenv(credentials.PasswordVariable)
+	if password == "" {
+		return nil, fmt.Errorf("Environment variable '%s' (CloudControl password) is not set", credentials.PasswordVariable)
+	}
+
+	return &Credentials{
+		Username: username,
+		Password: password,
+	}, nil
+}
+
+var _ CredentialsProvider = &EnvironmentCredentials{}
+
+// FileCredentials is a CredentialsProvider that reads credentials from a JSON file (e.g. a mounted secret) of the form:
+//
+//	{ "username": "my-user", "password": "my-password" }
+//
+// The file is read each time credentials are requested, so changes to the file take effect the next time CloudControl rejects the current credentials.
+type FileCredentials struct {
+	// The path of the file containing the credentials.
+	Path string
+}
+
+// NewFileCredentials creates a CredentialsProvider that reads credentials from the specified JSON file.
+func NewFileCredentials(path string) *FileCredentials {
+	return &FileCredentials{
+		Path: path,
+	}
+}
+
+// GetCredentials retrieves the current credentials.
+func (credentials *FileCredentials) GetCredentials() (*Credentials, error) {
+	fileContent, err := ioutil.ReadFile(credentials.Path)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to read credentials file '%s': %s", credentials.Path, err.Error())
+	}
+
+	fileCredentials := &Credentials{}
+	err = json.Unmarshal(fileContent, fileCredentials)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid credentials file '%s': %s", credentials.Path, err.Error())
+	}
+
+	if fileCredentials.Username == "" || fileCredentials.Password == "" {
+		return nil, fmt.Errorf("Credentials file '%s' must specify both a user name and a password", credentials.Path)
+	}
+
+	return fileCredentials, nil
+}
+
+var _ CredentialsProvider = &FileCredentials{}
+
+// CredentialsProviderFunc is an adapter that enables an ordinary function to be used as a CredentialsProvider (e.g. to retrieve credentials from a secret store).
+type CredentialsProviderFunc func() (*Credentials, error)
+
+// GetCredentials retrieves the current credentials.
+func (provider CredentialsProviderFunc) GetCredentials() (*Credentials, error) {
+	return provider()
+}
+
+var _ CredentialsProvider = CredentialsProviderFunc(nil)
+
+// NewClientWithCredentialsProvider creates a new cloud compute API client that retrieves its credentials from the specified provider.
+// region is the cloud compute region identifier.
+func NewClientWithCredentialsProvider(region string, credentialsProvider CredentialsProvider) *Client {
+	baseAddress := getRegionBaseAddress(region)
+
+	return NewClientWithBaseAddressAndCredentialsProvider(baseAddress, credentialsProvider)
+}
+
+// NewClientWithBaseAddressAndCredentialsProvider creates a new cloud compute API client (using a custom end-point base address) that retrieves its credentials from the specified provider.
+// baseAddress is the base URL of the CloudControl API end-point.
+func NewClientWithBaseAddressAndCredentialsProvider(baseAddress string, credentialsProvider CredentialsProvider) *Client {
+	client := NewClientWithBaseAddress(baseAddress, "", "")
+	client.credentialsProvider = credentialsProvider
+
+	return client
+}
+
+// getCredentials gets the client's current credentials (retrieving them from the credentials provider, if required).
+func (client *Client) getCredentials() (*Credentials, error) {
+	client.stateLock.Lock()
+	credentials := client.credentials
+	client.stateLock.Unlock()
+
+	if credentials != nil {
+		return credentials, nil
+	}
+
+	credentials, err := client.credentialsProvider.GetCredentials()
+	if err != nil {
+		return nil, err
+	}
+
+	client.stateLock.Lock()
+	client.credentials = credentials
+	client.stateLock.Unlock()
+
+	return credentials, nil
+}
+
+// refreshCredentials discards the client's current credentials and retrieves them again from the credentials provider.
+//
+// Returns true if the credentials have changed.
+func (client *Client) refreshCredentials() (changed bool, err error) {
+	client.stateLock.Lock()
+	previousCredentials := client.credentials
+	client.credentials = nil
+	client.stateLock.Unlock()
+
+	credentials, err := client.getCredentials()
+	if err != nil {
+		return false, err
+	}
+
+	return previousCredentials == nil || *credentials != *previousCredentials, nil
+}
+
+// authenticateRequest adds the client's current credentials to the specified request.
+func (client *Client) authenticateRequest(request *http.Request) error {
+	credentials, err := client.getCredentials()
+	if err != nil {
+		return err
+	}
+
+	request.SetBasicAuth(credentials.Username, credentials.Password)
+
+	return nil
+}
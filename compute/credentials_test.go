@@ -0,0 +1,121 @@
+package compute
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"testing"
+)
+
+// Client retries with refreshed credentials after a 401.
+func TestClient_CredentialsProvider_RefreshAfterUnauthorized(test *testing.T) {
+	expect := expect(test)
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		username, password, _ := request.BasicAuth()
+		if username != "user1" || password != "new-password" {
+			writer.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		writer.Header().Set("Content-Type", "text/xml")
+		writer.WriteHeader(http.StatusOK)
+
+		fmt.Fprintln(writer, accountTestResponse)
+	}))
+	defer testServer.Close()
+
+	providerCallCount := 0
+	client := NewClientWithBaseAddressAndCredentialsProvider(testServer.URL, CredentialsProviderFunc(func() (*Credentials, error) {
+		providerCallCount++
+
+		password := "old-password"
+		if providerCallCount > 1 {
+			password = "new-password"
+		}
+
+		return &Credentials{Username: "user1", Password: password}, nil
+	}))
+
+	_, err := client.GetOrganizationID()
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	expect.EqualsInt("ProviderCallCount", 2, providerCallCount)
+}
+
+// Client reports invalid credentials if refreshed credentials are unchanged.
+func TestClient_CredentialsProvider_Unauthorized(test *testing.T) {
+	expect := expect(test)
+
+	requestCount := 0
+	testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		requestCount++
+
+		writer.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer testServer.Close()
+
+	client := NewClientWithBaseAddress(testServer.URL, "user1", "wrong-password")
+
+	_, err := client.GetOrganizationID()
+	expect.NotNil("Error", err)
+	expect.EqualsInt("RequestCount", 1, requestCount)
+}
+
+// Environment credentials provider.
+func TestEnvironmentCredentials(test *testing.T) {
+	expect := expect(test)
+
+	provider := &EnvironmentCredentials{
+		UsernameVariable: "GO_DD_CLOUD_COMPUTE_TEST_USER",
+		PasswordVariable: "GO_DD_CLOUD_COMPUTE_TEST_PASSWORD",
+	}
+
+	_, err := provider.GetCredentials()
+	expect.NotNil("Error (variables not set)", err)
+
+	os.Setenv(provider.UsernameVariable, "user1")
+	os.Setenv(provider.PasswordVariable, "password1")
+	defer os.Unsetenv(provider.UsernameVariable)
+	defer os.Unsetenv(provider.PasswordVariable)
+
+	credentials, err := provider.GetCredentials()
+	if err != nil {
+		test.Fatal(err)
+	}
+	expect.EqualsString("Credentials.Username", "user1", credentials.Username)
+	expect.EqualsString("Credentials.Password", "password1", credentials.Password)
+}
+
+// File credentials provider.
+func TestFileCredentials(test *testing.T) {
+	expect := expect(test)
+
+	directory, err := ioutil.TempDir("", "credentials")
+	if err != nil {
+		test.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+
+	credentialsFile := path.Join(directory, "credentials.json")
+	err = ioutil.WriteFile(credentialsFile, []byte(`{ "username": "user1", "password": "password1" }`), 0600)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	credentials, err := NewFileCredentials(credentialsFile).GetCredentials()
+	if err != nil {
+		test.Fatal(err)
+	}
+	expect.EqualsString("Credentials.Username", "user1", credentials.Username)
+	expect.EqualsString("Credentials.Password", "password1", credentials.Password)
+
+	_, err = NewFileCredentials(path.Join(directory, "missing.json")).GetCredentials()
+	expect.NotNil("Error (missing file)", err)
+}
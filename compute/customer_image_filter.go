@@ -0,0 +1,171 @@
+package compute
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// CustomerImageFilter represents server-side filtering criteria for listing customer images.
+//
+// Create a CustomerImageFilter by calling NewCustomerImageFilter, then chain calls to its WithXXX methods:
+//
+//	filter := compute.NewCustomerImageFilter().WithOperatingSystemFamily(compute.OperatingSystemFamilyWindows).WithState(compute.ResourceStatusNormal)
+type CustomerImageFilter struct {
+	filter url.Values
+}
+
+// NewCustomerImageFilter creates a new CustomerImageFilter (which initially matches all customer images, in all datacenters).
+func NewCustomerImageFilter() *CustomerImageFilter {
+	return &CustomerImageFilter{
+		filter: url.Values{},
+	}
+}
+
+// WithName restricts the filter to customer images with the specified name.
+func (filter *CustomerImageFilter) WithName(name string) *CustomerImageFilter {
+	return filter.with("name", name)
+}
+
+// WithDatacenterID restricts the filter to customer images in the specified datacenter.
+func (filter *CustomerImageFilter) WithDatacenterID(datacenterID string) *CustomerImageFilter {
+	return filter.with("datacenterId", datacenterID)
+}
+
+// WithState restricts the filter to customer images in the specified state (e.g. ResourceStatusNormal).
+func (filter *CustomerImageFilter) WithState(state string) *CustomerImageFilter {
+	return filter.with("state", state)
+}
+
+// WithOperatingSystemID restricts the filter to customer images with the specified operating system (e.g. "CENTOS764").
+func (filter *CustomerImageFilter) WithOperatingSystemID(operatingSystemID string) *CustomerImageFilter {
+	return filter.with("operatingSystemId", operatingSystemID)
+}
+
+// WithOperatingSystemFamily restricts the filter to customer images with an operating system in the specified family (e.g. OperatingSystemFamilyUNIX).
+func (filter *CustomerImageFilter) WithOperatingSystemFamily(operatingSystemFamily string) *CustomerImageFilter {
+	return filter.with("operatingSystemFamily", operatingSystemFamily)
+}
+
+// with sets a filter field (replacing any existing value for that field).
+func (filter *CustomerImageFilter) with(field string, value string) *CustomerImageFilter {
+	if filter.filter == nil {
+		filter.filter = url.Values{}
+	}
+	filter.filter.Set(field, value)
+
+	return filter
+}
+
+// copy creates a copy of the filter (so it can be modified without affecting the original).
+func (filter *CustomerImageFilter) copy() *CustomerImageFilter {
+	return &CustomerImageFilter{
+		filter: filter.toQueryParameters(),
+	}
+}
+
+// toQueryParameters converts the filter to URL query parameters (a nil filter matches all customer images).
+func (filter *CustomerImageFilter) toQueryParameters() url.Values {
+	query := url.Values{}
+	if filter == nil {
+		return query
+	}
+
+	for field, values := range filter.filter {
+		query[field] = append([]string(nil), values...)
+	}
+
+	return query
+}
+
+// ListCustomerImages retrieves a page of the customer images in all datacenters.
+func (client *Client) ListCustomerImages(paging *Paging) (images *CustomerImages, err error) {
+	return client.ListCustomerImagesWithFilter(nil, paging)
+}
+
+// ListCustomerImagesWithFilter retrieves a page of the customer images (in all datacenters) that match the specified filter.
+//
+// Pass a nil filter to match all customer images.
+func (client *Client) ListCustomerImagesWithFilter(filter *CustomerImageFilter, paging *Paging) (images *CustomerImages, err error) {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return nil, err
+	}
+
+	err = paging.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	queryParameters := paging.EnsurePaging().toQueryParameters()
+	query := filter.toQueryParameters()
+	if len(query) > 0 {
+		queryParameters = query.Encode() + "&" + queryParameters
+	}
+
+	requestURI := fmt.Sprintf("%s/image/customerImage?%s",
+		url.QueryEscape(organizationID),
+		queryParameters,
+	)
+	request, err := client.newRequestV24(requestURI, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode != http.StatusOK {
+		var apiResponse *APIResponseV2
+
+		apiResponse, err = readAPIResponseAsJSON(responseBody, statusCode)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, apiResponse.ToError("Request to list customer images failed with status code %d (%s): %s", statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	images = &CustomerImages{}
+	err = json.Unmarshal(responseBody, images)
+	if err != nil {
+		return nil, err
+	}
+	images.listPage = func(client *Client, paging *Paging) (*CustomerImages, error) {
+		return client.ListCustomerImagesWithFilter(filter, paging)
+	}
+
+	return images, nil
+}
+
+// ListAllCustomerImages retrieves all customer images (in all datacenters, across all pages of results) that match the specified filter.
+//
+// Pass a nil filter to match all customer images.
+// If there are more matching customer images than can be retrieved via paging, they are retrieved separately for each datacenter.
+func (client *Client) ListAllCustomerImages(filter *CustomerImageFilter) (images []CustomerImage, err error) {
+	err = ForEachPartitionedPage(client.listDatacenterIDs, func(datacenterID string, paging *Paging) (*PagedResult, error) {
+		partitionFilter := filter
+		if datacenterID != "" {
+			partitionFilter = filter.copy().WithDatacenterID(datacenterID)
+		}
+
+		page, err := client.ListCustomerImagesWithFilter(partitionFilter, paging)
+		if err != nil {
+			return nil, err
+		}
+
+		images = append(images, page.Images...)
+
+		return &page.PagedResult, nil
+	}, func() {
+		images = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return images, nil
+}
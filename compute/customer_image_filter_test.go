@@ -0,0 +1,175 @@
+package compute
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// List customer images in all datacenters (successful).
+func TestClient_ListCustomerImages_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			images, err := client.ListCustomerImages(nil)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("Images.Length", 1, len(images.Images))
+			expect.EqualsString("Images[0].Name", "Golden.Image.1", images.Images[0].Name)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.IsTrue("Request.URL", strings.HasSuffix(request.URL.Path, "/image/customerImage"))
+			expect.EqualsString("Query.datacenterId", "", request.URL.Query().Get("datacenterId"))
+
+			return http.StatusOK, findImageCustomerImageTestResponse
+		},
+	})
+}
+
+// List customer images with filter (successful).
+func TestClient_ListCustomerImagesWithFilter_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			filter := NewCustomerImageFilter().
+				WithName("Golden.Image.1").
+				WithState(ResourceStatusNormal).
+				WithOperatingSystemFamily(OperatingSystemFamilyUNIX)
+
+			images, err := client.ListCustomerImagesWithFilter(filter, nil)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("Images.Length", 1, len(images.Images))
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			query := request.URL.Query()
+			expect.EqualsString("Query.name", "Golden.Image.1", query.Get("name"))
+			expect.EqualsString("Query.state", "NORMAL", query.Get("state"))
+			expect.EqualsString("Query.operatingSystemFamily", "UNIX", query.Get("operatingSystemFamily"))
+			expect.EqualsString("Query.pageNumber", "1", query.Get("pageNumber"))
+
+			return http.StatusOK, findImageCustomerImageTestResponse
+		},
+	})
+}
+
+// List all customer images (too many to page through, so partitioned by datacenter).
+func TestClient_ListAllCustomerImages_Partitioned(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			filter := NewCustomerImageFilter().WithState(ResourceStatusNormal)
+
+			images, err := client.ListAllCustomerImages(filter)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("Images.Length", 2, len(images))
+			expect.EqualsString("Images[0].DataCenterID", "AU9", images[0].DataCenterID)
+			expect.EqualsString("Images[1].DataCenterID", "AU10", images[1].DataCenterID)
+
+			// The caller's filter must not be modified by partitioning.
+			expect.EqualsString("Filter.datacenterId", "", filter.toQueryParameters().Get("datacenterId"))
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			if strings.HasSuffix(request.URL.Path, "/infrastructure/datacenter") {
+				return http.StatusOK, listDatacenterIDsTestResponse
+			}
+
+			query := request.URL.Query()
+			expect.EqualsString("Query.state", "NORMAL", query.Get("state"))
+
+			switch query.Get("datacenterId") {
+			case "":
+				return http.StatusOK, strings.NewReplacer("{{datacenterId}}", "AU9", "{{totalCount}}", "12000").Replace(listAllCustomerImagesTestResponse)
+			case "AU9":
+				return http.StatusOK, strings.NewReplacer("{{datacenterId}}", "AU9", "{{totalCount}}", "1").Replace(listAllCustomerImagesTestResponse)
+			case "AU10":
+				return http.StatusOK, strings.NewReplacer("{{datacenterId}}", "AU10", "{{totalCount}}", "1").Replace(listAllCustomerImagesTestResponse)
+			}
+
+			test.Fatalf("Unexpected request: %s", request.URL.String())
+
+			return http.StatusBadRequest, ""
+		},
+	})
+}
+
+// Find customer images by name across all datacenters (multiple matches).
+func TestClient_FindCustomerImages_Multiple(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			images, err := client.FindCustomerImages("Golden.Image.1")
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("Images.Length", 2, len(images))
+			expect.EqualsString("Images[0].DataCenterID", "AU9", images[0].DataCenterID)
+			expect.EqualsString("Images[1].DataCenterID", "AU10", images[1].DataCenterID)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			query := request.URL.Query()
+			expect.EqualsString("Query.name", "Golden.Image.1", query.Get("name"))
+			expect.EqualsString("Query.datacenterId", "", query.Get("datacenterId"))
+
+			return http.StatusOK, findCustomerImagesMultipleTestResponse
+		},
+	})
+}
+
+/*
+ * Test responses.
+ */
+
+const findCustomerImagesMultipleTestResponse = `
+{
+	"customerImage": [
+		{
+			"id": "4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b",
+			"name": "Golden.Image.1",
+			"datacenterId": "AU9",
+			"createTime": "2016-09-12T06:40:13.000Z",
+			"state": "NORMAL"
+		},
+		{
+			"id": "e2c9d5a1-7b3f-4c8e-9a6d-2f1b0c3e4d5a",
+			"name": "Golden.Image.1",
+			"datacenterId": "AU10",
+			"createTime": "2016-10-01T00:00:00.000Z",
+			"state": "NORMAL"
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 2,
+	"totalCount": 2,
+	"pageSize": 250
+}
+`
+
+const listAllCustomerImagesTestResponse = `
+{
+	"customerImage": [
+		{
+			"id": "4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b",
+			"name": "Golden.Image.1",
+			"datacenterId": "{{datacenterId}}",
+			"state": "NORMAL"
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 1,
+	"totalCount": {{totalCount}},
+	"pageSize": 50
+}
+`
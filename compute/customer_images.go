@@ -5,24 +5,45 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 // CustomerImages represents a page of CustomerImage results.
 type CustomerImages struct {
-	// The current page of network domains.
+	// The current page of customer images.
 	Images []CustomerImage `json:"customerImage"`
 
-	// The current page number.
-	PageNumber int `json:"pageNumber"`
+	PagedResult
 
-	// The number of customer images in the current page of results.
-	PageCount int `json:"pageCount"`
+	// Retrieves another page of results using the same criteria as this page (nil if the page was not retrieved via the CloudControl API).
+	listPage func(client *Client, paging *Paging) (*CustomerImages, error)
+}
 
-	// The total number of customer images that match the requested filter criteria (if any).
-	TotalCount int `json:"totalCount"`
+// ForEach calls fn for each customer image in this page and each subsequent page of results (subsequent pages are retrieved using the specified client).
+//
+// Iteration stops at the first error returned by fn (or encountered while retrieving a page of results).
+func (page *CustomerImages) ForEach(client *Client, fn func(image *CustomerImage) error) error {
+	for {
+		for index := range page.Images {
+			err := fn(&page.Images[index])
+			if err != nil {
+				return err
+			}
+		}
+
+		if page.IsLastPage() || page.listPage == nil {
+			return nil
+		}
 
-	// The maximum number of customer images per page.
-	PageSize int `json:"pageSize"`
+		nextPage, err := page.listPage(client, page.NextPage())
+		if err != nil {
+			return err
+		}
+		if nextPage == nil {
+			return nil
+		}
+		page = nextPage
+	}
 }
 
 // CustomerImage represents a custom virtual machine image.
@@ -35,22 +56,128 @@ type CustomerImage struct {
 	CPU             VirtualMachineCPU    `json:"cpu"`
 	MemoryGB        int                  `json:"memoryGb"`
 	Disks           []VirtualMachineDisk `json:"disk"`
-	CreateTime      string               `json:"createTime"`
+	CreateTime      Timestamp            `json:"createTime"`
 	State           string               `json:"state"`
+
+	// CloudControl v2.4 and higher
+	Guest           *ImageGuest           `json:"guest,omitempty"`
+	SoftwareLabels  []string              `json:"softwareLabel"`
+	VirtualHardware *ImageVirtualHardware `json:"virtualHardware,omitempty"`
+	NetworkAdapters []ImageNetworkAdapter `json:"nic"`
+
+	// The progress of the image's pending operation (if any).
+	Progress *OperationProgress `json:"progress,omitempty"`
+
+	// The image's SCSI controllers and their disks (newer schema versions only; see UnmarshalJSON).
+	SCSIControllers []ImageSCSIController `json:"scsiController,omitempty"`
+}
+
+// ImageSCSIController represents a SCSI controller (and its disks) defined by an image.
+type ImageSCSIController struct {
+	// The controller Id.
+	ID string `json:"id,omitempty"`
+
+	// The controller's SCSI bus number.
+	BusNumber int `json:"busNumber"`
+
+	// The controller's adapter type (e.g. "LSI_LOGIC_PARALLEL").
+	AdapterType string `json:"adapterType,omitempty"`
+
+	// The controller's virtual hardware key.
+	Key int `json:"key,omitempty"`
+
+	// The disks attached to the controller.
+	Disks []VirtualMachineDisk `json:"disk"`
+
+	// The controller's current state.
+	State string `json:"state,omitempty"`
+}
+
+// UnmarshalJSON deserialises a CustomerImage from JSON.
+//
+// Newer schema versions group an image's disks by SCSI controller ("scsiController") rather than listing them directly ("disk");
+// in that case, Disks is populated from the controllers' disks (ordered by bus number, then SCSI unit Id, with each disk's SCSIBusNumber set)
+// so that existing code (e.g. ApplyTo) continues to see every disk.
+func (image *CustomerImage) UnmarshalJSON(data []byte) error {
+	type customerImageFields CustomerImage // Prevent recursion.
+
+	var fields customerImageFields
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+	*image = CustomerImage(fields)
+
+	if len(image.Disks) == 0 && len(image.SCSIControllers) > 0 {
+		controllers := make([]scsiControllerDisks, len(image.SCSIControllers))
+		for index, controller := range image.SCSIControllers {
+			controllers[index] = scsiControllerDisks{
+				BusNumber: controller.BusNumber,
+				Disks:     controller.Disks,
+			}
+		}
+		image.Disks = flattenSCSIControllerDisks(controllers)
+	}
+
+	return nil
+}
+
+// ImageGuest represents the guest OS configuration for an image (CloudControl v2.4 and higher).
+type ImageGuest struct {
+	// The image's operating system.
+	OperatingSystem OperatingSystem `json:"operatingSystem"`
+
+	// Does the image support guest OS customisation?
+	OSCustomization bool `json:"osCustomization"`
+}
+
+// ImageVirtualHardware represents the virtual hardware configuration for an image (CloudControl v2.4 and higher).
+type ImageVirtualHardware struct {
+	// The virtual hardware version (e.g. "vmx-10").
+	Version string `json:"version"`
+
+	// Is the virtual hardware version the latest supported by the underlying infrastructure?
+	UpToDate bool `json:"upToDate"`
+}
+
+// NeedsUpgrade determines whether the image's virtual hardware is older than the latest version supported by the underlying infrastructure.
+func (hardware *ImageVirtualHardware) NeedsUpgrade() bool {
+	return hardware != nil && !hardware.UpToDate
+}
+
+// ImageNetworkAdapter represents a network adapter defined by an image (CloudControl v2.4 and higher).
+type ImageNetworkAdapter struct {
+	// The network adapter type (e.g. NetworkAdapterTypeE1000 or NetworkAdapterTypeVMXNET3).
+	AdapterType string `json:"networkAdapter"`
+
+	// The network adapter's key (unique within the image).
+	Key int `json:"key"`
 }
 
 // GetID retrieves the image ID.
 func (image *CustomerImage) GetID() string {
+	if image == nil {
+		return ""
+	}
+
 	return image.ID
 }
 
 // GetName retrieves the image name.
 func (image *CustomerImage) GetName() string {
+	if image == nil {
+		return ""
+	}
+
 	return image.Name
 }
 
 // ToEntityReference creates an EntityReference representing the CustomerImage.
 func (image *CustomerImage) ToEntityReference() EntityReference {
+	if image == nil {
+		return EntityReference{}
+	}
+
 	return EntityReference{
 		ID:   image.ID,
 		Name: image.Name,
@@ -66,6 +193,10 @@ func (image *CustomerImage) GetResourceType() ResourceType {
 
 // GetState retrieves the resource's current state (e.g. ResourceStatusNormal, etc).
 func (image *CustomerImage) GetState() string {
+	if image == nil {
+		return ""
+	}
+
 	return image.State
 }
 
@@ -86,14 +217,45 @@ func (image *CustomerImage) GetDatacenterID() string {
 	return image.DataCenterID
 }
 
+// GetVirtualHardwareVersion retrieves the image's virtual hardware version (e.g. "vmx-10").
+//
+// Returns an empty string if the virtual hardware version is not available (CloudControl versions earlier than v2.4).
+func (image *CustomerImage) GetVirtualHardwareVersion() string {
+	if image.VirtualHardware == nil {
+		return ""
+	}
+
+	return image.VirtualHardware.Version
+}
+
 // GetOS retrieves information about the image's operating system.
 func (image *CustomerImage) GetOS() OperatingSystem {
+	if image.Guest != nil && image.Guest.OperatingSystem.ID != "" {
+		return image.Guest.OperatingSystem
+	}
+
 	return image.OperatingSystem
 }
 
+// SupportsGuestOSCustomization determines whether servers deployed from the image can have their guest OS customised.
+//
+// Images retrieved via CloudControl versions earlier than v2.4 are assumed to support guest OS customisation.
+func (image *CustomerImage) SupportsGuestOSCustomization() bool {
+	if image.Guest == nil {
+		return true
+	}
+
+	return image.Guest.OSCustomization
+}
+
 // ApplyTo applies the CustomerImage to the specified ServerDeploymentConfiguration.
+//
+// If the image does not support guest OS customisation, the server will be deployed without it (unless config.GuestOSCustomization has already been set).
 func (image *CustomerImage) ApplyTo(config *ServerDeploymentConfiguration) {
 	config.ImageID = image.ID
+	if config.GuestOSCustomization == nil && !image.SupportsGuestOSCustomization() {
+		config.GuestOSCustomization = boolToPtr(false)
+	}
 	config.CPU = image.CPU
 	config.MemoryGB = image.MemoryGB
 	config.Disks = make([]VirtualMachineDisk, len(image.Disks))
@@ -104,12 +266,82 @@ func (image *CustomerImage) ApplyTo(config *ServerDeploymentConfiguration) {
 
 var _ Image = &CustomerImage{}
 
+const (
+	// ImageExportStateCompleted indicates that an image export has completed successfully.
+	ImageExportStateCompleted = "COMPLETED"
+
+	// ImageExportStateFailed indicates that an image export has failed.
+	ImageExportStateFailed = "FAILED"
+)
+
+// ImageExport represents a record from the customer image export history.
+type ImageExport struct {
+	ID               string `json:"id"`
+	ImageID          string `json:"imageId"`
+	ImageName        string `json:"imageName"`
+	OVFPackagePrefix string `json:"ovfPackagePrefix"`
+	DatacenterID     string `json:"datacenterId"`
+	UserName         string `json:"exportedBy"`
+	StartTime        string `json:"startTime"`
+	EndTime          string `json:"endTime"`
+	State            string `json:"state"`
+}
+
+// ImageExports represents a page of ImageExport results.
+type ImageExports struct {
+	Items []ImageExport `json:"imageExport"`
+
+	PagedResult
+}
+
+// IsImageExportFailedError determines if an error is an ImageExportFailedError.
+func IsImageExportFailedError(err error) bool {
+	_, isImageExportFailedError := err.(*ImageExportFailedError)
+
+	return isImageExportFailedError
+}
+
+// ImageExportFailedError is the error returned when the export history indicates that a customer image export has failed.
+type ImageExportFailedError struct {
+	ImageID  string
+	ExportID string
+}
+
+// Get a string representation of the error.
+func (err *ImageExportFailedError) Error() string {
+	return fmt.Sprintf("Export '%s' of customer image '%s' has failed",
+		err.ExportID,
+		err.ImageID,
+	)
+}
+
+var _ error = &ImageExportFailedError{}
+
 // Request body when exporting a customer image to an OVF package.
 type exportCustomerImage struct {
 	ImageID          string `json:"imageId"`
 	OVFPackagePrefix string `json:"ovfPackagePrefix"`
 }
 
+// Request body when editing a customer image's metadata.
+type editCustomerImageMetadata struct {
+	ImageID     string `json:"imageId"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// Request body when copying a customer image to another datacenter.
+type copyCustomerImage struct {
+	ImageID            string `json:"imageId"`
+	TargetDatacenterID string `json:"targetDatacenterId"`
+	Name               string `json:"name"`
+}
+
+// Request body when deleting a customer image.
+type deleteCustomerImage struct {
+	ID string `json:"id"`
+}
+
 // Request body when importing a customer image from an OVF package.
 type importCustomerImage struct {
 	OVFPackageManifest   string `json:"ovfPackage"`
@@ -130,7 +362,7 @@ func (client *Client) GetCustomerImage(id string) (image *CustomerImage, err err
 		url.QueryEscape(organizationID),
 		url.QueryEscape(id),
 	)
-	request, err := client.newRequestV22(requestURI, http.MethodGet, nil)
+	request, err := client.newRequestV24(requestURI, http.MethodGet, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -164,6 +396,8 @@ func (client *Client) GetCustomerImage(id string) (image *CustomerImage, err err
 }
 
 // FindCustomerImage finds a customer image by name in a given data centre.
+//
+// Returns an error if more than one image matches (use FindCustomerImages to retrieve all matching images).
 func (client *Client) FindCustomerImage(name string, dataCenterID string) (image *CustomerImage, err error) {
 	organizationID, err := client.getOrganizationID()
 	if err != nil {
@@ -175,7 +409,7 @@ func (client *Client) FindCustomerImage(name string, dataCenterID string) (image
 		url.QueryEscape(name),
 		url.QueryEscape(dataCenterID),
 	)
-	request, err := client.newRequestV22(requestURI, http.MethodGet, nil)
+	request, err := client.newRequestV24(requestURI, http.MethodGet, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -213,6 +447,28 @@ func (client *Client) FindCustomerImage(name string, dataCenterID string) (image
 	return &images.Images[0], err
 }
 
+// FindCustomerImages finds all customer images (in any data centre) with the specified name.
+//
+// Since image names are only unique within a data centre, callers can use each image's DataCenterID to choose between the matching images.
+// Returns an empty slice if no matching images were found.
+func (client *Client) FindCustomerImages(name string) (images []CustomerImage, err error) {
+	page, err := client.ListCustomerImagesWithFilter(NewCustomerImageFilter().WithName(name), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = page.ForEach(client, func(image *CustomerImage) error {
+		images = append(images, *image)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return images, nil
+}
+
 // ListCustomerImagesInDatacenter lists all customer images in a given data centre.
 func (client *Client) ListCustomerImagesInDatacenter(dataCenterID string, paging *Paging) (images *CustomerImages, err error) {
 	organizationID, err := client.getOrganizationID()
@@ -220,12 +476,17 @@ func (client *Client) ListCustomerImagesInDatacenter(dataCenterID string, paging
 		return nil, err
 	}
 
+	err = paging.Validate()
+	if err != nil {
+		return nil, err
+	}
+
 	requestURI := fmt.Sprintf("%s/image/customerImage?datacenterId=%s&%s",
 		url.QueryEscape(organizationID),
 		url.QueryEscape(dataCenterID),
 		paging.EnsurePaging().toQueryParameters(),
 	)
-	request, err := client.newRequestV22(requestURI, http.MethodGet, nil)
+	request, err := client.newRequestV24(requestURI, http.MethodGet, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -248,10 +509,145 @@ func (client *Client) ListCustomerImagesInDatacenter(dataCenterID string, paging
 
 	images = &CustomerImages{}
 	err = json.Unmarshal(responseBody, images)
+	images.listPage = func(client *Client, paging *Paging) (*CustomerImages, error) {
+		return client.ListCustomerImagesInDatacenter(dataCenterID, paging)
+	}
 
 	return
 }
 
+// EditCustomerImage updates the name and / or description of the specified customer image.
+//
+// Leave name or description empty to keep its current value.
+func (client *Client) EditCustomerImage(id string, name string, description string) error {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return err
+	}
+
+	requestURI := fmt.Sprintf("%s/image/editImageMetadata",
+		url.QueryEscape(organizationID),
+	)
+	request, err := client.newRequestV24(requestURI, http.MethodPost, &editCustomerImageMetadata{
+		ImageID:     id,
+		Name:        name,
+		Description: description,
+	})
+	if err != nil {
+		return err
+	}
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return err
+	}
+
+	apiResponse, err := readAPIResponseAsJSON(responseBody, statusCode)
+	if err != nil {
+		return err
+	}
+
+	if apiResponse.ResponseCode != ResponseCodeOK {
+		return apiResponse.ToError("Request to edit customer image '%s' failed with unexpected status code %d (%s): %s", id, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	return nil
+}
+
+// DeleteCustomerImage deletes the specified customer image.
+//
+// The image's status will be ResourceStatusPendingDelete while the deletion is in progress.
+//
+// If the image is in use, or another operation is in progress for it, the returned error will satisfy IsResourceInUseError or IsResourceBusyError (respectively).
+func (client *Client) DeleteCustomerImage(id string) error {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return err
+	}
+
+	requestURI := fmt.Sprintf("%s/image/deleteImage",
+		url.QueryEscape(organizationID),
+	)
+	request, err := client.newRequestV22(requestURI, http.MethodPost, &deleteCustomerImage{
+		ID: id,
+	})
+	if err != nil {
+		return err
+	}
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return err
+	}
+
+	apiResponse, err := readAPIResponseAsJSON(responseBody, statusCode)
+	if err != nil {
+		return err
+	}
+
+	switch apiResponse.ResponseCode {
+	case ResponseCodeInProgress:
+		return nil
+	case ResponseCodeInUse:
+		return apiResponse.ToError("Cannot delete customer image '%s' because it is in use (status code %d): %s", id, statusCode, apiResponse.Message)
+	case ResponseCodeResourceBusy:
+		return apiResponse.ToError("Cannot delete customer image '%s' because another operation is in progress (status code %d): %s", id, statusCode, apiResponse.Message)
+	default:
+		return apiResponse.ToError("Request to delete customer image '%s' failed with unexpected status code %d (%s): %s", id, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+}
+
+// DeleteCustomerImageAndWait deletes the specified customer image, and then waits for the deletion to complete.
+func (client *Client) DeleteCustomerImageAndWait(id string, timeout time.Duration) error {
+	err := client.DeleteCustomerImage(id)
+	if err != nil {
+		return err
+	}
+
+	return client.WaitForDelete(ResourceTypeCustomerImage, id, timeout)
+}
+
+// CopyCustomerImage copies the specified customer image to another datacenter.
+//
+// The new image's status will be ResourceStatusPendingAdd while the copy is in progress (call WaitForCustomerImageCopy to wait for it to complete).
+func (client *Client) CopyCustomerImage(sourceImageID string, targetDatacenterID string, newName string) (imageID string, err error) {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return "", err
+	}
+
+	requestURI := fmt.Sprintf("%s/image/copyImage",
+		url.QueryEscape(organizationID),
+	)
+	request, err := client.newRequestV24(requestURI, http.MethodPost, &copyCustomerImage{
+		ImageID:            sourceImageID,
+		TargetDatacenterID: targetDatacenterID,
+		Name:               newName,
+	})
+	if err != nil {
+		return "", err
+	}
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return "", err
+	}
+
+	apiResponse, err := readAPIResponseAsJSON(responseBody, statusCode)
+	if err != nil {
+		return "", err
+	}
+
+	if apiResponse.ResponseCode != ResponseCodeInProgress {
+		return "", apiResponse.ToError("Request to copy customer image '%s' to datacenter '%s' failed with status code %d (%s): %s", sourceImageID, targetDatacenterID, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	// Expected: "info" { "name": "imageId", "value": "the-Id-of-the-new-customer-image" }
+	imageIDMessage := apiResponse.GetFieldMessage("imageId")
+	if imageIDMessage == nil {
+		return "", apiResponse.ToError("Received an unexpected response (missing 'imageId') with status code %d (%s): %s", statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	return *imageIDMessage, nil
+}
+
 // ImportCustomerImage imports the specified customer image from an OVF package.
 //
 // The OVF package can be uploaded via FTPS (call GetDatacenter to determine the FTPS end-point for the target datacenter).
@@ -355,3 +751,97 @@ func (client *Client) ExportCustomerImage(imageID string, ovfPackagePrefix strin
 
 	return *imageExportIDMessage, nil
 }
+
+// GetCustomerImageExport retrieves the export history record for the specified image export.
+//
+// Returns nil if the export does not appear in the export history (e.g. because it is still in progress).
+func (client *Client) GetCustomerImageExport(exportID string) (export *ImageExport, err error) {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return nil, err
+	}
+
+	requestURI := fmt.Sprintf("%s/image/exportHistory?id=%s",
+		url.QueryEscape(organizationID),
+		url.QueryEscape(exportID),
+	)
+	request, err := client.newRequestV24(requestURI, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode != http.StatusOK {
+		var apiResponse *APIResponseV2
+
+		apiResponse, err = readAPIResponseAsJSON(responseBody, statusCode)
+		if err != nil {
+			return nil, err
+		}
+
+		if apiResponse.ResponseCode == ResponseCodeResourceNotFound {
+			return nil, nil // Not an error, but was not found.
+		}
+
+		return nil, apiResponse.ToError("Request to retrieve image export '%s' failed with status code %d (%s): %s", exportID, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	exports := &ImageExports{}
+	err = json.Unmarshal(responseBody, exports)
+	if err != nil {
+		return nil, err
+	}
+
+	if exports.IsEmpty() {
+		return nil, nil
+	}
+
+	return &exports.Items[0], nil
+}
+
+// ListCustomerImageExportHistory retrieves a page of records from the customer image export history.
+func (client *Client) ListCustomerImageExportHistory(paging *Paging) (exports *ImageExports, err error) {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return nil, err
+	}
+
+	err = paging.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	requestURI := fmt.Sprintf("%s/image/exportHistory?%s",
+		url.QueryEscape(organizationID),
+		paging.EnsurePaging().toQueryParameters(),
+	)
+	request, err := client.newRequestV24(requestURI, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode != http.StatusOK {
+		var apiResponse *APIResponseV2
+
+		apiResponse, err = readAPIResponseAsJSON(responseBody, statusCode)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, apiResponse.ToError("Request to list customer image export history failed with status code %d (%s): %s", statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	exports = &ImageExports{}
+	err = json.Unmarshal(responseBody, exports)
+
+	return exports, err
+}
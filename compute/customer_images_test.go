@@ -0,0 +1,625 @@
+package compute
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Get customer image export from export history (successful).
+func TestClient_GetCustomerImageExport_Success(test *testing.T) {
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			export, err := client.GetCustomerImageExport("b2b2a0cf-6a2d-4ba5-8d26-e4eaed6f1f2a")
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			verifyGetCustomerImageExportTestResponse(test, export)
+		},
+		Respond: testRespondOK(getCustomerImageExportTestResponse),
+	})
+}
+
+// Get customer image export from export history (not found).
+func TestClient_GetCustomerImageExport_NotFound(test *testing.T) {
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			export, err := client.GetCustomerImageExport("b2b2a0cf-6a2d-4ba5-8d26-e4eaed6f1f2a")
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect(test).IsNil("ImageExport", export)
+		},
+		Respond: testRespondOK(emptyImageExportHistoryTestResponse),
+	})
+}
+
+// Wait for customer image export (wait times out, and the export history indicates that the export has completed).
+func TestClient_WaitForCustomerImageExport_ExportCompleted(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			resource, err := client.WaitForCustomerImageExport("4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b", "b2b2a0cf-6a2d-4ba5-8d26-e4eaed6f1f2a", 10*time.Millisecond, true)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.NotNil("Resource", resource)
+			expect.EqualsString("Resource.ID", "4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b", resource.GetID())
+			expect.EqualsString("Resource.State", ResourceStatusNormal, resource.GetState())
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			if strings.HasSuffix(request.URL.Path, "/image/exportHistory") {
+				return http.StatusOK, getCustomerImageExportTestResponse
+			}
+
+			expect.IsTrue("Request.URL", strings.HasSuffix(request.URL.Path, "/image/customerImage/4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b"))
+
+			return http.StatusOK, getCustomerImageV24TestResponse
+		},
+	})
+}
+
+// Wait for customer image export (the image returns to NORMAL, but the export history indicates that the export has failed).
+func TestClient_WaitForCustomerImageExport_ExportFailed(test *testing.T) {
+	defer testWithResourceStatusPollInterval(time.Millisecond)()
+
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			resource, err := client.WaitForCustomerImageExport("4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b", "b2b2a0cf-6a2d-4ba5-8d26-e4eaed6f1f2a", time.Second, true)
+
+			expect.IsTrue("IsImageExportFailedError", IsImageExportFailedError(err))
+			expect.IsFalse("IsOperationTimedOutError", IsOperationTimedOutError(err))
+			expect.IsTrue("Resource == nil", resource == nil)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			if strings.HasSuffix(request.URL.Path, "/image/exportHistory") {
+				return http.StatusOK, strings.Replace(getCustomerImageExportTestResponse, ImageExportStateCompleted, ImageExportStateFailed, 1)
+			}
+
+			return http.StatusOK, getCustomerImageV24TestResponse
+		},
+	})
+}
+
+// Wait for customer image export (the image returns to NORMAL, but the export is not listed in the export history).
+func TestClient_WaitForCustomerImageExport_ExportNotListed(test *testing.T) {
+	defer testWithResourceStatusPollInterval(time.Millisecond)()
+
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			resource, err := client.WaitForCustomerImageExport("4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b", "b2b2a0cf-6a2d-4ba5-8d26-e4eaed6f1f2a", time.Second, true)
+
+			expect.NotNil("Error", err)
+			expect.IsFalse("IsOperationTimedOutError", IsOperationTimedOutError(err))
+			expect.IsTrue("Resource == nil", resource == nil)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			if strings.HasSuffix(request.URL.Path, "/image/exportHistory") {
+				return http.StatusOK, emptyImageExportHistoryTestResponse
+			}
+
+			return http.StatusOK, getCustomerImageV24TestResponse
+		},
+	})
+}
+
+// Wait for customer image export (wait times out, and the export is not yet listed in the export history).
+func TestClient_WaitForCustomerImageExport_ExportNotListedTimeout(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			resource, err := client.WaitForCustomerImageExport("4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b", "b2b2a0cf-6a2d-4ba5-8d26-e4eaed6f1f2a", 10*time.Millisecond, true)
+
+			expect.IsTrue("IsOperationTimedOutError", IsOperationTimedOutError(err))
+			expect.IsTrue("Resource == nil", resource == nil)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			if strings.HasSuffix(request.URL.Path, "/image/exportHistory") {
+				return http.StatusOK, emptyImageExportHistoryTestResponse
+			}
+
+			return http.StatusOK, getPendingCustomerImageTestResponse
+		},
+	})
+}
+
+// Edit customer image metadata (successful).
+func TestClient_EditCustomerImage_Success(test *testing.T) {
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			err := client.EditCustomerImage("4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b", "Golden.Image.2", "")
+			if err != nil {
+				test.Fatal(err)
+			}
+		},
+		Respond: testValidateJSONRequestAndRespondOK(editCustomerImageTestResponse, &editCustomerImageMetadata{}, verifyEditCustomerImageTestRequest),
+	})
+}
+
+// Delete customer image (successful).
+func TestClient_DeleteCustomerImage_Success(test *testing.T) {
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			err := client.DeleteCustomerImage("4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b")
+			if err != nil {
+				test.Fatal(err)
+			}
+		},
+		Respond: testValidateJSONRequestAndRespondOK(deleteCustomerImageTestResponse, &deleteCustomerImage{}, verifyDeleteCustomerImageTestRequest),
+	})
+}
+
+// Delete customer image (image is in use).
+func TestClient_DeleteCustomerImage_InUse(test *testing.T) {
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			err := client.DeleteCustomerImage("4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b")
+
+			expect := expect(test)
+			expect.NotNil("Error", err)
+			expect.IsTrue("IsResourceInUseError", IsResourceInUseError(err))
+			expect.IsFalse("IsResourceBusyError", IsResourceBusyError(err))
+		},
+		Respond: testRespond(http.StatusBadRequest, deleteCustomerImageInUseTestResponse),
+	})
+}
+
+// Copy customer image to another datacenter (successful).
+func TestClient_CopyCustomerImage_Success(test *testing.T) {
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			imageID, err := client.CopyCustomerImage("4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b", "AU9", "Golden.Image.1")
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect(test).EqualsString("ImageID", "e2c9d5a1-7b3f-4c8e-9a6d-2f1b0c3e4d5a", imageID)
+		},
+		Respond: testValidateJSONRequestAndRespondOK(copyCustomerImageTestResponse, &copyCustomerImage{}, verifyCopyCustomerImageTestRequest),
+	})
+}
+
+// Get customer image (CloudControl v2.4 schema).
+func TestClient_GetCustomerImage_V24_Success(test *testing.T) {
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			image, err := client.GetCustomerImage("4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b")
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			verifyGetCustomerImageV24TestResponse(test, image)
+		},
+		Respond: testRespondOK(getCustomerImageV24TestResponse),
+	})
+}
+
+// Get customer image (disks grouped by SCSI controller).
+func TestClient_GetCustomerImage_SCSIControllers_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			image, err := client.GetCustomerImage("4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b")
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.NotNil("CustomerImage", image)
+			expect.EqualsInt("CustomerImage.SCSIControllers.Length", 2, len(image.SCSIControllers))
+			expect.EqualsString("CustomerImage.SCSIControllers[0].AdapterType", "LSI_LOGIC_SAS", image.SCSIControllers[0].AdapterType)
+
+			expect.EqualsInt("CustomerImage.Disks.Length", 3, len(image.Disks))
+			expect.EqualsString("CustomerImage.Disks[0].ID", "disk-0-0", *image.Disks[0].ID)
+			expect.EqualsInt("CustomerImage.Disks[0].SizeGB", 20, image.Disks[0].SizeGB)
+			expect.EqualsString("CustomerImage.Disks[1].ID", "disk-0-1", *image.Disks[1].ID)
+			expect.EqualsString("CustomerImage.Disks[1].Speed", "HIGHPERFORMANCE", image.Disks[1].Speed)
+			expect.EqualsInt("CustomerImage.Disks[1].SCSIUnitID", 1, image.Disks[1].SCSIUnitID)
+			expect.EqualsString("CustomerImage.Disks[2].ID", "disk-1-0", *image.Disks[2].ID)
+			expect.EqualsInt("CustomerImage.Disks[0].SCSIBusNumber", 0, image.Disks[0].SCSIBusNumber)
+			expect.EqualsInt("CustomerImage.Disks[2].SCSIBusNumber", 1, image.Disks[2].SCSIBusNumber)
+
+			config := &ServerDeploymentConfiguration{}
+			image.ApplyTo(config)
+			expect.EqualsInt("ServerDeploymentConfiguration.Disks.Length", 3, len(config.Disks))
+			expect.EqualsInt("ServerDeploymentConfiguration.Disks[1].SizeGB", 50, config.Disks[1].SizeGB)
+		},
+		Respond: testRespondOK(getCustomerImageSCSIControllersTestResponse),
+	})
+}
+
+// Apply customer image without guest OS customisation support to server deployment configuration.
+func TestCustomerImage_ApplyTo_NoGuestOSCustomization(test *testing.T) {
+	expect := expect(test)
+
+	image := &CustomerImage{
+		ID: "4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b",
+		Guest: &ImageGuest{
+			OSCustomization: false,
+		},
+	}
+
+	config := &ServerDeploymentConfiguration{}
+	image.ApplyTo(config)
+
+	expect.EqualsString("ServerDeploymentConfiguration.ImageID", "4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b", config.ImageID)
+	expect.NotNil("ServerDeploymentConfiguration.GuestOSCustomization", config.GuestOSCustomization)
+	expect.IsFalse("ServerDeploymentConfiguration.GuestOSCustomization", *config.GuestOSCustomization)
+
+	image.Guest.OSCustomization = true
+	config = &ServerDeploymentConfiguration{}
+	image.ApplyTo(config)
+	expect.IsTrue("ServerDeploymentConfiguration.GuestOSCustomization == nil", config.GuestOSCustomization == nil)
+}
+
+// Apply customer image to server deployment configuration (guest OS customisation explicitly set by the caller is retained).
+func TestCustomerImage_ApplyTo_CallerGuestOSCustomization(test *testing.T) {
+	expect := expect(test)
+
+	image := &CustomerImage{
+		ID: "4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b",
+		Guest: &ImageGuest{
+			OSCustomization: true,
+		},
+	}
+
+	config := &ServerDeploymentConfiguration{
+		GuestOSCustomization: boolToPtr(false),
+	}
+	image.ApplyTo(config)
+	expect.NotNil("ServerDeploymentConfiguration.GuestOSCustomization", config.GuestOSCustomization)
+	expect.IsFalse("ServerDeploymentConfiguration.GuestOSCustomization", *config.GuestOSCustomization)
+
+	image.Guest.OSCustomization = false
+	config = &ServerDeploymentConfiguration{
+		GuestOSCustomization: boolToPtr(true),
+	}
+	image.ApplyTo(config)
+	expect.NotNil("ServerDeploymentConfiguration.GuestOSCustomization", config.GuestOSCustomization)
+	expect.IsTrue("ServerDeploymentConfiguration.GuestOSCustomization", *config.GuestOSCustomization)
+}
+
+// Iterate over all pages of customer images (successful).
+func TestCustomerImages_ForEach_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			page, err := client.ListCustomerImagesInDatacenter("AU9", &Paging{PageNumber: 1, PageSize: 5})
+			if err != nil {
+				test.Fatal(err)
+			}
+			expect.IsFalse("Page.IsLastPage", page.IsLastPage())
+			expect.EqualsInt("Page.NextPage().PageNumber", 2, page.NextPage().PageNumber)
+
+			var imageNames []string
+			err = page.ForEach(client, func(image *CustomerImage) error {
+				imageNames = append(imageNames, image.Name)
+
+				return nil
+			})
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("ImageNames.Length", 6, len(imageNames))
+			expect.EqualsString("ImageNames[0]", "Image.1", imageNames[0])
+			expect.EqualsString("ImageNames[5]", "Image.6", imageNames[5])
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			query := request.URL.Query()
+			expect.EqualsString("Query.datacenterId", "AU9", query.Get("datacenterId"))
+
+			if query.Get("pageNumber") == "2" {
+				return http.StatusOK, listCustomerImagesPage2TestResponse
+			}
+
+			return http.StatusOK, listCustomerImagesPage1TestResponse
+		},
+	})
+}
+
+/*
+ * Test requests.
+ */
+
+const editCustomerImageTestRequest = `
+{
+	"imageId": "4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b",
+	"name": "Golden.Image.2"
+}
+`
+
+func verifyEditCustomerImageTestRequest(test *testing.T, requestBody interface{}) {
+	expect := expect(test)
+
+	expect.NotNil("EditCustomerImageMetadata", requestBody)
+	request := requestBody.(*editCustomerImageMetadata)
+
+	expect.EqualsString("EditCustomerImageMetadata.ImageID", "4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b", request.ImageID)
+	expect.EqualsString("EditCustomerImageMetadata.Name", "Golden.Image.2", request.Name)
+	expect.EqualsString("EditCustomerImageMetadata.Description", "", request.Description)
+}
+
+const deleteCustomerImageTestRequest = `
+{
+	"id": "4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b"
+}
+`
+
+func verifyDeleteCustomerImageTestRequest(test *testing.T, requestBody interface{}) {
+	expect := expect(test)
+
+	expect.NotNil("DeleteCustomerImage", requestBody)
+	request := requestBody.(*deleteCustomerImage)
+
+	expect.EqualsString("DeleteCustomerImage.ID", "4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b", request.ID)
+}
+
+const copyCustomerImageTestRequest = `
+{
+	"imageId": "4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b",
+	"targetDatacenterId": "AU9",
+	"name": "Golden.Image.1"
+}
+`
+
+func verifyCopyCustomerImageTestRequest(test *testing.T, requestBody interface{}) {
+	expect := expect(test)
+
+	expect.NotNil("CopyCustomerImage", requestBody)
+	request := requestBody.(*copyCustomerImage)
+
+	expect.EqualsString("CopyCustomerImage.ImageID", "4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b", request.ImageID)
+	expect.EqualsString("CopyCustomerImage.TargetDatacenterID", "AU9", request.TargetDatacenterID)
+	expect.EqualsString("CopyCustomerImage.Name", "Golden.Image.1", request.Name)
+}
+
+/*
+ * Test responses.
+ */
+
+const copyCustomerImageTestResponse = `
+{
+	"operation": "COPY_IMAGE",
+	"responseCode": "IN_PROGRESS",
+	"message": "Request to copy Customer Image 'Golden.Image.1' to datacenter 'AU9' has been accepted and is being processed.",
+	"info": [
+		{
+			"name": "imageId",
+			"value": "e2c9d5a1-7b3f-4c8e-9a6d-2f1b0c3e4d5a"
+		}
+	],
+	"warning": [],
+	"error": [],
+	"requestId": "na9_20161001T000000.000-0400_9b8a7c6d-5e4f-4321-a0b9-c8d7e6f5a4b3"
+}
+`
+
+const deleteCustomerImageTestResponse = `
+{
+	"operation": "DELETE_IMAGE",
+	"responseCode": "IN_PROGRESS",
+	"message": "Request to Delete Customer Image 'Nightly.Build.1' has been accepted and is being processed.",
+	"info": [],
+	"warning": [],
+	"error": [],
+	"requestId": "au9_20161001T000000.000-0000_a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"
+}
+`
+
+const deleteCustomerImageInUseTestResponse = `
+{
+	"operation": "DELETE_IMAGE",
+	"responseCode": "IN_USE",
+	"message": "Image 4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b is currently in use.",
+	"info": [],
+	"warning": [],
+	"error": [],
+	"requestId": "na9_20161001T000000.000-0400_0e1f2a3b-4c5d-4e6f-8a7b-9c0d1e2f3a4b"
+}
+`
+
+const editCustomerImageTestResponse = `
+{
+	"operation": "EDIT_IMAGE_METADATA",
+	"responseCode": "OK",
+	"message": "Image metadata has been updated.",
+	"info": [],
+	"warning": [],
+	"error": [],
+	"requestId": "na9_20161001T000000.000-0400_5a6b7c8d-9e0f-4a1b-8c2d-3e4f5a6b7c8d"
+}
+`
+
+const getCustomerImageExportTestResponse = `
+{
+	"imageExport": [
+		{
+			"id": "b2b2a0cf-6a2d-4ba5-8d26-e4eaed6f1f2a",
+			"imageId": "4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b",
+			"imageName": "Golden.Image.1",
+			"ovfPackagePrefix": "golden-image-1",
+			"datacenterId": "NA9",
+			"exportedBy": "devuser1",
+			"startTime": "2016-09-12T06:40:13.000Z",
+			"endTime": "2016-09-12T07:02:51.000Z",
+			"state": "COMPLETED"
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 1,
+	"totalCount": 1,
+	"pageSize": 250
+}
+`
+
+const emptyImageExportHistoryTestResponse = `
+{
+	"imageExport": [],
+	"pageNumber": 1,
+	"pageCount": 0,
+	"totalCount": 0,
+	"pageSize": 250
+}
+`
+
+const listCustomerImagesPage1TestResponse = `
+{
+	"customerImage": [
+		{ "id": "1", "name": "Image.1", "datacenterId": "AU9", "state": "NORMAL" },
+		{ "id": "2", "name": "Image.2", "datacenterId": "AU9", "state": "NORMAL" },
+		{ "id": "3", "name": "Image.3", "datacenterId": "AU9", "state": "NORMAL" },
+		{ "id": "4", "name": "Image.4", "datacenterId": "AU9", "state": "NORMAL" },
+		{ "id": "5", "name": "Image.5", "datacenterId": "AU9", "state": "NORMAL" }
+	],
+	"pageNumber": 1,
+	"pageCount": 5,
+	"totalCount": 6,
+	"pageSize": 5
+}
+`
+
+const listCustomerImagesPage2TestResponse = `
+{
+	"customerImage": [
+		{ "id": "6", "name": "Image.6", "datacenterId": "AU9", "state": "NORMAL" }
+	],
+	"pageNumber": 2,
+	"pageCount": 1,
+	"totalCount": 6,
+	"pageSize": 5
+}
+`
+
+const getCustomerImageV24TestResponse = `
+{
+	"id": "4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b",
+	"name": "Golden.Image.1",
+	"description": "Golden image (appliance)",
+	"datacenterId": "NA9",
+	"guest": {
+		"operatingSystem": {
+			"id": "OTHER64",
+			"displayName": "OTHER/64",
+			"family": "UNIX"
+		},
+		"osCustomization": false
+	},
+	"cpu": {
+		"count": 2,
+		"speed": "STANDARD",
+		"coresPerSocket": 1
+	},
+	"memoryGb": 4,
+	"disk": [
+		{
+			"id": "0d5b4e9c-3f2a-4b1d-8e7c-6a5f4e3d2c1b",
+			"scsiId": 0,
+			"sizeGb": 20,
+			"speed": "STANDARD"
+		}
+	],
+	"nic": [
+		{
+			"networkAdapter": "VMXNET3",
+			"key": 4000
+		}
+	],
+	"softwareLabel": [
+		"MSSQL2012R2E"
+	],
+	"virtualHardware": {
+		"version": "vmx-10",
+		"upToDate": false
+	},
+	"createTime": "2016-09-12T06:40:13.000Z",
+	"state": "NORMAL"
+}
+`
+
+const getCustomerImageSCSIControllersTestResponse = `
+{
+	"id": "4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b",
+	"name": "Golden.Image.2",
+	"datacenterId": "NA9",
+	"cpu": {
+		"count": 2,
+		"speed": "STANDARD",
+		"coresPerSocket": 1
+	},
+	"memoryGb": 4,
+	"scsiController": [
+		{
+			"id": "controller-1",
+			"busNumber": 1,
+			"adapterType": "LSI_LOGIC_SAS",
+			"key": 1001,
+			"disk": [
+				{ "id": "disk-1-0", "scsiId": 0, "sizeGb": 100, "speed": "STANDARD" }
+			],
+			"state": "NORMAL"
+		},
+		{
+			"id": "controller-0",
+			"busNumber": 0,
+			"adapterType": "LSI_LOGIC_PARALLEL",
+			"key": 1000,
+			"disk": [
+				{ "id": "disk-0-1", "scsiId": 1, "sizeGb": 50, "speed": "HIGHPERFORMANCE" },
+				{ "id": "disk-0-0", "scsiId": 0, "sizeGb": 20, "speed": "STANDARD" }
+			],
+			"state": "NORMAL"
+		}
+	],
+	"createTime": "2016-09-12T06:40:13.000Z",
+	"state": "NORMAL"
+}
+`
+
+func verifyGetCustomerImageV24TestResponse(test *testing.T, image *CustomerImage) {
+	expect := expect(test)
+
+	expect.NotNil("CustomerImage", image)
+	expect.EqualsString("CustomerImage.ID", "4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b", image.ID)
+
+	expect.NotNil("CustomerImage.Guest", image.Guest)
+	expect.IsFalse("CustomerImage.Guest.OSCustomization", image.Guest.OSCustomization)
+	expect.IsFalse("CustomerImage.SupportsGuestOSCustomization", image.SupportsGuestOSCustomization())
+	expect.EqualsString("CustomerImage.GetOS().ID", "OTHER64", image.GetOS().ID)
+
+	expect.EqualsInt("CustomerImage.NetworkAdapters.Length", 1, len(image.NetworkAdapters))
+	expect.EqualsString("CustomerImage.NetworkAdapters[0].AdapterType", NetworkAdapterTypeVMXNET3, image.NetworkAdapters[0].AdapterType)
+	expect.EqualsInt("CustomerImage.NetworkAdapters[0].Key", 4000, image.NetworkAdapters[0].Key)
+
+	expect.EqualsInt("CustomerImage.SoftwareLabels.Length", 1, len(image.SoftwareLabels))
+	expect.EqualsString("CustomerImage.SoftwareLabels[0]", "MSSQL2012R2E", image.SoftwareLabels[0])
+
+	expect.NotNil("CustomerImage.VirtualHardware", image.VirtualHardware)
+	expect.EqualsString("CustomerImage.VirtualHardware.Version", "vmx-10", image.VirtualHardware.Version)
+	expect.IsFalse("CustomerImage.VirtualHardware.UpToDate", image.VirtualHardware.UpToDate)
+	expect.IsTrue("CustomerImage.VirtualHardware.NeedsUpgrade", image.VirtualHardware.NeedsUpgrade())
+	expect.EqualsString("CustomerImage.GetVirtualHardwareVersion", "vmx-10", image.GetVirtualHardwareVersion())
+}
+
+func verifyGetCustomerImageExportTestResponse(test *testing.T, export *ImageExport) {
+	expect := expect(test)
+
+	expect.NotNil("ImageExport", export)
+	expect.EqualsString("ImageExport.ID", "b2b2a0cf-6a2d-4ba5-8d26-e4eaed6f1f2a", export.ID)
+	expect.EqualsString("ImageExport.ImageID", "4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b", export.ImageID)
+	expect.EqualsString("ImageExport.ImageName", "Golden.Image.1", export.ImageName)
+	expect.EqualsString("ImageExport.OVFPackagePrefix", "golden-image-1", export.OVFPackagePrefix)
+	expect.EqualsString("ImageExport.DatacenterID", "NA9", export.DatacenterID)
+	expect.EqualsString("ImageExport.State", ImageExportStateCompleted, export.State)
+}
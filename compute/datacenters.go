@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 )
 
 // Datacenter represents an MCP datacenter.
@@ -33,8 +34,126 @@ type Datacenter struct {
 	// The name of the FTPS host used to upload / download OVF packages to / from the datacenter.
 	FTPSHost string `json:"ftpsHost"`
 
+	// The datacenter's hypervisor configuration.
+	Hypervisor DatacenterHypervisor `json:"hypervisor"`
+
 	// The datacenter's network configuration.
 	Networking DatacenterNetworking `json:"networking"`
+
+	// The datacenter's cloud backup configuration (if backup is supported).
+	Backup *DatacenterBackup `json:"backup,omitempty"`
+
+	// The datacenter's console access configuration (if console access is supported).
+	ConsoleAccess *DatacenterConsoleAccess `json:"consoleAccess,omitempty"`
+
+	// The datacenter's monitoring configuration (if monitoring is supported).
+	Monitoring *DatacenterMonitoring `json:"monitoring,omitempty"`
+
+	// The datacenter's server snapshot configuration (if snapshots are supported).
+	Snapshot *DatacenterSnapshot `json:"snapshot,omitempty"`
+
+	// The datacenter's Disaster Recovery (DRS) configuration (if DRS is supported).
+	DRS *DatacenterDRS `json:"drs,omitempty"`
+}
+
+// SupportsCPUSpeed determines whether the datacenter supports the specified CPU speed (e.g. STANDARD, HIGHPERFORMANCE).
+func (datacenter *Datacenter) SupportsCPUSpeed(speed string) bool {
+	return datacenter.Hypervisor.GetCPUSpeed(speed) != nil
+}
+
+// SupportsDiskSpeed determines whether the datacenter supports the specified disk speed (e.g. STANDARD, HIGHPERFORMANCE).
+func (datacenter *Datacenter) SupportsDiskSpeed(speed string) bool {
+	return datacenter.Hypervisor.GetDiskSpeed(speed) != nil
+}
+
+// ValidateServerDeploymentConfiguration verifies that the datacenter supports the CPU and disk speeds used by the specified server deployment configuration.
+//
+// Speeds that are not specified (i.e. the datacenter default) are not checked.
+func (datacenter *Datacenter) ValidateServerDeploymentConfiguration(configuration *ServerDeploymentConfiguration) error {
+	var unsupported []string
+
+	if configuration.CPU.Speed != "" && !datacenter.SupportsCPUSpeed(configuration.CPU.Speed) {
+		unsupported = append(unsupported,
+			fmt.Sprintf("CPU speed '%s'", configuration.CPU.Speed),
+		)
+	}
+
+	for _, disk := range configuration.Disks {
+		if disk.Speed != "" && !datacenter.SupportsDiskSpeed(disk.Speed) {
+			unsupported = append(unsupported,
+				fmt.Sprintf("disk speed '%s' (SCSI unit %d)", disk.Speed, disk.SCSIUnitID),
+			)
+		}
+	}
+
+	if len(unsupported) > 0 {
+		return fmt.Errorf("Datacenter '%s' does not support %s", datacenter.ID, strings.Join(unsupported, ", "))
+	}
+
+	return nil
+}
+
+// DatacenterHypervisor represents the hypervisor configuration for an MCP datacenter.
+type DatacenterHypervisor struct {
+	// The hypervisor type (e.g. VMWARE).
+	Type string `json:"type"`
+
+	// Indicates whether the hypervisor infrastructure is under maintenance.
+	MaintenanceStatus string `json:"maintenanceStatus"`
+
+	// The disk speeds available in the datacenter.
+	DiskSpeeds []DatacenterSpeedOption `json:"diskSpeed"`
+
+	// The CPU speeds available in the datacenter.
+	CPUSpeeds []DatacenterSpeedOption `json:"cpuSpeed"`
+
+	// Additional properties of the hypervisor infrastructure.
+	Properties []DatacenterProperty `json:"property"`
+}
+
+// GetCPUSpeed retrieves the available CPU speed with the specified Id.
+// Returns nil if the CPU speed is not available.
+func (hypervisor *DatacenterHypervisor) GetCPUSpeed(id string) *DatacenterSpeedOption {
+	return findAvailableSpeedOption(hypervisor.CPUSpeeds, id)
+}
+
+// GetDiskSpeed retrieves the available disk speed with the specified Id.
+// Returns nil if the disk speed is not available.
+func (hypervisor *DatacenterHypervisor) GetDiskSpeed(id string) *DatacenterSpeedOption {
+	return findAvailableSpeedOption(hypervisor.DiskSpeeds, id)
+}
+
+// DatacenterSpeedOption represents a CPU or disk speed offered by an MCP datacenter.
+type DatacenterSpeedOption struct {
+	// The speed Id (e.g. STANDARD).
+	ID string `json:"id"`
+
+	// The speed display name.
+	DisplayName string `json:"displayName"`
+
+	// The speed abbreviation (disk speeds only).
+	Abbreviation string `json:"abbreviation,omitempty"`
+
+	// The speed description.
+	Description string `json:"description"`
+
+	// Is this the default speed for the datacenter?
+	IsDefault bool `json:"default"`
+
+	// Is this speed currently available?
+	IsAvailable bool `json:"available"`
+}
+
+// findAvailableSpeedOption finds the available speed option with the specified Id (case-insensitive).
+func findAvailableSpeedOption(speedOptions []DatacenterSpeedOption, id string) *DatacenterSpeedOption {
+	for index := range speedOptions {
+		speedOption := &speedOptions[index]
+		if strings.EqualFold(speedOption.ID, id) && speedOption.IsAvailable {
+			return speedOption
+		}
+	}
+
+	return nil
 }
 
 // DatacenterNetworking represents the networking configuration for an MCP datacenter.
@@ -47,6 +166,75 @@ type DatacenterNetworking struct {
 
 	// Indicates whether the networking infrastructure is under maintenance.
 	MaintenanceStatus string `json:"maintenanceStatus"`
+
+	// Additional properties of the networking infrastructure.
+	Properties []DatacenterProperty `json:"property"`
+}
+
+// GetProperty retrieves the value of the networking property with the specified name.
+// Returns an empty string if the property is not present.
+func (networking *DatacenterNetworking) GetProperty(name string) string {
+	for _, property := range networking.Properties {
+		if property.Name == name {
+			return property.Value
+		}
+	}
+
+	return ""
+}
+
+// DatacenterMonitoring represents the monitoring configuration for an MCP datacenter.
+type DatacenterMonitoring struct {
+	// Indicates whether the monitoring infrastructure is under maintenance.
+	MaintenanceStatus string `json:"maintenanceStatus"`
+
+	// Additional properties of the monitoring infrastructure.
+	Properties []DatacenterProperty `json:"property"`
+}
+
+// DatacenterBackup represents the cloud backup configuration for an MCP datacenter.
+type DatacenterBackup struct {
+	// The backup infrastructure type (e.g. COMMVAULT).
+	Type string `json:"type"`
+
+	// Indicates whether the backup infrastructure is under maintenance.
+	MaintenanceStatus string `json:"maintenanceStatus"`
+
+	// Additional properties of the backup infrastructure.
+	Properties []DatacenterProperty `json:"property"`
+}
+
+// DatacenterConsoleAccess represents the console access configuration for an MCP datacenter.
+type DatacenterConsoleAccess struct {
+	// Indicates whether the console access infrastructure is under maintenance.
+	MaintenanceStatus string `json:"maintenanceStatus"`
+
+	// Additional properties of the console access infrastructure.
+	Properties []DatacenterProperty `json:"property"`
+}
+
+// DatacenterSnapshot represents the server snapshot configuration for an MCP datacenter.
+type DatacenterSnapshot struct {
+	// Indicates whether the snapshot infrastructure is under maintenance.
+	MaintenanceStatus string `json:"maintenanceStatus"`
+
+	// Additional properties of the snapshot infrastructure.
+	Properties []DatacenterProperty `json:"property"`
+}
+
+// DatacenterDRS represents the Disaster Recovery (DRS) configuration for an MCP datacenter.
+type DatacenterDRS struct {
+	// Indicates whether the DRS infrastructure is under maintenance.
+	MaintenanceStatus string `json:"maintenanceStatus"`
+
+	// Additional properties of the DRS infrastructure.
+	Properties []DatacenterProperty `json:"property"`
+}
+
+// DatacenterProperty represents a name / value property of MCP datacenter infrastructure.
+type DatacenterProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
 }
 
 // Datacenters represents the response to a "List Datacenters" API call.
@@ -65,6 +253,11 @@ func (client *Client) ListDatacenters(paging *Paging) (datacenters *Datacenters,
 		return nil, err
 	}
 
+	err = paging.Validate()
+	if err != nil {
+		return nil, err
+	}
+
 	requestURI := fmt.Sprintf("%s/infrastructure/datacenter?%s",
 		url.QueryEscape(organizationID),
 		paging.EnsurePaging().toQueryParameters(),
@@ -148,3 +341,21 @@ func (client *Client) GetDatacenter(id string) (datacenter *Datacenter, err erro
 
 	return &datacenters.Items[0], nil
 }
+
+// listDatacenterIDs retrieves the Ids of all datacenters available to the current organisation.
+func (client *Client) listDatacenterIDs() (datacenterIDs []string, err error) {
+	err = ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		datacenters, err := client.ListDatacenters(paging)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, datacenter := range datacenters.Items {
+			datacenterIDs = append(datacenterIDs, datacenter.ID)
+		}
+
+		return &datacenters.PagedResult, nil
+	})
+
+	return
+}
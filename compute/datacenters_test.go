@@ -0,0 +1,151 @@
+package compute
+
+import (
+	"net/http"
+	"testing"
+)
+
+// Get datacenter (successful).
+func TestClient_GetDatacenter_Success(test *testing.T) {
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			datacenter, err := client.GetDatacenter("NA9")
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			verifyGetDatacenterTestResponse(test, datacenter)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect(test).EqualsString("Request.ID", "NA9", request.URL.Query().Get("id"))
+
+			return http.StatusOK, getDatacenterTestResponse
+		},
+	})
+}
+
+// Validate server deployment configuration against datacenter capabilities.
+func TestDatacenter_ValidateServerDeploymentConfiguration(test *testing.T) {
+	expect := expect(test)
+
+	datacenter := &Datacenter{
+		ID: "NA9",
+		Hypervisor: DatacenterHypervisor{
+			CPUSpeeds: []DatacenterSpeedOption{
+				{ID: "STANDARD", IsAvailable: true},
+			},
+			DiskSpeeds: []DatacenterSpeedOption{
+				{ID: "STANDARD", IsAvailable: true},
+				{ID: "HIGHPERFORMANCE", IsAvailable: false},
+			},
+		},
+	}
+
+	err := datacenter.ValidateServerDeploymentConfiguration(&ServerDeploymentConfiguration{
+		CPU: VirtualMachineCPU{Speed: "STANDARD"},
+		Disks: []VirtualMachineDisk{
+			{SCSIUnitID: 0, Speed: ServerDiskSpeedStandard},
+		},
+	})
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	err = datacenter.ValidateServerDeploymentConfiguration(&ServerDeploymentConfiguration{
+		CPU: VirtualMachineCPU{Speed: "HIGHPERFORMANCE"},
+		Disks: []VirtualMachineDisk{
+			{SCSIUnitID: 1, Speed: ServerDiskSpeedHighPerformance},
+		},
+	})
+	expect.NotNil("Error", err)
+	expect.EqualsString("Error", "Datacenter 'NA9' does not support CPU speed 'HIGHPERFORMANCE', disk speed 'HIGHPERFORMANCE' (SCSI unit 1)", err.Error())
+}
+
+/*
+ * Test responses.
+ */
+
+const getDatacenterTestResponse = `
+{
+	"datacenter": [
+		{
+			"displayName": "US - East 3 - MCP 2.0",
+			"type": "MCP 2.0",
+			"city": "Ashburn",
+			"state": "Virginia",
+			"country": "US",
+			"vpnUrl": "https://na9.cloud-vpn.net",
+			"ftpsHost": "ftps-na9.cloud-vpn.net",
+			"hypervisor": {
+				"diskSpeed": [
+					{
+						"id": "STANDARD",
+						"displayName": "Standard",
+						"abbreviation": "STD",
+						"description": "Standard storage",
+						"default": true,
+						"available": true
+					},
+					{
+						"id": "HIGHPERFORMANCE",
+						"displayName": "High Performance",
+						"abbreviation": "HPF",
+						"description": "High-performance storage",
+						"default": false,
+						"available": true
+					}
+				],
+				"cpuSpeed": [
+					{
+						"id": "STANDARD",
+						"displayName": "Standard",
+						"description": "Standard CPU speed",
+						"default": true,
+						"available": true
+					}
+				],
+				"property": [],
+				"type": "VMWARE",
+				"maintenanceStatus": "NORMAL"
+			},
+			"networking": {
+				"property": [],
+				"type": "2",
+				"maintenanceStatus": "NORMAL"
+			},
+			"backup": {
+				"property": [],
+				"type": "COMMVAULT",
+				"maintenanceStatus": "NORMAL"
+			},
+			"consoleAccess": {
+				"property": [],
+				"maintenanceStatus": "NORMAL"
+			},
+			"id": "NA9"
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 1,
+	"totalCount": 1,
+	"pageSize": 250
+}
+`
+
+func verifyGetDatacenterTestResponse(test *testing.T, datacenter *Datacenter) {
+	expect := expect(test)
+
+	expect.NotNil("Datacenter", datacenter)
+	expect.EqualsString("Datacenter.ID", "NA9", datacenter.ID)
+	expect.EqualsString("Datacenter.FTPSHost", "ftps-na9.cloud-vpn.net", datacenter.FTPSHost)
+	expect.EqualsString("Datacenter.Hypervisor.Type", "VMWARE", datacenter.Hypervisor.Type)
+	expect.EqualsInt("Datacenter.Hypervisor.DiskSpeeds.Length", 2, len(datacenter.Hypervisor.DiskSpeeds))
+	expect.EqualsInt("Datacenter.Hypervisor.CPUSpeeds.Length", 1, len(datacenter.Hypervisor.CPUSpeeds))
+	expect.IsTrue("Datacenter.SupportsDiskSpeed(HIGHPERFORMANCE)", datacenter.SupportsDiskSpeed(ServerDiskSpeedHighPerformance))
+	expect.IsFalse("Datacenter.SupportsCPUSpeed(HIGHPERFORMANCE)", datacenter.SupportsCPUSpeed("HIGHPERFORMANCE"))
+
+	capabilities := datacenter.GetCapabilities()
+	expect.IsTrue("Datacenter.Capabilities.Backup", capabilities.Backup)
+	expect.IsTrue("Datacenter.Capabilities.ConsoleAccess", capabilities.ConsoleAccess)
+	expect.IsFalse("Datacenter.Capabilities.Monitoring", capabilities.Monitoring)
+}
@@ -0,0 +1,344 @@
+package compute
+
+import (
+	"fmt"
+	"log"
+	"time"
+)
+
+// The type of firewall rule created by CloudControl (rather than the customer); these rules cannot be deleted.
+const firewallRuleTypeDefault = "DEFAULT_RULE"
+
+// Destroyer tears down a network domain and everything deployed in it.
+//
+// Resources are deleted in dependency order (firewall rules, NAT rules, public IP blocks, VIP configuration, servers, VLANs, and finally the network domain),
+// waiting for each stage's deletions to complete before moving on to the next stage.
+type Destroyer struct {
+	// The CloudControl API client.
+	Client *Client
+
+	// The maximum amount of time to wait for each individual deletion to complete.
+	Timeout time.Duration
+}
+
+// DestroyResult represents the resources deleted by a Destroyer.
+type DestroyResult struct {
+	// The Ids of the firewall rules that were deleted.
+	DeletedFirewallRuleIDs []string
+
+	// The Ids of the NAT rules that were deleted.
+	DeletedNATRuleIDs []string
+
+	// The Ids of the public IP blocks that were removed.
+	RemovedPublicIPBlockIDs []string
+
+	// The Ids of the virtual listeners that were deleted.
+	DeletedVirtualListenerIDs []string
+
+	// The Ids of the VIP pool members that were removed.
+	RemovedVIPPoolMemberIDs []string
+
+	// The Ids of the VIP pools that were deleted.
+	DeletedVIPPoolIDs []string
+
+	// The Ids of the VIP nodes that were deleted.
+	DeletedVIPNodeIDs []string
+
+	// The Ids of the servers that were deleted.
+	DeletedServerIDs []string
+
+	// The Ids of the VLANs that were deleted.
+	DeletedVLANIDs []string
+
+	// The Id of the network domain that was deleted (empty if the network domain was not deleted).
+	DeletedNetworkDomainID string
+}
+
+// NewDestroyer creates a new Destroyer that waits up to 10 minutes for each deletion to complete.
+func NewDestroyer(client *Client) *Destroyer {
+	return &Destroyer{
+		Client:  client,
+		Timeout: 10 * time.Minute,
+	}
+}
+
+// DestroyNetworkDomain deletes the specified network domain and everything deployed in it.
+//
+// This is intended for cleaning up test environments; if any step fails, teardown stops and the error is returned
+// together with a result describing the resources deleted up to that point (it is safe to call DestroyNetworkDomain again).
+func (destroyer *Destroyer) DestroyNetworkDomain(networkDomainID string) (result *DestroyResult, err error) {
+	result = &DestroyResult{}
+	client := destroyer.Client
+
+	networkDomain, err := client.GetNetworkDomain(networkDomainID)
+	if err != nil {
+		return result, err
+	}
+	if networkDomain == nil {
+		return result, fmt.Errorf("No network domain was found with Id '%s'", networkDomainID)
+	}
+
+	log.Printf("Destroying network domain '%s' ('%s')...", networkDomain.ID, networkDomain.Name)
+
+	// Firewall rules (except for the built-in rules).
+	var firewallRuleIDs []string
+	err = ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		rules, err := client.ListFirewallRules(networkDomainID, paging)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, rule := range rules.Rules {
+			if rule.RuleType != firewallRuleTypeDefault {
+				firewallRuleIDs = append(firewallRuleIDs, rule.ID)
+			}
+		}
+
+		return &rules.PagedResult, nil
+	})
+	if err != nil {
+		return result, err
+	}
+	result.DeletedFirewallRuleIDs, err = destroyer.deleteAll(ResourceTypeFirewallRule, firewallRuleIDs, client.DeleteFirewallRule)
+	if err != nil {
+		return result, err
+	}
+
+	// NAT rules.
+	var natRuleIDs []string
+	err = ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		rules, err := client.ListNATRules(networkDomainID, paging)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, rule := range rules.Rules {
+			natRuleIDs = append(natRuleIDs, rule.ID)
+		}
+
+		return &rules.PagedResult, nil
+	})
+	if err != nil {
+		return result, err
+	}
+	result.DeletedNATRuleIDs, err = destroyer.deleteAll(ResourceTypeNATRule, natRuleIDs, client.DeleteNATRule)
+	if err != nil {
+		return result, err
+	}
+
+	// Public IP blocks (now that no NAT rules refer to them).
+	var publicIPBlockIDs []string
+	err = ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		blocks, err := client.ListPublicIPBlocks(networkDomainID, paging)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, block := range blocks.Blocks {
+			publicIPBlockIDs = append(publicIPBlockIDs, block.ID)
+		}
+
+		return &blocks.PagedResult, nil
+	})
+	if err != nil {
+		return result, err
+	}
+	result.RemovedPublicIPBlockIDs, err = destroyer.deleteAll(ResourceTypePublicIPBlock, publicIPBlockIDs, client.RemovePublicIPBlock)
+	if err != nil {
+		return result, err
+	}
+
+	// VIP configuration (listeners, then pool members, pools, and nodes).
+	var virtualListenerIDs []string
+	err = ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		listeners, err := client.ListVirtualListenersInNetworkDomain(networkDomainID, paging)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, listener := range listeners.Items {
+			virtualListenerIDs = append(virtualListenerIDs, listener.ID)
+		}
+
+		return &listeners.PagedResult, nil
+	})
+	if err != nil {
+		return result, err
+	}
+	result.DeletedVirtualListenerIDs, err = destroyer.deleteAll(ResourceTypeVirtualListener, virtualListenerIDs, client.DeleteVirtualListener)
+	if err != nil {
+		return result, err
+	}
+
+	var poolMemberIDs []string
+	err = ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		members, err := client.ListVIPPoolMembershipsInNetworkDomain(networkDomainID, paging)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, member := range members.Items {
+			poolMemberIDs = append(poolMemberIDs, member.ID)
+		}
+
+		return &members.PagedResult, nil
+	})
+	if err != nil {
+		return result, err
+	}
+	for _, poolMemberID := range poolMemberIDs {
+		log.Printf("Removing VIP pool member '%s'...", poolMemberID)
+
+		err = client.RemoveVIPPoolMember(poolMemberID)
+		if err != nil {
+			return result, err
+		}
+		result.RemovedVIPPoolMemberIDs = append(result.RemovedVIPPoolMemberIDs, poolMemberID)
+	}
+
+	var poolIDs []string
+	err = ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		pools, err := client.ListVIPPoolsInNetworkDomain(networkDomainID, paging)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pool := range pools.Items {
+			poolIDs = append(poolIDs, pool.ID)
+		}
+
+		return &pools.PagedResult, nil
+	})
+	if err != nil {
+		return result, err
+	}
+	result.DeletedVIPPoolIDs, err = destroyer.deleteAll(ResourceTypeVIPPool, poolIDs, client.DeleteVIPPool)
+	if err != nil {
+		return result, err
+	}
+
+	var nodeIDs []string
+	err = ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		nodes, err := client.ListVIPNodesInNetworkDomain(networkDomainID, paging)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, node := range nodes.Items {
+			nodeIDs = append(nodeIDs, node.ID)
+		}
+
+		return &nodes.PagedResult, nil
+	})
+	if err != nil {
+		return result, err
+	}
+	result.DeletedVIPNodeIDs, err = destroyer.deleteAll(ResourceTypeVIPNode, nodeIDs, client.DeleteVIPNode)
+	if err != nil {
+		return result, err
+	}
+
+	// Servers (which must be powered off before they can be deleted).
+	var servers []Server
+	err = ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		page, err := client.ListServersInNetworkDomain(networkDomainID, paging)
+		if err != nil {
+			return nil, err
+		}
+
+		servers = append(servers, page.Items...)
+
+		return &page.PagedResult, nil
+	})
+	if err != nil {
+		return result, err
+	}
+
+	var serverIDs []string
+	for _, server := range servers {
+		if server.Started {
+			log.Printf("Powering off server '%s' ('%s')...", server.ID, server.Name)
+
+			err = client.PowerOffServer(server.ID)
+			if err != nil {
+				return result, err
+			}
+			_, err = client.WaitForChange(ResourceTypeServer, server.ID, "Power off", destroyer.Timeout)
+			if err != nil {
+				return result, err
+			}
+		}
+
+		serverIDs = append(serverIDs, server.ID)
+	}
+	result.DeletedServerIDs, err = destroyer.deleteAll(ResourceTypeServer, serverIDs, client.DeleteServer)
+	if err != nil {
+		return result, err
+	}
+
+	// VLANs.
+	var vlanIDs []string
+	err = ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		vlans, err := client.ListVLANs(networkDomainID, paging)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, vlan := range vlans.VLANs {
+			vlanIDs = append(vlanIDs, vlan.ID)
+		}
+
+		return &vlans.PagedResult, nil
+	})
+	if err != nil {
+		return result, err
+	}
+	result.DeletedVLANIDs, err = destroyer.deleteAll(ResourceTypeVLAN, vlanIDs, client.DeleteVLAN)
+	if err != nil {
+		return result, err
+	}
+
+	// And finally, the network domain itself.
+	_, err = destroyer.deleteAll(ResourceTypeNetworkDomain, []string{networkDomainID}, client.DeleteNetworkDomain)
+	if err != nil {
+		return result, err
+	}
+	result.DeletedNetworkDomainID = networkDomainID
+
+	log.Printf("Destroyed network domain '%s' ('%s').", networkDomain.ID, networkDomain.Name)
+
+	return result, nil
+}
+
+// deleteAll deletes the specified resources, then waits for all of the deletions to complete.
+//
+// Returns the Ids of the resources that were deleted.
+func (destroyer *Destroyer) deleteAll(resourceType ResourceType, ids []string, deleteResource func(id string) error) (deletedIDs []string, err error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	resourceDescription, err := GetResourceDescription(resourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range ids {
+		log.Printf("Deleting %s '%s'...", resourceDescription, id)
+
+		err = deleteResource(id)
+		if err != nil {
+			return deletedIDs, err
+		}
+		deletedIDs = append(deletedIDs, id)
+	}
+
+	for _, id := range deletedIDs {
+		err = destroyer.Client.WaitForDelete(resourceType, id, destroyer.Timeout)
+		if err != nil {
+			return deletedIDs, err
+		}
+	}
+
+	return deletedIDs, nil
+}
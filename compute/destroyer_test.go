@@ -0,0 +1,293 @@
+package compute
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Destroy network domain (successful; resources are deleted in dependency order, waiting for each stage to complete).
+func TestDestroyer_DestroyNetworkDomain_Success(test *testing.T) {
+	expect := expect(test)
+	defer testWithResourceStatusPollInterval(time.Millisecond)()
+
+	cloud := newTestDestroyerCloud("")
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			result, err := NewDestroyer(client).DestroyNetworkDomain(testDestroyerNetworkDomainID)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			// The built-in firewall rule is left alone.
+			expect.EqualsInt("Result.DeletedFirewallRuleIDs.Length", 1, len(result.DeletedFirewallRuleIDs))
+			expect.EqualsString("Result.DeletedFirewallRuleIDs[0]", testDestroyerFirewallRuleID, result.DeletedFirewallRuleIDs[0])
+			expect.EqualsInt("Result.DeletedNATRuleIDs.Length", 1, len(result.DeletedNATRuleIDs))
+			expect.EqualsInt("Result.RemovedPublicIPBlockIDs.Length", 1, len(result.RemovedPublicIPBlockIDs))
+			expect.EqualsInt("Result.DeletedVirtualListenerIDs.Length", 1, len(result.DeletedVirtualListenerIDs))
+			expect.EqualsInt("Result.RemovedVIPPoolMemberIDs.Length", 1, len(result.RemovedVIPPoolMemberIDs))
+			expect.EqualsInt("Result.DeletedVIPPoolIDs.Length", 1, len(result.DeletedVIPPoolIDs))
+			expect.EqualsInt("Result.DeletedVIPNodeIDs.Length", 1, len(result.DeletedVIPNodeIDs))
+			expect.EqualsInt("Result.DeletedServerIDs.Length", 1, len(result.DeletedServerIDs))
+			expect.EqualsInt("Result.DeletedVLANIDs.Length", 1, len(result.DeletedVLANIDs))
+			expect.EqualsString("Result.DeletedNetworkDomainID", testDestroyerNetworkDomainID, result.DeletedNetworkDomainID)
+
+			expectedRequests := []string{
+				"GET network/networkDomain/" + testDestroyerNetworkDomainID,
+				"GET network/firewallRule",
+				"POST network/deleteFirewallRule",
+				"GET network/firewallRule/" + testDestroyerFirewallRuleID,
+				"GET network/natRule",
+				"POST network/deleteNatRule",
+				"GET network/natRule/" + testDestroyerNATRuleID,
+				"GET network/publicIpBlock",
+				"POST network/removePublicIpBlock",
+				"GET network/publicIpBlock/" + testDestroyerPublicIPBlockID,
+				"GET networkDomainVip/virtualListener",
+				"POST networkDomainVip/deleteVirtualListener",
+				"GET networkDomainVip/virtualListener/" + testDestroyerVirtualListenerID,
+				"GET networkDomainVip/poolMember",
+				"POST networkDomainVip/removePoolMember",
+				"GET networkDomainVip/pool",
+				"POST networkDomainVip/deletePool",
+				"GET networkDomainVip/pool/" + testDestroyerVIPPoolID,
+				"GET networkDomainVip/node",
+				"POST networkDomainVip/deleteNode",
+				"GET networkDomainVip/node/" + testDestroyerVIPNodeID,
+				"GET server/server",
+				"POST server/powerOffServer",
+				"GET server/server/" + testDestroyerServerID,
+				"POST server/deleteServer",
+				"GET server/server/" + testDestroyerServerID,
+				"GET network/vlan",
+				"POST network/deleteVlan",
+				"GET network/vlan/" + testDestroyerVLANID,
+				"POST network/deleteNetworkDomain",
+				"GET network/networkDomain/" + testDestroyerNetworkDomainID,
+			}
+			requests := cloud.router.Requests()
+			expect.EqualsInt("Requests.Length", len(expectedRequests), len(requests))
+			for index := 0; index < len(expectedRequests) && index < len(requests); index++ {
+				expect.EqualsString(fmt.Sprintf("Requests[%d]", index), expectedRequests[index], requests[index])
+			}
+		},
+		Respond: cloud.router.Respond,
+	})
+}
+
+// Destroy network domain (a deletion fails part-way through, so teardown stops).
+func TestDestroyer_DestroyNetworkDomain_PartialFailure(test *testing.T) {
+	expect := expect(test)
+	defer testWithResourceStatusPollInterval(time.Millisecond)()
+
+	cloud := newTestDestroyerCloud("networkDomainVip/deletePool")
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			result, err := NewDestroyer(client).DestroyNetworkDomain(testDestroyerNetworkDomainID)
+			expect.NotNil("Error", err)
+			expect.IsTrue("Error.Message", strings.Contains(err.Error(), "RESOURCE_BUSY"))
+
+			// Everything before the failed stage was deleted.
+			expect.NotNil("Result", result)
+			expect.EqualsInt("Result.DeletedFirewallRuleIDs.Length", 1, len(result.DeletedFirewallRuleIDs))
+			expect.EqualsInt("Result.DeletedNATRuleIDs.Length", 1, len(result.DeletedNATRuleIDs))
+			expect.EqualsInt("Result.RemovedPublicIPBlockIDs.Length", 1, len(result.RemovedPublicIPBlockIDs))
+			expect.EqualsInt("Result.DeletedVirtualListenerIDs.Length", 1, len(result.DeletedVirtualListenerIDs))
+			expect.EqualsInt("Result.RemovedVIPPoolMemberIDs.Length", 1, len(result.RemovedVIPPoolMemberIDs))
+
+			// Nothing after it was touched.
+			expect.EqualsInt("Result.DeletedVIPPoolIDs.Length", 0, len(result.DeletedVIPPoolIDs))
+			expect.EqualsInt("Result.DeletedVIPNodeIDs.Length", 0, len(result.DeletedVIPNodeIDs))
+			expect.EqualsInt("Result.DeletedServerIDs.Length", 0, len(result.DeletedServerIDs))
+			expect.EqualsInt("Result.DeletedVLANIDs.Length", 0, len(result.DeletedVLANIDs))
+			expect.EqualsString("Result.DeletedNetworkDomainID", "", result.DeletedNetworkDomainID)
+
+			requests := cloud.router.Requests()
+			expect.EqualsString("LastRequest", "POST networkDomainVip/deletePool", requests[len(requests)-1])
+			for _, request := range []string{"POST networkDomainVip/deleteNode", "POST server/powerOffServer", "POST server/deleteServer", "POST network/deleteVlan", "POST network/deleteNetworkDomain"} {
+				expect.EqualsInt(request+".Index", -1, indexOfString(requests, request))
+			}
+		},
+		Respond: cloud.router.Respond,
+	})
+}
+
+// Destroy network domain (network domain not found).
+func TestDestroyer_DestroyNetworkDomain_NotFound(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			result, err := NewDestroyer(client).DestroyNetworkDomain("8cdfd607-f429-4df6-9352-162cfc0891be")
+			expect.NotNil("Error", err)
+			expect.IsTrue("Error.Message", strings.Contains(err.Error(), "No network domain was found"))
+
+			expect.NotNil("Result", result)
+			expect.EqualsString("Result.DeletedNetworkDomainID", "", result.DeletedNetworkDomainID)
+		},
+		Respond: testRespond(http.StatusBadRequest, destroyerNetworkDomainNotFoundTestResponse),
+	})
+}
+
+const (
+	testDestroyerNetworkDomainID   = "8cdfd607-f429-4df6-9352-162cfc0891be"
+	testDestroyerFirewallRuleID    = "0e0b4ef2-0eb1-4c7a-8d4f-4a5b8d6b1b01"
+	testDestroyerDefaultRuleID     = "0e0b4ef2-0eb1-4c7a-8d4f-4a5b8d6b1b02"
+	testDestroyerNATRuleID         = "2169a38e-5692-497e-a22a-701a838a6539"
+	testDestroyerPublicIPBlockID   = "cacc028a-7f12-11e4-a91c-0030487e0302"
+	testDestroyerVirtualListenerID = "6115469d-a8bb-445b-bb23-d23b5283f2b9"
+	testDestroyerVIPPoolMemberID   = "3dd806a2-c2c8-4c0c-9a4f-5219ea9266c0"
+	testDestroyerVIPPoolID         = "4d360b1f-bc2c-4ab7-9884-1f03ba2768f7"
+	testDestroyerVIPNodeID         = "34de6ed6-46a4-4dae-a753-2f8d3840c6f9"
+	testDestroyerServerID          = "7b62aae5-bdbe-4595-b58d-c78f95db2a7f"
+	testDestroyerVLANID            = "0e56433f-d808-4669-821d-812769517ff8"
+)
+
+// testDestroyerCloud simulates a network domain containing one of each type of resource removed by a Destroyer.
+type testDestroyerCloud struct {
+	router  *testRouter
+	lock    sync.Mutex
+	deleted map[string]bool
+}
+
+// newTestDestroyerCloud creates a new testDestroyerCloud; if failPath is not empty, deletions via that (POST) path fail.
+func newTestDestroyerCloud(failPath string) *testDestroyerCloud {
+	cloud := &testDestroyerCloud{
+		router:  newTestRouter(),
+		deleted: make(map[string]bool),
+	}
+	router := cloud.router
+
+	router.Handle(http.MethodGet, "network/networkDomain/"+testDestroyerNetworkDomainID,
+		testRespondUntilDeleted(testDestroyerResourceResponse(testDestroyerNetworkDomainID, "test-domain", ""), cloud.isDeletedFunc(testDestroyerNetworkDomainID)),
+	)
+	router.Handle(http.MethodPost, "network/deleteNetworkDomain", cloud.deleteResponder(failPath, "network/deleteNetworkDomain", ResponseCodeInProgress))
+
+	cloud.handleResource("network/firewallRule", "firewallRule", testDestroyerFirewallRuleID, "network/deleteFirewallRule", ResponseCodeOK, failPath,
+		testDestroyerResourceResponse(testDestroyerDefaultRuleID, "CCDEFAULT.BlockOutboundMailIPv4", `"ruleType": "DEFAULT_RULE",`),
+		testDestroyerResourceResponse(testDestroyerFirewallRuleID, "AllowHTTP", `"ruleType": "CLIENT_RULE",`),
+	)
+	cloud.handleResource("network/natRule", "natRule", testDestroyerNATRuleID, "network/deleteNatRule", ResponseCodeOK, failPath)
+	cloud.handleResource("network/publicIpBlock", "publicIpBlock", testDestroyerPublicIPBlockID, "network/removePublicIpBlock", ResponseCodeOK, failPath)
+	cloud.handleResource("networkDomainVip/virtualListener", "virtualListener", testDestroyerVirtualListenerID, "networkDomainVip/deleteVirtualListener", ResponseCodeOK, failPath)
+
+	router.Handle(http.MethodGet, "networkDomainVip/poolMember", testRespondOK(
+		testDestroyerListResponse("poolMember", testDestroyerResourceResponse(testDestroyerVIPPoolMemberID, "", "")),
+	))
+	router.Handle(http.MethodPost, "networkDomainVip/removePoolMember", cloud.deleteResponder(failPath, "networkDomainVip/removePoolMember", ResponseCodeOK))
+
+	cloud.handleResource("networkDomainVip/pool", "vipPool", testDestroyerVIPPoolID, "networkDomainVip/deletePool", ResponseCodeOK, failPath)
+	cloud.handleResource("networkDomainVip/node", "node", testDestroyerVIPNodeID, "networkDomainVip/deleteNode", ResponseCodeOK, failPath)
+	cloud.handleResource("server/server", "server", testDestroyerServerID, "server/deleteServer", ResponseCodeInProgress, failPath,
+		testDestroyerResourceResponse(testDestroyerServerID, "web-1", `"started": true,`),
+	)
+	router.Handle(http.MethodPost, "server/powerOffServer", cloud.deleteResponder(failPath, "server/powerOffServer", ResponseCodeInProgress))
+	cloud.handleResource("network/vlan", "vlan", testDestroyerVLANID, "network/deleteVlan", ResponseCodeInProgress, failPath)
+
+	return cloud
+}
+
+// handleResource registers responders to list, get, and delete a resource (listItems defaults to the resource itself).
+func (cloud *testDestroyerCloud) handleResource(path string, listField string, id string, deletePath string, deleteResponseCode string, failPath string, listItems ...string) {
+	if len(listItems) == 0 {
+		listItems = []string{testDestroyerResourceResponse(id, "", "")}
+	}
+
+	cloud.router.Handle(http.MethodGet, path, testRespondOK(testDestroyerListResponse(listField, listItems...)))
+	cloud.router.Handle(http.MethodGet, path+"/"+id,
+		testRespondUntilDeleted(testDestroyerResourceResponse(id, "", ""), cloud.isDeletedFunc(id)),
+	)
+	cloud.router.Handle(http.MethodPost, deletePath, cloud.deleteResponder(failPath, deletePath, deleteResponseCode))
+}
+
+// deleteResponder creates a responder that marks the resource identified in the request body as deleted (or fails, if path is failPath).
+func (cloud *testDestroyerCloud) deleteResponder(failPath string, path string, responseCode string) ClientTestResponder {
+	return func(test *testing.T, request *http.Request) (int, string) {
+		if path == failPath {
+			return http.StatusBadRequest, testDestroyerResourceBusyResponse
+		}
+
+		requestBody := &deleteServer{}
+		err := readRequestBodyAsJSON(request, requestBody)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		// Powering off a server doesn't delete it.
+		if path != "server/powerOffServer" {
+			cloud.markDeleted(requestBody.ID)
+		}
+
+		return http.StatusOK, testBlueGreenAPIResponse(strings.ToUpper(path), responseCode, "", "")
+	}
+}
+
+func (cloud *testDestroyerCloud) markDeleted(id string) {
+	cloud.lock.Lock()
+	defer cloud.lock.Unlock()
+
+	cloud.deleted[id] = true
+}
+
+func (cloud *testDestroyerCloud) isDeletedFunc(id string) func() bool {
+	return func() bool {
+		cloud.lock.Lock()
+		defer cloud.lock.Unlock()
+
+		return cloud.deleted[id]
+	}
+}
+
+func testDestroyerResourceResponse(id string, name string, extraFields string) string {
+	return fmt.Sprintf(`
+{
+	"id": "%s",
+	"name": "%s",
+	%s
+	"state": "NORMAL"
+}
+`, id, name, extraFields)
+}
+
+func testDestroyerListResponse(field string, items ...string) string {
+	return fmt.Sprintf(`
+{
+	"%s": [ %s ],
+	"pageNumber": 1,
+	"pageCount": %d,
+	"totalCount": %d,
+	"pageSize": 50
+}
+`, field, strings.Join(items, ","), len(items), len(items))
+}
+
+/*
+ * Test responses.
+ */
+
+const testDestroyerResourceBusyResponse = `
+{
+	"operation": "DELETE_POOL",
+	"responseCode": "RESOURCE_BUSY",
+	"message": "The resource is busy.",
+	"info": [],
+	"warning": [],
+	"error": [],
+	"requestId": "na9_20170321T074626030-0400_7e9fffe7-190b-46f2-9107-9d52fe57d0ad"
+}
+`
+
+const destroyerNetworkDomainNotFoundTestResponse = `
+{
+	"operation": "GET_NETWORK_DOMAIN",
+	"responseCode": "RESOURCE_NOT_FOUND",
+	"message": "Network Domain 8cdfd607-f429-4df6-9352-162cfc0891be not found.",
+	"info": [],
+	"warning": [],
+	"error": [],
+	"requestId": "na9_20170321T074626030-0400_7e9fffe7-190b-46f2-9107-9d52fe57d0ad"
+}
+`
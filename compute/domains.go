@@ -28,7 +28,7 @@ type NetworkDomain struct {
 	OutsideTransitVLANIPv4Subnet IPv4Range `json:"outsideTransitVlanIpv4Subnet"`
 
 	// The network domain's creation timestamp.
-	CreateTime string `json:"createTime"`
+	CreateTime Timestamp `json:"createTime"`
 
 	// The network domain's current state.
 	State string `json:"state"`
@@ -42,6 +42,10 @@ type NetworkDomain struct {
 
 // GetID returns the network domain's Id.
 func (domain *NetworkDomain) GetID() string {
+	if domain == nil {
+		return ""
+	}
+
 	return domain.ID
 }
 
@@ -52,11 +56,19 @@ func (domain *NetworkDomain) GetResourceType() ResourceType {
 
 // GetName returns the network domain's name.
 func (domain *NetworkDomain) GetName() string {
+	if domain == nil {
+		return ""
+	}
+
 	return domain.Name
 }
 
 // GetState returns the network domain's current state.
 func (domain *NetworkDomain) GetState() string {
+	if domain == nil {
+		return ""
+	}
+
 	return domain.State
 }
 
@@ -69,6 +81,10 @@ var _ Resource = &NetworkDomain{}
 
 // ToEntityReference creates an EntityReference representing the NetworkDomain.
 func (domain *NetworkDomain) ToEntityReference() EntityReference {
+	if domain == nil {
+		return EntityReference{}
+	}
+
 	return EntityReference{
 		ID:   domain.ID,
 		Name: domain.Name,
@@ -83,6 +99,36 @@ type NetworkDomains struct {
 	Domains []NetworkDomain `json:"networkDomain"`
 
 	PagedResult
+
+	// Retrieves another page of results using the same criteria as this page (nil if the page was not retrieved via the CloudControl API).
+	listPage func(client *Client, paging *Paging) (*NetworkDomains, error)
+}
+
+// ForEach calls fn for each network domain in this page and each subsequent page of results (subsequent pages are retrieved using the specified client).
+//
+// Iteration stops at the first error returned by fn (or encountered while retrieving a page of results).
+func (page *NetworkDomains) ForEach(client *Client, fn func(domain *NetworkDomain) error) error {
+	for {
+		for index := range page.Domains {
+			err := fn(&page.Domains[index])
+			if err != nil {
+				return err
+			}
+		}
+
+		if page.IsLastPage() || page.listPage == nil {
+			return nil
+		}
+
+		nextPage, err := page.listPage(client, page.NextPage())
+		if err != nil {
+			return err
+		}
+		if nextPage == nil {
+			return nil
+		}
+		page = nextPage
+	}
 }
 
 // Request body for deploying a compute network domain.
@@ -122,40 +168,32 @@ type deleteNetworkDomain struct {
 }
 
 // ListNetworkDomains retrieves a list of all network domains.
-// TODO: Support filtering and sorting.
+// TODO: Support sorting.
 func (client *Client) ListNetworkDomains(paging *Paging) (domains *NetworkDomains, err error) {
-	organizationID, err := client.getOrganizationID()
-	if err != nil {
-		return nil, err
-	}
-
-	requestURI := fmt.Sprintf("%s/network/networkDomain?%s",
-		url.QueryEscape(organizationID),
-		paging.EnsurePaging().toQueryParameters(),
-	)
-	request, err := client.newRequestV24(requestURI, http.MethodGet, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	responseBody, statusCode, err := client.executeRequest(request)
-	if err != nil {
-		return nil, err
-	}
+	return client.listNetworkDomains("", paging)
+}
 
-	if statusCode != http.StatusOK {
-		var apiResponse *APIResponseV2
+// ListNetworkDomainsInDatacenter retrieves a list of all network domains in the specified datacenter.
+func (client *Client) ListNetworkDomainsInDatacenter(datacenterID string, paging *Paging) (domains *NetworkDomains, err error) {
+	return client.listNetworkDomains(datacenterID, paging)
+}
 
-		apiResponse, err = readAPIResponseAsJSON(responseBody, statusCode)
+// ListAllNetworkDomains retrieves all network domains (across all pages of results).
+//
+// If there are more network domains than can be retrieved via paging, they are retrieved separately for each datacenter.
+func (client *Client) ListAllNetworkDomains() (domains []NetworkDomain, err error) {
+	err = ForEachPartitionedPage(client.listDatacenterIDs, func(datacenterID string, paging *Paging) (*PagedResult, error) {
+		page, err := client.listNetworkDomains(datacenterID, paging)
 		if err != nil {
 			return nil, err
 		}
 
-		return nil, apiResponse.ToError("Request failed with status code %d (%s): %s", statusCode, apiResponse.ResponseCode, apiResponse.Message)
-	}
+		domains = append(domains, page.Domains...)
 
-	domains = &NetworkDomains{}
-	err = json.Unmarshal(responseBody, domains)
+		return &page.PagedResult, nil
+	}, func() {
+		domains = nil
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -163,6 +201,16 @@ func (client *Client) ListNetworkDomains(paging *Paging) (domains *NetworkDomain
 	return domains, nil
 }
 
+// listNetworkDomains retrieves a list of network domains (optionally, only those in the specified datacenter).
+func (client *Client) listNetworkDomains(datacenterID string, paging *Paging) (domains *NetworkDomains, err error) {
+	var filter *NetworkDomainFilter
+	if datacenterID != "" {
+		filter = NewNetworkDomainFilter().WithDatacenterID(datacenterID)
+	}
+
+	return client.ListNetworkDomainsWithFilter(filter, paging)
+}
+
 // GetNetworkDomain retrieves the network domain with the specified Id.
 // id is the Id of the network domain to retrieve.
 // Returns nil if no network domain is found with the specified Id.
@@ -210,52 +258,10 @@ func (client *Client) GetNetworkDomain(id string) (domain *NetworkDomain, err er
 }
 
 // GetNetworkDomainByName retrieves the network domain (if any) with the specified name in the specified data centre.
+//
+// Equivalent to FindNetworkDomainByName.
 func (client *Client) GetNetworkDomainByName(name string, dataCenterID string) (domain *NetworkDomain, err error) {
-	organizationID, err := client.getOrganizationID()
-	if err != nil {
-		return nil, err
-	}
-
-	requestURI := fmt.Sprintf("%s/network/networkDomain?name=%s&datacenterId=%s",
-		url.QueryEscape(organizationID),
-		url.QueryEscape(name),
-		url.QueryEscape(dataCenterID),
-	)
-	request, err := client.newRequestV24(requestURI, http.MethodGet, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	responseBody, statusCode, err := client.executeRequest(request)
-	if err != nil {
-		return nil, err
-	}
-
-	if statusCode != http.StatusOK {
-		var apiResponse *APIResponseV2
-
-		apiResponse, err = readAPIResponseAsJSON(responseBody, statusCode)
-		if err != nil {
-			return nil, err
-		}
-
-		return nil, apiResponse.ToError("Request failed with status code %d (%s): %s", statusCode, apiResponse.ResponseCode, apiResponse.Message)
-	}
-
-	domains := &NetworkDomains{}
-	err = json.Unmarshal(responseBody, domains)
-	if err != nil {
-		return nil, err
-	}
-	if domains.IsEmpty() {
-		return nil, nil // No matching network domain was found.
-	}
-
-	if len(domains.Domains) != 1 {
-		return nil, fmt.Errorf("Found multiple network domains (%d) named '%s' in data centre '%s'.", len(domains.Domains), name, dataCenterID)
-	}
-
-	return &domains.Domains[0], nil
+	return client.FindNetworkDomainByName(name, dataCenterID)
 }
 
 // DeployNetworkDomain deploys a new network domain.
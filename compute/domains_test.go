@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
@@ -167,10 +168,111 @@ func TestClient_ListNetworkDomains_Success(test *testing.T) {
 	verifyListNetworkDomainsTestResponse(test, networkDomains)
 }
 
+// List all network domains (too many to page through, so partitioned by datacenter).
+func TestClient_ListAllNetworkDomains_Partitioned(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			domains, err := client.ListAllNetworkDomains()
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("Domains.Length", 2, len(domains))
+			expect.EqualsString("Domains[0].DatacenterID", "AU9", domains[0].DatacenterID)
+			expect.EqualsString("Domains[1].DatacenterID", "AU10", domains[1].DatacenterID)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			if strings.HasSuffix(request.URL.Path, "/infrastructure/datacenter") {
+				return http.StatusOK, listDatacenterIDsTestResponse
+			}
+
+			switch request.URL.Query().Get("datacenterId") {
+			case "":
+				return http.StatusOK, listNetworkDomainsUnaddressableTestResponse
+			case "AU9":
+				return http.StatusOK, listNetworkDomainsAU9TestResponse
+			case "AU10":
+				return http.StatusOK, listNetworkDomainsAU10TestResponse
+			}
+
+			test.Fatalf("Unexpected request: %s", request.URL.String())
+
+			return http.StatusBadRequest, ""
+		},
+	})
+}
+
 /*
  * Test responses.
  */
 
+const listNetworkDomainsUnaddressableTestResponse = `
+{
+	"networkDomain": [
+		{
+			"name": "Domain 1",
+			"id": "75ab2a57-b75e-4ec6-945a-e8c60164fdf6",
+			"datacenterId": "AU9"
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 1,
+	"totalCount": 12000,
+	"pageSize": 1
+}
+`
+
+const listNetworkDomainsAU9TestResponse = `
+{
+	"networkDomain": [
+		{
+			"name": "Domain 1",
+			"id": "75ab2a57-b75e-4ec6-945a-e8c60164fdf6",
+			"datacenterId": "AU9"
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 1,
+	"totalCount": 1,
+	"pageSize": 50
+}
+`
+
+const listNetworkDomainsAU10TestResponse = `
+{
+	"networkDomain": [
+		{
+			"name": "Domain 2",
+			"id": "b91e0ba4-322c-32ca-bbc7-50b9a72d5f98",
+			"datacenterId": "AU10"
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 1,
+	"totalCount": 1,
+	"pageSize": 50
+}
+`
+
+const listDatacenterIDsTestResponse = `
+{
+	"datacenter": [
+		{
+			"id": "AU9"
+		},
+		{
+			"id": "AU10"
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 2,
+	"totalCount": 2,
+	"pageSize": 50
+}
+`
+
 var listNetworkDomainsTestResponse = `
 {
 	  "networkDomain": [
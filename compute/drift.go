@@ -0,0 +1,267 @@
+package compute
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Change represents a single difference between a resource's actual configuration and its desired configuration.
+type Change struct {
+	// The name of the field that differs (e.g. "memoryGb" or "disk[0].sizeGb").
+	Field string
+
+	// The field's actual value (empty if the field is absent from the actual configuration).
+	Actual string
+
+	// The field's desired value (empty if the field is absent from the desired configuration).
+	Desired string
+}
+
+// String returns a textual description of the change.
+func (change Change) String() string {
+	return fmt.Sprintf("%s: '%s' => '%s'", change.Field, change.Actual, change.Desired)
+}
+
+// ChangeSet represents the differences between a resource's actual configuration and its desired configuration.
+type ChangeSet []Change
+
+// HasChanges determines whether the change set contains any changes.
+func (changes ChangeSet) HasChanges() bool {
+	return len(changes) > 0
+}
+
+// HasChange determines whether the change set contains a change to the specified field.
+func (changes ChangeSet) HasChange(field string) bool {
+	for _, change := range changes {
+		if change.Field == field {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Fields retrieves the names of the fields that have changed.
+func (changes ChangeSet) Fields() []string {
+	fields := make([]string, len(changes))
+	for index, change := range changes {
+		fields[index] = change.Field
+	}
+
+	return fields
+}
+
+// String returns a textual description of the change set (one change per line).
+func (changes ChangeSet) String() string {
+	lines := make([]string, len(changes))
+	for index, change := range changes {
+		lines[index] = change.String()
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// add adds a change to the set if the actual and desired values differ.
+func (changes *ChangeSet) add(field string, actual string, desired string) {
+	if actual == desired {
+		return
+	}
+
+	*changes = append(*changes, Change{
+		Field:   field,
+		Actual:  actual,
+		Desired: desired,
+	})
+}
+
+// Diff determines how the server differs from the specified deployment configuration.
+//
+// Only fields that can be determined from the deployed server are compared (e.g. the administrator password is not);
+// CPU, memory, and network adapter addressing are only compared if they are specified by the configuration.
+func (server *Server) Diff(configuration *ServerDeploymentConfiguration) (changes ChangeSet) {
+	changes.add("name", server.Name, configuration.Name)
+	changes.add("description", server.Description, configuration.Description)
+	changes.add("imageId", server.SourceImageID, configuration.ImageID)
+
+	if configuration.CPU.Count != 0 {
+		changes.add("cpu.count", strconv.Itoa(server.CPU.Count), strconv.Itoa(configuration.CPU.Count))
+	}
+	if configuration.CPU.Speed != "" {
+		changes.add("cpu.speed", server.CPU.Speed, configuration.CPU.Speed)
+	}
+	if configuration.CPU.CoresPerSocket != 0 {
+		changes.add("cpu.coresPerSocket", strconv.Itoa(server.CPU.CoresPerSocket), strconv.Itoa(configuration.CPU.CoresPerSocket))
+	}
+	if configuration.MemoryGB != 0 {
+		changes.add("memoryGb", strconv.Itoa(server.MemoryGB), strconv.Itoa(configuration.MemoryGB))
+	}
+
+	changes = append(changes, diffDisks(server.Disks, configuration.Disks)...)
+
+	if configuration.Network.NetworkDomainID != "" {
+		changes.add("networkInfo.networkDomainId", server.Network.NetworkDomainID, configuration.Network.NetworkDomainID)
+	}
+	changes = append(changes,
+		diffNetworkAdapter("networkInfo.primaryNic", server.Network.PrimaryAdapter, configuration.Network.PrimaryAdapter)...,
+	)
+	changes.add("networkInfo.additionalNic.count",
+		strconv.Itoa(len(server.Network.AdditionalNetworkAdapters)),
+		strconv.Itoa(len(configuration.Network.AdditionalNetworkAdapters)),
+	)
+	for index, desiredAdapter := range configuration.Network.AdditionalNetworkAdapters {
+		if index >= len(server.Network.AdditionalNetworkAdapters) {
+			break
+		}
+
+		changes = append(changes, diffNetworkAdapter(
+			fmt.Sprintf("networkInfo.additionalNic[%d]", index),
+			server.Network.AdditionalNetworkAdapters[index],
+			desiredAdapter,
+		)...)
+	}
+
+	return
+}
+
+// Diff determines how the VLAN differs from the specified deployment configuration.
+func (vlan *VLAN) Diff(configuration *DeployVLAN) (changes ChangeSet) {
+	changes.add("name", vlan.Name, configuration.Name)
+	changes.add("description", vlan.Description, configuration.Description)
+	changes.add("networkDomainId", vlan.NetworkDomain.ID, configuration.VLANID)
+	changes.add("privateIpv4Range",
+		vlan.IPv4Range.ToDisplayString(),
+		IPv4Range{BaseAddress: configuration.IPv4BaseAddress, PrefixSize: configuration.IPv4PrefixSize}.ToDisplayString(),
+	)
+
+	if configuration.Detached != nil {
+		changes.add("attached", strconv.FormatBool(vlan.IsAttached()), "false")
+		changes.add("ipv4GatewayAddress", vlan.IPv4GatewayAddress, configuration.Detached.IPv4GatewayAddress)
+	} else if configuration.Attached != nil {
+		changes.add("attached", strconv.FormatBool(vlan.IsAttached()), "true")
+
+		if vlan.GatewayAddressing != "" {
+			changes.add("gatewayAddressing", vlan.GatewayAddressing, configuration.Attached.GatewayAddressing)
+		}
+	}
+
+	return
+}
+
+// Diff determines how the firewall rule differs from the specified configuration.
+//
+// The rule's placement is not compared (it cannot be determined from the firewall rule alone).
+func (rule *FirewallRule) Diff(configuration *FirewallRuleConfiguration) (changes ChangeSet) {
+	changes.add("name", rule.Name, configuration.Name)
+	changes.add("action", rule.Action, configuration.Action)
+	changes.add("enabled", strconv.FormatBool(rule.Enabled), strconv.FormatBool(configuration.Enabled))
+	changes.add("ipVersion", rule.IPVersion, configuration.IPVersion)
+	changes.add("protocol", rule.Protocol, configuration.Protocol)
+	changes.add("networkDomainId", rule.NetworkDomainID, configuration.NetworkDomainID)
+	changes.add("source", rule.Source.toDisplayString(), configuration.Source.toDisplayString())
+	changes.add("destination", rule.Destination.toDisplayString(), configuration.Destination.toDisplayString())
+
+	return
+}
+
+// toDisplayString converts the firewall rule scope to a display string (e.g. "10.0.0.0/24:80-90").
+func (scope FirewallRuleScope) toDisplayString() string {
+	address := FirewallRuleMatchAny
+	if scope.IPAddress != nil {
+		address = strings.ToUpper(scope.IPAddress.Address)
+		if scope.IPAddress.PrefixSize != nil {
+			address = fmt.Sprintf("%s/%d", address, *scope.IPAddress.PrefixSize)
+		}
+	} else if scope.AddressListID != nil {
+		address = "list:" + *scope.AddressListID
+	} else if scope.AddressList != nil {
+		address = "list:" + scope.AddressList.ID
+	}
+
+	port := FirewallRuleMatchAny
+	if scope.Port != nil {
+		port = strconv.Itoa(scope.Port.Begin)
+		if scope.Port.End != nil {
+			port = fmt.Sprintf("%s-%d", port, *scope.Port.End)
+		}
+	} else if scope.PortListID != nil {
+		port = "list:" + *scope.PortListID
+	}
+
+	return address + ":" + port
+}
+
+// diffDisks determines the differences between actual and desired disks (matched by SCSI unit Id).
+func diffDisks(actualDisks []VirtualMachineDisk, desiredDisks []VirtualMachineDisk) (changes ChangeSet) {
+	actualDisksByUnitID := make(map[int]VirtualMachineDisk, len(actualDisks))
+	for _, disk := range actualDisks {
+		actualDisksByUnitID[disk.SCSIUnitID] = disk
+	}
+	desiredDisksByUnitID := make(map[int]VirtualMachineDisk, len(desiredDisks))
+	for _, disk := range desiredDisks {
+		desiredDisksByUnitID[disk.SCSIUnitID] = disk
+	}
+
+	var unitIDs []int
+	for unitID := range actualDisksByUnitID {
+		unitIDs = append(unitIDs, unitID)
+	}
+	for unitID := range desiredDisksByUnitID {
+		if _, ok := actualDisksByUnitID[unitID]; !ok {
+			unitIDs = append(unitIDs, unitID)
+		}
+	}
+	sort.Ints(unitIDs)
+
+	for _, unitID := range unitIDs {
+		field := fmt.Sprintf("disk[%d]", unitID)
+
+		actualDisk, haveActual := actualDisksByUnitID[unitID]
+		desiredDisk, haveDesired := desiredDisksByUnitID[unitID]
+		if !haveDesired {
+			changes.add(field, actualDisk.toDisplayString(), "")
+
+			continue
+		}
+		if !haveActual {
+			changes.add(field, "", desiredDisk.toDisplayString())
+
+			continue
+		}
+
+		if desiredDisk.SizeGB != 0 {
+			changes.add(field+".sizeGb", strconv.Itoa(actualDisk.SizeGB), strconv.Itoa(desiredDisk.SizeGB))
+		}
+		if desiredDisk.Speed != "" {
+			changes.add(field+".speed", actualDisk.Speed, desiredDisk.Speed)
+		}
+		if desiredDisk.IOPS != 0 {
+			changes.add(field+".iops", strconv.Itoa(actualDisk.IOPS), strconv.Itoa(desiredDisk.IOPS))
+		}
+	}
+
+	return
+}
+
+// toDisplayString converts the disk to a display string (e.g. "20GB STANDARD").
+func (disk VirtualMachineDisk) toDisplayString() string {
+	return fmt.Sprintf("%dGB %s", disk.SizeGB, disk.Speed)
+}
+
+// diffNetworkAdapter determines the differences between an actual and desired network adapter.
+//
+// Only the properties specified by the desired network adapter are compared.
+func diffNetworkAdapter(field string, actualAdapter VirtualMachineNetworkAdapter, desiredAdapter VirtualMachineNetworkAdapter) (changes ChangeSet) {
+	if desiredAdapter.VLANID != nil {
+		changes.add(field+".vlanId", stringOrEmpty(actualAdapter.VLANID), *desiredAdapter.VLANID)
+	}
+	if desiredAdapter.PrivateIPv4Address != nil {
+		changes.add(field+".privateIpv4", stringOrEmpty(actualAdapter.PrivateIPv4Address), *desiredAdapter.PrivateIPv4Address)
+	}
+	if desiredAdapter.AdapterType != nil {
+		changes.add(field+".networkAdapter", stringOrEmpty(actualAdapter.AdapterType), *desiredAdapter.AdapterType)
+	}
+
+	return
+}
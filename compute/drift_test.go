@@ -0,0 +1,162 @@
+package compute
+
+import (
+	"testing"
+)
+
+// Server vs deployment configuration (no drift).
+func TestServer_Diff_NoChanges(test *testing.T) {
+	expect := expect(test)
+
+	server := &Server{
+		Name:          "web-1",
+		Description:   "Web server",
+		SourceImageID: "image-1",
+		CPU:           VirtualMachineCPU{Count: 2, Speed: "STANDARD", CoresPerSocket: 1},
+		MemoryGB:      4,
+		Disks: []VirtualMachineDisk{
+			{ID: stringToPtr("disk-0"), SCSIUnitID: 0, SizeGB: 20, Speed: "STANDARD"},
+		},
+		Network: VirtualMachineNetwork{
+			NetworkDomainID: "domain-1",
+			PrimaryAdapter: VirtualMachineNetworkAdapter{
+				ID:                 stringToPtr("nic-1"),
+				VLANID:             stringToPtr("vlan-1"),
+				PrivateIPv4Address: stringToPtr("10.0.0.10"),
+			},
+		},
+	}
+	configuration := &ServerDeploymentConfiguration{
+		Name:        "web-1",
+		Description: "Web server",
+		ImageID:     "image-1",
+		MemoryGB:    4,
+		Disks: []VirtualMachineDisk{
+			{SCSIUnitID: 0, SizeGB: 20, Speed: "STANDARD"},
+		},
+		Network: VirtualMachineNetwork{
+			NetworkDomainID: "domain-1",
+			PrimaryAdapter: VirtualMachineNetworkAdapter{
+				VLANID: stringToPtr("vlan-1"),
+			},
+		},
+	}
+
+	changes := server.Diff(configuration)
+	expect.IsFalse("HasChanges", changes.HasChanges())
+}
+
+// Server vs deployment configuration (drift).
+func TestServer_Diff_Changes(test *testing.T) {
+	expect := expect(test)
+
+	server := &Server{
+		Name:          "web-1",
+		SourceImageID: "image-1",
+		MemoryGB:      4,
+		Disks: []VirtualMachineDisk{
+			{SCSIUnitID: 0, SizeGB: 20, Speed: "STANDARD"},
+			{SCSIUnitID: 1, SizeGB: 50, Speed: "STANDARD"},
+		},
+		Network: VirtualMachineNetwork{
+			PrimaryAdapter: VirtualMachineNetworkAdapter{
+				PrivateIPv4Address: stringToPtr("10.0.0.10"),
+			},
+		},
+	}
+	configuration := &ServerDeploymentConfiguration{
+		Name:     "web-1",
+		ImageID:  "image-1",
+		MemoryGB: 8,
+		Disks: []VirtualMachineDisk{
+			{SCSIUnitID: 0, SizeGB: 40, Speed: "STANDARD"},
+			{SCSIUnitID: 2, SizeGB: 10, Speed: "ECONOMY"},
+		},
+		Network: VirtualMachineNetwork{
+			PrimaryAdapter: VirtualMachineNetworkAdapter{
+				PrivateIPv4Address: stringToPtr("10.0.0.11"),
+			},
+		},
+	}
+
+	changes := server.Diff(configuration)
+	expect.EqualsInt("Changes.Length", 5, len(changes))
+	expect.EqualsString("Changes[0].Field", "memoryGb", changes[0].Field)
+	expect.EqualsString("Changes[0].Actual", "4", changes[0].Actual)
+	expect.EqualsString("Changes[0].Desired", "8", changes[0].Desired)
+	expect.EqualsString("Changes[1].Field", "disk[0].sizeGb", changes[1].Field)
+	expect.EqualsString("Changes[2].Field", "disk[1]", changes[2].Field)
+	expect.EqualsString("Changes[2].Desired", "", changes[2].Desired)
+	expect.EqualsString("Changes[3].Field", "disk[2]", changes[3].Field)
+	expect.EqualsString("Changes[3].Desired", "10GB ECONOMY", changes[3].Desired)
+	expect.IsTrue("HasChange(primaryNic.privateIpv4)", changes.HasChange("networkInfo.primaryNic.privateIpv4"))
+}
+
+// VLAN vs deployment configuration.
+func TestVLAN_Diff(test *testing.T) {
+	expect := expect(test)
+
+	vlan := &VLAN{
+		Name:               "vlan-1",
+		Description:        "Primary VLAN",
+		NetworkDomain:      EntityReference{ID: "domain-1"},
+		IPv4Range:          IPv4Range{BaseAddress: "10.0.0.0", PrefixSize: 24},
+		IPv4GatewayAddress: "10.0.0.1",
+	}
+	configuration := &DeployVLAN{
+		VLANID:          "domain-1",
+		Name:            "vlan-1",
+		Description:     "Primary VLAN",
+		IPv4BaseAddress: "10.0.0.0",
+		IPv4PrefixSize:  24,
+	}
+
+	expect.IsFalse("NoChanges.HasChanges", vlan.Diff(configuration).HasChanges())
+
+	configuration.IPv4PrefixSize = 23
+	configuration.Detached = &DetachedVLANConfiguration{IPv4GatewayAddress: "10.0.0.254"}
+
+	changes := vlan.Diff(configuration)
+	expect.EqualsInt("Changes.Length", 3, len(changes))
+	expect.EqualsString("Changes[0].Field", "privateIpv4Range", changes[0].Field)
+	expect.EqualsString("Changes[0].Desired", "10.0.0.0/23", changes[0].Desired)
+	expect.EqualsString("Changes[1].Field", "attached", changes[1].Field)
+	expect.EqualsString("Changes[2].Field", "ipv4GatewayAddress", changes[2].Field)
+}
+
+// Firewall rule vs configuration.
+func TestFirewallRule_Diff(test *testing.T) {
+	expect := expect(test)
+
+	rule := &FirewallRule{
+		Name:            "AllowHTTPS",
+		Action:          FirewallRuleActionAccept,
+		IPVersion:       FirewallRuleIPVersion4,
+		Protocol:        FirewallRuleProtocolTCP,
+		Enabled:         true,
+		NetworkDomainID: "domain-1",
+		Destination: FirewallRuleScope{
+			IPAddress: &FirewallRuleIPAddress{Address: "10.0.0.10"},
+			Port:      &FirewallRulePort{Begin: 443},
+		},
+	}
+
+	configuration := &FirewallRuleConfiguration{
+		Name:            "AllowHTTPS",
+		NetworkDomainID: "domain-1",
+	}
+	configuration.Accept().Enable().IPv4().TCP()
+	configuration.MatchAnySourceAddress().MatchDestinationAddress("10.0.0.10").MatchDestinationPort(443)
+
+	expect.IsFalse("NoChanges.HasChanges", rule.Diff(configuration).HasChanges())
+
+	configuration.Disable()
+	configuration.Destination.Port = &FirewallRulePort{Begin: 443, End: intToPtr(444)}
+
+	changes := rule.Diff(configuration)
+	expect.EqualsInt("Changes.Length", 2, len(changes))
+	expect.EqualsString("Changes[0].Field", "enabled", changes[0].Field)
+	expect.EqualsString("Changes[1].Field", "destination", changes[1].Field)
+	expect.EqualsString("Changes[1].Actual", "10.0.0.10:443", changes[1].Actual)
+	expect.EqualsString("Changes[1].Desired", "10.0.0.10:443-444", changes[1].Desired)
+}
@@ -0,0 +1,49 @@
+package compute
+
+import "fmt"
+
+// ResolveEntity retrieves the full resource identified by an entity reference (e.g. from a nested API response).
+//
+// The reference must have an Id (references that only have a name cannot be resolved, since names are not unique).
+// Returns nil if the resource no longer exists.
+func (client *Client) ResolveEntity(reference EntityReference, resourceType ResourceType) (Resource, error) {
+	if reference.ID == "" {
+		description, _ := GetResourceDescription(resourceType)
+
+		return nil, fmt.Errorf("Cannot resolve %s reference '%s' because it does not have an Id", description, reference.Name)
+	}
+
+	resource, err := client.GetResource(reference.ID, resourceType)
+	if err != nil {
+		return nil, err
+	}
+	if resource == nil || resource.IsDeleted() {
+		return nil, nil // GetResource returns a typed nil when the resource is not found.
+	}
+
+	return resource, nil
+}
+
+// ResolveEntities retrieves the full resources identified by the specified entity references (all of the same resource type).
+//
+// The results are in the same order as the references, with nil for resources that no longer exist. Each distinct Id is only retrieved once.
+func (client *Client) ResolveEntities(references []EntityReference, resourceType ResourceType) ([]Resource, error) {
+	resolved := make(map[string]Resource, len(references))
+
+	resources := make([]Resource, len(references))
+	for index, reference := range references {
+		resource, ok := resolved[reference.ID]
+		if !ok {
+			var err error
+			resource, err = client.ResolveEntity(reference, resourceType)
+			if err != nil {
+				return nil, err
+			}
+			resolved[reference.ID] = resource
+		}
+
+		resources[index] = resource
+	}
+
+	return resources, nil
+}
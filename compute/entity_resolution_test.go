@@ -0,0 +1,69 @@
+package compute
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// Resolve entity references (batch, with duplicate and missing references).
+func TestClient_ResolveEntities_Success(test *testing.T) {
+	expect := expect(test)
+
+	requestCount := 0
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			resources, err := client.ResolveEntities([]EntityReference{
+				{ID: "75ab2a57-b75e-4ec6-945a-e8c60164fdf6", Name: "Domain 1"},
+				{ID: "8cdfd607-f429-4df6-9352-162cfc0891be"},
+				{ID: "75ab2a57-b75e-4ec6-945a-e8c60164fdf6"},
+			}, ResourceTypeNetworkDomain)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("Resources.Length", 3, len(resources))
+
+			expect.NotNil("Resources[0]", resources[0])
+			expect.EqualsString("Resources[0].Name", "Domain 1", resources[0].GetName())
+			expect.IsTrue("Resources[0] is NetworkDomain", resources[0].(*NetworkDomain) != nil)
+
+			expect.IsTrue("Resources[1] is nil", resources[1] == nil)
+
+			expect.IsTrue("Resources[2] is Resources[0]", resources[2] == resources[0])
+
+			expect.EqualsInt("RequestCount", 2, requestCount)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			requestCount++
+
+			if strings.HasSuffix(request.URL.Path, "/8cdfd607-f429-4df6-9352-162cfc0891be") {
+				return http.StatusBadRequest, destroyerNetworkDomainNotFoundTestResponse
+			}
+
+			return http.StatusOK, resolveNetworkDomainTestResponse
+		},
+	})
+}
+
+// Resolve entity reference (no Id).
+func TestClient_ResolveEntity_NoID(test *testing.T) {
+	client := NewClientWithBaseAddress("https://api.example.com", "user1", "password")
+
+	_, err := client.ResolveEntity(EntityReference{Name: "Domain 1"}, ResourceTypeNetworkDomain)
+	expect(test).NotNil("Error", err)
+}
+
+/*
+ * Test responses.
+ */
+
+const resolveNetworkDomainTestResponse = `
+{
+	"name": "Domain 1",
+	"id": "75ab2a57-b75e-4ec6-945a-e8c60164fdf6",
+	"datacenterId": "AU9",
+	"type": "ESSENTIALS",
+	"state": "NORMAL"
+}
+`
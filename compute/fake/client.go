@@ -0,0 +1,1040 @@
+// Package fake contains an in-memory implementation of compute.ClientAPI for use in unit tests.
+//
+// The fake Client records every call made to it, and serves canned resources (which tests can add directly to its maps).
+// Asynchronous operations complete immediately, so the WaitForXXX methods never block.
+package fake
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/DimensionDataResearch/go-dd-cloud-compute/compute"
+)
+
+// Call represents a call made to the fake Client.
+type Call struct {
+	// The name of the method that was called (e.g. "DeployServer").
+	Method string
+
+	// The arguments passed to the method.
+	Args []interface{}
+}
+
+// Client is an in-memory implementation of compute.ClientAPI.
+//
+// Resources are keyed by Id; tags are keyed by asset Id.
+// To make a method fail, add an entry for it (keyed by method name) to Errors.
+type Client struct {
+	Account        *compute.Account
+	Datacenters    map[string]*compute.Datacenter
+	NetworkDomains map[string]*compute.NetworkDomain
+	VLANs          map[string]*compute.VLAN
+	Servers        map[string]*compute.Server
+	OSImages       map[string]*compute.OSImage
+	CustomerImages map[string]*compute.CustomerImage
+	Tags           map[string][]compute.Tag
+	Errors         map[string]error
+
+	stateLock *sync.Mutex
+	calls     []Call
+	nextID    int
+}
+
+var _ compute.ClientAPI = &Client{}
+
+// NewClient creates a new fake Client with no resources.
+func NewClient() *Client {
+	return &Client{
+		Account: &compute.Account{
+			UserName:       "fake-user",
+			OrganizationID: "fake-organization-id",
+		},
+		Datacenters:    make(map[string]*compute.Datacenter),
+		NetworkDomains: make(map[string]*compute.NetworkDomain),
+		VLANs:          make(map[string]*compute.VLAN),
+		Servers:        make(map[string]*compute.Server),
+		OSImages:       make(map[string]*compute.OSImage),
+		CustomerImages: make(map[string]*compute.CustomerImage),
+		Tags:           make(map[string][]compute.Tag),
+		Errors:         make(map[string]error),
+		stateLock:      &sync.Mutex{},
+	}
+}
+
+// Calls retrieves all calls made to the fake Client (in order).
+func (client *Client) Calls() []Call {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	return append([]Call(nil), client.calls...)
+}
+
+// CallsTo retrieves all calls made to the specified method of the fake Client (in order).
+func (client *Client) CallsTo(method string) (calls []Call) {
+	for _, call := range client.Calls() {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+
+	return
+}
+
+// record records a call (the caller must hold the state lock) and returns the error (if any) configured for the method.
+func (client *Client) record(method string, args ...interface{}) error {
+	client.calls = append(client.calls, Call{
+		Method: method,
+		Args:   args,
+	})
+
+	return client.Errors[method]
+}
+
+// newID generates a new resource Id (the caller must hold the state lock).
+func (client *Client) newID(prefix string) string {
+	client.nextID++
+
+	return fmt.Sprintf("%s-%d", prefix, client.nextID)
+}
+
+// notFound creates an error representing a missing resource.
+func notFound(resourceDescription string, id string) error {
+	response := &compute.APIResponseV2{
+		ResponseCode: compute.ResponseCodeResourceNotFound,
+		Message:      fmt.Sprintf("No %s was found with Id '%s'.", resourceDescription, id),
+	}
+
+	return response.ToError("%s", response.Message)
+}
+
+// newPagedResult creates a PagedResult representing a single page containing all results.
+func newPagedResult(count int) compute.PagedResult {
+	return compute.PagedResult{
+		PageNumber: 1,
+		PageCount:  count,
+		TotalCount: count,
+		PageSize:   count,
+	}
+}
+
+// sortedKeys gets the keys of a resource map in sorted order (so that results are deterministic).
+func sortedKeys(keys []string) []string {
+	sort.Strings(keys)
+
+	return keys
+}
+
+/*
+ * Account
+ */
+
+// GetAccount retrieves the current user's account information.
+func (client *Client) GetAccount() (*compute.Account, error) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record("GetAccount"); err != nil {
+		return nil, err
+	}
+
+	return client.Account, nil
+}
+
+// GetOrganizationID retrieves the Id of the current user's organisation.
+func (client *Client) GetOrganizationID() (string, error) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record("GetOrganizationID"); err != nil {
+		return "", err
+	}
+
+	return client.Account.OrganizationID, nil
+}
+
+/*
+ * Datacenters
+ */
+
+// ListDatacenters retrieves a list of all datacenters.
+func (client *Client) ListDatacenters(paging *compute.Paging) (*compute.Datacenters, error) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record("ListDatacenters", paging); err != nil {
+		return nil, err
+	}
+
+	datacenters := &compute.Datacenters{}
+	var ids []string
+	for id := range client.Datacenters {
+		ids = append(ids, id)
+	}
+	for _, id := range sortedKeys(ids) {
+		datacenters.Items = append(datacenters.Items, *client.Datacenters[id])
+	}
+	datacenters.PagedResult = newPagedResult(len(datacenters.Items))
+
+	return datacenters, nil
+}
+
+// GetDatacenter retrieves the datacenter with the specified Id.
+func (client *Client) GetDatacenter(id string) (*compute.Datacenter, error) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record("GetDatacenter", id); err != nil {
+		return nil, err
+	}
+
+	return client.Datacenters[id], nil
+}
+
+/*
+ * Network domains
+ */
+
+// ListNetworkDomains retrieves a list of all network domains.
+func (client *Client) ListNetworkDomains(paging *compute.Paging) (*compute.NetworkDomains, error) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record("ListNetworkDomains", paging); err != nil {
+		return nil, err
+	}
+
+	domains := &compute.NetworkDomains{}
+	var ids []string
+	for id := range client.NetworkDomains {
+		ids = append(ids, id)
+	}
+	for _, id := range sortedKeys(ids) {
+		domains.Domains = append(domains.Domains, *client.NetworkDomains[id])
+	}
+	domains.PagedResult = newPagedResult(len(domains.Domains))
+
+	return domains, nil
+}
+
+// GetNetworkDomain retrieves the network domain with the specified Id.
+func (client *Client) GetNetworkDomain(id string) (*compute.NetworkDomain, error) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record("GetNetworkDomain", id); err != nil {
+		return nil, err
+	}
+
+	return client.NetworkDomains[id], nil
+}
+
+// GetNetworkDomainByName retrieves the network domain (if any) with the specified name in the specified datacenter.
+func (client *Client) GetNetworkDomainByName(name string, dataCenterID string) (*compute.NetworkDomain, error) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record("GetNetworkDomainByName", name, dataCenterID); err != nil {
+		return nil, err
+	}
+
+	for _, domain := range client.NetworkDomains {
+		if domain.Name == name && domain.DatacenterID == dataCenterID {
+			return domain, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// DeployNetworkDomain deploys a new network domain.
+func (client *Client) DeployNetworkDomain(name string, description string, plan string, datacenter string) (string, error) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record("DeployNetworkDomain", name, description, plan, datacenter); err != nil {
+		return "", err
+	}
+
+	id := client.newID("network-domain")
+	client.NetworkDomains[id] = &compute.NetworkDomain{
+		ID:           id,
+		Name:         name,
+		Description:  description,
+		Type:         plan,
+		DatacenterID: datacenter,
+		State:        compute.ResourceStatusNormal,
+	}
+
+	return id, nil
+}
+
+// EditNetworkDomain updates an existing network domain.
+func (client *Client) EditNetworkDomain(id string, name *string, description *string, plan *string) error {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record("EditNetworkDomain", id, name, description, plan); err != nil {
+		return err
+	}
+
+	domain, ok := client.NetworkDomains[id]
+	if !ok {
+		return notFound("network domain", id)
+	}
+	if name != nil {
+		domain.Name = *name
+	}
+	if description != nil {
+		domain.Description = *description
+	}
+	if plan != nil {
+		domain.Type = *plan
+	}
+
+	return nil
+}
+
+// DeleteNetworkDomain deletes an existing network domain.
+func (client *Client) DeleteNetworkDomain(id string) error {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record("DeleteNetworkDomain", id); err != nil {
+		return err
+	}
+
+	if _, ok := client.NetworkDomains[id]; !ok {
+		return notFound("network domain", id)
+	}
+	delete(client.NetworkDomains, id)
+
+	return nil
+}
+
+/*
+ * VLANs
+ */
+
+// GetVLAN retrieves the VLAN with the specified Id.
+func (client *Client) GetVLAN(id string) (*compute.VLAN, error) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record("GetVLAN", id); err != nil {
+		return nil, err
+	}
+
+	return client.VLANs[id], nil
+}
+
+// GetVLANByName retrieves the VLAN (if any) with the specified name in the specified network domain.
+func (client *Client) GetVLANByName(name string, networkDomainID string) (*compute.VLAN, error) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record("GetVLANByName", name, networkDomainID); err != nil {
+		return nil, err
+	}
+
+	for _, vlan := range client.VLANs {
+		if vlan.Name == name && vlan.NetworkDomain.ID == networkDomainID {
+			return vlan, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// ListVLANs retrieves a list of all VLANs in the specified network domain.
+func (client *Client) ListVLANs(networkDomainID string, paging *compute.Paging) (*compute.VLANs, error) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record("ListVLANs", networkDomainID, paging); err != nil {
+		return nil, err
+	}
+
+	vlans := &compute.VLANs{}
+	var ids []string
+	for id, vlan := range client.VLANs {
+		if vlan.NetworkDomain.ID == networkDomainID {
+			ids = append(ids, id)
+		}
+	}
+	for _, id := range sortedKeys(ids) {
+		vlans.VLANs = append(vlans.VLANs, *client.VLANs[id])
+	}
+	vlans.PagedResult = newPagedResult(len(vlans.VLANs))
+
+	return vlans, nil
+}
+
+// DeployVLAN deploys a new VLAN.
+func (client *Client) DeployVLAN(networkDomainID string, name string, description string, ipv4BaseAddress string, ipv4PrefixSize int) (string, error) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record("DeployVLAN", networkDomainID, name, description, ipv4BaseAddress, ipv4PrefixSize); err != nil {
+		return "", err
+	}
+
+	id := client.newID("vlan")
+	client.VLANs[id] = &compute.VLAN{
+		ID:          id,
+		Name:        name,
+		Description: description,
+		NetworkDomain: compute.EntityReference{
+			ID: networkDomainID,
+		},
+		IPv4Range: compute.IPv4Range{
+			BaseAddress: ipv4BaseAddress,
+			PrefixSize:  ipv4PrefixSize,
+		},
+		State: compute.ResourceStatusNormal,
+	}
+
+	return id, nil
+}
+
+// EditVLAN updates an existing VLAN.
+func (client *Client) EditVLAN(id string, name *string, description *string) error {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record("EditVLAN", id, name, description); err != nil {
+		return err
+	}
+
+	vlan, ok := client.VLANs[id]
+	if !ok {
+		return notFound("VLAN", id)
+	}
+	if name != nil {
+		vlan.Name = *name
+	}
+	if description != nil {
+		vlan.Description = *description
+	}
+
+	return nil
+}
+
+// DeleteVLAN deletes an existing VLAN.
+func (client *Client) DeleteVLAN(id string) error {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record("DeleteVLAN", id); err != nil {
+		return err
+	}
+
+	if _, ok := client.VLANs[id]; !ok {
+		return notFound("VLAN", id)
+	}
+	delete(client.VLANs, id)
+
+	return nil
+}
+
+/*
+ * Servers
+ */
+
+// GetServer retrieves the server with the specified Id.
+func (client *Client) GetServer(id string) (*compute.Server, error) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record("GetServer", id); err != nil {
+		return nil, err
+	}
+
+	return client.Servers[id], nil
+}
+
+// ListServersInNetworkDomain retrieves a page of servers in the specified network domain.
+func (client *Client) ListServersInNetworkDomain(networkDomainID string, paging *compute.Paging) (compute.Servers, error) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	servers := compute.Servers{}
+	if err := client.record("ListServersInNetworkDomain", networkDomainID, paging); err != nil {
+		return servers, err
+	}
+
+	var ids []string
+	for id, server := range client.Servers {
+		if server.Network.NetworkDomainID == networkDomainID {
+			ids = append(ids, id)
+		}
+	}
+	for _, id := range sortedKeys(ids) {
+		servers.Items = append(servers.Items, *client.Servers[id])
+	}
+	servers.PagedResult = newPagedResult(len(servers.Items))
+
+	return servers, nil
+}
+
+// ListServersInVLAN retrieves a page of servers with a network adapter attached to the specified VLAN.
+func (client *Client) ListServersInVLAN(vlanID string, paging *compute.Paging) (compute.Servers, error) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	servers := compute.Servers{}
+	if err := client.record("ListServersInVLAN", vlanID, paging); err != nil {
+		return servers, err
+	}
+
+	var ids []string
+	for id, server := range client.Servers {
+		for _, networkAdapter := range server.Network.GetNetworkAdapters() {
+			if networkAdapter.VLANID != nil && *networkAdapter.VLANID == vlanID {
+				ids = append(ids, id)
+
+				break
+			}
+		}
+	}
+	for _, id := range sortedKeys(ids) {
+		servers.Items = append(servers.Items, *client.Servers[id])
+	}
+	servers.PagedResult = newPagedResult(len(servers.Items))
+
+	return servers, nil
+}
+
+// DeployServer deploys a new server.
+func (client *Client) DeployServer(serverConfiguration compute.ServerDeploymentConfiguration) (string, error) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record("DeployServer", serverConfiguration); err != nil {
+		return "", err
+	}
+
+	id := client.newID("server")
+	client.Servers[id] = &compute.Server{
+		ID:            id,
+		Name:          serverConfiguration.Name,
+		Description:   serverConfiguration.Description,
+		CPU:           serverConfiguration.CPU,
+		MemoryGB:      serverConfiguration.MemoryGB,
+		Disks:         serverConfiguration.Disks,
+		Network:       serverConfiguration.Network,
+		SourceImageID: serverConfiguration.ImageID,
+		State:         compute.ResourceStatusNormal,
+		Deployed:      true,
+		Started:       serverConfiguration.Start,
+	}
+
+	return id, nil
+}
+
+// EditServerMetadata modifies a server's name and / or description.
+func (client *Client) EditServerMetadata(serverID string, name *string, description *string) error {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record("EditServerMetadata", serverID, name, description); err != nil {
+		return err
+	}
+
+	server, ok := client.Servers[serverID]
+	if !ok {
+		return notFound("server", serverID)
+	}
+	if name != nil {
+		server.Name = *name
+	}
+	if description != nil {
+		server.Description = *description
+	}
+
+	return nil
+}
+
+// ReconfigureServer updates the configuration for a server.
+func (client *Client) ReconfigureServer(serverID string, memoryGB *int, cpuCount *int, cpuCoresPerSocket *int, cpuSpeed *string) error {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record("ReconfigureServer", serverID, memoryGB, cpuCount, cpuCoresPerSocket, cpuSpeed); err != nil {
+		return err
+	}
+
+	server, ok := client.Servers[serverID]
+	if !ok {
+		return notFound("server", serverID)
+	}
+	if memoryGB != nil {
+		server.MemoryGB = *memoryGB
+	}
+	if cpuCount != nil {
+		server.CPU.Count = *cpuCount
+	}
+	if cpuCoresPerSocket != nil {
+		server.CPU.CoresPerSocket = *cpuCoresPerSocket
+	}
+	if cpuSpeed != nil {
+		server.CPU.Speed = *cpuSpeed
+	}
+
+	return nil
+}
+
+// StartServer requests that the specified server be started.
+func (client *Client) StartServer(id string) error {
+	return client.setServerStarted("StartServer", id, true)
+}
+
+// ShutdownServer requests that the specified server be shut down (gracefully).
+func (client *Client) ShutdownServer(id string) error {
+	return client.setServerStarted("ShutdownServer", id, false)
+}
+
+// PowerOffServer requests that the specified server be powered off (non-gracefully).
+func (client *Client) PowerOffServer(id string) error {
+	return client.setServerStarted("PowerOffServer", id, false)
+}
+
+// setServerStarted records a call to a server power operation and updates the server's power state.
+func (client *Client) setServerStarted(method string, id string, started bool) error {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record(method, id); err != nil {
+		return err
+	}
+
+	server, ok := client.Servers[id]
+	if !ok {
+		return notFound("server", id)
+	}
+	server.Started = started
+
+	return nil
+}
+
+// DeleteServer deletes an existing server.
+func (client *Client) DeleteServer(id string) error {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record("DeleteServer", id); err != nil {
+		return err
+	}
+
+	if _, ok := client.Servers[id]; !ok {
+		return notFound("server", id)
+	}
+	delete(client.Servers, id)
+
+	return nil
+}
+
+/*
+ * Images
+ */
+
+// GetOSImage retrieves a specific OS image by Id.
+func (client *Client) GetOSImage(id string) (*compute.OSImage, error) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record("GetOSImage", id); err != nil {
+		return nil, err
+	}
+
+	return client.OSImages[id], nil
+}
+
+// FindOSImage finds an OS image by name in a given data centre.
+func (client *Client) FindOSImage(name string, dataCenterID string) (*compute.OSImage, error) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record("FindOSImage", name, dataCenterID); err != nil {
+		return nil, err
+	}
+
+	for _, image := range client.OSImages {
+		if image.Name == name && image.DataCenterID == dataCenterID {
+			return image, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// GetCustomerImage retrieves a specific customer image by Id.
+func (client *Client) GetCustomerImage(id string) (*compute.CustomerImage, error) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record("GetCustomerImage", id); err != nil {
+		return nil, err
+	}
+
+	return client.CustomerImages[id], nil
+}
+
+// FindCustomerImage finds a customer image by name in a given data centre.
+func (client *Client) FindCustomerImage(name string, dataCenterID string) (*compute.CustomerImage, error) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record("FindCustomerImage", name, dataCenterID); err != nil {
+		return nil, err
+	}
+
+	for _, image := range client.CustomerImages {
+		if image.Name == name && image.DataCenterID == dataCenterID {
+			return image, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// ListCustomerImagesInDatacenter lists all customer images in a given data centre.
+func (client *Client) ListCustomerImagesInDatacenter(dataCenterID string, paging *compute.Paging) (*compute.CustomerImages, error) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record("ListCustomerImagesInDatacenter", dataCenterID, paging); err != nil {
+		return nil, err
+	}
+
+	images := &compute.CustomerImages{}
+	var ids []string
+	for id, image := range client.CustomerImages {
+		if image.DataCenterID == dataCenterID {
+			ids = append(ids, id)
+		}
+	}
+	for _, id := range sortedKeys(ids) {
+		images.Images = append(images.Images, *client.CustomerImages[id])
+	}
+	images.PagedResult = newPagedResult(len(images.Images))
+
+	return images, nil
+}
+
+// ListCustomerImages lists the customer images in all datacenters.
+func (client *Client) ListCustomerImages(paging *compute.Paging) (*compute.CustomerImages, error) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record("ListCustomerImages", paging); err != nil {
+		return nil, err
+	}
+
+	images := &compute.CustomerImages{}
+	var ids []string
+	for id := range client.CustomerImages {
+		ids = append(ids, id)
+	}
+	for _, id := range sortedKeys(ids) {
+		images.Images = append(images.Images, *client.CustomerImages[id])
+	}
+	images.PagedResult = newPagedResult(len(images.Images))
+
+	return images, nil
+}
+
+// EditCustomerImage updates the name and / or description of the specified customer image.
+func (client *Client) EditCustomerImage(id string, name string, description string) error {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record("EditCustomerImage", id, name, description); err != nil {
+		return err
+	}
+
+	image, ok := client.CustomerImages[id]
+	if !ok {
+		return notFound("customer image", id)
+	}
+	if name != "" {
+		image.Name = name
+	}
+	if description != "" {
+		image.Description = description
+	}
+
+	return nil
+}
+
+// DeleteCustomerImage deletes the specified customer image.
+func (client *Client) DeleteCustomerImage(id string) error {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record("DeleteCustomerImage", id); err != nil {
+		return err
+	}
+
+	if _, ok := client.CustomerImages[id]; !ok {
+		return notFound("customer image", id)
+	}
+	delete(client.CustomerImages, id)
+
+	return nil
+}
+
+// CopyCustomerImage copies the specified customer image to another datacenter.
+func (client *Client) CopyCustomerImage(sourceImageID string, targetDatacenterID string, newName string) (string, error) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record("CopyCustomerImage", sourceImageID, targetDatacenterID, newName); err != nil {
+		return "", err
+	}
+
+	sourceImage, ok := client.CustomerImages[sourceImageID]
+	if !ok {
+		return "", notFound("customer image", sourceImageID)
+	}
+
+	id := client.newID("customer-image")
+	image := *sourceImage
+	image.ID = id
+	image.Name = newName
+	image.DataCenterID = targetDatacenterID
+	image.CreateTime = compute.NewTimestamp(time.Now().UTC())
+	client.CustomerImages[id] = &image
+
+	return id, nil
+}
+
+// CloneServer clones a server to create a customer image.
+func (client *Client) CloneServer(serverID string, imageName string, imageDescription string, preventGuestOSCustomisation bool) (string, error) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record("CloneServer", serverID, imageName, imageDescription, preventGuestOSCustomisation); err != nil {
+		return "", err
+	}
+
+	return client.cloneServer(serverID, imageName, imageDescription)
+}
+
+// CloneServerWithOptions clones a server to create a customer image, using the specified options.
+func (client *Client) CloneServerWithOptions(serverID string, options *compute.CloneOptions) (string, error) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record("CloneServerWithOptions", serverID, options); err != nil {
+		return "", err
+	}
+	if options == nil {
+		return "", fmt.Errorf("Must specify options when cloning server '%s'", serverID)
+	}
+
+	return client.cloneServer(serverID, options.ImageName, options.ImageDescription)
+}
+
+// cloneServer creates a customer image from the specified server (the caller must hold the state lock).
+func (client *Client) cloneServer(serverID string, imageName string, imageDescription string) (string, error) {
+	server, ok := client.Servers[serverID]
+	if !ok {
+		return "", notFound("server", serverID)
+	}
+
+	// The fake doesn't track which datacenter a server lives in; use its network domain's datacenter (if known).
+	var datacenterID string
+	if domain, ok := client.NetworkDomains[server.Network.NetworkDomainID]; ok {
+		datacenterID = domain.DatacenterID
+	}
+
+	id := client.newID("customer-image")
+	client.CustomerImages[id] = &compute.CustomerImage{
+		ID:              id,
+		Name:            imageName,
+		Description:     imageDescription,
+		DataCenterID:    datacenterID,
+		OperatingSystem: server.OperatingSystem,
+		CPU:             server.CPU,
+		MemoryGB:        server.MemoryGB,
+		Disks:           server.Disks,
+		CreateTime:      compute.NewTimestamp(time.Now().UTC()),
+		State:           compute.ResourceStatusNormal,
+	}
+
+	return id, nil
+}
+
+/*
+ * Tags
+ */
+
+// GetAssetTags gets a page of tags applied to the specified asset.
+func (client *Client) GetAssetTags(assetID string, assetType string, paging *compute.Paging) (*compute.TagDetails, error) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record("GetAssetTags", assetID, assetType, paging); err != nil {
+		return nil, err
+	}
+
+	tagDetails := &compute.TagDetails{}
+	for _, tag := range client.Tags[assetID] {
+		tagDetails.Items = append(tagDetails.Items, compute.TagDetail{
+			AssetType: assetType,
+			AssetID:   assetID,
+			Name:      tag.Name,
+			Value:     tag.Value,
+		})
+	}
+	tagDetails.PagedResult = newPagedResult(len(tagDetails.Items))
+
+	return tagDetails, nil
+}
+
+// ApplyAssetTags applies the specified tags to an asset.
+func (client *Client) ApplyAssetTags(assetID string, assetType string, tags ...compute.Tag) (*compute.APIResponseV2, error) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record("ApplyAssetTags", assetID, assetType, tags); err != nil {
+		return nil, err
+	}
+
+	for _, tag := range tags {
+		client.Tags[assetID] = append(removeTag(client.Tags[assetID], tag.Name), tag)
+	}
+
+	return &compute.APIResponseV2{
+		ResponseCode: compute.ResponseCodeOK,
+	}, nil
+}
+
+// RemoveAssetTags removes the specified tags from an asset.
+func (client *Client) RemoveAssetTags(assetID string, assetType string, tagNames ...string) (*compute.APIResponseV2, error) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record("RemoveAssetTags", assetID, assetType, tagNames); err != nil {
+		return nil, err
+	}
+
+	for _, tagName := range tagNames {
+		client.Tags[assetID] = removeTag(client.Tags[assetID], tagName)
+	}
+
+	return &compute.APIResponseV2{
+		ResponseCode: compute.ResponseCodeOK,
+	}, nil
+}
+
+// removeTag removes the tag (if any) with the specified name.
+func removeTag(tags []compute.Tag, tagName string) (remainingTags []compute.Tag) {
+	for _, tag := range tags {
+		if tag.Name != tagName {
+			remainingTags = append(remainingTags, tag)
+		}
+	}
+
+	return
+}
+
+/*
+ * Waiting
+ */
+
+// GetResource retrieves a compute resource of the specified type by Id.
+//
+// Returns nil if the resource does not exist (or its type is not supported by the fake).
+func (client *Client) GetResource(id string, resourceType compute.ResourceType) (compute.Resource, error) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record("GetResource", id, resourceType); err != nil {
+		return nil, err
+	}
+
+	return client.getResource(id, resourceType), nil
+}
+
+// WaitForDeploy waits for a resource's pending deployment operation to complete.
+func (client *Client) WaitForDeploy(resourceType compute.ResourceType, id string, timeout time.Duration) (compute.Resource, error) {
+	return client.waitForResource("WaitForDeploy", resourceType, id, timeout)
+}
+
+// WaitForEdit waits for a resource's pending edit operation to complete.
+func (client *Client) WaitForEdit(resourceType compute.ResourceType, id string, timeout time.Duration) (compute.Resource, error) {
+	return client.waitForResource("WaitForEdit", resourceType, id, timeout)
+}
+
+// WaitForChange waits for a resource's pending change operation to complete.
+func (client *Client) WaitForChange(resourceType compute.ResourceType, id string, actionDescription string, timeout time.Duration) (compute.Resource, error) {
+	return client.waitForResource("WaitForChange", resourceType, id, timeout)
+}
+
+// WaitForDelete waits for a resource's pending deletion to complete.
+func (client *Client) WaitForDelete(resourceType compute.ResourceType, id string, timeout time.Duration) error {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	return client.record("WaitForDelete", resourceType, id, timeout)
+}
+
+// WaitForServerClone waits for a server's pending clone operation to complete.
+func (client *Client) WaitForServerClone(customerImageID string, timeout time.Duration) (compute.Resource, error) {
+	return client.waitForResource("WaitForServerClone", compute.ResourceTypeCustomerImage, customerImageID, timeout)
+}
+
+// WaitForCustomerImageCopy waits for a customer image's pending copy operation to complete.
+func (client *Client) WaitForCustomerImageCopy(customerImageID string, timeout time.Duration) (compute.Resource, error) {
+	return client.waitForResource("WaitForCustomerImageCopy", compute.ResourceTypeCustomerImage, customerImageID, timeout)
+}
+
+// waitForResource records a call to a WaitForXXX method and returns the resource (operations on the fake complete immediately).
+func (client *Client) waitForResource(method string, resourceType compute.ResourceType, id string, timeout time.Duration) (compute.Resource, error) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if err := client.record(method, resourceType, id, timeout); err != nil {
+		return nil, err
+	}
+
+	resource := client.getResource(id, resourceType)
+	if resource == nil {
+		description, _ := compute.GetResourceDescription(resourceType)
+
+		return nil, notFound(description, id)
+	}
+
+	return resource, nil
+}
+
+// getResource retrieves a resource (the caller must hold the state lock).
+func (client *Client) getResource(id string, resourceType compute.ResourceType) compute.Resource {
+	switch resourceType {
+	case compute.ResourceTypeNetworkDomain:
+		if domain, ok := client.NetworkDomains[id]; ok {
+			return domain
+		}
+	case compute.ResourceTypeVLAN:
+		if vlan, ok := client.VLANs[id]; ok {
+			return vlan
+		}
+	case compute.ResourceTypeServer:
+		if server, ok := client.Servers[id]; ok {
+			return server
+		}
+	case compute.ResourceTypeOSImage:
+		if image, ok := client.OSImages[id]; ok {
+			return image
+		}
+	case compute.ResourceTypeCustomerImage:
+		if image, ok := client.CustomerImages[id]; ok {
+			return image
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package fake
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DimensionDataResearch/go-dd-cloud-compute/compute"
+)
+
+// deployAndStart is an example of code under test that depends only on the narrow interfaces it needs.
+func deployAndStart(client interface {
+	compute.ServerClient
+	compute.WaitClient
+}, configuration compute.ServerDeploymentConfiguration) (*compute.Server, error) {
+	serverID, err := client.DeployServer(configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := client.WaitForDeploy(compute.ResourceTypeServer, serverID, 10*time.Minute)
+	if err != nil {
+		return nil, err
+	}
+
+	err = client.StartServer(serverID)
+	if err != nil {
+		return nil, err
+	}
+
+	return resource.(*compute.Server), nil
+}
+
+// Fake client deploys resources and records calls.
+func TestClient_DeployServer(test *testing.T) {
+	client := NewClient()
+
+	vlanID := "vlan-1"
+	server, err := deployAndStart(client, compute.ServerDeploymentConfiguration{
+		Name: "server1",
+		Network: compute.VirtualMachineNetwork{
+			NetworkDomainID: "network-domain-1",
+			PrimaryAdapter: compute.VirtualMachineNetworkAdapter{
+				VLANID: &vlanID,
+			},
+		},
+	})
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	if server.Name != "server1" || !server.Started {
+		test.Fatalf("Unexpected server: %+v", server)
+	}
+
+	calls := client.Calls()
+	if len(calls) != 3 {
+		test.Fatalf("Expected 3 calls but found %d.", len(calls))
+	}
+	for index, method := range []string{"DeployServer", "WaitForDeploy", "StartServer"} {
+		if calls[index].Method != method {
+			test.Fatalf("Expected call %d to be '%s' but found '%s'.", index, method, calls[index].Method)
+		}
+	}
+
+	servers, err := client.ListServersInNetworkDomain("network-domain-1", nil)
+	if err != nil {
+		test.Fatal(err)
+	}
+	if len(servers.Items) != 1 || servers.Items[0].ID != server.ID {
+		test.Fatalf("Unexpected servers: %+v", servers.Items)
+	}
+
+	servers, err = client.ListServersInVLAN(vlanID, nil)
+	if err != nil {
+		test.Fatal(err)
+	}
+	if len(servers.Items) != 1 || servers.Items[0].ID != server.ID {
+		test.Fatalf("Unexpected servers in VLAN: %+v", servers.Items)
+	}
+}
+
+// Fake client returns configured errors.
+func TestClient_ConfiguredError(test *testing.T) {
+	client := NewClient()
+	client.Errors["DeployServer"] = &compute.APIError{Message: "Deployment failed"}
+
+	_, err := deployAndStart(client, compute.ServerDeploymentConfiguration{
+		Name: "server1",
+	})
+	if err == nil {
+		test.Fatal("Expected DeployServer to fail.")
+	}
+	if len(client.Servers) != 0 {
+		test.Fatalf("Expected no servers but found %d.", len(client.Servers))
+	}
+	if len(client.CallsTo("DeployServer")) != 1 {
+		test.Fatal("Expected call to DeployServer to be recorded.")
+	}
+}
+
+// Fake client serves canned resources and tracks tags.
+func TestClient_CannedResources(test *testing.T) {
+	client := NewClient()
+	client.CustomerImages["image1"] = &compute.CustomerImage{
+		ID:           "image1",
+		Name:         "golden",
+		DataCenterID: "AU9",
+		State:        compute.ResourceStatusNormal,
+	}
+
+	copyID, err := client.CopyCustomerImage("image1", "AU10", "golden")
+	if err != nil {
+		test.Fatal(err)
+	}
+	image, err := client.FindCustomerImage("golden", "AU10")
+	if err != nil {
+		test.Fatal(err)
+	}
+	if image == nil || image.ID != copyID {
+		test.Fatalf("Copied image not found.")
+	}
+	images, err := client.ListCustomerImages(nil)
+	if err != nil {
+		test.Fatal(err)
+	}
+	if len(images.Images) != 2 {
+		test.Fatalf("Expected 2 customer images across all datacenters (found %d).", len(images.Images))
+	}
+
+	_, err = client.ApplyAssetTags(copyID, compute.AssetTypeCustomerImage, compute.Tag{Name: "role", Value: "web"})
+	if err != nil {
+		test.Fatal(err)
+	}
+	tags, err := client.GetAssetTags(copyID, compute.AssetTypeCustomerImage, nil)
+	if err != nil {
+		test.Fatal(err)
+	}
+	if len(tags.Items) != 1 || tags.Items[0].Value != "web" {
+		test.Fatalf("Unexpected tags: %+v", tags.Items)
+	}
+
+	err = client.DeleteCustomerImage("missing")
+	if !compute.IsResourceNotFoundError(err) {
+		test.Fatalf("Expected resource-not-found error but found %v.", err)
+	}
+}
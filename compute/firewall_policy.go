@@ -0,0 +1,581 @@
+package compute
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// FirewallPolicy is a declarative description of the firewall configuration for a network domain (named IP address lists, port lists, and firewall rules).
+//
+// Rules can refer to address lists and port lists by name; these can be defined in the policy, or already exist in the target network domain.
+type FirewallPolicy struct {
+	// The IP address lists defined by the policy.
+	AddressLists []FirewallPolicyAddressList `json:"addressLists"`
+
+	// The port lists defined by the policy.
+	PortLists []FirewallPolicyPortList `json:"portLists"`
+
+	// The firewall rules defined by the policy (in order).
+	Rules []FirewallPolicyRule `json:"rules"`
+}
+
+// FirewallPolicyAddressList represents an IP address list in a FirewallPolicy.
+type FirewallPolicyAddressList struct {
+	// The address list name.
+	Name string `json:"name"`
+
+	// The address list description.
+	Description string `json:"description,omitempty"`
+
+	// The address list IP version (IPv4 or IPv6; defaults to IPv4).
+	IPVersion string `json:"ipVersion,omitempty"`
+
+	// The address list entries ("address", "address/prefix-size", or "begin-address - end-address").
+	Addresses []string `json:"addresses"`
+}
+
+// FirewallPolicyPortList represents a port list in a FirewallPolicy.
+type FirewallPolicyPortList struct {
+	// The port list name.
+	Name string `json:"name"`
+
+	// The port list description.
+	Description string `json:"description,omitempty"`
+
+	// The port list entries ("port" or "begin-port - end-port").
+	Ports []string `json:"ports"`
+}
+
+// FirewallPolicyRule represents a firewall rule in a FirewallPolicy.
+type FirewallPolicyRule struct {
+	// The rule name.
+	Name string `json:"name"`
+
+	// The rule action (ACCEPT_DECISIVELY or DROP; "accept" and "drop" are also permitted).
+	Action string `json:"action"`
+
+	// Is the rule enabled? (defaults to true).
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// The rule IP version (IPv4 or IPv6; defaults to IPv4).
+	IPVersion string `json:"ipVersion,omitempty"`
+
+	// The rule protocol (IP, TCP, UDP, or ICMP; defaults to TCP).
+	Protocol string `json:"protocol,omitempty"`
+
+	// The rule source.
+	Source FirewallPolicyEndpoint `json:"source"`
+
+	// The rule destination.
+	Destination FirewallPolicyEndpoint `json:"destination"`
+}
+
+// FirewallPolicyEndpoint represents the source or destination of a firewall rule in a FirewallPolicy.
+//
+// At most one of Address / AddressList and one of Port / PortList can be specified; unspecified values match any address or port.
+type FirewallPolicyEndpoint struct {
+	// The address ("any", "address", or "address/prefix-size").
+	Address string `json:"address,omitempty"`
+
+	// The name of the address list.
+	AddressList string `json:"addressList,omitempty"`
+
+	// The port ("any", "port", or "begin-port - end-port").
+	Port string `json:"port,omitempty"`
+
+	// The name of the port list.
+	PortList string `json:"portList,omitempty"`
+}
+
+// FirewallPolicyResult represents the changes made when applying a FirewallPolicy.
+type FirewallPolicyResult struct {
+	// The Ids of address lists that were created.
+	CreatedAddressListIDs []string
+
+	// The Ids of existing address lists that were updated.
+	UpdatedAddressListIDs []string
+
+	// The Ids of port lists that were created.
+	CreatedPortListIDs []string
+
+	// The Ids of existing port lists that were updated.
+	UpdatedPortListIDs []string
+
+	// The Ids of firewall rules that were created.
+	CreatedRuleIDs []string
+
+	// The Ids of existing firewall rules that were enabled or disabled.
+	UpdatedRuleIDs []string
+}
+
+// LoadFirewallPolicy reads and validates a FirewallPolicy (in JSON format).
+func LoadFirewallPolicy(reader io.Reader) (policy *FirewallPolicy, err error) {
+	decoder := json.NewDecoder(reader)
+	decoder.DisallowUnknownFields()
+
+	policy = &FirewallPolicy{}
+	err = decoder.Decode(policy)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid firewall policy: %s", err.Error())
+	}
+
+	err = policy.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return policy, nil
+}
+
+// Validate verifies that the policy is well-formed.
+//
+// References from rules to address lists or port lists that are not defined in the policy are not checked here (they may already exist in the target network domain).
+func (policy *FirewallPolicy) Validate() error {
+	var problems []string
+
+	addressListNames := make(map[string]bool)
+	for _, addressList := range policy.AddressLists {
+		if addressList.Name == "" {
+			problems = append(problems, "address list has no name")
+		} else if addressListNames[addressList.Name] {
+			problems = append(problems, fmt.Sprintf("address list '%s' is defined more than once", addressList.Name))
+		}
+		addressListNames[addressList.Name] = true
+
+		if len(addressList.Addresses) == 0 {
+			problems = append(problems, fmt.Sprintf("address list '%s' has no addresses", addressList.Name))
+		}
+		for _, address := range addressList.Addresses {
+			if _, err := parseFirewallPolicyAddressListEntry(address); err != nil {
+				problems = append(problems, fmt.Sprintf("address list '%s': %s", addressList.Name, err.Error()))
+			}
+		}
+	}
+
+	portListNames := make(map[string]bool)
+	for _, portList := range policy.PortLists {
+		if portList.Name == "" {
+			problems = append(problems, "port list has no name")
+		} else if portListNames[portList.Name] {
+			problems = append(problems, fmt.Sprintf("port list '%s' is defined more than once", portList.Name))
+		}
+		portListNames[portList.Name] = true
+
+		if len(portList.Ports) == 0 {
+			problems = append(problems, fmt.Sprintf("port list '%s' has no ports", portList.Name))
+		}
+		for _, port := range portList.Ports {
+			if _, _, err := parseFirewallPolicyPortRange(port); err != nil {
+				problems = append(problems, fmt.Sprintf("port list '%s': %s", portList.Name, err.Error()))
+			}
+		}
+	}
+
+	ruleNames := make(map[string]bool)
+	for _, rule := range policy.Rules {
+		if rule.Name == "" {
+			problems = append(problems, "rule has no name")
+		} else if ruleNames[rule.Name] {
+			problems = append(problems, fmt.Sprintf("rule '%s' is defined more than once", rule.Name))
+		}
+		ruleNames[rule.Name] = true
+
+		if _, err := parseFirewallPolicyAction(rule.Action); err != nil {
+			problems = append(problems, fmt.Sprintf("rule '%s': %s", rule.Name, err.Error()))
+		}
+		for _, err := range rule.Source.validate() {
+			problems = append(problems, fmt.Sprintf("rule '%s' (source): %s", rule.Name, err.Error()))
+		}
+		for _, err := range rule.Destination.validate() {
+			problems = append(problems, fmt.Sprintf("rule '%s' (destination): %s", rule.Name, err.Error()))
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("Invalid firewall policy: %s", strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
+// ApplyFirewallPolicy applies the specified firewall policy to a network domain.
+//
+// Address lists and port lists are created (or, if a list with the same name already exists, its entries are replaced).
+// Rules are created in order (after any existing rules); existing rules with the same name are only enabled or disabled, as required (CloudControl does not support editing other properties of a firewall rule).
+//
+// All references to address lists and port lists are resolved before any changes are made.
+func (client *Client) ApplyFirewallPolicy(networkDomainID string, policy *FirewallPolicy) (result *FirewallPolicyResult, err error) {
+	err = policy.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	existingAddressLists, err := client.ListIPAddressLists(networkDomainID)
+	if err != nil {
+		return nil, err
+	}
+	addressListIDs := make(map[string]string)
+	for _, addressList := range existingAddressLists.AddressLists {
+		addressListIDs[addressList.Name] = addressList.ID
+	}
+
+	existingPortLists, err := client.ListPortLists(networkDomainID)
+	if err != nil {
+		return nil, err
+	}
+	portListIDs := make(map[string]string)
+	for _, portList := range existingPortLists.PortLists {
+		portListIDs[portList.Name] = portList.ID
+	}
+
+	existingRules := make(map[string]FirewallRule)
+	err = ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		rules, err := client.ListFirewallRules(networkDomainID, paging)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, rule := range rules.Rules {
+			existingRules[rule.Name] = rule
+		}
+
+		return &rules.PagedResult, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Resolve references before making any changes.
+	definedAddressLists := make(map[string]bool)
+	for _, addressList := range policy.AddressLists {
+		definedAddressLists[addressList.Name] = true
+	}
+	definedPortLists := make(map[string]bool)
+	for _, portList := range policy.PortLists {
+		definedPortLists[portList.Name] = true
+	}
+	var unresolved []string
+	for _, rule := range policy.Rules {
+		for _, endpoint := range []FirewallPolicyEndpoint{rule.Source, rule.Destination} {
+			if endpoint.AddressList != "" && !definedAddressLists[endpoint.AddressList] && addressListIDs[endpoint.AddressList] == "" {
+				unresolved = append(unresolved, fmt.Sprintf("rule '%s' refers to unknown address list '%s'", rule.Name, endpoint.AddressList))
+			}
+			if endpoint.PortList != "" && !definedPortLists[endpoint.PortList] && portListIDs[endpoint.PortList] == "" {
+				unresolved = append(unresolved, fmt.Sprintf("rule '%s' refers to unknown port list '%s'", rule.Name, endpoint.PortList))
+			}
+		}
+	}
+	if len(unresolved) > 0 {
+		return nil, fmt.Errorf("Cannot apply firewall policy to network domain '%s': %s", networkDomainID, strings.Join(unresolved, "; "))
+	}
+
+	result = &FirewallPolicyResult{}
+
+	for _, addressList := range policy.AddressLists {
+		ipVersion := addressList.IPVersion
+		if ipVersion == "" {
+			ipVersion = FirewallRuleIPVersion4
+		}
+
+		entries := make([]IPAddressListEntry, len(addressList.Addresses))
+		for index, address := range addressList.Addresses {
+			entries[index], _ = parseFirewallPolicyAddressListEntry(address) // Already validated.
+		}
+
+		existingID, exists := addressListIDs[addressList.Name]
+		if exists {
+			log.Printf("Updating IP address list '%s' ('%s')...", addressList.Name, existingID)
+
+			err = client.EditIPAddressList(EditIPAddressList{
+				ID:          existingID,
+				Description: addressList.Description,
+				Addresses:   entries,
+			})
+			if err != nil {
+				return result, err
+			}
+			result.UpdatedAddressListIDs = append(result.UpdatedAddressListIDs, existingID)
+
+			continue
+		}
+
+		log.Printf("Creating IP address list '%s'...", addressList.Name)
+
+		var addressListID string
+		addressListID, err = client.CreateIPAddressList(addressList.Name, addressList.Description, ipVersion, networkDomainID, entries, nil)
+		if err != nil {
+			return result, err
+		}
+		addressListIDs[addressList.Name] = addressListID
+		result.CreatedAddressListIDs = append(result.CreatedAddressListIDs, addressListID)
+	}
+
+	for _, portList := range policy.PortLists {
+		entries := make([]PortListEntry, len(portList.Ports))
+		for index, port := range portList.Ports {
+			begin, end, _ := parseFirewallPolicyPortRange(port) // Already validated.
+			entries[index] = PortListEntry{
+				Begin: begin,
+				End:   end,
+			}
+		}
+
+		existingID, exists := portListIDs[portList.Name]
+		if exists {
+			log.Printf("Updating port list '%s' ('%s')...", portList.Name, existingID)
+
+			err = client.EditPortList(existingID, EditPortList{
+				ID:          existingID,
+				Description: portList.Description,
+				Ports:       entries,
+			})
+			if err != nil {
+				return result, err
+			}
+			result.UpdatedPortListIDs = append(result.UpdatedPortListIDs, existingID)
+
+			continue
+		}
+
+		log.Printf("Creating port list '%s'...", portList.Name)
+
+		var portListID string
+		portListID, err = client.CreatePortList(portList.Name, portList.Description, networkDomainID, entries, nil)
+		if err != nil {
+			return result, err
+		}
+		portListIDs[portList.Name] = portListID
+		result.CreatedPortListIDs = append(result.CreatedPortListIDs, portListID)
+	}
+
+	for _, rule := range policy.Rules {
+		var configuration FirewallRuleConfiguration
+		configuration, err = rule.compile(networkDomainID, addressListIDs, portListIDs)
+		if err != nil {
+			return result, err
+		}
+
+		existingRule, exists := existingRules[rule.Name]
+		if exists {
+			if existingRule.Enabled != configuration.Enabled {
+				log.Printf("Updating firewall rule '%s' ('%s')...", rule.Name, existingRule.ID)
+
+				err = client.EditFirewallRule(existingRule.ID, configuration.Enabled)
+				if err != nil {
+					return result, err
+				}
+				result.UpdatedRuleIDs = append(result.UpdatedRuleIDs, existingRule.ID)
+			}
+
+			continue
+		}
+
+		log.Printf("Creating firewall rule '%s'...", rule.Name)
+
+		var ruleID string
+		ruleID, err = client.CreateFirewallRule(configuration)
+		if err != nil {
+			return result, err
+		}
+		result.CreatedRuleIDs = append(result.CreatedRuleIDs, ruleID)
+	}
+
+	return result, nil
+}
+
+// compile converts the policy rule to a FirewallRuleConfiguration.
+func (rule *FirewallPolicyRule) compile(networkDomainID string, addressListIDs map[string]string, portListIDs map[string]string) (configuration FirewallRuleConfiguration, err error) {
+	configuration = FirewallRuleConfiguration{
+		Name:            rule.Name,
+		Enabled:         rule.Enabled == nil || *rule.Enabled,
+		IPVersion:       rule.IPVersion,
+		Protocol:        strings.ToUpper(rule.Protocol),
+		NetworkDomainID: networkDomainID,
+	}
+	configuration.PlaceLast()
+
+	if configuration.IPVersion == "" {
+		configuration.IPVersion = FirewallRuleIPVersion4
+	}
+	if configuration.Protocol == "" {
+		configuration.Protocol = FirewallRuleProtocolTCP
+	}
+
+	configuration.Action, err = parseFirewallPolicyAction(rule.Action)
+	if err != nil {
+		return
+	}
+
+	configuration.Source, err = rule.Source.compile(addressListIDs, portListIDs)
+	if err != nil {
+		return
+	}
+
+	configuration.Destination, err = rule.Destination.compile(addressListIDs, portListIDs)
+
+	return
+}
+
+// validate checks the endpoint for errors.
+func (endpoint *FirewallPolicyEndpoint) validate() (errors []error) {
+	if endpoint.Address != "" && endpoint.AddressList != "" {
+		errors = append(errors, fmt.Errorf("cannot specify both address and address list"))
+	}
+	if endpoint.Port != "" && endpoint.PortList != "" {
+		errors = append(errors, fmt.Errorf("cannot specify both port and port list"))
+	}
+	if endpoint.Address != "" && !strings.EqualFold(endpoint.Address, FirewallRuleMatchAny) {
+		if _, _, err := parseFirewallPolicyAddress(endpoint.Address); err != nil {
+			errors = append(errors, err)
+		}
+	}
+	if endpoint.Port != "" && !strings.EqualFold(endpoint.Port, FirewallRuleMatchAny) {
+		if _, _, err := parseFirewallPolicyPortRange(endpoint.Port); err != nil {
+			errors = append(errors, err)
+		}
+	}
+
+	return
+}
+
+// compile converts the policy endpoint to a FirewallRuleScope.
+func (endpoint *FirewallPolicyEndpoint) compile(addressListIDs map[string]string, portListIDs map[string]string) (scope FirewallRuleScope, err error) {
+	if endpoint.AddressList != "" {
+		addressListID, ok := addressListIDs[endpoint.AddressList]
+		if !ok {
+			return scope, fmt.Errorf("Unknown address list '%s'", endpoint.AddressList)
+		}
+		scope.AddressListID = &addressListID
+	} else if endpoint.Address == "" || strings.EqualFold(endpoint.Address, FirewallRuleMatchAny) {
+		scope.IPAddress = &FirewallRuleIPAddress{
+			Address: FirewallRuleMatchAny,
+		}
+	} else {
+		address, prefixSize, err := parseFirewallPolicyAddress(endpoint.Address)
+		if err != nil {
+			return scope, err
+		}
+		scope.IPAddress = &FirewallRuleIPAddress{
+			Address:    strings.ToUpper(address),
+			PrefixSize: prefixSize,
+		}
+	}
+
+	if endpoint.PortList != "" {
+		portListID, ok := portListIDs[endpoint.PortList]
+		if !ok {
+			return scope, fmt.Errorf("Unknown port list '%s'", endpoint.PortList)
+		}
+		scope.PortListID = &portListID
+	} else if endpoint.Port != "" && !strings.EqualFold(endpoint.Port, FirewallRuleMatchAny) {
+		begin, end, err := parseFirewallPolicyPortRange(endpoint.Port)
+		if err != nil {
+			return scope, err
+		}
+		scope.Port = &FirewallRulePort{
+			Begin: begin,
+			End:   end,
+		}
+	}
+
+	return scope, nil
+}
+
+// parseFirewallPolicyAction parses a firewall rule action.
+func parseFirewallPolicyAction(action string) (string, error) {
+	switch strings.ToUpper(action) {
+	case "ACCEPT", FirewallRuleActionAccept:
+		return FirewallRuleActionAccept, nil
+	case FirewallRuleActionDrop:
+		return FirewallRuleActionDrop, nil
+	default:
+		return "", fmt.Errorf("invalid action '%s' (must be ACCEPT_DECISIVELY or DROP)", action)
+	}
+}
+
+// parseFirewallPolicyAddress parses an address ("address" or "address/prefix-size").
+func parseFirewallPolicyAddress(value string) (address string, prefixSize *int, err error) {
+	if strings.Contains(value, "/") {
+		var network *net.IPNet
+		_, network, err = net.ParseCIDR(value)
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid network '%s'", value)
+		}
+
+		size, _ := network.Mask.Size()
+
+		return network.IP.String(), &size, nil
+	}
+
+	if net.ParseIP(value) == nil {
+		return "", nil, fmt.Errorf("invalid IP address '%s'", value)
+	}
+
+	return value, nil, nil
+}
+
+// parseFirewallPolicyAddressListEntry parses an address list entry ("address", "address/prefix-size", or "begin-address - end-address").
+func parseFirewallPolicyAddressListEntry(value string) (entry IPAddressListEntry, err error) {
+	if strings.Contains(value, "-") {
+		parts := strings.SplitN(value, "-", 2)
+		begin := strings.TrimSpace(parts[0])
+		end := strings.TrimSpace(parts[1])
+		if net.ParseIP(begin) == nil || net.ParseIP(end) == nil {
+			return entry, fmt.Errorf("invalid IP address range '%s'", value)
+		}
+
+		return IPAddressListEntry{
+			Begin: begin,
+			End:   &end,
+		}, nil
+	}
+
+	address, prefixSize, err := parseFirewallPolicyAddress(strings.TrimSpace(value))
+	if err != nil {
+		return entry, err
+	}
+
+	return IPAddressListEntry{
+		Begin:      address,
+		PrefixSize: prefixSize,
+	}, nil
+}
+
+// parseFirewallPolicyPortRange parses a port or port range ("port" or "begin-port - end-port").
+func parseFirewallPolicyPortRange(value string) (begin int, end *int, err error) {
+	parts := strings.SplitN(value, "-", 2)
+
+	begin, err = parseFirewallPolicyPort(parts[0])
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if len(parts) == 1 {
+		return begin, nil, nil
+	}
+
+	endPort, err := parseFirewallPolicyPort(parts[1])
+	if err != nil {
+		return 0, nil, err
+	}
+	if endPort <= begin {
+		return 0, nil, fmt.Errorf("invalid port range '%s' (end port must be greater than begin port)", value)
+	}
+
+	return begin, &endPort, nil
+}
+
+// parseFirewallPolicyPort parses a single port number.
+func parseFirewallPolicyPort(value string) (int, error) {
+	port, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || port < 1 || port > 65535 {
+		return 0, fmt.Errorf("invalid port '%s'", strings.TrimSpace(value))
+	}
+
+	return port, nil
+}
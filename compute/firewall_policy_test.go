@@ -0,0 +1,278 @@
+package compute
+
+import (
+	"net/http"
+	"path"
+	"strings"
+	"testing"
+)
+
+// Load firewall policy (successful).
+func TestLoadFirewallPolicy_Success(test *testing.T) {
+	expect := expect(test)
+
+	policy, err := LoadFirewallPolicy(strings.NewReader(firewallPolicyTestDocument))
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	expect.EqualsInt("Policy.AddressLists.Length", 1, len(policy.AddressLists))
+	expect.EqualsInt("Policy.PortLists.Length", 1, len(policy.PortLists))
+	expect.EqualsInt("Policy.Rules.Length", 2, len(policy.Rules))
+}
+
+// Load firewall policy (invalid document).
+func TestLoadFirewallPolicy_Invalid(test *testing.T) {
+	expect := expect(test)
+
+	_, err := LoadFirewallPolicy(strings.NewReader(`
+	{
+		"addressLists": [
+			{ "name": "office", "addresses": [ "10.0.0.300" ] }
+		],
+		"rules": [
+			{ "name": "allow-office", "action": "allow", "source": { "addressList": "office", "address": "10.0.0.1" } }
+		]
+	}
+	`))
+	expect.NotNil("Error", err)
+	expect.IsTrue("Error mentions invalid address", strings.Contains(err.Error(), "invalid IP address '10.0.0.300'"))
+	expect.IsTrue("Error mentions invalid action", strings.Contains(err.Error(), "invalid action 'allow'"))
+	expect.IsTrue("Error mentions conflicting source", strings.Contains(err.Error(), "cannot specify both address and address list"))
+}
+
+// Apply firewall policy (successful).
+func TestClient_ApplyFirewallPolicy_Success(test *testing.T) {
+	expect := expect(test)
+
+	policy, err := LoadFirewallPolicy(strings.NewReader(firewallPolicyTestDocument))
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	var createdRules []*FirewallRuleConfiguration
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			result, err := client.ApplyFirewallPolicy("484174a2-ae74-4658-9e56-50fc90e086cf", policy)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("Result.CreatedAddressListIDs.Length", 1, len(result.CreatedAddressListIDs))
+			expect.EqualsString("Result.CreatedAddressListIDs[0]", "6c2b5a94-9d16-4d28-9d8c-0bd7e1c0a1b2", result.CreatedAddressListIDs[0])
+			expect.EqualsInt("Result.UpdatedPortListIDs.Length", 1, len(result.UpdatedPortListIDs))
+			expect.EqualsString("Result.UpdatedPortListIDs[0]", "c8c92ea3-2da8-4d51-8153-f39bec794d69", result.UpdatedPortListIDs[0])
+			expect.EqualsInt("Result.CreatedRuleIDs.Length", 1, len(result.CreatedRuleIDs))
+			expect.EqualsInt("Result.UpdatedRuleIDs.Length", 0, len(result.UpdatedRuleIDs))
+
+			expect.EqualsInt("CreatedRules.Length", 1, len(createdRules))
+			createdRule := createdRules[0]
+			expect.EqualsString("CreatedRule.Name", "allow-office-web", createdRule.Name)
+			expect.EqualsString("CreatedRule.Action", FirewallRuleActionAccept, createdRule.Action)
+			expect.EqualsString("CreatedRule.Placement.Position", "LAST", createdRule.Placement.Position)
+			expect.NotNil("CreatedRule.Source.AddressListID", createdRule.Source.AddressListID)
+			expect.EqualsString("CreatedRule.Source.AddressListID", "6c2b5a94-9d16-4d28-9d8c-0bd7e1c0a1b2", *createdRule.Source.AddressListID)
+			expect.NotNil("CreatedRule.Destination.IPAddress", createdRule.Destination.IPAddress)
+			expect.EqualsString("CreatedRule.Destination.IPAddress.Address", "192.168.1.0", createdRule.Destination.IPAddress.Address)
+			expect.EqualsInt("CreatedRule.Destination.IPAddress.PrefixSize", 24, *createdRule.Destination.IPAddress.PrefixSize)
+			expect.NotNil("CreatedRule.Destination.PortListID", createdRule.Destination.PortListID)
+			expect.EqualsString("CreatedRule.Destination.PortListID", "c8c92ea3-2da8-4d51-8153-f39bec794d69", *createdRule.Destination.PortListID)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			switch path.Base(request.URL.Path) {
+			case "ipAddressList":
+				return http.StatusOK, `{ "ipAddressList": [], "pageNumber": 1, "pageCount": 0, "totalCount": 0, "pageSize": 250 }`
+			case "portList":
+				return http.StatusOK, firewallPolicyPortListsTestResponse
+			case "firewallRule":
+				return http.StatusOK, firewallPolicyRulesTestResponse
+			case "createIpAddressList":
+				return http.StatusOK, firewallPolicyCreateIPAddressListTestResponse
+			case "editPortList":
+				return http.StatusOK, firewallPolicyEditPortListTestResponse
+			case "createFirewallRule":
+				configuration := &FirewallRuleConfiguration{}
+				err := readRequestBodyAsJSON(request, configuration)
+				if err != nil {
+					test.Fatal(err)
+				}
+				createdRules = append(createdRules, configuration)
+
+				return http.StatusOK, firewallPolicyCreateFirewallRuleTestResponse
+			}
+
+			test.Fatalf("Unexpected request: %s %s", request.Method, request.URL.Path)
+
+			return http.StatusBadRequest, ""
+		},
+	})
+}
+
+// Apply firewall policy (rule refers to unknown list).
+func TestClient_ApplyFirewallPolicy_UnknownList(test *testing.T) {
+	expect := expect(test)
+
+	policy := &FirewallPolicy{
+		Rules: []FirewallPolicyRule{
+			{
+				Name:   "allow-partners",
+				Action: "accept",
+				Source: FirewallPolicyEndpoint{
+					AddressList: "partners",
+				},
+			},
+		},
+	}
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			_, err := client.ApplyFirewallPolicy("484174a2-ae74-4658-9e56-50fc90e086cf", policy)
+			expect.NotNil("Error", err)
+			expect.IsTrue("Error mentions unknown list", strings.Contains(err.Error(), "unknown address list 'partners'"))
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			if request.Method != http.MethodGet {
+				test.Fatalf("Unexpected mutation: %s %s", request.Method, request.URL.Path)
+			}
+
+			switch path.Base(request.URL.Path) {
+			case "ipAddressList":
+				return http.StatusOK, `{ "ipAddressList": [], "pageNumber": 1, "pageCount": 0, "totalCount": 0, "pageSize": 250 }`
+			case "portList":
+				return http.StatusOK, firewallPolicyPortListsTestResponse
+			}
+
+			return http.StatusOK, firewallPolicyRulesTestResponse
+		},
+	})
+}
+
+/*
+ * Test requests.
+ */
+
+const firewallPolicyTestDocument = `
+{
+	"addressLists": [
+		{
+			"name": "office",
+			"description": "Office networks",
+			"addresses": [ "10.1.0.0/16", "10.2.0.1 - 10.2.0.10", "10.3.0.5" ]
+		}
+	],
+	"portLists": [
+		{
+			"name": "web-ports",
+			"ports": [ "80", "443", "8000-8080" ]
+		}
+	],
+	"rules": [
+		{
+			"name": "allow-ssh",
+			"action": "ACCEPT_DECISIVELY",
+			"destination": { "address": "192.168.1.10", "port": "22" }
+		},
+		{
+			"name": "allow-office-web",
+			"action": "accept",
+			"source": { "addressList": "office" },
+			"destination": { "address": "192.168.1.0/24", "portList": "web-ports" }
+		}
+	]
+}
+`
+
+/*
+ * Test responses.
+ */
+
+const firewallPolicyPortListsTestResponse = `
+{
+	"portList": [
+		{
+			"id": "c8c92ea3-2da8-4d51-8153-f39bec794d69",
+			"name": "web-ports",
+			"description": "",
+			"port": [ { "begin": 80 } ],
+			"childPortList": [],
+			"state": "NORMAL",
+			"createTime": "2015-06-10T09:41:09.000Z"
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 1,
+	"totalCount": 1,
+	"pageSize": 250
+}
+`
+
+const firewallPolicyRulesTestResponse = `
+{
+	"firewallRule": [
+		{
+			"id": "1aa3d0ce-d95d-4296-8338-9717e0d37ff9",
+			"name": "allow-ssh",
+			"action": "ACCEPT_DECISIVELY",
+			"ipVersion": "IPV4",
+			"protocol": "TCP",
+			"source": { "ip": { "address": "ANY" } },
+			"destination": { "ip": { "address": "192.168.1.10" }, "port": { "begin": 22 } },
+			"enabled": true,
+			"state": "NORMAL",
+			"networkDomainId": "484174a2-ae74-4658-9e56-50fc90e086cf",
+			"datacenterId": "NA9",
+			"ruleType": "CLIENT_RULE"
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 1,
+	"totalCount": 1,
+	"pageSize": 50
+}
+`
+
+const firewallPolicyCreateIPAddressListTestResponse = `
+{
+	"operation": "CREATE_IP_ADDRESS_LIST",
+	"responseCode": "OK",
+	"message": "IP Address List 'office' has been created.",
+	"info": [
+		{
+			"name": "ipAddressListId",
+			"value": "6c2b5a94-9d16-4d28-9d8c-0bd7e1c0a1b2"
+		}
+	],
+	"warning": [],
+	"error": [],
+	"requestId": "na9_20160321T074626030-0400_7e9fffe7-190b-46f2-9107-9d52fe57d0ad"
+}
+`
+
+const firewallPolicyEditPortListTestResponse = `
+{
+	"operation": "EDIT_PORT_LIST",
+	"responseCode": "OK",
+	"message": "Port List 'web-ports' has been edited successfully.",
+	"info": [],
+	"warning": [],
+	"error": [],
+	"requestId": "na9_20160321T074626030-0400_7e9fffe7-190b-46f2-9107-9d52fe57d0ad"
+}
+`
+
+const firewallPolicyCreateFirewallRuleTestResponse = `
+{
+	"operation": "CREATE_FIREWALL_RULE",
+	"responseCode": "OK",
+	"message": "Firewall Rule 'allow-office-web' has been created.",
+	"info": [
+		{
+			"name": "firewallRuleId",
+			"value": "d0a19bbd-2b3c-4c8e-8d5e-6f1a2b3c4d5e"
+		}
+	],
+	"warning": [],
+	"error": [],
+	"requestId": "na9_20160321T074626030-0400_7e9fffe7-190b-46f2-9107-9d52fe57d0ad"
+}
+`
@@ -0,0 +1,159 @@
+package compute
+
+import (
+	"fmt"
+	"log"
+)
+
+// FirewallRuleMove represents a single placement edit required to reorder firewall rules.
+type FirewallRuleMove struct {
+	// The name of the firewall rule to move.
+	RuleName string
+
+	// The firewall rule's new placement (always relative to another firewall rule).
+	Placement FirewallRulePlacement
+}
+
+// PlanFirewallRuleMoves computes the minimal sequence of placement edits that arranges the named firewall rules in the specified order.
+//
+// currentNames is the names of the network domain's firewall rules in their current order; firewall rules that do not appear in orderedNames are left where they are
+// (orderedNames only specifies the relative order of the named rules). The moves must be applied in the order they are returned.
+func PlanFirewallRuleMoves(currentNames []string, orderedNames []string) ([]FirewallRuleMove, error) {
+	currentPositions := make(map[string]int, len(currentNames))
+	for position, name := range currentNames {
+		currentPositions[name] = position
+	}
+
+	// The current position of each rule, in the desired order.
+	positions := make([]int, len(orderedNames))
+	seenNames := make(map[string]bool, len(orderedNames))
+	for index, name := range orderedNames {
+		if seenNames[name] {
+			return nil, fmt.Errorf("Firewall rule '%s' appears more than once in the requested order", name)
+		}
+		seenNames[name] = true
+
+		position, ok := currentPositions[name]
+		if !ok {
+			return nil, fmt.Errorf("No firewall rule named '%s' was found", name)
+		}
+		positions[index] = position
+	}
+
+	// Rules in the longest run that is already correctly ordered (relative to each other) can stay where they are; everything else must move.
+	stationary := longestIncreasingSubsequence(positions)
+
+	var moves []FirewallRuleMove
+	for index, name := range orderedNames {
+		if stationary[index] {
+			continue
+		}
+
+		var placement FirewallRulePlacement
+		if index == 0 {
+			// Place before the first rule that is not moving.
+			for anchorIndex := range orderedNames {
+				if stationary[anchorIndex] {
+					placement = PlaceFirewallRuleBefore(orderedNames[anchorIndex])
+
+					break
+				}
+			}
+		} else {
+			// The preceding rule is already in its final position.
+			placement = PlaceFirewallRuleAfter(orderedNames[index-1])
+		}
+
+		moves = append(moves, FirewallRuleMove{
+			RuleName:  name,
+			Placement: placement,
+		})
+	}
+
+	return moves, nil
+}
+
+// ReorderFirewallRules arranges the named firewall rules in a network domain in the specified order, using as few placement edits as possible.
+//
+// Firewall rules that do not appear in orderedNames are left where they are. Returns the moves that were performed.
+func (client *Client) ReorderFirewallRules(networkDomainID string, orderedNames []string) ([]FirewallRuleMove, error) {
+	var (
+		currentNames []string
+		ruleIDs      = make(map[string]string)
+	)
+	err := ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		rules, err := client.ListFirewallRules(networkDomainID, paging)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, rule := range rules.Rules {
+			currentNames = append(currentNames, rule.Name)
+			ruleIDs[rule.Name] = rule.ID
+		}
+
+		return &rules.PagedResult, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	moves, err := PlanFirewallRuleMoves(currentNames, orderedNames)
+	if err != nil {
+		return nil, err
+	}
+
+	for index, move := range moves {
+		log.Printf("Moving firewall rule '%s' %s '%s'...", move.RuleName, move.Placement.Position, *move.Placement.RelativeToRuleName)
+
+		err = client.MoveFirewallRule(ruleIDs[move.RuleName], move.Placement)
+		if err != nil {
+			return moves[:index], err
+		}
+	}
+
+	return moves, nil
+}
+
+// longestIncreasingSubsequence finds a longest strictly-increasing subsequence of the specified values.
+//
+// Returns a set of flags (one per value) indicating whether each value is part of the subsequence.
+func longestIncreasingSubsequence(values []int) []bool {
+	// tails[length-1] is the index of the smallest value that ends an increasing subsequence of that length.
+	var tails []int
+	predecessors := make([]int, len(values))
+	for index, value := range values {
+		// Binary search for the first tail whose value is >= this value.
+		low, high := 0, len(tails)
+		for low < high {
+			middle := (low + high) / 2
+			if values[tails[middle]] < value {
+				low = middle + 1
+			} else {
+				high = middle
+			}
+		}
+
+		if low > 0 {
+			predecessors[index] = tails[low-1]
+		} else {
+			predecessors[index] = -1
+		}
+
+		if low == len(tails) {
+			tails = append(tails, index)
+		} else {
+			tails[low] = index
+		}
+	}
+
+	inSubsequence := make([]bool, len(values))
+	if len(tails) == 0 {
+		return inSubsequence
+	}
+	for index := tails[len(tails)-1]; index >= 0; index = predecessors[index] {
+		inSubsequence[index] = true
+	}
+
+	return inSubsequence
+}
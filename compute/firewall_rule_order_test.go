@@ -0,0 +1,128 @@
+package compute
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// Plan firewall rule moves (already in order).
+func TestPlanFirewallRuleMoves_AlreadyOrdered(test *testing.T) {
+	expect := expect(test)
+
+	moves, err := PlanFirewallRuleMoves([]string{"a", "x", "b", "c"}, []string{"a", "b", "c"})
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	expect.EqualsInt("Moves.Length", 0, len(moves))
+}
+
+// Plan firewall rule moves (minimal number of moves).
+func TestPlanFirewallRuleMoves_Minimal(test *testing.T) {
+	expect := expect(test)
+
+	// Only "e" and "a" need to move.
+	moves, err := PlanFirewallRuleMoves([]string{"a", "b", "c", "d", "e"}, []string{"e", "b", "c", "a", "d"})
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	expect.EqualsInt("Moves.Length", 2, len(moves))
+
+	expect.EqualsString("Moves[0].RuleName", "e", moves[0].RuleName)
+	expect.EqualsString("Moves[0].Placement.Position", FirewallRulePositionBefore, moves[0].Placement.Position)
+	expect.EqualsString("Moves[0].Placement.RelativeToRuleName", "b", *moves[0].Placement.RelativeToRuleName)
+
+	expect.EqualsString("Moves[1].RuleName", "a", moves[1].RuleName)
+	expect.EqualsString("Moves[1].Placement.Position", FirewallRulePositionAfter, moves[1].Placement.Position)
+	expect.EqualsString("Moves[1].Placement.RelativeToRuleName", "c", *moves[1].Placement.RelativeToRuleName)
+}
+
+// Plan firewall rule moves (unknown or duplicate rule name).
+func TestPlanFirewallRuleMoves_Invalid(test *testing.T) {
+	expect := expect(test)
+
+	_, err := PlanFirewallRuleMoves([]string{"a", "b"}, []string{"b", "z"})
+	expect.NotNil("Error (unknown rule)", err)
+
+	_, err = PlanFirewallRuleMoves([]string{"a", "b"}, []string{"b", "a", "b"})
+	expect.NotNil("Error (duplicate rule)", err)
+}
+
+// Reorder firewall rules (successful).
+func TestClient_ReorderFirewallRules_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			moves, err := client.ReorderFirewallRules("484174a2-ae74-4658-9e56-50fc90e086cf", []string{"AllowHTTPS", "AllowSSH"})
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("Moves.Length", 1, len(moves))
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			if strings.HasSuffix(request.URL.Path, "/network/firewallRule") {
+				return http.StatusOK, listFirewallRulesForReorderTestResponse
+			}
+
+			expect.EqualsString("Request.URL.Path", "/caas/2.4/my-organization-id/network/editFirewallRule", request.URL.Path)
+
+			requestBody := &moveFirewallRule{}
+			err := readRequestBodyAsJSON(request, requestBody)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsString("MoveFirewallRule.ID", "c2a4c6cc-9c19-4d36-a7bd-2b8a3c1fd1e5", requestBody.ID)
+			expect.EqualsString("MoveFirewallRule.Placement.Position", FirewallRulePositionBefore, requestBody.Placement.Position)
+			expect.EqualsString("MoveFirewallRule.Placement.RelativeToRuleName", "AllowSSH", *requestBody.Placement.RelativeToRuleName)
+
+			return http.StatusOK, moveFirewallRuleTestResponse
+		},
+	})
+}
+
+/*
+ * Test responses.
+ */
+
+const listFirewallRulesForReorderTestResponse = `
+{
+	"firewallRule": [
+		{
+			"id": "2e6e2aa9-3a0f-4f3e-8fb9-9a1c0d5ad3e2",
+			"name": "AllowSSH",
+			"action": "ACCEPT_DECISIVELY",
+			"ruleType": "CLIENT_RULE"
+		},
+		{
+			"id": "c2a4c6cc-9c19-4d36-a7bd-2b8a3c1fd1e5",
+			"name": "AllowHTTPS",
+			"action": "ACCEPT_DECISIVELY",
+			"ruleType": "CLIENT_RULE"
+		},
+		{
+			"id": "f4a4f4a4-1d2b-4c3a-9e8f-7a6b5c4d3e2f",
+			"name": "CCDEFAULT.BlockOutboundMailIPv4",
+			"action": "DROP",
+			"ruleType": "DEFAULT_RULE"
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 3,
+	"totalCount": 3,
+	"pageSize": 50
+}
+`
+
+const moveFirewallRuleTestResponse = `
+{
+	"operation": "EDIT_FIREWALL_RULE",
+	"responseCode": "OK",
+	"message": "Firewall Rule has been updated.",
+	"requestId": "na9/2016-01-01T00:00:00.000Z/abcd"
+}
+`
@@ -32,6 +32,18 @@ const (
 
 	// FirewallRuleMatchAny indicates a firewall rule value that matches any other value in the same scope.
 	FirewallRuleMatchAny = "ANY"
+
+	// FirewallRulePositionFirst indicates that a firewall rule is placed before all other (non-default) firewall rules.
+	FirewallRulePositionFirst = "FIRST"
+
+	// FirewallRulePositionLast indicates that a firewall rule is placed after all other (non-default) firewall rules.
+	FirewallRulePositionLast = "LAST"
+
+	// FirewallRulePositionBefore indicates that a firewall rule is placed immediately before another firewall rule.
+	FirewallRulePositionBefore = "BEFORE"
+
+	// FirewallRulePositionAfter indicates that a firewall rule is placed immediately after another firewall rule.
+	FirewallRulePositionAfter = "AFTER"
 )
 
 // FirewallRule represents a firewall rule.
@@ -52,6 +64,10 @@ type FirewallRule struct {
 
 // GetID returns the firewall rule's Id.
 func (rule *FirewallRule) GetID() string {
+	if rule == nil {
+		return ""
+	}
+
 	return rule.ID
 }
 
@@ -62,11 +78,19 @@ func (rule *FirewallRule) GetResourceType() ResourceType {
 
 // GetName returns the firewall rule's name.
 func (rule *FirewallRule) GetName() string {
+	if rule == nil {
+		return ""
+	}
+
 	return rule.Name
 }
 
 // GetState returns the firewall rule's current state.
 func (rule *FirewallRule) GetState() string {
+	if rule == nil {
+		return ""
+	}
+
 	return rule.State
 }
 
@@ -77,6 +101,10 @@ func (rule *FirewallRule) IsDeleted() bool {
 
 // ToEntityReference creates an EntityReference representing the CustomerImage.
 func (rule *FirewallRule) ToEntityReference() EntityReference {
+	if rule == nil {
+		return EntityReference{}
+	}
+
 	return EntityReference{
 		ID:   rule.ID,
 		Name: rule.Name,
@@ -333,7 +361,16 @@ func (configuration *FirewallRuleConfiguration) ICMP() *FirewallRuleConfiguratio
 // PlaceFirst modifies the configuration so that the firewall rule will be placed in the first available position.
 func (configuration *FirewallRuleConfiguration) PlaceFirst() *FirewallRuleConfiguration {
 	configuration.Placement = FirewallRulePlacement{
-		Position: "FIRST",
+		Position: FirewallRulePositionFirst,
+	}
+
+	return configuration
+}
+
+// PlaceLast modifies the configuration so that the firewall rule will be placed in the last available position.
+func (configuration *FirewallRuleConfiguration) PlaceLast() *FirewallRuleConfiguration {
+	configuration.Placement = FirewallRulePlacement{
+		Position: FirewallRulePositionLast,
 	}
 
 	return configuration
@@ -342,7 +379,7 @@ func (configuration *FirewallRuleConfiguration) PlaceFirst() *FirewallRuleConfig
 // PlaceBefore modifies the configuration so that the firewall rule will be placed before the specified rule.
 func (configuration *FirewallRuleConfiguration) PlaceBefore(beforeRuleName string) *FirewallRuleConfiguration {
 	configuration.Placement = FirewallRulePlacement{
-		Position:           "BEFORE",
+		Position:           FirewallRulePositionBefore,
 		RelativeToRuleName: &beforeRuleName,
 	}
 
@@ -352,7 +389,7 @@ func (configuration *FirewallRuleConfiguration) PlaceBefore(beforeRuleName strin
 // PlaceAfter modifies the configuration so that the firewall rule will be placed after the specified rule.
 func (configuration *FirewallRuleConfiguration) PlaceAfter(afterRuleName string) *FirewallRuleConfiguration {
 	configuration.Placement = FirewallRulePlacement{
-		Position:           "AFTER",
+		Position:           FirewallRulePositionAfter,
 		RelativeToRuleName: &afterRuleName,
 	}
 
@@ -536,11 +573,46 @@ type FirewallRulePlacement struct {
 	RelativeToRuleName *string `json:"relativeToRule,omitempty"`
 }
 
+// PlaceFirewallRuleFirst creates a FirewallRulePlacement that places a firewall rule before all other (non-default) firewall rules.
+func PlaceFirewallRuleFirst() FirewallRulePlacement {
+	return FirewallRulePlacement{
+		Position: FirewallRulePositionFirst,
+	}
+}
+
+// PlaceFirewallRuleLast creates a FirewallRulePlacement that places a firewall rule after all other (non-default) firewall rules.
+func PlaceFirewallRuleLast() FirewallRulePlacement {
+	return FirewallRulePlacement{
+		Position: FirewallRulePositionLast,
+	}
+}
+
+// PlaceFirewallRuleBefore creates a FirewallRulePlacement that places a firewall rule immediately before the firewall rule with the specified name.
+func PlaceFirewallRuleBefore(ruleName string) FirewallRulePlacement {
+	return FirewallRulePlacement{
+		Position:           FirewallRulePositionBefore,
+		RelativeToRuleName: &ruleName,
+	}
+}
+
+// PlaceFirewallRuleAfter creates a FirewallRulePlacement that places a firewall rule immediately after the firewall rule with the specified name.
+func PlaceFirewallRuleAfter(ruleName string) FirewallRulePlacement {
+	return FirewallRulePlacement{
+		Position:           FirewallRulePositionAfter,
+		RelativeToRuleName: &ruleName,
+	}
+}
+
 type editFirewallRule struct {
 	ID      string `json:"id"`
 	Enabled bool   `json:"enabled"`
 }
 
+type moveFirewallRule struct {
+	ID        string                `json:"id"`
+	Placement FirewallRulePlacement `json:"placement"`
+}
+
 type deleteFirewallRule struct {
 	ID string `json:"id"`
 }
@@ -597,6 +669,11 @@ func (client *Client) ListFirewallRules(networkDomainID string, paging *Paging)
 		return nil, err
 	}
 
+	err = paging.Validate()
+	if err != nil {
+		return nil, err
+	}
+
 	requestURI := fmt.Sprintf("%s/network/firewallRule?networkDomainId=%s&%s",
 		url.QueryEscape(organizationID),
 		url.QueryEscape(networkDomainID),
@@ -695,6 +772,41 @@ func (client *Client) EditFirewallRule(id string, enabled bool) error {
 	return nil
 }
 
+// MoveFirewallRule changes the position of a firewall rule (relative to the network domain's other firewall rules).
+// This operation is synchronous.
+func (client *Client) MoveFirewallRule(id string, placement FirewallRulePlacement) error {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return err
+	}
+
+	requestURI := fmt.Sprintf("%s/network/editFirewallRule",
+		url.QueryEscape(organizationID),
+	)
+	request, err := client.newRequestV24(requestURI, http.MethodPost, &moveFirewallRule{
+		ID:        id,
+		Placement: placement,
+	})
+	if err != nil {
+		return err
+	}
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return err
+	}
+
+	apiResponse, err := readAPIResponseAsJSON(responseBody, statusCode)
+	if err != nil {
+		return err
+	}
+
+	if apiResponse.ResponseCode != ResponseCodeOK {
+		return apiResponse.ToError("Request to move firewall rule '%s' failed with unexpected status code %d (%s): %s", id, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	return nil
+}
+
 // DeleteFirewallRule deletes the specified FirewallRule rule.
 func (client *Client) DeleteFirewallRule(id string) error {
 	organizationID, err := client.getOrganizationID()
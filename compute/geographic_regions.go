@@ -0,0 +1,139 @@
+package compute
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// GeographicRegion represents a CloudControl geographic region (geo) in which an organisation can deploy.
+type GeographicRegion struct {
+	// The region Id (e.g. "na").
+	ID string `json:"id"`
+
+	// The region name (e.g. "North America").
+	Name string `json:"name"`
+
+	// The host name of the region's CloudControl API end-point (e.g. "api-na.dimensiondata.com").
+	CloudAPIHost string `json:"cloudApiHost"`
+
+	// The URL of the region's CloudControl UI.
+	CloudUIURL string `json:"cloudUiUrl"`
+
+	// The URL of the region's monitoring service.
+	MonitoringURL string `json:"monitoringUrl"`
+
+	// The host name of the region's FTPS server (used for image import / export).
+	FTPSHost string `json:"ftpsHost"`
+
+	// The region's time zone.
+	TimeZone string `json:"timeZone"`
+
+	// The region's current state.
+	State string `json:"state"`
+
+	// Is this the organisation's home geo?
+	IsHome bool `json:"isHome"`
+}
+
+// GetBaseAddress gets the base address of the region's CloudControl API end-point (e.g. "https://api-na.dimensiondata.com").
+//
+// Returns an empty string if the region does not specify an API end-point.
+func (region *GeographicRegion) GetBaseAddress() string {
+	if region.CloudAPIHost == "" {
+		return ""
+	}
+	if strings.Contains(region.CloudAPIHost, "://") {
+		return strings.TrimSuffix(region.CloudAPIHost, "/")
+	}
+
+	return "https://" + region.CloudAPIHost
+}
+
+// GeographicRegions represents a page of GeographicRegion results.
+type GeographicRegions struct {
+	// The current page of geographic regions.
+	Items []GeographicRegion `json:"geographicRegion"`
+
+	PagedResult
+}
+
+// ListGeographicRegions retrieves a list of all geographic regions available to the current organisation.
+func (client *Client) ListGeographicRegions(paging *Paging) (regions *GeographicRegions, err error) {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return nil, err
+	}
+
+	err = paging.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	requestURI := fmt.Sprintf("%s/infrastructure/geographicRegion?%s",
+		url.QueryEscape(organizationID),
+		paging.EnsurePaging().toQueryParameters(),
+	)
+
+	return client.listGeographicRegions(requestURI)
+}
+
+// GetHomeGeo retrieves the current organisation's home geographic region.
+//
+// Returns nil if the organisation's home geo could not be determined.
+func (client *Client) GetHomeGeo() (region *GeographicRegion, err error) {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return nil, err
+	}
+
+	requestURI := fmt.Sprintf("%s/infrastructure/geographicRegion?isHome=true",
+		url.QueryEscape(organizationID),
+	)
+	regions, err := client.listGeographicRegions(requestURI)
+	if err != nil {
+		return nil, err
+	}
+
+	for index := range regions.Items {
+		if regions.Items[index].IsHome {
+			return &regions.Items[index], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// listGeographicRegions retrieves geographic regions using the specified request URI.
+func (client *Client) listGeographicRegions(requestURI string) (regions *GeographicRegions, err error) {
+	request, err := client.newRequestV24(requestURI, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode != http.StatusOK {
+		var apiResponse *APIResponseV2
+
+		apiResponse, err = readAPIResponseAsJSON(responseBody, statusCode)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, apiResponse.ToError("Request failed with status code %d (%s): %s", statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	regions = &GeographicRegions{}
+	err = json.Unmarshal(responseBody, regions)
+	if err != nil {
+		return nil, err
+	}
+
+	return regions, nil
+}
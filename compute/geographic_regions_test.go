@@ -0,0 +1,95 @@
+package compute
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// List geographic regions (successful).
+func TestClient_ListGeographicRegions_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			regions, err := client.ListGeographicRegions(nil)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("Regions.Length", 2, len(regions.Items))
+
+			region := regions.Items[0]
+			expect.EqualsString("Region.ID", "na", region.ID)
+			expect.EqualsString("Region.Name", "North America", region.Name)
+			expect.EqualsString("Region.BaseAddress", "https://api-na.dimensiondata.com", region.GetBaseAddress())
+			expect.IsTrue("Region.IsHome", region.IsHome)
+
+			expect.IsFalse("Regions[1].IsHome", regions.Items[1].IsHome)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.IsTrue("Request.URL", strings.HasSuffix(request.URL.Path, "/infrastructure/geographicRegion"))
+
+			return http.StatusOK, listGeographicRegionsTestResponse
+		},
+	})
+}
+
+// Get home geo (successful).
+func TestClient_GetHomeGeo_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			region, err := client.GetHomeGeo()
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.IsTrue("Region != nil", region != nil)
+			expect.EqualsString("Region.ID", "na", region.ID)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.EqualsString("Query.isHome", "true", request.URL.Query().Get("isHome"))
+
+			return http.StatusOK, listGeographicRegionsTestResponse
+		},
+	})
+}
+
+/*
+ * Test responses.
+ */
+
+const listGeographicRegionsTestResponse = `
+{
+	"geographicRegion": [
+		{
+			"id": "na",
+			"name": "North America",
+			"cloudApiHost": "api-na.dimensiondata.com",
+			"cloudUiUrl": "https://na.mcp-services.net",
+			"monitoringUrl": "https://na-monitoring.mcp-services.net",
+			"ftpsHost": "ftps-na.cloud-vpn.net",
+			"timeZone": "America/New_York",
+			"state": "ENABLED",
+			"isHome": true
+		},
+		{
+			"id": "eu",
+			"name": "Europe",
+			"cloudApiHost": "api-eu.dimensiondata.com",
+			"cloudUiUrl": "https://eu.mcp-services.net",
+			"monitoringUrl": "https://eu-monitoring.mcp-services.net",
+			"ftpsHost": "ftps-eu.cloud-vpn.net",
+			"timeZone": "Europe/London",
+			"state": "ENABLED",
+			"isHome": false
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 2,
+	"totalCount": 2,
+	"pageSize": 250
+}
+`
@@ -7,7 +7,31 @@ import (
 	"net/url"
 )
 
-// HealthMonitor represents a load-balancer persistence (stickiness) profile.
+// The names of the predefined (default) load-balancer health monitors available in every network domain.
+const (
+	// HealthMonitorNameICMP is the name of the default health monitor that checks a node using ICMP echo (ping).
+	HealthMonitorNameICMP = "CCDEFAULT.Icmp"
+
+	// HealthMonitorNameTCP is the name of the default health monitor that checks a node / pool member by opening a TCP connection.
+	HealthMonitorNameTCP = "CCDEFAULT.Tcp"
+
+	// HealthMonitorNameTCPHalfOpen is the name of the default health monitor that checks a pool member using a half-open TCP connection (SYN only).
+	HealthMonitorNameTCPHalfOpen = "CCDEFAULT.TcpHalfOpen"
+
+	// HealthMonitorNameHTTP is the name of the default health monitor that checks a pool member by sending an HTTP request.
+	HealthMonitorNameHTTP = "CCDEFAULT.Http"
+
+	// HealthMonitorNameHTTPS is the name of the default health monitor that checks a pool member by sending an HTTPS request.
+	HealthMonitorNameHTTPS = "CCDEFAULT.Https"
+
+	// HealthMonitorNameUDP is the name of the default health monitor that checks a pool member by sending a UDP datagram.
+	HealthMonitorNameUDP = "CCDEFAULT.Udp"
+)
+
+// MaxVIPPoolHealthMonitors is the maximum number of health monitors that can be assigned to a VIP pool.
+const MaxVIPPoolHealthMonitors = 2
+
+// HealthMonitor represents a load-balancer health monitor.
 type HealthMonitor struct {
 	ID               string `json:"id"`
 	Name             string `json:"name"`
@@ -15,6 +39,26 @@ type HealthMonitor struct {
 	IsPoolCompatible bool   `json:"poolCompatible"`
 }
 
+// GetID retrieves the health monitor's ID.
+func (healthMonitor *HealthMonitor) GetID() string {
+	return healthMonitor.ID
+}
+
+// GetName retrieves the health monitor's name.
+func (healthMonitor *HealthMonitor) GetName() string {
+	return healthMonitor.Name
+}
+
+// ToEntityReference creates an EntityReference representing the HealthMonitor.
+func (healthMonitor *HealthMonitor) ToEntityReference() EntityReference {
+	return EntityReference{
+		ID:   healthMonitor.ID,
+		Name: healthMonitor.Name,
+	}
+}
+
+var _ NamedEntity = &HealthMonitor{}
+
 // HealthMonitors represents a page of HealthMonitor results.
 type HealthMonitors struct {
 	Items []HealthMonitor `json:"defaultHealthMonitor"`
@@ -29,6 +73,11 @@ func (client *Client) ListDefaultHealthMonitors(networkDomainID string, paging *
 		return nil, err
 	}
 
+	err = paging.Validate()
+	if err != nil {
+		return nil, err
+	}
+
 	requestURI := fmt.Sprintf("%s/networkDomainVip/defaultHealthMonitor?networkDomainId=%s&%s",
 		url.QueryEscape(organizationID),
 		url.QueryEscape(networkDomainID),
@@ -63,3 +112,136 @@ func (client *Client) ListDefaultHealthMonitors(networkDomainID string, paging *
 
 	return healthMonitors, nil
 }
+
+// GetDefaultHealthMonitorByName retrieves the default load-balancing health monitor (if any) with the specified name in the specified network domain.
+// Returns nil if no health monitor is found with the specified name.
+func (client *Client) GetDefaultHealthMonitorByName(name string, networkDomainID string) (healthMonitor *HealthMonitor, err error) {
+	err = ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		healthMonitors, err := client.ListDefaultHealthMonitors(networkDomainID, paging)
+		if err != nil {
+			return nil, err
+		}
+
+		for index := range healthMonitors.Items {
+			if healthMonitors.Items[index].Name == name {
+				healthMonitor = &healthMonitors.Items[index]
+
+				return nil, nil // Found it.
+			}
+		}
+
+		return &healthMonitors.PagedResult, nil
+	})
+
+	return
+}
+
+// ListAllDefaultHealthMonitors retrieves all default load-balancing health monitors in the specified network domain.
+func (client *Client) ListAllDefaultHealthMonitors(networkDomainID string) (healthMonitors []HealthMonitor, err error) {
+	err = ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		page, err := client.ListDefaultHealthMonitors(networkDomainID, paging)
+		if err != nil {
+			return nil, err
+		}
+
+		healthMonitors = append(healthMonitors, page.Items...)
+
+		return &page.PagedResult, nil
+	})
+
+	return
+}
+
+// SetVIPNodeHealthMonitor assigns the default health monitor with the specified name (e.g. HealthMonitorNameICMP) to a VIP node.
+//
+// If healthMonitorName is empty, the node's health monitor (if any) is removed.
+func (client *Client) SetVIPNodeHealthMonitor(nodeID string, healthMonitorName string) error {
+	node, err := client.GetVIPNode(nodeID)
+	if err != nil {
+		return err
+	}
+	if node == nil {
+		return fmt.Errorf("No VIP node was found with Id '%s'", nodeID)
+	}
+
+	healthMonitorID := ""
+	if healthMonitorName != "" {
+		healthMonitors, err := client.resolveDefaultHealthMonitors(node.NetworkDomainID, []string{healthMonitorName})
+		if err != nil {
+			return err
+		}
+		if !healthMonitors[0].IsNodeCompatible {
+			return fmt.Errorf("Health monitor '%s' cannot be assigned to a VIP node", healthMonitorName)
+		}
+
+		healthMonitorID = healthMonitors[0].ID
+	}
+
+	return client.EditVIPNode(nodeID, EditVIPNodeConfiguration{
+		HealthMonitorID: &healthMonitorID,
+	})
+}
+
+// SetVIPPoolHealthMonitors assigns the default health monitors with the specified names (e.g. HealthMonitorNameHTTP) to a VIP pool, replacing any existing health monitors.
+//
+// Up to MaxVIPPoolHealthMonitors health monitors can be assigned; if no names are specified, the pool's health monitors are removed.
+func (client *Client) SetVIPPoolHealthMonitors(poolID string, healthMonitorNames ...string) error {
+	if len(healthMonitorNames) > MaxVIPPoolHealthMonitors {
+		return fmt.Errorf("Cannot assign %d health monitors to VIP pool '%s' (the maximum is %d)", len(healthMonitorNames), poolID, MaxVIPPoolHealthMonitors)
+	}
+
+	pool, err := client.GetVIPPool(poolID)
+	if err != nil {
+		return err
+	}
+	if pool == nil {
+		return fmt.Errorf("No VIP pool was found with Id '%s'", poolID)
+	}
+
+	healthMonitorIDs := []string{}
+	if len(healthMonitorNames) > 0 {
+		healthMonitors, err := client.resolveDefaultHealthMonitors(pool.NetworkDomainID, healthMonitorNames)
+		if err != nil {
+			return err
+		}
+
+		for _, healthMonitor := range healthMonitors {
+			if !healthMonitor.IsPoolCompatible {
+				return fmt.Errorf("Health monitor '%s' cannot be assigned to a VIP pool", healthMonitor.Name)
+			}
+
+			healthMonitorIDs = append(healthMonitorIDs, healthMonitor.ID)
+		}
+	}
+
+	return client.EditVIPPool(poolID, EditVIPPoolConfiguration{
+		HealthMonitorIDs: &healthMonitorIDs,
+	})
+}
+
+// resolveDefaultHealthMonitors retrieves the default health monitors with the specified names (in the same order as the names).
+func (client *Client) resolveDefaultHealthMonitors(networkDomainID string, healthMonitorNames []string) ([]HealthMonitor, error) {
+	availableHealthMonitors, err := client.ListAllDefaultHealthMonitors(networkDomainID)
+	if err != nil {
+		return nil, err
+	}
+
+	healthMonitors := make([]HealthMonitor, len(healthMonitorNames))
+	for index, healthMonitorName := range healthMonitorNames {
+		found := false
+		for _, availableHealthMonitor := range availableHealthMonitors {
+			if availableHealthMonitor.Name == healthMonitorName {
+				healthMonitors[index] = availableHealthMonitor
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf("No default health monitor named '%s' was found in network domain '%s'", healthMonitorName, networkDomainID)
+		}
+	}
+
+	return healthMonitors, nil
+}
@@ -0,0 +1,138 @@
+package compute
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// Set VIP pool health monitors (successful).
+func TestClient_SetVIPPoolHealthMonitors_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			err := client.SetVIPPoolHealthMonitors("afb1fdc4-3a7e-4e10-b4e2-b3c0a0b8c1a7", HealthMonitorNameHTTP, HealthMonitorNameTCP)
+			if err != nil {
+				test.Fatal(err)
+			}
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			switch {
+			case strings.HasSuffix(request.URL.Path, "/networkDomainVip/pool/afb1fdc4-3a7e-4e10-b4e2-b3c0a0b8c1a7"):
+				return http.StatusOK, getVIPPoolForHealthMonitorsTestResponse
+
+			case strings.HasSuffix(request.URL.Path, "/networkDomainVip/defaultHealthMonitor"):
+				expect.EqualsString("Query.networkDomainId", "553f26b6-2a73-42c3-a78b-6116f11291d0", request.URL.Query().Get("networkDomainId"))
+
+				return http.StatusOK, listDefaultHealthMonitorsTestResponse
+
+			case strings.HasSuffix(request.URL.Path, "/networkDomainVip/editPool"):
+				requestBody := &EditVIPPoolConfiguration{}
+				err := readRequestBodyAsJSON(request, requestBody)
+				if err != nil {
+					test.Fatal(err)
+				}
+
+				expect.EqualsString("EditVIPPool.ID", "afb1fdc4-3a7e-4e10-b4e2-b3c0a0b8c1a7", requestBody.ID)
+				expect.NotNil("EditVIPPool.HealthMonitorIDs", requestBody.HealthMonitorIDs)
+				expect.EqualsInt("EditVIPPool.HealthMonitorIDs.Length", 2, len(*requestBody.HealthMonitorIDs))
+				expect.EqualsString("EditVIPPool.HealthMonitorIDs[0]", "01683574-d487-11e4-811f-005056806999", (*requestBody.HealthMonitorIDs)[0])
+				expect.EqualsString("EditVIPPool.HealthMonitorIDs[1]", "0168546c-d487-11e4-811f-005056806999", (*requestBody.HealthMonitorIDs)[1])
+
+				return http.StatusOK, editVIPPoolHealthMonitorsTestResponse
+			}
+
+			test.Fatalf("Unexpected request to '%s'.", request.URL.Path)
+
+			return http.StatusNotFound, ""
+		},
+	})
+}
+
+// Set VIP pool health monitors (health monitor is not pool-compatible).
+func TestClient_SetVIPPoolHealthMonitors_Incompatible(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			err := client.SetVIPPoolHealthMonitors("afb1fdc4-3a7e-4e10-b4e2-b3c0a0b8c1a7", HealthMonitorNameICMP)
+			expect.NotNil("Error", err)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			if strings.HasSuffix(request.URL.Path, "/networkDomainVip/defaultHealthMonitor") {
+				return http.StatusOK, listDefaultHealthMonitorsTestResponse
+			}
+
+			return http.StatusOK, getVIPPoolForHealthMonitorsTestResponse
+		},
+	})
+}
+
+// Set VIP pool health monitors (too many health monitors).
+func TestClient_SetVIPPoolHealthMonitors_TooMany(test *testing.T) {
+	client := NewClientWithBaseAddress("https://api.example.com", "user", "password")
+
+	err := client.SetVIPPoolHealthMonitors("afb1fdc4-3a7e-4e10-b4e2-b3c0a0b8c1a7", HealthMonitorNameHTTP, HealthMonitorNameTCP, HealthMonitorNameUDP)
+	if err == nil {
+		test.Fatal("Expected an error when assigning more than MaxVIPPoolHealthMonitors health monitors.")
+	}
+}
+
+/*
+ * Test responses.
+ */
+
+const getVIPPoolForHealthMonitorsTestResponse = `
+{
+	"id": "afb1fdc4-3a7e-4e10-b4e2-b3c0a0b8c1a7",
+	"name": "myProductionPool",
+	"description": "Pool for load balancing production application servers",
+	"loadBalanceMethod": "ROUND_ROBIN",
+	"healthMonitor": [],
+	"serviceDownAction": "RESELECT",
+	"slowRampTime": 10,
+	"state": "NORMAL",
+	"networkDomainId": "553f26b6-2a73-42c3-a78b-6116f11291d0",
+	"datacenterId": "NA9",
+	"createTime": "2015-06-04T09:15:07.000Z"
+}
+`
+
+const listDefaultHealthMonitorsTestResponse = `
+{
+	"defaultHealthMonitor": [
+		{
+			"id": "0168b83a-d487-11e4-811f-005056806999",
+			"name": "CCDEFAULT.Icmp",
+			"nodeCompatible": true,
+			"poolCompatible": false
+		},
+		{
+			"id": "0168546c-d487-11e4-811f-005056806999",
+			"name": "CCDEFAULT.Tcp",
+			"nodeCompatible": true,
+			"poolCompatible": true
+		},
+		{
+			"id": "01683574-d487-11e4-811f-005056806999",
+			"name": "CCDEFAULT.Http",
+			"nodeCompatible": false,
+			"poolCompatible": true
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 3,
+	"totalCount": 3,
+	"pageSize": 250
+}
+`
+
+const editVIPPoolHealthMonitorsTestResponse = `
+{
+	"operation": "EDIT_POOL",
+	"responseCode": "OK",
+	"message": "Pool 'afb1fdc4-3a7e-4e10-b4e2-b3c0a0b8c1a7' has been edited.",
+	"requestId": "na9/2015-06-04T09:15:07.000Z/abcd"
+}
+`
@@ -0,0 +1,43 @@
+package compute
+
+import (
+	"net/http"
+)
+
+// NewClientWithHTTPClient creates a new cloud compute API client that uses the specified HTTP client.
+// region is the cloud compute region identifier.
+//
+// This enables the compute client to be plugged into an existing HTTP stack; for example:
+//
+//	compute.NewClientWithHTTPClient(region, username, password, retryablehttp.NewClient().StandardClient())
+//	compute.NewClientWithHTTPClient(region, username, password, cleanhttp.DefaultPooledClient())
+//
+// If the supplied HTTP client already retries failed requests, leave the compute client's own retry facility disabled (the default).
+func NewClientWithHTTPClient(region string, username string, password string, httpClient *http.Client) *Client {
+	baseAddress := getRegionBaseAddress(region)
+
+	return NewClientWithBaseAddressAndHTTPClient(baseAddress, username, password, httpClient)
+}
+
+// NewClientWithBaseAddressAndHTTPClient creates a new cloud compute API client that uses the specified HTTP client and a custom end-point base address.
+// baseAddress is the base URL of the CloudControl API end-point.
+//
+// If httpClient is nil, a client created by NewDefaultHTTPClient is used.
+func NewClientWithBaseAddressAndHTTPClient(baseAddress string, username string, password string, httpClient *http.Client) *Client {
+	if httpClient == nil {
+		httpClient = NewDefaultHTTPClient()
+	}
+
+	client := NewClientWithBaseAddress(baseAddress, username, password)
+	client.httpClient = httpClient
+
+	return client
+}
+
+// NewDefaultHTTPClient creates a new HTTP client with sensible defaults for use with the CloudControl API.
+//
+// Unlike http.DefaultClient, the client does not share state (such as its connection pool) with other clients, and has timeouts configured.
+// These defaults follow the same conventions as HashiCorp's go-cleanhttp (DefaultPooledClient); see DefaultClientConfiguration.
+func NewDefaultHTTPClient() *http.Client {
+	return DefaultClientConfiguration().NewHTTPClient()
+}
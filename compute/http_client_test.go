@@ -0,0 +1,54 @@
+package compute
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// testRoundTripper counts the requests that pass through it.
+type testRoundTripper struct {
+	requestCount int
+}
+
+func (roundTripper *testRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	roundTripper.requestCount++
+
+	return http.DefaultTransport.RoundTrip(request)
+}
+
+// Client uses a caller-supplied HTTP client.
+func TestClient_WithHTTPClient(test *testing.T) {
+	expect := expect(test)
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.Header().Set("Content-Type", "text/xml")
+		writer.WriteHeader(http.StatusOK)
+
+		fmt.Fprintln(writer, accountTestResponse)
+	}))
+	defer testServer.Close()
+
+	roundTripper := &testRoundTripper{}
+	client := NewClientWithBaseAddressAndHTTPClient(testServer.URL, "user1", "password", &http.Client{
+		Transport: roundTripper,
+	})
+
+	account, err := client.GetAccount()
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	verifyAccountTestResponse(test, account)
+	expect.EqualsInt("RoundTripper.RequestCount", 1, roundTripper.requestCount)
+}
+
+// Client uses default HTTP client if none is supplied.
+func TestClient_WithDefaultHTTPClient(test *testing.T) {
+	expect := expect(test)
+
+	client := NewClientWithBaseAddressAndHTTPClient("https://api-na.example.com", "user1", "password", nil)
+	expect.NotNil("Client.HTTPClient", client.httpClient)
+	expect.NotNil("Client.HTTPClient.Transport", client.httpClient.Transport)
+}
@@ -0,0 +1,142 @@
+package compute
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+)
+
+// ImageImportFromURLConfiguration represents the configuration for importing a customer image from an OVF package (or OVA) that is published at a web URL.
+type ImageImportFromURLConfiguration struct {
+	// The name of the new customer image.
+	ImageName string
+
+	// An optional description for the new customer image.
+	ImageDescription string
+
+	// The Id of the datacenter where the image will be imported.
+	DatacenterID string
+
+	// The Id of the cluster (within the datacenter) where the image will be imported (optional).
+	ClusterID string
+
+	// The HTTP(S) URL of the OVF package's manifest (.mf) file, or of an OVA file.
+	//
+	// If the URL refers to a manifest, the files it lists must be published alongside it.
+	URL string
+
+	// Prevent guest OS customisation when deploying servers from the new image?
+	PreventGuestOSCustomization bool
+}
+
+// Validate determines whether the image import configuration is valid.
+func (configuration *ImageImportFromURLConfiguration) Validate() error {
+	if configuration.ImageName == "" {
+		return fmt.Errorf("Must specify the name of the image to import")
+	}
+	if configuration.DatacenterID == "" {
+		return fmt.Errorf("Must specify the Id of the datacenter where image '%s' will be imported", configuration.ImageName)
+	}
+
+	if configuration.URL == "" {
+		return fmt.Errorf("Must specify the URL from which image '%s' will be imported", configuration.ImageName)
+	}
+	importURL, err := url.Parse(configuration.URL)
+	if err != nil {
+		return fmt.Errorf("Invalid URL '%s' for image '%s': %s", configuration.URL, configuration.ImageName, err.Error())
+	}
+	if importURL.Scheme != "http" && importURL.Scheme != "https" {
+		return fmt.Errorf("Invalid URL '%s' for image '%s' (must be an HTTP or HTTPS URL)", configuration.URL, configuration.ImageName)
+	}
+	if importURL.Host == "" {
+		return fmt.Errorf("Invalid URL '%s' for image '%s' (must be an absolute URL)", configuration.URL, configuration.ImageName)
+	}
+
+	extension := strings.ToLower(path.Ext(importURL.Path))
+	if extension != ".mf" && extension != ".ova" {
+		return fmt.Errorf("Invalid URL '%s' for image '%s' (must refer to an OVF manifest (.mf) or OVA (.ova) file)", configuration.URL, configuration.ImageName)
+	}
+
+	return nil
+}
+
+// Request body when importing a customer image from a web URL.
+type importCustomerImageFromURL struct {
+	URL                  string `json:"url"`
+	ImageName            string `json:"name"`
+	ImageDescription     string `json:"description,omitempty"`
+	DatacenterID         string `json:"datacenterId"`
+	ClusterID            string `json:"clusterId,omitempty"`
+	GuestOSCustomization bool   `json:"guestOsCustomization"`
+}
+
+// ImportCustomerImageFromURL imports a customer image from an OVF package (or OVA) published at a web URL (requires CloudControl v2.9 or higher).
+//
+// Unlike ImportCustomerImage, the package does not need to be uploaded via FTPS first.
+//
+// The image's status will be ResourceStatusPendingAdd while the import is in progress (see GetImageProgress and WaitForCustomerImageImport), then ResourceStatusNormal once the import is complete.
+func (client *Client) ImportCustomerImageFromURL(configuration *ImageImportFromURLConfiguration) (imageID string, err error) {
+	if configuration == nil {
+		return "", fmt.Errorf("Must specify the image import configuration")
+	}
+	err = configuration.Validate()
+	if err != nil {
+		return "", err
+	}
+
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return "", err
+	}
+
+	requestURI := fmt.Sprintf("%s/image/importImageFromUrl",
+		url.QueryEscape(organizationID),
+	)
+	request, err := client.newRequestV29(requestURI, http.MethodPost, &importCustomerImageFromURL{
+		URL:                  configuration.URL,
+		ImageName:            configuration.ImageName,
+		ImageDescription:     configuration.ImageDescription,
+		DatacenterID:         configuration.DatacenterID,
+		ClusterID:            configuration.ClusterID,
+		GuestOSCustomization: !configuration.PreventGuestOSCustomization,
+	})
+	if err != nil {
+		return "", err
+	}
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return "", err
+	}
+
+	apiResponse, err := readAPIResponseAsJSON(responseBody, statusCode)
+	if err != nil {
+		return "", err
+	}
+
+	if apiResponse.ResponseCode != ResponseCodeInProgress {
+		return "", apiResponse.ToError("Request to import customer image '%s' in datacenter '%s' from '%s' failed with status code %d (%s): %s",
+			configuration.ImageName,
+			configuration.DatacenterID,
+			configuration.URL,
+			statusCode,
+			apiResponse.ResponseCode,
+			apiResponse.Message,
+		)
+	}
+
+	// Expected: "info" { "name": "imageId", "value": "the-Id-of-new-customer-image" }
+	imageIDMessage := apiResponse.GetFieldMessage("imageId")
+	if imageIDMessage == nil {
+		return "", apiResponse.ToError("Received an unexpected response (missing 'imageId') with status code %d (%s): %s", statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	return *imageIDMessage, nil
+}
+
+// WaitForCustomerImageImport waits for a customer image's pending import operation to complete.
+func (client *Client) WaitForCustomerImageImport(customerImageID string, timeout time.Duration) (resource Resource, err error) {
+	return client.waitForPendingOperation(ResourceTypeCustomerImage, customerImageID, "Import", ResourceStatusPendingAdd, false, timeout)
+}
@@ -0,0 +1,85 @@
+package compute
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// Import customer image from URL (successful).
+func TestClient_ImportCustomerImageFromURL_Success(test *testing.T) {
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			imageID, err := client.ImportCustomerImageFromURL(&ImageImportFromURLConfiguration{
+				ImageName:    "Golden.Image.1",
+				DatacenterID: "AU9",
+				URL:          "https://images.example.com/golden-image-1/golden-image-1.mf",
+			})
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect(test).EqualsString("ImageID", "e2c9d5a1-7b3f-4c8e-9a6d-2f1b0c3e4d5a", imageID)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect := expect(test)
+
+			expect.IsTrue("Request.URL", strings.HasSuffix(request.URL.Path, "/image/importImageFromUrl"))
+
+			requestBody := &importCustomerImageFromURL{}
+			err := readRequestBodyAsJSON(request, requestBody)
+			if err != nil {
+				test.Fatal(err)
+			}
+			expect.EqualsString("ImportImage.URL", "https://images.example.com/golden-image-1/golden-image-1.mf", requestBody.URL)
+			expect.EqualsString("ImportImage.ImageName", "Golden.Image.1", requestBody.ImageName)
+			expect.EqualsString("ImportImage.DatacenterID", "AU9", requestBody.DatacenterID)
+			expect.IsTrue("ImportImage.GuestOSCustomization", requestBody.GuestOSCustomization)
+
+			return http.StatusOK, importCustomerImageFromURLTestResponse
+		},
+	})
+}
+
+// Validate image import configuration (invalid URLs).
+func TestImageImportFromURLConfiguration_Validate_InvalidURL(test *testing.T) {
+	expect := expect(test)
+
+	configuration := &ImageImportFromURLConfiguration{
+		ImageName:    "Golden.Image.1",
+		DatacenterID: "AU9",
+	}
+
+	configuration.URL = "ftp://images.example.com/golden-image-1.mf"
+	expect.NotNil("Error (FTP URL)", configuration.Validate())
+
+	configuration.URL = "/golden-image-1.mf"
+	expect.NotNil("Error (relative URL)", configuration.Validate())
+
+	configuration.URL = "https://images.example.com/golden-image-1.vmdk"
+	expect.NotNil("Error (not a manifest)", configuration.Validate())
+
+	configuration.URL = "https://images.example.com/golden-image-1.OVA"
+	expect.IsTrue("OVA URL is valid", configuration.Validate() == nil)
+}
+
+/*
+ * Test responses.
+ */
+
+const importCustomerImageFromURLTestResponse = `
+{
+	"operation": "IMPORT_IMAGE_FROM_URL",
+	"responseCode": "IN_PROGRESS",
+	"message": "Request to import Customer Image 'Golden.Image.1' has been accepted and is being processed.",
+	"info": [
+		{
+			"name": "imageId",
+			"value": "e2c9d5a1-7b3f-4c8e-9a6d-2f1b0c3e4d5a"
+		}
+	],
+	"warning": [],
+	"error": [],
+	"requestId": "au9_20161001T000000.000-0000_1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+}
+`
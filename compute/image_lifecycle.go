@@ -0,0 +1,255 @@
+package compute
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// ImageLifecycleRetentionTagName is the default name of the tag that specifies how long a customer image should be retained (e.g. "retain=30d").
+	ImageLifecycleRetentionTagName = "retain"
+
+	// ImageLifecycleExportTagName is the default name of the tag that specifies the OVF package prefix to which a customer image should be exported before it is deleted (e.g. "exportBeforeDelete=my-image").
+	ImageLifecycleExportTagName = "exportBeforeDelete"
+
+	// DefaultImageLifecycleExportTimeout is the default maximum amount of time to wait for each image export to complete.
+	DefaultImageLifecycleExportTimeout = 2 * time.Hour
+)
+
+// ImageLifecycleAction represents an action taken (or, in dry-run mode, that would be taken) for a customer image by an ImageLifecyclePolicy.
+type ImageLifecycleAction string
+
+const (
+	// ImageLifecycleActionRetain indicates that the image is retained (it has no retention tag, or has not yet reached the end of its retention period).
+	ImageLifecycleActionRetain ImageLifecycleAction = "RETAIN"
+
+	// ImageLifecycleActionDelete indicates that the image is deleted.
+	ImageLifecycleActionDelete ImageLifecycleAction = "DELETE"
+
+	// ImageLifecycleActionExportAndDelete indicates that the image is exported to an OVF package and then deleted.
+	ImageLifecycleActionExportAndDelete ImageLifecycleAction = "EXPORT_AND_DELETE"
+)
+
+// ImageLifecyclePolicy represents a tag-driven lifecycle policy for the customer images in a datacenter.
+//
+// Images tagged with a retention period (e.g. "retain=30d") are deleted once they are older than that period.
+// If they are also tagged with an OVF package prefix (e.g. "exportBeforeDelete=my-image"), they are exported before being deleted.
+type ImageLifecyclePolicy struct {
+	// The Id of the datacenter whose customer images the policy applies to.
+	DatacenterID string
+
+	// The name of the tag that specifies the image retention period (if empty, ImageLifecycleRetentionTagName is used).
+	RetentionTagName string
+
+	// The name of the tag that specifies the OVF package prefix for export before deletion (if empty, ImageLifecycleExportTagName is used).
+	ExportTagName string
+
+	// The maximum amount of time to wait for each image export to complete (if zero, DefaultImageLifecycleExportTimeout is used).
+	ExportTimeout time.Duration
+
+	// The time against which image ages are calculated (if zero, the current time is used).
+	ReferenceTime time.Time
+
+	// If true, report the actions that would be taken without actually taking them.
+	DryRun bool
+}
+
+// Validate determines whether the ImageLifecyclePolicy is valid.
+func (policy *ImageLifecyclePolicy) Validate() error {
+	if policy.DatacenterID == "" {
+		return fmt.Errorf("Must specify the Id of the datacenter whose customer images the policy applies to")
+	}
+	if policy.ExportTimeout < 0 {
+		return fmt.Errorf("Invalid export timeout %s (must not be negative)", policy.ExportTimeout)
+	}
+
+	return nil
+}
+
+// ImageLifecycleResult represents the outcome of applying an ImageLifecyclePolicy to a single customer image.
+type ImageLifecycleResult struct {
+	ImageID          string
+	ImageName        string
+	Age              time.Duration
+	RetentionPeriod  time.Duration
+	OVFPackagePrefix string
+	ExportID         string
+	Action           ImageLifecycleAction
+}
+
+// ImageLifecycleReport represents the outcome of applying an ImageLifecyclePolicy.
+type ImageLifecycleReport struct {
+	// Were actions only reported (and not actually taken)?
+	DryRun bool
+
+	// The results for each customer image that the policy was applied to.
+	Results []ImageLifecycleResult
+}
+
+// ApplyImageLifecyclePolicy applies the specified lifecycle policy to the customer images in the policy's datacenter.
+//
+// An image is only deleted after being exported if the export history confirms that its export has completed;
+// if the export fails (or cannot be confirmed), the image is retained and an error is returned.
+//
+// If an error is encountered, the report includes the results for the images processed up to that point.
+func (client *Client) ApplyImageLifecyclePolicy(policy ImageLifecyclePolicy) (report *ImageLifecycleReport, err error) {
+	err = policy.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	retentionTagName := policy.RetentionTagName
+	if retentionTagName == "" {
+		retentionTagName = ImageLifecycleRetentionTagName
+	}
+	exportTagName := policy.ExportTagName
+	if exportTagName == "" {
+		exportTagName = ImageLifecycleExportTagName
+	}
+	exportTimeout := policy.ExportTimeout
+	if exportTimeout == 0 {
+		exportTimeout = DefaultImageLifecycleExportTimeout
+	}
+	referenceTime := policy.ReferenceTime
+	if referenceTime.IsZero() {
+		referenceTime = time.Now()
+	}
+
+	// Evaluate the policy for all images before taking any action (deleting images while paging through them would shift subsequent pages).
+	var results []ImageLifecycleResult
+	err = ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		images, err := client.ListCustomerImagesInDatacenter(policy.DatacenterID, paging)
+		if err != nil {
+			return nil, err
+		}
+
+		for index := range images.Images {
+			result, err := client.evaluateImageLifecyclePolicy(&images.Images[index], retentionTagName, exportTagName, referenceTime)
+			if err != nil {
+				return nil, err
+			}
+
+			results = append(results, *result)
+		}
+
+		return &images.PagedResult, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report = &ImageLifecycleReport{
+		DryRun: policy.DryRun,
+	}
+	for _, result := range results {
+		if !policy.DryRun {
+			err = client.applyImageLifecycleAction(&result, exportTimeout)
+			if err != nil {
+				return report, err
+			}
+		}
+
+		report.Results = append(report.Results, result)
+	}
+
+	return report, nil
+}
+
+// evaluateImageLifecyclePolicy determines the lifecycle action for the specified customer image.
+func (client *Client) evaluateImageLifecyclePolicy(image *CustomerImage, retentionTagName string, exportTagName string, referenceTime time.Time) (*ImageLifecycleResult, error) {
+	result := &ImageLifecycleResult{
+		ImageID:   image.ID,
+		ImageName: image.Name,
+		Action:    ImageLifecycleActionRetain,
+	}
+
+	if image.CreateTime.IsZero() {
+		return nil, fmt.Errorf("Customer image '%s' has invalid create time '%s'", image.ID, image.CreateTime.Raw)
+	}
+	result.Age = referenceTime.Sub(image.CreateTime.Time)
+
+	tags, err := client.getAllAssetTags(image.ID, AssetTypeCustomerImage)
+	if err != nil {
+		return nil, err
+	}
+
+	var retentionTag, exportTag *Tag
+	for index := range tags {
+		switch tags[index].Name {
+		case retentionTagName:
+			retentionTag = &tags[index]
+		case exportTagName:
+			exportTag = &tags[index]
+		}
+	}
+
+	if retentionTag == nil {
+		return result, nil
+	}
+
+	result.RetentionPeriod, err = parseRetentionPeriod(retentionTag.Value)
+	if err != nil {
+		return nil, fmt.Errorf("Customer image '%s' has invalid '%s' tag: %s", image.ID, retentionTagName, err)
+	}
+	if result.Age < result.RetentionPeriod {
+		return result, nil
+	}
+
+	if exportTag != nil && exportTag.Value != "" {
+		result.Action = ImageLifecycleActionExportAndDelete
+		result.OVFPackagePrefix = exportTag.Value
+	} else {
+		result.Action = ImageLifecycleActionDelete
+	}
+
+	return result, nil
+}
+
+// applyImageLifecycleAction takes the lifecycle action (if any) described by the specified result.
+func (client *Client) applyImageLifecycleAction(result *ImageLifecycleResult, exportTimeout time.Duration) (err error) {
+	switch result.Action {
+	case ImageLifecycleActionExportAndDelete:
+		result.ExportID, err = client.ExportCustomerImage(result.ImageID, result.OVFPackagePrefix)
+		if err != nil {
+			return err
+		}
+
+		// Only delete the image once the export history confirms that the export has completed.
+		_, err = client.WaitForCustomerImageExport(result.ImageID, result.ExportID, exportTimeout, true)
+		if err != nil {
+			return err
+		}
+
+		return client.DeleteCustomerImage(result.ImageID)
+	case ImageLifecycleActionDelete:
+		return client.DeleteCustomerImage(result.ImageID)
+	default:
+		return nil
+	}
+}
+
+// parseRetentionPeriod parses a retention period such as "30d", "2w", or "12h".
+//
+// Days ("d") and weeks ("w") are supported in addition to the units supported by time.ParseDuration.
+func parseRetentionPeriod(value string) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+
+	var unit time.Duration
+	switch {
+	case strings.HasSuffix(value, "d"):
+		unit = 24 * time.Hour
+	case strings.HasSuffix(value, "w"):
+		unit = 7 * 24 * time.Hour
+	default:
+		return time.ParseDuration(value)
+	}
+
+	count, err := strconv.Atoi(value[:len(value)-1])
+	if err != nil || count < 0 {
+		return 0, fmt.Errorf("Invalid retention period '%s'", value)
+	}
+
+	return time.Duration(count) * unit, nil
+}
@@ -0,0 +1,347 @@
+package compute
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Apply image lifecycle policy (dry run).
+func TestClient_ApplyImageLifecyclePolicy_DryRun(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			report, err := client.ApplyImageLifecyclePolicy(ImageLifecyclePolicy{
+				DatacenterID:  "AU9",
+				ReferenceTime: imageLifecycleTestReferenceTime,
+				DryRun:        true,
+			})
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			verifyApplyImageLifecyclePolicyTestReport(test, report)
+			expect.IsTrue("Report.DryRun", report.DryRun)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			if request.Method != http.MethodGet {
+				test.Fatalf("Unexpected '%s' request to '%s' in dry-run mode.", request.Method, request.URL.Path)
+			}
+
+			return respondImageLifecyclePolicyTestRequest(test, request)
+		},
+	})
+}
+
+// Apply image lifecycle policy (deletes expired images).
+func TestClient_ApplyImageLifecyclePolicy_Delete(test *testing.T) {
+	expect := expect(test)
+
+	var deletedImageIDs []string
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			report, err := client.ApplyImageLifecyclePolicy(ImageLifecyclePolicy{
+				DatacenterID:  "AU9",
+				ReferenceTime: imageLifecycleTestReferenceTime,
+			})
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			verifyApplyImageLifecyclePolicyTestReport(test, report)
+			expect.IsFalse("Report.DryRun", report.DryRun)
+
+			expect.EqualsInt("DeletedImageIDs.Length", 1, len(deletedImageIDs))
+			expect.EqualsString("DeletedImageIDs[0]", "2fa4c8b1-5a62-4ae6-a7b5-3f8d2b1c9e01", deletedImageIDs[0])
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			if strings.HasSuffix(request.URL.Path, "/image/deleteImage") {
+				requestBody := &deleteCustomerImage{}
+				err := readRequestBodyAsJSON(request, requestBody)
+				if err != nil {
+					test.Fatal(err)
+				}
+				deletedImageIDs = append(deletedImageIDs, requestBody.ID)
+
+				return http.StatusOK, deleteCustomerImageTestResponse
+			}
+
+			return respondImageLifecyclePolicyTestRequest(test, request)
+		},
+	})
+}
+
+// Apply image lifecycle policy (export fails, so the image is retained).
+func TestClient_ApplyImageLifecyclePolicy_ExportFailed(test *testing.T) {
+	testApplyImageLifecyclePolicyExportNotConfirmed(test, strings.Replace(getCustomerImageExportTestResponse, ImageExportStateCompleted, ImageExportStateFailed, 1), func(err error) {
+		expect(test).IsTrue("IsImageExportFailedError", IsImageExportFailedError(err))
+	})
+}
+
+// Apply image lifecycle policy (export never appears in the export history, so the image is retained).
+func TestClient_ApplyImageLifecyclePolicy_ExportNotListed(test *testing.T) {
+	testApplyImageLifecyclePolicyExportNotConfirmed(test, emptyImageExportHistoryTestResponse, func(err error) {
+		expect(test).NotNil("Error", err)
+	})
+}
+
+// Apply image lifecycle policy (negative export timeout).
+func TestClient_ApplyImageLifecyclePolicy_InvalidExportTimeout(test *testing.T) {
+	client := NewClientWithBaseAddress("https://api.example.com", "user1", "password")
+
+	_, err := client.ApplyImageLifecyclePolicy(ImageLifecyclePolicy{
+		DatacenterID:  "AU9",
+		ExportTimeout: -time.Minute,
+	})
+	expect(test).NotNil("Error", err)
+}
+
+// Parse image retention periods.
+func TestParseRetentionPeriod(test *testing.T) {
+	expect := expect(test)
+
+	retentionPeriod, err := parseRetentionPeriod("30d")
+	if err != nil {
+		test.Fatal(err)
+	}
+	expect.IsTrue("RetentionPeriod(30d)", retentionPeriod == 30*24*time.Hour)
+
+	retentionPeriod, err = parseRetentionPeriod("2w")
+	if err != nil {
+		test.Fatal(err)
+	}
+	expect.IsTrue("RetentionPeriod(2w)", retentionPeriod == 14*24*time.Hour)
+
+	retentionPeriod, err = parseRetentionPeriod("12h")
+	if err != nil {
+		test.Fatal(err)
+	}
+	expect.IsTrue("RetentionPeriod(12h)", retentionPeriod == 12*time.Hour)
+
+	_, err = parseRetentionPeriod("forever")
+	expect.NotNil("Error(forever)", err)
+}
+
+func testApplyImageLifecyclePolicyExportNotConfirmed(test *testing.T, exportHistoryResponse string, verifyError func(err error)) {
+	defer testWithResourceStatusPollInterval(time.Millisecond)()
+
+	expect := expect(test)
+
+	exported := false
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			report, err := client.ApplyImageLifecyclePolicy(ImageLifecyclePolicy{
+				DatacenterID:  "AU9",
+				ExportTimeout: time.Second,
+				ReferenceTime: imageLifecycleTestReferenceTime,
+			})
+			verifyError(err)
+
+			expect.IsTrue("Exported", exported)
+			expect.NotNil("Report", report)
+			expect.EqualsInt("Report.Results.Length", 0, len(report.Results))
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			switch {
+			case strings.HasSuffix(request.URL.Path, "/image/deleteImage"):
+				test.Fatalf("Customer image was deleted without a confirmed export.")
+			case strings.HasSuffix(request.URL.Path, "/image/exportImage"):
+				exported = true
+
+				return http.StatusOK, exportCustomerImageForLifecycleTestResponse
+			case strings.HasSuffix(request.URL.Path, "/image/exportHistory"):
+				return http.StatusOK, exportHistoryResponse
+			case strings.HasSuffix(request.URL.Path, "/image/customerImage/2fa4c8b1-5a62-4ae6-a7b5-3f8d2b1c9e01"):
+				return http.StatusOK, getCustomerImageV24TestResponse
+			case strings.HasSuffix(request.URL.Path, "/tag/tag") && request.URL.Query().Get("assetId") == "2fa4c8b1-5a62-4ae6-a7b5-3f8d2b1c9e01":
+				return http.StatusOK, expiredImageWithExportTagsTestResponse
+			}
+
+			return respondImageLifecyclePolicyTestRequest(test, request)
+		},
+	})
+}
+
+var imageLifecycleTestReferenceTime = time.Date(2016, 10, 1, 0, 0, 0, 0, time.UTC)
+
+func respondImageLifecyclePolicyTestRequest(test *testing.T, request *http.Request) (int, string) {
+	if strings.HasSuffix(request.URL.Path, "/image/customerImage") {
+		return http.StatusOK, listCustomerImagesForLifecycleTestResponse
+	}
+
+	if strings.HasSuffix(request.URL.Path, "/tag/tag") {
+		switch request.URL.Query().Get("assetId") {
+		case "2fa4c8b1-5a62-4ae6-a7b5-3f8d2b1c9e01":
+			return http.StatusOK, expiredImageTagsTestResponse
+		case "8b1d7c3e-9f2a-4c5d-b6e7-1a2b3c4d5e6f":
+			return http.StatusOK, unexpiredImageTagsTestResponse
+		default:
+			return http.StatusOK, noImageTagsTestResponse
+		}
+	}
+
+	test.Fatalf("Unexpected request to '%s'.", request.URL.Path)
+
+	return http.StatusNotFound, ""
+}
+
+func verifyApplyImageLifecyclePolicyTestReport(test *testing.T, report *ImageLifecycleReport) {
+	expect := expect(test)
+
+	expect.NotNil("Report", report)
+	expect.EqualsInt("Report.Results.Length", 3, len(report.Results))
+
+	expired := report.Results[0]
+	expect.EqualsString("Results[0].ImageID", "2fa4c8b1-5a62-4ae6-a7b5-3f8d2b1c9e01", expired.ImageID)
+	expect.EqualsString("Results[0].Action", string(ImageLifecycleActionDelete), string(expired.Action))
+
+	unexpired := report.Results[1]
+	expect.EqualsString("Results[1].ImageID", "8b1d7c3e-9f2a-4c5d-b6e7-1a2b3c4d5e6f", unexpired.ImageID)
+	expect.EqualsString("Results[1].Action", string(ImageLifecycleActionRetain), string(unexpired.Action))
+
+	untagged := report.Results[2]
+	expect.EqualsString("Results[2].ImageID", "c4e5f6a7-b8c9-4d0e-9f1a-2b3c4d5e6f70", untagged.ImageID)
+	expect.EqualsString("Results[2].Action", string(ImageLifecycleActionRetain), string(untagged.Action))
+}
+
+/*
+ * Test responses.
+ */
+
+const listCustomerImagesForLifecycleTestResponse = `
+{
+	"customerImage": [
+		{
+			"id": "2fa4c8b1-5a62-4ae6-a7b5-3f8d2b1c9e01",
+			"name": "Nightly.Build.1",
+			"datacenterId": "AU9",
+			"createTime": "2016-08-01T00:00:00.000Z",
+			"state": "NORMAL"
+		},
+		{
+			"id": "8b1d7c3e-9f2a-4c5d-b6e7-1a2b3c4d5e6f",
+			"name": "Nightly.Build.2",
+			"datacenterId": "AU9",
+			"createTime": "2016-09-25T00:00:00.000Z",
+			"state": "NORMAL"
+		},
+		{
+			"id": "c4e5f6a7-b8c9-4d0e-9f1a-2b3c4d5e6f70",
+			"name": "Golden.Image",
+			"datacenterId": "AU9",
+			"createTime": "2015-01-01T00:00:00.000Z",
+			"state": "NORMAL"
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 3,
+	"totalCount": 3,
+	"pageSize": 250
+}
+`
+
+const expiredImageTagsTestResponse = `
+{
+	"tag": [
+		{
+			"assetType": "CUSTOMER_IMAGE",
+			"assetId": "2fa4c8b1-5a62-4ae6-a7b5-3f8d2b1c9e01",
+			"assetName": "Nightly.Build.1",
+			"datacenterId": "AU9",
+			"tagKeyId": "d8f1e2c3-b4a5-4968-8776-655443322110",
+			"tagKeyName": "retain",
+			"value": "30d",
+			"valueRequired": true,
+			"displayOnReport": false
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 1,
+	"totalCount": 1,
+	"pageSize": 250
+}
+`
+
+const unexpiredImageTagsTestResponse = `
+{
+	"tag": [
+		{
+			"assetType": "CUSTOMER_IMAGE",
+			"assetId": "8b1d7c3e-9f2a-4c5d-b6e7-1a2b3c4d5e6f",
+			"assetName": "Nightly.Build.2",
+			"datacenterId": "AU9",
+			"tagKeyId": "d8f1e2c3-b4a5-4968-8776-655443322110",
+			"tagKeyName": "retain",
+			"value": "30d",
+			"valueRequired": true,
+			"displayOnReport": false
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 1,
+	"totalCount": 1,
+	"pageSize": 250
+}
+`
+
+const noImageTagsTestResponse = `
+{
+	"tag": [],
+	"pageNumber": 1,
+	"pageCount": 0,
+	"totalCount": 0,
+	"pageSize": 250
+}
+`
+
+const expiredImageWithExportTagsTestResponse = `
+{
+	"tag": [
+		{
+			"assetType": "CUSTOMER_IMAGE",
+			"assetId": "2fa4c8b1-5a62-4ae6-a7b5-3f8d2b1c9e01",
+			"assetName": "Nightly.Build.1",
+			"datacenterId": "AU9",
+			"tagKeyId": "d8f1e2c3-b4a5-4968-8776-655443322110",
+			"tagKeyName": "retain",
+			"value": "30d",
+			"valueRequired": true,
+			"displayOnReport": false
+		},
+		{
+			"assetType": "CUSTOMER_IMAGE",
+			"assetId": "2fa4c8b1-5a62-4ae6-a7b5-3f8d2b1c9e01",
+			"assetName": "Nightly.Build.1",
+			"datacenterId": "AU9",
+			"tagKeyId": "e9a2f3d4-c5b6-4a79-9887-766554433221",
+			"tagKeyName": "exportBeforeDelete",
+			"value": "nightly-build-1",
+			"valueRequired": true,
+			"displayOnReport": false
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 2,
+	"totalCount": 2,
+	"pageSize": 250
+}
+`
+
+const exportCustomerImageForLifecycleTestResponse = `
+{
+	"operation": "EXPORT_IMAGE",
+	"responseCode": "IN_PROGRESS",
+	"message": "Request to export Image 'Nightly.Build.1' has been accepted and is being processed.",
+	"info": [
+		{
+			"name": "imageExportId",
+			"value": "b2b2a0cf-6a2d-4ba5-8d26-e4eaed6f1f2a"
+		}
+	],
+	"warning": [],
+	"error": [],
+	"requestId": "au9_20161001T000000.000-0400_6b7c8d9e-0f1a-4b2c-9d3e-4f5a6b7c8d9e"
+}
+`
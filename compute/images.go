@@ -1,5 +1,10 @@
 package compute
 
+import (
+	"fmt"
+	"math"
+)
+
 // ImageType represents a type of Image.
 type ImageType int
 
@@ -42,3 +47,260 @@ type Image interface {
 	// ApplyTo applies the Image to the specified ServerDeploymentConfiguration.
 	ApplyTo(config *ServerDeploymentConfiguration)
 }
+
+// FindImage finds an OS or customer image by Id or name.
+//
+// OS images are searched first, then customer images. If nameOrID is an Id, datacenterID is only used to verify the image's location.
+// Returns nil if no matching image is found.
+func (client *Client) FindImage(nameOrID string, datacenterID string) (image Image, err error) {
+	if isUUID(nameOrID) {
+		image, err = client.getImageByID(nameOrID)
+		if err != nil || image == nil {
+			return nil, err
+		}
+
+		if datacenterID != "" && image.GetDatacenterID() != datacenterID {
+			return nil, nil
+		}
+
+		return image, nil
+	}
+
+	osImage, err := client.FindOSImage(nameOrID, datacenterID)
+	if err != nil {
+		return nil, err
+	}
+	if osImage != nil {
+		return osImage, nil
+	}
+
+	customerImage, err := client.FindCustomerImage(nameOrID, datacenterID)
+	if err != nil {
+		return nil, err
+	}
+	if customerImage != nil {
+		return customerImage, nil
+	}
+
+	return nil, nil
+}
+
+// getImageByID retrieves an OS or customer image by Id.
+func (client *Client) getImageByID(id string) (image Image, err error) {
+	osImage, err := client.GetOSImage(id)
+	if err != nil {
+		return nil, err
+	}
+	if osImage != nil {
+		return osImage, nil
+	}
+
+	customerImage, err := client.GetCustomerImage(id)
+	if err != nil {
+		return nil, err
+	}
+	if customerImage != nil {
+		return customerImage, nil
+	}
+
+	return nil, nil
+}
+
+// ImageDiskOverride modifies the disks that an Image applies to a ServerDeploymentConfiguration (see ApplyImageWithOverrides).
+type ImageDiskOverride func(disks []VirtualMachineDisk) ([]VirtualMachineDisk, error)
+
+// ApplyImageWithOverrides applies the Image to the specified ServerDeploymentConfiguration (as for Image.ApplyTo), then applies the specified disk overrides (in order).
+//
+// The image's CPU, memory, and OS defaults are still inherited. Disks cannot be made smaller than the corresponding image disk
+// (disks are matched by SCSI controller bus number and SCSI unit Id). If an error is returned, config is left unchanged.
+func ApplyImageWithOverrides(image Image, config *ServerDeploymentConfiguration, overrides ...ImageDiskOverride) error {
+	result := *config
+	image.ApplyTo(&result)
+
+	imageDisks := make(map[imageDiskKey]VirtualMachineDisk, len(result.Disks))
+	for _, disk := range result.Disks {
+		imageDisks[newImageDiskKey(disk)] = disk
+	}
+
+	disks := make([]VirtualMachineDisk, len(result.Disks))
+	copy(disks, result.Disks)
+	for _, override := range overrides {
+		var err error
+		disks, err = override(disks)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, disk := range disks {
+		imageDisk, ok := imageDisks[newImageDiskKey(disk)]
+		if !ok {
+			return fmt.Errorf("Image '%s' has no disk with SCSI unit Id %d on SCSI bus %d", image.GetName(), disk.SCSIUnitID, disk.SCSIBusNumber)
+		}
+		if disk.SizeGB < imageDisk.SizeGB {
+			return fmt.Errorf("Disk with SCSI unit Id %d on SCSI bus %d cannot be smaller than the corresponding disk in image '%s' (%dGB)", disk.SCSIUnitID, disk.SCSIBusNumber, image.GetName(), imageDisk.SizeGB)
+		}
+	}
+	result.Disks = disks
+
+	*config = result
+
+	return nil
+}
+
+// OverrideDiskSpeed creates an ImageDiskOverride that changes the speed of the disk with the specified SCSI unit Id.
+//
+// Fails if disks on more than one SCSI controller have that unit Id (use OverrideControllerDiskSpeed instead).
+func OverrideDiskSpeed(scsiUnitID int, speed string) ImageDiskOverride {
+	return func(disks []VirtualMachineDisk) ([]VirtualMachineDisk, error) {
+		index, err := findDiskBySCSIUnitID(disks, scsiUnitID)
+		if err != nil {
+			return nil, fmt.Errorf("Cannot change speed of disk: %s", err.Error())
+		}
+		disks[index].Speed = speed
+
+		return disks, nil
+	}
+}
+
+// OverrideControllerDiskSpeed creates an ImageDiskOverride that changes the speed of the disk with the specified SCSI unit Id on the SCSI controller with the specified bus number.
+func OverrideControllerDiskSpeed(scsiBusNumber int, scsiUnitID int, speed string) ImageDiskOverride {
+	return func(disks []VirtualMachineDisk) ([]VirtualMachineDisk, error) {
+		index, err := findDiskBySCSIBusAndUnitID(disks, scsiBusNumber, scsiUnitID)
+		if err != nil {
+			return nil, fmt.Errorf("Cannot change speed of disk: %s", err.Error())
+		}
+		disks[index].Speed = speed
+
+		return disks, nil
+	}
+}
+
+// OverrideAllDiskSpeeds creates an ImageDiskOverride that changes the speed of all disks.
+func OverrideAllDiskSpeeds(speed string) ImageDiskOverride {
+	return func(disks []VirtualMachineDisk) ([]VirtualMachineDisk, error) {
+		for index := range disks {
+			disks[index].Speed = speed
+		}
+
+		return disks, nil
+	}
+}
+
+// OverrideDiskSize creates an ImageDiskOverride that changes the size of the disk with the specified SCSI unit Id.
+//
+// Fails if disks on more than one SCSI controller have that unit Id (use OverrideControllerDiskSize instead).
+func OverrideDiskSize(scsiUnitID int, sizeGB int) ImageDiskOverride {
+	return func(disks []VirtualMachineDisk) ([]VirtualMachineDisk, error) {
+		index, err := findDiskBySCSIUnitID(disks, scsiUnitID)
+		if err != nil {
+			return nil, fmt.Errorf("Cannot change size of disk: %s", err.Error())
+		}
+		disks[index].SizeGB = sizeGB
+
+		return disks, nil
+	}
+}
+
+// OverrideControllerDiskSize creates an ImageDiskOverride that changes the size of the disk with the specified SCSI unit Id on the SCSI controller with the specified bus number.
+func OverrideControllerDiskSize(scsiBusNumber int, scsiUnitID int, sizeGB int) ImageDiskOverride {
+	return func(disks []VirtualMachineDisk) ([]VirtualMachineDisk, error) {
+		index, err := findDiskBySCSIBusAndUnitID(disks, scsiBusNumber, scsiUnitID)
+		if err != nil {
+			return nil, fmt.Errorf("Cannot change size of disk: %s", err.Error())
+		}
+		disks[index].SizeGB = sizeGB
+
+		return disks, nil
+	}
+}
+
+// ScaleDiskSizes creates an ImageDiskOverride that multiplies the size of all disks by the specified factor (rounding up to the nearest GB).
+func ScaleDiskSizes(factor float64) ImageDiskOverride {
+	return func(disks []VirtualMachineDisk) ([]VirtualMachineDisk, error) {
+		if factor < 1 {
+			return nil, fmt.Errorf("Invalid disk scale factor %g (disks cannot be made smaller than the image's disks)", factor)
+		}
+
+		for index := range disks {
+			disks[index].SizeGB = int(math.Ceil(float64(disks[index].SizeGB) * factor))
+		}
+
+		return disks, nil
+	}
+}
+
+// DropDisk creates an ImageDiskOverride that removes the disk with the specified SCSI unit Id.
+//
+// Fails if disks on more than one SCSI controller have that unit Id (use DropControllerDisk instead).
+func DropDisk(scsiUnitID int) ImageDiskOverride {
+	return func(disks []VirtualMachineDisk) ([]VirtualMachineDisk, error) {
+		index, err := findDiskBySCSIUnitID(disks, scsiUnitID)
+		if err != nil {
+			return nil, fmt.Errorf("Cannot remove disk: %s", err.Error())
+		}
+
+		return append(disks[:index], disks[index+1:]...), nil
+	}
+}
+
+// DropControllerDisk creates an ImageDiskOverride that removes the disk with the specified SCSI unit Id from the SCSI controller with the specified bus number.
+func DropControllerDisk(scsiBusNumber int, scsiUnitID int) ImageDiskOverride {
+	return func(disks []VirtualMachineDisk) ([]VirtualMachineDisk, error) {
+		index, err := findDiskBySCSIBusAndUnitID(disks, scsiBusNumber, scsiUnitID)
+		if err != nil {
+			return nil, fmt.Errorf("Cannot remove disk: %s", err.Error())
+		}
+
+		return append(disks[:index], disks[index+1:]...), nil
+	}
+}
+
+// imageDiskKey identifies a disk by SCSI controller (bus number) and SCSI unit Id.
+type imageDiskKey struct {
+	scsiBusNumber int
+	scsiUnitID    int
+}
+
+// newImageDiskKey creates the imageDiskKey for the specified disk.
+func newImageDiskKey(disk VirtualMachineDisk) imageDiskKey {
+	return imageDiskKey{
+		scsiBusNumber: disk.SCSIBusNumber,
+		scsiUnitID:    disk.SCSIUnitID,
+	}
+}
+
+// findDiskBySCSIUnitID finds the index of the disk with the specified SCSI unit Id (on any SCSI controller).
+func findDiskBySCSIUnitID(disks []VirtualMachineDisk, scsiUnitID int) (int, error) {
+	found := -1
+	for index, disk := range disks {
+		if disk.SCSIUnitID != scsiUnitID {
+			continue
+		}
+		if found != -1 {
+			return -1, fmt.Errorf("more than one SCSI controller has a disk with SCSI unit Id %d", scsiUnitID)
+		}
+
+		found = index
+	}
+	if found == -1 {
+		return -1, fmt.Errorf("no disk with SCSI unit Id %d", scsiUnitID)
+	}
+
+	return found, nil
+}
+
+// findDiskBySCSIBusAndUnitID finds the index of the disk with the specified SCSI unit Id on the SCSI controller with the specified bus number.
+func findDiskBySCSIBusAndUnitID(disks []VirtualMachineDisk, scsiBusNumber int, scsiUnitID int) (int, error) {
+	key := imageDiskKey{
+		scsiBusNumber: scsiBusNumber,
+		scsiUnitID:    scsiUnitID,
+	}
+	for index, disk := range disks {
+		if newImageDiskKey(disk) == key {
+			return index, nil
+		}
+	}
+
+	return -1, fmt.Errorf("no disk with SCSI unit Id %d on SCSI bus %d", scsiUnitID, scsiBusNumber)
+}
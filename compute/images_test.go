@@ -0,0 +1,230 @@
+package compute
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// Find image by name (OS image).
+func TestClient_FindImage_OSImage(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			image, err := client.FindImage("CentOS 7 64-bit 2 CPU", "AU9")
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.NotNil("Image", image)
+			expect.EqualsString("Image.Type", "OS", ImageTypeName(image.GetType()))
+			expect.EqualsString("Image.ID", "7e68acb4-bbb8-4206-b30b-0e6c878056bc", image.GetID())
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			if !strings.HasSuffix(request.URL.Path, "/image/osImage") {
+				test.Fatalf("Unexpected request to '%s'.", request.URL.Path)
+			}
+
+			return http.StatusOK, findOSImageTestResponse
+		},
+	})
+}
+
+// Find image by name (falls back to customer image).
+func TestClient_FindImage_CustomerImage(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			image, err := client.FindImage("Golden.Image.1", "AU9")
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.NotNil("Image", image)
+			expect.EqualsString("Image.Type", "Customer", ImageTypeName(image.GetType()))
+			expect.EqualsString("Image.ID", "4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b", image.GetID())
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			if strings.HasSuffix(request.URL.Path, "/image/osImage") {
+				return http.StatusOK, findImageNoOSImagesTestResponse
+			}
+
+			return http.StatusOK, findImageCustomerImageTestResponse
+		},
+	})
+}
+
+// Find image by Id (not found).
+func TestClient_FindImage_ByID_NotFound(test *testing.T) {
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			image, err := client.FindImage("4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b", "AU9")
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect(test).IsTrue("Image == nil", image == nil)
+		},
+		Respond: testRespond(http.StatusBadRequest, imageNotFoundTestResponse),
+	})
+}
+
+/*
+ * Test responses.
+ */
+
+const findImageNoOSImagesTestResponse = `
+{
+	"osImage": [],
+	"pageNumber": 1,
+	"pageCount": 0,
+	"totalCount": 0,
+	"pageSize": 250
+}
+`
+
+const findImageCustomerImageTestResponse = `
+{
+	"customerImage": [
+		{
+			"id": "4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b",
+			"name": "Golden.Image.1",
+			"datacenterId": "AU9",
+			"createTime": "2016-09-12T06:40:13.000Z",
+			"state": "NORMAL"
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 1,
+	"totalCount": 1,
+	"pageSize": 250
+}
+`
+
+const imageNotFoundTestResponse = `
+{
+	"operation": "GET_IMAGE",
+	"responseCode": "RESOURCE_NOT_FOUND",
+	"message": "Image 4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b not found.",
+	"info": [],
+	"warning": [],
+	"error": [],
+	"requestId": "au9_20161001T000000.000-0000_7c1f8a2e-3b4d-4e5f-8a9b-0c1d2e3f4a5b"
+}
+`
+
+// Apply image with disk overrides.
+func TestApplyImageWithOverrides(test *testing.T) {
+	expect := expect(test)
+
+	image := &OSImage{
+		ID:       "8cdfd607-f429-4df6-9352-162cfc0891be",
+		Name:     "CentOS 7 64-bit 2 CPU",
+		MemoryGB: 4,
+		Disks: []VirtualMachineDisk{
+			{SCSIUnitID: 0, SizeGB: 10, Speed: "STANDARD"},
+			{SCSIUnitID: 1, SizeGB: 20, Speed: "STANDARD"},
+			{SCSIUnitID: 2, SizeGB: 30, Speed: "STANDARD"},
+		},
+	}
+
+	config := &ServerDeploymentConfiguration{}
+	err := ApplyImageWithOverrides(image, config,
+		OverrideAllDiskSpeeds("ECONOMY"),
+		OverrideDiskSpeed(0, "HIGHPERFORMANCE"),
+		ScaleDiskSizes(1.5),
+		OverrideDiskSize(2, 100),
+		DropDisk(1),
+	)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	expect.EqualsString("Config.ImageID", image.ID, config.ImageID)
+	expect.EqualsInt("Config.MemoryGB", 4, config.MemoryGB)
+	expect.EqualsInt("Config.Disks.Length", 2, len(config.Disks))
+	expect.EqualsString("Config.Disks[0].Speed", "HIGHPERFORMANCE", config.Disks[0].Speed)
+	expect.EqualsInt("Config.Disks[0].SizeGB", 15, config.Disks[0].SizeGB)
+	expect.EqualsInt("Config.Disks[1].SCSIUnitID", 2, config.Disks[1].SCSIUnitID)
+	expect.EqualsString("Config.Disks[1].Speed", "ECONOMY", config.Disks[1].Speed)
+	expect.EqualsInt("Config.Disks[1].SizeGB", 100, config.Disks[1].SizeGB)
+
+	// Image disks are not modified.
+	expect.EqualsString("Image.Disks[0].Speed", "STANDARD", image.Disks[0].Speed)
+
+	// Disks cannot shrink.
+	err = ApplyImageWithOverrides(image, &ServerDeploymentConfiguration{}, OverrideDiskSize(0, 5))
+	expect.NotNil("Error (shrink disk)", err)
+
+	// Unknown disk.
+	err = ApplyImageWithOverrides(image, &ServerDeploymentConfiguration{}, DropDisk(7))
+	expect.NotNil("Error (unknown disk)", err)
+}
+
+// Apply image with disk overrides (disks on different SCSI controllers share unit Ids).
+func TestApplyImageWithOverrides_MultipleControllers(test *testing.T) {
+	expect := expect(test)
+
+	image := &CustomerImage{
+		ID:   "4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b",
+		Name: "Golden.Image.1",
+		Disks: []VirtualMachineDisk{
+			{SCSIBusNumber: 0, SCSIUnitID: 0, SizeGB: 10, Speed: "STANDARD"},
+			{SCSIBusNumber: 1, SCSIUnitID: 0, SizeGB: 50, Speed: "STANDARD"},
+		},
+	}
+
+	config := &ServerDeploymentConfiguration{}
+	err := ApplyImageWithOverrides(image, config,
+		OverrideControllerDiskSize(0, 0, 20),
+		OverrideControllerDiskSpeed(1, 0, "HIGHPERFORMANCE"),
+	)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	expect.EqualsInt("Config.Disks.Length", 2, len(config.Disks))
+	expect.EqualsInt("Config.Disks[0].SizeGB", 20, config.Disks[0].SizeGB)
+	expect.EqualsString("Config.Disks[0].Speed", "STANDARD", config.Disks[0].Speed)
+	expect.EqualsInt("Config.Disks[1].SizeGB", 50, config.Disks[1].SizeGB)
+	expect.EqualsString("Config.Disks[1].Speed", "HIGHPERFORMANCE", config.Disks[1].Speed)
+
+	// The 50GB disk on bus 1 must not be compared against the 10GB disk on bus 0 (and vice versa).
+	err = ApplyImageWithOverrides(image, &ServerDeploymentConfiguration{}, OverrideControllerDiskSize(1, 0, 20))
+	expect.NotNil("Error (shrink disk on bus 1)", err)
+
+	// Unit Id alone is ambiguous.
+	err = ApplyImageWithOverrides(image, &ServerDeploymentConfiguration{}, OverrideDiskSize(0, 100))
+	expect.NotNil("Error (ambiguous disk)", err)
+}
+
+// Apply image with disk overrides (the configuration is left unchanged if an override is invalid).
+func TestApplyImageWithOverrides_ErrorLeavesConfigUnchanged(test *testing.T) {
+	expect := expect(test)
+
+	image := &OSImage{
+		ID: "8cdfd607-f429-4df6-9352-162cfc0891be",
+		Disks: []VirtualMachineDisk{
+			{SCSIUnitID: 0, SizeGB: 10, Speed: "STANDARD"},
+		},
+	}
+
+	config := &ServerDeploymentConfiguration{
+		Name: "web-1",
+		Disks: []VirtualMachineDisk{
+			{SCSIUnitID: 0, SizeGB: 40, Speed: "ECONOMY"},
+		},
+	}
+	err := ApplyImageWithOverrides(image, config,
+		OverrideDiskSpeed(0, "HIGHPERFORMANCE"),
+		OverrideDiskSize(0, 5),
+	)
+	expect.NotNil("Error", err)
+
+	expect.EqualsString("Config.ImageID", "", config.ImageID)
+	expect.EqualsInt("Config.Disks.Length", 1, len(config.Disks))
+	expect.EqualsInt("Config.Disks[0].SizeGB", 40, config.Disks[0].SizeGB)
+	expect.EqualsString("Config.Disks[0].Speed", "ECONOMY", config.Disks[0].Speed)
+}
@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"sync"
 	"testing"
+	"time"
 )
 
 /*
@@ -129,3 +131,108 @@ func testClientRequest(test *testing.T, testConfiguration *ClientTestConfig) {
 
 	testConfiguration.Request(test, client)
 }
+
+// testRouter dispatches requests for an integration test to responders by method and organisation-relative path (e.g. "GET server/server/{id}"),
+// recording the requests it receives.
+type testRouter struct {
+	lock       sync.Mutex
+	responders map[string]ClientTestResponder
+	requests   []string
+}
+
+func newTestRouter() *testRouter {
+	return &testRouter{
+		responders: make(map[string]ClientTestResponder),
+	}
+}
+
+// Handle registers (or replaces) the responder for the specified method and organisation-relative path.
+func (router *testRouter) Handle(method string, path string, responder ClientTestResponder) {
+	router.lock.Lock()
+	defer router.lock.Unlock()
+
+	router.responders[method+" "+path] = responder
+}
+
+// Requests gets the requests received so far (as "METHOD path").
+func (router *testRouter) Requests() []string {
+	router.lock.Lock()
+	defer router.lock.Unlock()
+
+	return append([]string(nil), router.requests...)
+}
+
+// Respond dispatches a request to the appropriate responder.
+func (router *testRouter) Respond(test *testing.T, request *http.Request) (int, string) {
+	// Strip "/caas/{version}/{organizationId}/".
+	pathSegments := strings.SplitN(strings.TrimPrefix(request.URL.Path, "/"), "/", 4)
+	key := request.Method + " " + pathSegments[len(pathSegments)-1]
+
+	router.lock.Lock()
+	router.requests = append(router.requests, key)
+	responder, ok := router.responders[key]
+	router.lock.Unlock()
+
+	if !ok {
+		test.Errorf("Unexpected request: %s", key)
+
+		return http.StatusBadRequest, testRouterUnexpectedRequestResponse
+	}
+
+	return responder(test, request)
+}
+
+// Temporarily reduce the interval at which resources are polled while waiting (returns a function that restores the original interval).
+func testWithResourceStatusPollInterval(interval time.Duration) func() {
+	originalInterval := resourceStatusPollInterval
+	resourceStatusPollInterval = interval
+
+	return func() {
+		resourceStatusPollInterval = originalInterval
+	}
+}
+
+func indexOfString(values []string, value string) int {
+	for index, candidate := range values {
+		if candidate == value {
+			return index
+		}
+	}
+
+	return -1
+}
+
+// Respond with a resource (or a RESOURCE_NOT_FOUND response, once the resource has been deleted).
+func testRespondUntilDeleted(responseBody string, isDeleted func() bool) ClientTestResponder {
+	return func(test *testing.T, request *http.Request) (int, string) {
+		if isDeleted() {
+			return http.StatusBadRequest, testRouterResourceNotFoundResponse
+		}
+
+		return http.StatusOK, responseBody
+	}
+}
+
+const testRouterUnexpectedRequestResponse = `
+{
+	"operation": "UNKNOWN",
+	"responseCode": "UNEXPECTED_ERROR",
+	"message": "Unexpected request.",
+	"info": [],
+	"warning": [],
+	"error": [],
+	"requestId": "test_unexpected_request"
+}
+`
+
+const testRouterResourceNotFoundResponse = `
+{
+	"operation": "GET",
+	"responseCode": "RESOURCE_NOT_FOUND",
+	"message": "Resource not found.",
+	"info": [],
+	"warning": [],
+	"error": [],
+	"requestId": "test_resource_not_found"
+}
+`
@@ -0,0 +1,189 @@
+package compute
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// InventoryMatchByID indicates that an inventory entry was matched to a server by its Id (UUID).
+	InventoryMatchByID = "ID"
+
+	// InventoryMatchByName indicates that an inventory entry was matched to a server by its name.
+	InventoryMatchByName = "NAME"
+
+	// InventoryMatchByIPAddress indicates that an inventory entry was matched to a server by one of its IP addresses.
+	InventoryMatchByIPAddress = "IP_ADDRESS"
+)
+
+// InventoryEntry represents a virtual machine from an external inventory (e.g. exported from vSphere).
+type InventoryEntry struct {
+	// The virtual machine name.
+	Name string `json:"name"`
+
+	// The virtual machine UUID (if known).
+	UUID string `json:"uuid,omitempty"`
+
+	// The virtual machine's IP addresses (if known).
+	IPAddresses []string `json:"ipAddresses,omitempty"`
+}
+
+// InventoryMatch represents an inventory entry that was matched to a CloudControl server.
+type InventoryMatch struct {
+	// The inventory entry.
+	Entry InventoryEntry `json:"entry"`
+
+	// The matching server.
+	Server EntityReference `json:"server"`
+
+	// How the entry was matched to the server (ID, NAME, or IP_ADDRESS).
+	MatchedBy string `json:"matchedBy"`
+}
+
+// UnmatchedInventoryEntry represents an inventory entry that could not be matched to a CloudControl server.
+type UnmatchedInventoryEntry struct {
+	// The inventory entry.
+	Entry InventoryEntry `json:"entry"`
+
+	// The reason the entry could not be matched.
+	Reason string `json:"reason"`
+}
+
+// InventoryMapping represents the result of matching an external inventory to CloudControl servers.
+type InventoryMapping struct {
+	// Inventory entries that were matched to a server.
+	Matched []InventoryMatch `json:"matched"`
+
+	// Inventory entries that could not be matched to a server.
+	Unmatched []UnmatchedInventoryEntry `json:"unmatched"`
+
+	// Servers that were not matched by any inventory entry.
+	UnmatchedServers []EntityReference `json:"unmatchedServers"`
+}
+
+// MapInventoryToServers matches the specified inventory entries to the servers in the specified network domain.
+//
+// See MapInventory for details of how entries are matched.
+func (client *Client) MapInventoryToServers(networkDomainID string, entries []InventoryEntry) (mapping *InventoryMapping, err error) {
+	var servers []Server
+	err = ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		page, err := client.ListServersInNetworkDomain(networkDomainID, paging)
+		if err != nil {
+			return nil, err
+		}
+
+		servers = append(servers, page.Items...)
+
+		return &page.PagedResult, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return MapInventory(entries, servers), nil
+}
+
+// MapInventory matches the specified inventory entries to the specified servers.
+//
+// Each entry is matched by UUID (against the server Id), then by name, and finally by IP address (all comparisons are case-insensitive).
+// An entry is left unmatched if it matches no server, or if it matches more than one server by the same criterion.
+func MapInventory(entries []InventoryEntry, servers []Server) *InventoryMapping {
+	mapping := &InventoryMapping{}
+
+	serversByID := make(map[string][]*Server)
+	serversByName := make(map[string][]*Server)
+	serversByIPAddress := make(map[string][]*Server)
+	for index := range servers {
+		server := &servers[index]
+
+		serversByID[strings.ToLower(server.ID)] = append(serversByID[strings.ToLower(server.ID)], server)
+		serversByName[strings.ToLower(server.Name)] = append(serversByName[strings.ToLower(server.Name)], server)
+		for _, ipAddress := range getServerIPAddresses(server) {
+			serversByIPAddress[strings.ToLower(ipAddress)] = append(serversByIPAddress[strings.ToLower(ipAddress)], server)
+		}
+	}
+
+	matchedServerIDs := make(map[string]bool)
+	for _, entry := range entries {
+		var (
+			candidates []*Server
+			matchedBy  string
+		)
+
+		if entry.UUID != "" {
+			candidates = serversByID[strings.ToLower(entry.UUID)]
+			matchedBy = InventoryMatchByID
+		}
+		if len(candidates) == 0 && entry.Name != "" {
+			candidates = serversByName[strings.ToLower(entry.Name)]
+			matchedBy = InventoryMatchByName
+		}
+		if len(candidates) == 0 {
+			candidates = findServersByIPAddress(serversByIPAddress, entry.IPAddresses)
+			matchedBy = InventoryMatchByIPAddress
+		}
+
+		switch len(candidates) {
+		case 0:
+			mapping.Unmatched = append(mapping.Unmatched, UnmatchedInventoryEntry{
+				Entry:  entry,
+				Reason: "No matching server was found.",
+			})
+		case 1:
+			server := candidates[0]
+			matchedServerIDs[server.ID] = true
+
+			mapping.Matched = append(mapping.Matched, InventoryMatch{
+				Entry:     entry,
+				Server:    server.ToEntityReference(),
+				MatchedBy: matchedBy,
+			})
+		default:
+			mapping.Unmatched = append(mapping.Unmatched, UnmatchedInventoryEntry{
+				Entry:  entry,
+				Reason: fmt.Sprintf("Matched %d servers (by %s).", len(candidates), matchedBy),
+			})
+		}
+	}
+
+	for index := range servers {
+		server := &servers[index]
+		if !matchedServerIDs[server.ID] {
+			mapping.UnmatchedServers = append(mapping.UnmatchedServers, server.ToEntityReference())
+		}
+	}
+
+	return mapping
+}
+
+// findServersByIPAddress finds the distinct servers that have any of the specified IP addresses.
+func findServersByIPAddress(serversByIPAddress map[string][]*Server, ipAddresses []string) (servers []*Server) {
+	seenServerIDs := make(map[string]bool)
+	for _, ipAddress := range ipAddresses {
+		for _, server := range serversByIPAddress[strings.ToLower(ipAddress)] {
+			if seenServerIDs[server.ID] {
+				continue
+			}
+
+			seenServerIDs[server.ID] = true
+			servers = append(servers, server)
+		}
+	}
+
+	return
+}
+
+// getServerIPAddresses gets the private IPv4 and IPv6 addresses of all the server's network adapters.
+func getServerIPAddresses(server *Server) (ipAddresses []string) {
+	networkAdapters := append([]VirtualMachineNetworkAdapter{server.Network.PrimaryAdapter}, server.Network.AdditionalNetworkAdapters...)
+	for _, networkAdapter := range networkAdapters {
+		if networkAdapter.PrivateIPv4Address != nil && *networkAdapter.PrivateIPv4Address != "" {
+			ipAddresses = append(ipAddresses, *networkAdapter.PrivateIPv4Address)
+		}
+		if networkAdapter.PrivateIPv6Address != nil && *networkAdapter.PrivateIPv6Address != "" {
+			ipAddresses = append(ipAddresses, *networkAdapter.PrivateIPv6Address)
+		}
+	}
+
+	return
+}
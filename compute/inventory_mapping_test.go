@@ -0,0 +1,59 @@
+package compute
+
+import "testing"
+
+// Map inventory entries to servers by Id, name, and IP address.
+func TestMapInventory(test *testing.T) {
+	expect := expect(test)
+
+	servers := []Server{
+		{
+			ID:   "5a32d6e4-9707-4813-a269-56ab4d989f4d",
+			Name: "web-01",
+		},
+		{
+			ID:   "681a6db2-9c7c-4d98-a0c4-7b3d7c1619ba",
+			Name: "WEB-02",
+		},
+		{
+			ID:   "5783e93f-5370-44fc-a772-cd3c29a2ecaa",
+			Name: "db-01",
+			Network: VirtualMachineNetwork{
+				PrimaryAdapter: VirtualMachineNetworkAdapter{
+					PrivateIPv4Address: stringToPtr("10.0.3.13"),
+				},
+			},
+		},
+		{
+			ID:   "40285f24-300f-11e2-b574-1a6dd6e90d84",
+			Name: "legacy",
+		},
+		{
+			ID:   "00616730-faca-4cb7-860d-07c553f4c41e",
+			Name: "legacy",
+		},
+	}
+
+	mapping := MapInventory([]InventoryEntry{
+		{Name: "web-01-old", UUID: "5A32D6E4-9707-4813-A269-56AB4D989F4D"},
+		{Name: "web-02"},
+		{Name: "database", IPAddresses: []string{"10.0.3.13"}},
+		{Name: "legacy"},
+		{Name: "missing", IPAddresses: []string{"10.0.9.9"}},
+	}, servers)
+
+	expect.EqualsInt("Matched.Length", 3, len(mapping.Matched))
+	expect.EqualsString("Matched[0].Server.ID", "5a32d6e4-9707-4813-a269-56ab4d989f4d", mapping.Matched[0].Server.ID)
+	expect.EqualsString("Matched[0].MatchedBy", InventoryMatchByID, mapping.Matched[0].MatchedBy)
+	expect.EqualsString("Matched[1].Server.ID", "681a6db2-9c7c-4d98-a0c4-7b3d7c1619ba", mapping.Matched[1].Server.ID)
+	expect.EqualsString("Matched[1].MatchedBy", InventoryMatchByName, mapping.Matched[1].MatchedBy)
+	expect.EqualsString("Matched[2].Server.ID", "5783e93f-5370-44fc-a772-cd3c29a2ecaa", mapping.Matched[2].Server.ID)
+	expect.EqualsString("Matched[2].MatchedBy", InventoryMatchByIPAddress, mapping.Matched[2].MatchedBy)
+
+	expect.EqualsInt("Unmatched.Length", 2, len(mapping.Unmatched))
+	expect.EqualsString("Unmatched[0].Entry.Name", "legacy", mapping.Unmatched[0].Entry.Name)
+	expect.EqualsString("Unmatched[1].Entry.Name", "missing", mapping.Unmatched[1].Entry.Name)
+
+	expect.EqualsInt("UnmatchedServers.Length", 2, len(mapping.UnmatchedServers))
+	expect.EqualsString("UnmatchedServers[0].ID", "40285f24-300f-11e2-b574-1a6dd6e90d84", mapping.UnmatchedServers[0].ID)
+}
@@ -11,17 +11,21 @@ import (
 
 // PublicIPBlock represents an allocated block of public IPv4 addresses.
 type PublicIPBlock struct {
-	ID              string `json:"id"`
-	NetworkDomainID string `json:"networkDomainId"`
-	DataCenterID    string `json:"datacenterId"`
-	BaseIP          string `json:"baseIp"`
-	Size            int    `json:"size"`
-	CreateTime      string `json:"createTime"`
-	State           string `json:"state"`
+	ID              string    `json:"id"`
+	NetworkDomainID string    `json:"networkDomainId"`
+	DataCenterID    string    `json:"datacenterId"`
+	BaseIP          string    `json:"baseIp"`
+	Size            int       `json:"size"`
+	CreateTime      Timestamp `json:"createTime"`
+	State           string    `json:"state"`
 }
 
 // GetID returns the public IPv4 address block's Id.
 func (block *PublicIPBlock) GetID() string {
+	if block == nil {
+		return ""
+	}
+
 	return block.ID
 }
 
@@ -32,11 +36,19 @@ func (block *PublicIPBlock) GetResourceType() ResourceType {
 
 // GetName returns the public IPv4 address block's name.
 func (block *PublicIPBlock) GetName() string {
+	if block == nil {
+		return ""
+	}
+
 	return fmt.Sprintf("%s+%d", block.BaseIP, block.Size)
 }
 
 // GetState returns the network block's current state.
 func (block *PublicIPBlock) GetState() string {
+	if block == nil {
+		return ""
+	}
+
 	return block.State
 }
 
@@ -47,6 +59,10 @@ func (block *PublicIPBlock) IsDeleted() bool {
 
 // ToEntityReference creates an EntityReference representing the CustomerImage.
 func (block *PublicIPBlock) ToEntityReference() EntityReference {
+	if block == nil {
+		return EntityReference{}
+	}
+
 	return EntityReference{
 		ID: block.ID,
 		Name: fmt.Sprintf("%s+%d",
@@ -142,6 +158,11 @@ func (client *Client) ListPublicIPBlocks(networkDomainID string, paging *Paging)
 		return nil, err
 	}
 
+	err = paging.Validate()
+	if err != nil {
+		return nil, err
+	}
+
 	requestURI := fmt.Sprintf("%s/network/publicIpBlock?networkDomainId=%s&%s",
 		url.QueryEscape(organizationID),
 		url.QueryEscape(networkDomainID),
@@ -248,6 +269,11 @@ func (client *Client) ListReservedPublicIPAddresses(networkDomainID string, pagi
 		return nil, err
 	}
 
+	err = paging.Validate()
+	if err != nil {
+		return nil, err
+	}
+
 	requestURI := fmt.Sprintf("%s/network/reservedPublicIpv4Address?networkDomainId=%s&%s",
 		url.QueryEscape(organizationID),
 		url.QueryEscape(networkDomainID),
@@ -287,22 +313,16 @@ func (client *Client) GetAvailablePublicIPAddresses(networkDomainID string) (ava
 	availableIPs = make(map[string]string)
 
 	// Public IPs are allocated in blocks.
-	page := DefaultPaging()
-	for {
-		var publicIPBlocks *PublicIPBlocks
-		publicIPBlocks, err = client.ListPublicIPBlocks(networkDomainID, page)
+	err = ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		publicIPBlocks, err := client.ListPublicIPBlocks(networkDomainID, paging)
 		if err != nil {
-			return
-		}
-		if publicIPBlocks.IsEmpty() {
-			break // We're done
+			return nil, err
 		}
 
-		var blockAddresses []string
 		for _, block := range publicIPBlocks.Blocks {
-			blockAddresses, err = calculateBlockAddresses(block)
+			blockAddresses, err := calculateBlockAddresses(block)
 			if err != nil {
-				return
+				return nil, err
 			}
 
 			for _, address := range blockAddresses {
@@ -310,27 +330,25 @@ func (client *Client) GetAvailablePublicIPAddresses(networkDomainID string) (ava
 			}
 		}
 
-		page.Next()
+		return &publicIPBlocks.PagedResult, nil
+	})
+	if err != nil {
+		return
 	}
 
 	// Some of those IPs may be reserved for other NAT rules or VIPs.
-	page.First()
-	for {
-		var reservedIPs *ReservedPublicIPs
-		reservedIPs, err = client.ListReservedPublicIPAddresses(networkDomainID, page)
+	err = ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		reservedIPs, err := client.ListReservedPublicIPAddresses(networkDomainID, paging)
 		if err != nil {
-			return
-		}
-		if reservedIPs.IsEmpty() {
-			break // We're done
+			return nil, err
 		}
 
 		for _, reservedIP := range reservedIPs.IPs {
 			delete(availableIPs, reservedIP.Address)
 		}
 
-		page.Next()
-	}
+		return &reservedIPs.PagedResult, nil
+	})
 
 	return
 }
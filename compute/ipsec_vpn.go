@@ -0,0 +1,381 @@
+package compute
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+)
+
+const (
+	// IKEVersion1 represents version 1 of the Internet Key Exchange (IKE) protocol.
+	IKEVersion1 = "IKEv1"
+
+	// IKEVersion2 represents version 2 of the Internet Key Exchange (IKE) protocol.
+	IKEVersion2 = "IKEv2"
+)
+
+const (
+	// MinIPsecPresharedKeyLength is the minimum length of an IPsec site connection's pre-shared key.
+	MinIPsecPresharedKeyLength = 8
+
+	// MaxIPsecPresharedKeyLength is the maximum length of an IPsec site connection's pre-shared key.
+	MaxIPsecPresharedKeyLength = 128
+
+	// DefaultIPsecPresharedKeyLength is the length of pre-shared keys generated by RotateIPsecPresharedKey.
+	DefaultIPsecPresharedKeyLength = 32
+
+	ipsecPresharedKeyCharacters = "ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz23456789"
+)
+
+// IPsecSiteConnection represents an IPsec VPN connection between a network domain and a remote (peer) site.
+type IPsecSiteConnection struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	NetworkDomainID string `json:"networkDomainId"`
+
+	// The public IPv4 address of the network domain's VPN end-point.
+	LocalIPAddress string `json:"localIpv4Address"`
+
+	// The public IPv4 address of the remote site's VPN end-point.
+	PeerIPAddress string `json:"peerIpv4Address"`
+
+	// The IPv4 networks (CIDR notation) in the network domain that are reachable via the connection.
+	LocalNetworks []string `json:"localNetwork"`
+
+	// The IPv4 networks (CIDR notation) at the remote site that are reachable via the connection.
+	PeerNetworks []string `json:"peerNetwork"`
+
+	// The IKE version (IKEVersion1 or IKEVersion2).
+	IKEVersion string `json:"ikeVersion"`
+
+	CreateTime   Timestamp `json:"createTime"`
+	State        string    `json:"state"`
+	DataCenterID string    `json:"datacenterId"`
+}
+
+// IPsecSiteConnections represents a page of IPsecSiteConnection results.
+type IPsecSiteConnections struct {
+	Items []IPsecSiteConnection `json:"ipsecSiteConnection"`
+
+	PagedResult
+}
+
+// IPsecSiteConnectionConfiguration represents the configuration for a new IPsec site connection.
+type IPsecSiteConnectionConfiguration struct {
+	// The Id of the network domain where the connection will be created.
+	NetworkDomainID string `json:"networkDomainId"`
+
+	// The connection name.
+	Name string `json:"name"`
+
+	// The connection description.
+	Description string `json:"description,omitempty"`
+
+	// The public IPv4 address of the remote site's VPN end-point.
+	PeerIPAddress string `json:"peerIpv4Address"`
+
+	// The IPv4 networks (CIDR notation) in the network domain that will be reachable via the connection.
+	LocalNetworks []string `json:"localNetwork"`
+
+	// The IPv4 networks (CIDR notation) at the remote site that will be reachable via the connection.
+	PeerNetworks []string `json:"peerNetwork"`
+
+	// The pre-shared key used to authenticate the connection.
+	PresharedKey string `json:"presharedKey"`
+
+	// The IKE version (IKEVersion1 or IKEVersion2); defaults to IKEVersion2.
+	IKEVersion string `json:"ikeVersion,omitempty"`
+}
+
+// Validate determines whether the IPsec site connection configuration is valid.
+func (configuration *IPsecSiteConnectionConfiguration) Validate() error {
+	if configuration.NetworkDomainID == "" {
+		return fmt.Errorf("Must specify the Id of the network domain where the IPsec site connection will be created")
+	}
+	if configuration.Name == "" {
+		return fmt.Errorf("Must specify the name of the IPsec site connection")
+	}
+
+	peerIPAddress := net.ParseIP(configuration.PeerIPAddress)
+	if peerIPAddress == nil || peerIPAddress.To4() == nil {
+		return fmt.Errorf("Invalid peer IPv4 address '%s' for IPsec site connection '%s'", configuration.PeerIPAddress, configuration.Name)
+	}
+
+	if len(configuration.LocalNetworks) == 0 {
+		return fmt.Errorf("Must specify at least one local network for IPsec site connection '%s'", configuration.Name)
+	}
+	for _, network := range configuration.LocalNetworks {
+		_, _, err := net.ParseCIDR(network)
+		if err != nil {
+			return fmt.Errorf("Invalid local network '%s' for IPsec site connection '%s' (must be in CIDR notation)", network, configuration.Name)
+		}
+	}
+	if len(configuration.PeerNetworks) == 0 {
+		return fmt.Errorf("Must specify at least one peer network for IPsec site connection '%s'", configuration.Name)
+	}
+	for _, network := range configuration.PeerNetworks {
+		_, _, err := net.ParseCIDR(network)
+		if err != nil {
+			return fmt.Errorf("Invalid peer network '%s' for IPsec site connection '%s' (must be in CIDR notation)", network, configuration.Name)
+		}
+	}
+
+	switch configuration.IKEVersion {
+	case "", IKEVersion1, IKEVersion2:
+	default:
+		return fmt.Errorf("Invalid IKE version '%s' for IPsec site connection '%s' (must be '%s' or '%s')", configuration.IKEVersion, configuration.Name, IKEVersion1, IKEVersion2)
+	}
+
+	return validateIPsecPresharedKey(configuration.PresharedKey)
+}
+
+// Request body for deleting an IPsec site connection.
+type deleteIPsecSiteConnection struct {
+	ID string `json:"id"`
+}
+
+// Request body for changing an IPsec site connection's pre-shared key.
+type changeIPsecPresharedKey struct {
+	ID           string `json:"id"`
+	PresharedKey string `json:"presharedKey"`
+}
+
+// GetIPsecSiteConnection retrieves the IPsec site connection with the specified Id.
+// Returns nil if no IPsec site connection is found with the specified Id.
+func (client *Client) GetIPsecSiteConnection(id string) (connection *IPsecSiteConnection, err error) {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return nil, err
+	}
+
+	requestURI := fmt.Sprintf("%s/network/ipsecSiteConnection/%s",
+		url.QueryEscape(organizationID),
+		url.QueryEscape(id),
+	)
+	request, err := client.newRequestV29(requestURI, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode != http.StatusOK {
+		var apiResponse *APIResponseV2
+
+		apiResponse, err = readAPIResponseAsJSON(responseBody, statusCode)
+		if err != nil {
+			return nil, err
+		}
+
+		if apiResponse.ResponseCode == ResponseCodeResourceNotFound {
+			return nil, nil // Not an error, but was not found.
+		}
+
+		return nil, apiResponse.ToError("Request to retrieve IPsec site connection failed with status code %d (%s): %s", statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	connection = &IPsecSiteConnection{}
+	err = json.Unmarshal(responseBody, connection)
+	if err != nil {
+		return nil, err
+	}
+
+	return connection, nil
+}
+
+// ListIPsecSiteConnections retrieves a page of the IPsec site connections defined for the specified network domain.
+func (client *Client) ListIPsecSiteConnections(networkDomainID string, paging *Paging) (connections *IPsecSiteConnections, err error) {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return nil, err
+	}
+
+	err = paging.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	requestURI := fmt.Sprintf("%s/network/ipsecSiteConnection?networkDomainId=%s&%s",
+		url.QueryEscape(organizationID),
+		url.QueryEscape(networkDomainID),
+		paging.EnsurePaging().toQueryParameters(),
+	)
+	request, err := client.newRequestV29(requestURI, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode != http.StatusOK {
+		var apiResponse *APIResponseV2
+
+		apiResponse, err = readAPIResponseAsJSON(responseBody, statusCode)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, apiResponse.ToError("Request to list IPsec site connections for network domain '%s' failed with status code %d (%s): %s", networkDomainID, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	connections = &IPsecSiteConnections{}
+	err = json.Unmarshal(responseBody, connections)
+
+	return connections, err
+}
+
+// CreateIPsecSiteConnection creates a new IPsec site connection between a network domain and a remote site.
+//
+// This operation is synchronous.
+func (client *Client) CreateIPsecSiteConnection(configuration IPsecSiteConnectionConfiguration) (connectionID string, err error) {
+	err = configuration.Validate()
+	if err != nil {
+		return "", err
+	}
+
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return "", err
+	}
+
+	requestURI := fmt.Sprintf("%s/network/createIpsecSiteConnection",
+		url.QueryEscape(organizationID),
+	)
+	request, err := client.newRequestV29(requestURI, http.MethodPost, &configuration)
+	if err != nil {
+		return "", err
+	}
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return "", err
+	}
+
+	apiResponse, err := readAPIResponseAsJSON(responseBody, statusCode)
+	if err != nil {
+		return "", err
+	}
+
+	if apiResponse.ResponseCode != ResponseCodeOK {
+		return "", apiResponse.ToError("Request to create IPsec site connection '%s' in network domain '%s' failed with unexpected status code %d (%s): %s", configuration.Name, configuration.NetworkDomainID, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	// Expected: "info" { "name": "ipsecSiteConnectionId", "value": "the-Id-of-the-new-connection" }
+	connectionIDMessage := apiResponse.GetFieldMessage("ipsecSiteConnectionId")
+	if connectionIDMessage == nil {
+		return "", apiResponse.ToError("Received an unexpected response (missing 'ipsecSiteConnectionId') with status code %d (%s): %s", statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	return *connectionIDMessage, nil
+}
+
+// DeleteIPsecSiteConnection deletes the specified IPsec site connection.
+//
+// This operation is synchronous.
+func (client *Client) DeleteIPsecSiteConnection(id string) error {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return err
+	}
+
+	requestURI := fmt.Sprintf("%s/network/deleteIpsecSiteConnection",
+		url.QueryEscape(organizationID),
+	)
+	request, err := client.newRequestV29(requestURI, http.MethodPost, &deleteIPsecSiteConnection{id})
+	if err != nil {
+		return err
+	}
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return err
+	}
+
+	apiResponse, err := readAPIResponseAsJSON(responseBody, statusCode)
+	if err != nil {
+		return err
+	}
+
+	if apiResponse.ResponseCode != ResponseCodeOK {
+		return apiResponse.ToError("Request to delete IPsec site connection '%s' failed with unexpected status code %d (%s): %s", id, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	return nil
+}
+
+// ChangeIPsecPresharedKey changes the pre-shared key used to authenticate the specified IPsec site connection.
+//
+// The remote site's VPN end-point must be updated to use the new key, or the connection will fail to re-establish.
+func (client *Client) ChangeIPsecPresharedKey(id string, presharedKey string) error {
+	err := validateIPsecPresharedKey(presharedKey)
+	if err != nil {
+		return err
+	}
+
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return err
+	}
+
+	requestURI := fmt.Sprintf("%s/network/changeIpsecPresharedKey",
+		url.QueryEscape(organizationID),
+	)
+	request, err := client.newRequestV29(requestURI, http.MethodPost, &changeIPsecPresharedKey{
+		ID:           id,
+		PresharedKey: presharedKey,
+	})
+	if err != nil {
+		return err
+	}
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return err
+	}
+
+	apiResponse, err := readAPIResponseAsJSON(responseBody, statusCode)
+	if err != nil {
+		return err
+	}
+
+	if apiResponse.ResponseCode != ResponseCodeOK {
+		return apiResponse.ToError("Request to change pre-shared key for IPsec site connection '%s' failed with unexpected status code %d (%s): %s", id, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	return nil
+}
+
+// RotateIPsecPresharedKey generates a new random pre-shared key (DefaultIPsecPresharedKeyLength characters) for the specified IPsec site connection.
+//
+// Returns the new key, which must then be configured on the remote site's VPN end-point.
+func (client *Client) RotateIPsecPresharedKey(id string) (presharedKey string, err error) {
+	key := make([]byte, DefaultIPsecPresharedKeyLength)
+	for index := range key {
+		key[index], err = randomCharacter(ipsecPresharedKeyCharacters)
+		if err != nil {
+			return "", err
+		}
+	}
+	presharedKey = string(key)
+
+	err = client.ChangeIPsecPresharedKey(id, presharedKey)
+	if err != nil {
+		return "", err
+	}
+
+	return presharedKey, nil
+}
+
+// validateIPsecPresharedKey determines whether the specified pre-shared key satisfies CloudControl's length rules.
+func validateIPsecPresharedKey(presharedKey string) error {
+	if len(presharedKey) < MinIPsecPresharedKeyLength || len(presharedKey) > MaxIPsecPresharedKeyLength {
+		return fmt.Errorf("IPsec pre-shared key must be between %d and %d characters long", MinIPsecPresharedKeyLength, MaxIPsecPresharedKeyLength)
+	}
+
+	return nil
+}
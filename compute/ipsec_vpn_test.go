@@ -0,0 +1,189 @@
+package compute
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// List IPsec site connections (successful).
+func TestClient_ListIPsecSiteConnections_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			connections, err := client.ListIPsecSiteConnections("484174a2-ae74-4658-9e56-50fc90e086cf", nil)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("Connections.Length", 1, len(connections.Items))
+
+			connection := connections.Items[0]
+			expect.EqualsString("Connection.Name", "Head Office", connection.Name)
+			expect.EqualsString("Connection.PeerIPAddress", "203.0.113.10", connection.PeerIPAddress)
+			expect.EqualsInt("Connection.PeerNetworks.Length", 2, len(connection.PeerNetworks))
+			expect.EqualsString("Connection.IKEVersion", IKEVersion2, connection.IKEVersion)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.EqualsString("Query.networkDomainId", "484174a2-ae74-4658-9e56-50fc90e086cf", request.URL.Query().Get("networkDomainId"))
+
+			return http.StatusOK, listIPsecSiteConnectionsTestResponse
+		},
+	})
+}
+
+// Create IPsec site connection (successful).
+func TestClient_CreateIPsecSiteConnection_Success(test *testing.T) {
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			connectionID, err := client.CreateIPsecSiteConnection(IPsecSiteConnectionConfiguration{
+				NetworkDomainID: "484174a2-ae74-4658-9e56-50fc90e086cf",
+				Name:            "Head Office",
+				PeerIPAddress:   "203.0.113.10",
+				LocalNetworks:   []string{"10.0.1.0/24"},
+				PeerNetworks:    []string{"192.168.0.0/24", "192.168.1.0/24"},
+				PresharedKey:    "correct-horse-battery-staple",
+			})
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect(test).EqualsString("ConnectionID", "9b1c7f2e-4d3a-4e5b-8c6d-7e8f9a0b1c2d", connectionID)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect := expect(test)
+
+			expect.IsTrue("Request.URL", strings.HasSuffix(request.URL.Path, "/network/createIpsecSiteConnection"))
+
+			requestBody := &IPsecSiteConnectionConfiguration{}
+			err := readRequestBodyAsJSON(request, requestBody)
+			if err != nil {
+				test.Fatal(err)
+			}
+			expect.EqualsString("Request.NetworkDomainID", "484174a2-ae74-4658-9e56-50fc90e086cf", requestBody.NetworkDomainID)
+			expect.EqualsString("Request.PeerIPAddress", "203.0.113.10", requestBody.PeerIPAddress)
+			expect.EqualsInt("Request.PeerNetworks.Length", 2, len(requestBody.PeerNetworks))
+			expect.EqualsString("Request.PresharedKey", "correct-horse-battery-staple", requestBody.PresharedKey)
+
+			return http.StatusOK, createIPsecSiteConnectionTestResponse
+		},
+	})
+}
+
+// Validate IPsec site connection configuration (invalid).
+func TestIPsecSiteConnectionConfiguration_Validate_Invalid(test *testing.T) {
+	expect := expect(test)
+
+	configuration := IPsecSiteConnectionConfiguration{
+		NetworkDomainID: "484174a2-ae74-4658-9e56-50fc90e086cf",
+		Name:            "Head Office",
+		PeerIPAddress:   "203.0.113.10",
+		LocalNetworks:   []string{"10.0.1.0/24"},
+		PeerNetworks:    []string{"192.168.0.0"},
+		PresharedKey:    "correct-horse-battery-staple",
+	}
+	expect.NotNil("Error (peer network is not a CIDR)", configuration.Validate())
+
+	configuration.PeerNetworks = []string{"192.168.0.0/24"}
+	configuration.PresharedKey = "short"
+	expect.NotNil("Error (pre-shared key is too short)", configuration.Validate())
+
+	configuration.PresharedKey = "correct-horse-battery-staple"
+	configuration.PeerIPAddress = "2001:db8::1"
+	expect.NotNil("Error (peer address is not IPv4)", configuration.Validate())
+}
+
+// Rotate IPsec pre-shared key (successful).
+func TestClient_RotateIPsecPresharedKey_Success(test *testing.T) {
+	expect := expect(test)
+
+	var requestedKey string
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			presharedKey, err := client.RotateIPsecPresharedKey("9b1c7f2e-4d3a-4e5b-8c6d-7e8f9a0b1c2d")
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("PresharedKey.Length", DefaultIPsecPresharedKeyLength, len(presharedKey))
+			expect.EqualsString("PresharedKey", requestedKey, presharedKey)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.IsTrue("Request.URL", strings.HasSuffix(request.URL.Path, "/network/changeIpsecPresharedKey"))
+
+			requestBody := &changeIPsecPresharedKey{}
+			err := readRequestBodyAsJSON(request, requestBody)
+			if err != nil {
+				test.Fatal(err)
+			}
+			expect.EqualsString("Request.ID", "9b1c7f2e-4d3a-4e5b-8c6d-7e8f9a0b1c2d", requestBody.ID)
+			requestedKey = requestBody.PresharedKey
+
+			return http.StatusOK, changeIPsecPresharedKeyTestResponse
+		},
+	})
+}
+
+/*
+ * Test responses.
+ */
+
+const listIPsecSiteConnectionsTestResponse = `
+{
+	"ipsecSiteConnection": [
+		{
+			"id": "9b1c7f2e-4d3a-4e5b-8c6d-7e8f9a0b1c2d",
+			"name": "Head Office",
+			"description": "VPN to head office",
+			"networkDomainId": "484174a2-ae74-4658-9e56-50fc90e086cf",
+			"localIpv4Address": "168.128.12.20",
+			"peerIpv4Address": "203.0.113.10",
+			"localNetwork": [
+				"10.0.1.0/24"
+			],
+			"peerNetwork": [
+				"192.168.0.0/24",
+				"192.168.1.0/24"
+			],
+			"ikeVersion": "IKEv2",
+			"createTime": "2017-03-01T02:41:17.000Z",
+			"state": "NORMAL",
+			"datacenterId": "AU9"
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 1,
+	"totalCount": 1,
+	"pageSize": 50
+}
+`
+
+const createIPsecSiteConnectionTestResponse = `
+{
+	"operation": "CREATE_IPSEC_SITE_CONNECTION",
+	"responseCode": "OK",
+	"message": "IPsec Site Connection 'Head Office' has been created.",
+	"info": [
+		{
+			"name": "ipsecSiteConnectionId",
+			"value": "9b1c7f2e-4d3a-4e5b-8c6d-7e8f9a0b1c2d"
+		}
+	],
+	"warning": [],
+	"error": [],
+	"requestId": "au9_20170301T024117.000-0000_5d6e7f8a-9b0c-4d1e-8f2a-3b4c5d6e7f8a"
+}
+`
+
+const changeIPsecPresharedKeyTestResponse = `
+{
+	"operation": "CHANGE_IPSEC_PRESHARED_KEY",
+	"responseCode": "OK",
+	"message": "Pre-shared key for IPsec Site Connection 'Head Office' has been changed.",
+	"info": [],
+	"warning": [],
+	"error": [],
+	"requestId": "au9_20170301T024117.000-0000_6e7f8a9b-0c1d-4e2f-9a3b-4c5d6e7f8a9b"
+}
+`
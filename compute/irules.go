@@ -37,7 +37,7 @@ var _ NamedEntity = &IRule{}
 
 // IRules represents a page of IRule results.
 type IRules struct {
-	Items []IRule `json:"defaultIRule"`
+	Items []IRule `json:"defaultIrule"`
 
 	PagedResult
 }
@@ -49,6 +49,11 @@ func (client *Client) ListDefaultIRules(networkDomainID string, paging *Paging)
 		return nil, err
 	}
 
+	err = paging.Validate()
+	if err != nil {
+		return nil, err
+	}
+
 	requestURI := fmt.Sprintf("%s/networkDomainVip/defaultIrule?networkDomainId=%s&%s",
 		url.QueryEscape(organizationID),
 		url.QueryEscape(networkDomainID),
@@ -83,3 +88,26 @@ func (client *Client) ListDefaultIRules(networkDomainID string, paging *Paging)
 
 	return irules, nil
 }
+
+// GetDefaultIRuleByName retrieves the default load-balancing iRule (if any) with the specified name in the specified network domain.
+// Returns nil if no iRule is found with the specified name.
+func (client *Client) GetDefaultIRuleByName(name string, networkDomainID string) (iRule *IRule, err error) {
+	err = ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		iRules, err := client.ListDefaultIRules(networkDomainID, paging)
+		if err != nil {
+			return nil, err
+		}
+
+		for index := range iRules.Items {
+			if iRules.Items[index].Name == name {
+				iRule = &iRules.Items[index]
+
+				return nil, nil // Found it.
+			}
+		}
+
+		return &iRules.PagedResult, nil
+	})
+
+	return
+}
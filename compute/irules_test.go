@@ -0,0 +1,80 @@
+package compute
+
+import (
+	"net/http"
+	"testing"
+)
+
+// List default iRules (successful).
+func TestClient_ListDefaultIRules_Success(test *testing.T) {
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			iRules, err := client.ListDefaultIRules("553f26b6-2a73-42c3-a78b-6116f11291d0", nil)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			verifyListDefaultIRulesTestResponse(test, iRules)
+		},
+		Respond: testRespondOK(listDefaultIRulesTestResponse),
+	})
+}
+
+// Get default iRule by name (successful).
+func TestClient_GetDefaultIRuleByName_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			iRule, err := client.GetDefaultIRuleByName("CCDEFAULT.Ips", "553f26b6-2a73-42c3-a78b-6116f11291d0")
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.NotNil("IRule", iRule)
+			expect.EqualsString("IRule.ID", "2b20e790-ffdc-11e4-b010-005056806999", iRule.ID)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.EqualsString("Request.NetworkDomainID", "553f26b6-2a73-42c3-a78b-6116f11291d0", request.URL.Query().Get("networkDomainId"))
+
+			return http.StatusOK, listDefaultIRulesTestResponse
+		},
+	})
+}
+
+/*
+ * Test responses.
+ */
+
+const listDefaultIRulesTestResponse = `
+{
+	"defaultIrule": [
+		{
+			"id": "2b20abd9-ffdc-11e4-b010-005056806999",
+			"name": "CCDEFAULT.IpProtocolTimers",
+			"virtualListenerCompatibility": []
+		},
+		{
+			"id": "2b20e790-ffdc-11e4-b010-005056806999",
+			"name": "CCDEFAULT.Ips",
+			"virtualListenerCompatibility": []
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 2,
+	"totalCount": 2,
+	"pageSize": 250
+}
+`
+
+func verifyListDefaultIRulesTestResponse(test *testing.T, iRules *IRules) {
+	expect := expect(test)
+
+	expect.NotNil("IRules", iRules)
+	expect.EqualsInt("IRules.PageCount", 2, iRules.PageCount)
+	expect.EqualsInt("IRules.Items.Length", 2, len(iRules.Items))
+	expect.EqualsString("IRules.Items[0].ID", "2b20abd9-ffdc-11e4-b010-005056806999", iRules.Items[0].ID)
+	expect.EqualsString("IRules.Items[0].Name", "CCDEFAULT.IpProtocolTimers", iRules.Items[0].Name)
+	expect.EqualsString("IRules.Items[1].ID", "2b20e790-ffdc-11e4-b010-005056806999", iRules.Items[1].ID)
+	expect.EqualsString("IRules.Items[1].Name", "CCDEFAULT.Ips", iRules.Items[1].Name)
+}
@@ -0,0 +1,162 @@
+package compute
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// IsoImage represents an ISO image (uploaded via FTPS) that can be attached to a server's CD-ROM drive.
+type IsoImage struct {
+	// The ISO image Id.
+	ID string `json:"id"`
+
+	// The ISO image's file name.
+	Name string `json:"name"`
+
+	// The ISO image's path (as expected by AttachIso).
+	Path string `json:"path"`
+
+	// The Id of the datacenter to which the ISO image was uploaded.
+	DatacenterID string `json:"datacenterId"`
+
+	// The size (in bytes) of the ISO image.
+	SizeBytes int64 `json:"fileSizeBytes"`
+
+	// The date / time (RFC3339) when the ISO image was uploaded.
+	UploadTime string `json:"uploadTime"`
+}
+
+// IsoImages represents a page of IsoImage results.
+type IsoImages struct {
+	Items []IsoImage `json:"isoImage"`
+
+	PagedResult
+}
+
+// Request body when attaching an ISO image to a server.
+type attachIso struct {
+	ServerID string `json:"serverId"`
+	IsoPath  string `json:"isoPath"`
+}
+
+// Request body when detaching an ISO image from a server.
+type detachIso struct {
+	ServerID string `json:"serverId"`
+}
+
+// ListIsoImages retrieves a page of the ISO images (uploaded via FTPS) that are available in the specified datacenter.
+func (client *Client) ListIsoImages(datacenterID string, paging *Paging) (images *IsoImages, err error) {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return nil, err
+	}
+
+	err = paging.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	requestURI := fmt.Sprintf("%s/image/iso?datacenterId=%s&%s",
+		url.QueryEscape(organizationID),
+		url.QueryEscape(datacenterID),
+		paging.EnsurePaging().toQueryParameters(),
+	)
+	request, err := client.newRequestV27(requestURI, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode != http.StatusOK {
+		var apiResponse *APIResponseV2
+
+		apiResponse, err = readAPIResponseAsJSON(responseBody, statusCode)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, apiResponse.ToError("Request to list ISO images in datacenter '%s' failed with status code %d (%s): %s", datacenterID, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	images = &IsoImages{}
+	err = json.Unmarshal(responseBody, images)
+
+	return images, err
+}
+
+// AttachIso attaches an ISO image to a server's CD-ROM drive.
+//
+// isoPath is the image's path, as returned by ListIsoImages. The server must have a CD-ROM drive (see AddCdromDevice).
+func (client *Client) AttachIso(serverID string, isoPath string) error {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return err
+	}
+
+	requestURI := fmt.Sprintf("%s/server/attachIso",
+		url.QueryEscape(organizationID),
+	)
+	request, err := client.newRequestV27(requestURI, http.MethodPost, &attachIso{
+		ServerID: serverID,
+		IsoPath:  isoPath,
+	})
+	if err != nil {
+		return err
+	}
+
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return err
+	}
+
+	apiResponse, err := readAPIResponseAsJSON(responseBody, statusCode)
+	if err != nil {
+		return err
+	}
+
+	if apiResponse.ResponseCode != ResponseCodeInProgress {
+		return apiResponse.ToError("Request to attach ISO image '%s' to server '%s' failed with status code %d (%s): %s", isoPath, serverID, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	return nil
+}
+
+// DetachIso detaches the ISO image (if any) from a server's CD-ROM drive.
+func (client *Client) DetachIso(serverID string) error {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return err
+	}
+
+	requestURI := fmt.Sprintf("%s/server/detachIso",
+		url.QueryEscape(organizationID),
+	)
+	request, err := client.newRequestV27(requestURI, http.MethodPost, &detachIso{
+		ServerID: serverID,
+	})
+	if err != nil {
+		return err
+	}
+
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return err
+	}
+
+	apiResponse, err := readAPIResponseAsJSON(responseBody, statusCode)
+	if err != nil {
+		return err
+	}
+
+	if apiResponse.ResponseCode != ResponseCodeInProgress {
+		return apiResponse.ToError("Request to detach ISO image from server '%s' failed with status code %d (%s): %s", serverID, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	return nil
+}
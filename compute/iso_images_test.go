@@ -0,0 +1,84 @@
+package compute
+
+import (
+	"net/http"
+	"testing"
+)
+
+// List ISO images (successful).
+func TestClient_ListIsoImages_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			images, err := client.ListIsoImages("NA9", nil)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("IsoImages.Items.Length", 1, len(images.Items))
+
+			image := images.Items[0]
+			expect.EqualsString("IsoImage.ID", "e4d8a3c5-1b2f-4a6d-9c7e-8f0a1b2c3d4e", image.ID)
+			expect.EqualsString("IsoImage.Name", "ubuntu-16.04-server-amd64.iso", image.Name)
+			expect.EqualsString("IsoImage.Path", "/iso/ubuntu-16.04-server-amd64.iso", image.Path)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.EqualsString("Request.URL.Path", "/caas/2.7/my-organization-id/image/iso", request.URL.Path)
+			expect.EqualsString("Query.datacenterId", "NA9", request.URL.Query().Get("datacenterId"))
+
+			return http.StatusOK, listIsoImagesTestResponse
+		},
+	})
+}
+
+// Attach ISO image to server (successful).
+func TestClient_AttachIso_Success(test *testing.T) {
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			err := client.AttachIso("7b62aae5-bdbe-4595-b58d-c78f95db2a7f", "/iso/ubuntu-16.04-server-amd64.iso")
+			if err != nil {
+				test.Fatal(err)
+			}
+		},
+		Respond: testValidateJSONRequestAndRespondOK(attachIsoTestResponse, &attachIso{}, func(test *testing.T, requestBody interface{}) {
+			expect := expect(test)
+
+			request := requestBody.(*attachIso)
+			expect.EqualsString("AttachIso.ServerID", "7b62aae5-bdbe-4595-b58d-c78f95db2a7f", request.ServerID)
+			expect.EqualsString("AttachIso.IsoPath", "/iso/ubuntu-16.04-server-amd64.iso", request.IsoPath)
+		}),
+	})
+}
+
+/*
+ * Test responses.
+ */
+
+const listIsoImagesTestResponse = `
+{
+	"isoImage": [
+		{
+			"id": "e4d8a3c5-1b2f-4a6d-9c7e-8f0a1b2c3d4e",
+			"name": "ubuntu-16.04-server-amd64.iso",
+			"path": "/iso/ubuntu-16.04-server-amd64.iso",
+			"datacenterId": "NA9",
+			"fileSizeBytes": 686817280,
+			"uploadTime": "2016-03-01T10:15:00.000Z"
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 1,
+	"totalCount": 1,
+	"pageSize": 50
+}
+`
+
+const attachIsoTestResponse = `
+{
+	"operation": "ATTACH_ISO",
+	"responseCode": "IN_PROGRESS",
+	"message": "Request to attach ISO to Server 'rescue01' has been accepted. Please use appropriate Get or List API for status.",
+	"requestId": "na9/2016-01-01T00:00:00.000Z/abcd"
+}
+`
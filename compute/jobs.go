@@ -0,0 +1,482 @@
+package compute
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// JobType represents a well-known type of asynchronous operation.
+type JobType string
+
+const (
+	// JobTypeDeployServer represents the deployment of a server.
+	JobTypeDeployServer JobType = "DEPLOY_SERVER"
+
+	// JobTypeDeleteServer represents the deletion of a server.
+	JobTypeDeleteServer JobType = "DELETE_SERVER"
+
+	// JobTypeCloneServer represents the cloning of a server to create a customer image.
+	JobTypeCloneServer JobType = "CLONE_SERVER"
+
+	// JobTypeCopyCustomerImage represents the copying of a customer image to another datacenter.
+	JobTypeCopyCustomerImage JobType = "COPY_CUSTOMER_IMAGE"
+
+	// JobTypeExportCustomerImage represents the export of a customer image to an OVF package.
+	JobTypeExportCustomerImage JobType = "EXPORT_CUSTOMER_IMAGE"
+
+	// JobTypeImportCustomerImage represents the import of a customer image.
+	JobTypeImportCustomerImage JobType = "IMPORT_CUSTOMER_IMAGE"
+)
+
+// Job represents an asynchronous CloudControl operation that has been started by the client.
+//
+// A job's Id is derived from its type and target resource, so a job can be resumed (see Client.ResumeJob) after a process restart.
+type Job struct {
+	// The job Id.
+	ID string `json:"id"`
+
+	// The job type.
+	Type JobType `json:"type"`
+
+	// The Id of the resource that the job targets (for clone, copy, and import jobs, this is the Id of the new customer image).
+	ResourceID string `json:"resourceId"`
+
+	// The Id of the image export (export jobs only).
+	ExportID string `json:"exportId,omitempty"`
+
+	// The date / time when the job was started.
+	StartTime time.Time `json:"startTime"`
+
+	client *Client
+}
+
+// newJob creates a new Job for the specified operation.
+func newJob(client *Client, jobType JobType, resourceID string, exportID string) *Job {
+	jobID := string(jobType) + "/" + resourceID
+	if exportID != "" {
+		jobID += "/" + exportID
+	}
+
+	return &Job{
+		ID:         jobID,
+		Type:       jobType,
+		ResourceID: resourceID,
+		ExportID:   exportID,
+		StartTime:  time.Now().UTC(),
+		client:     client,
+	}
+}
+
+// parseJobID parses a job Id (of the form "TYPE/resourceId[/exportId]").
+func parseJobID(jobID string) (jobType JobType, resourceID string, exportID string, err error) {
+	components := strings.Split(jobID, "/")
+	if len(components) < 2 || len(components) > 3 || components[1] == "" {
+		return "", "", "", fmt.Errorf("Invalid job Id '%s' (expected 'TYPE/resourceId')", jobID)
+	}
+	jobType = JobType(components[0])
+	resourceID = components[1]
+	if len(components) == 3 {
+		exportID = components[2]
+	}
+
+	_, _, err = jobType.getWaitParameters()
+	if err != nil {
+		return "", "", "", err
+	}
+	if (jobType == JobTypeExportCustomerImage) != (exportID != "") {
+		return "", "", "", fmt.Errorf("Invalid job Id '%s' (only export jobs include an export Id)", jobID)
+	}
+
+	return
+}
+
+// getWaitParameters gets the resource type and action description used to wait for a job of this type.
+func (jobType JobType) getWaitParameters() (resourceType ResourceType, actionDescription string, err error) {
+	switch jobType {
+	case JobTypeDeployServer:
+		return ResourceTypeServer, "Deploy", nil
+	case JobTypeDeleteServer:
+		return ResourceTypeServer, "Delete", nil
+	case JobTypeCloneServer:
+		return ResourceTypeCustomerImage, "Clone", nil
+	case JobTypeCopyCustomerImage:
+		return ResourceTypeCustomerImage, "Copy", nil
+	case JobTypeExportCustomerImage:
+		return ResourceTypeCustomerImage, "Export", nil
+	case JobTypeImportCustomerImage:
+		return ResourceTypeCustomerImage, "Import", nil
+	default:
+		return 0, "", fmt.Errorf("Unrecognised job type '%s'", jobType)
+	}
+}
+
+// Wait waits for the job to complete (or the context to be done, in which case the context's error is returned).
+//
+// Returns the target resource (or nil for delete jobs). Once the job has completed, it is removed from the client's job registry (if any).
+//
+// Export jobs are only considered to have completed successfully once the image export history confirms that the export has completed (see WaitForCustomerImageExport).
+func (job *Job) Wait(ctx context.Context) (Resource, error) {
+	resourceType, actionDescription, err := job.Type.getWaitParameters()
+	if err != nil {
+		return nil, err
+	}
+
+	isDelete := job.Type == JobTypeDeleteServer
+	resource, err := job.client.waitForResourceStatusContext(ctx, resourceType, job.ResourceID, actionDescription, isDelete)
+	if err == ctx.Err() && err != nil {
+		return nil, err // Still in progress; the job can be resumed later.
+	}
+	if err == nil && job.ExportID != "" {
+		resource, err = job.client.confirmCustomerImageExport(job.ResourceID, job.ExportID, resource, nil)
+	}
+
+	registry := job.client.getJobRegistry()
+	if registry != nil {
+		registryErr := registry.Delete(job.ID)
+		if registryErr != nil && err == nil {
+			err = registryErr
+		}
+	}
+
+	return resource, err
+}
+
+// JobRegistry is a store for the jobs started by a client, enabling them to be resumed (e.g. after a process restart).
+//
+// Implementations must be safe for concurrent use.
+type JobRegistry interface {
+	// Save stores the specified job.
+	Save(job *Job) error
+
+	// Load retrieves the job (if any) with the specified Id.
+	//
+	// Returns nil if no job was found with the specified Id.
+	Load(jobID string) (*Job, error)
+
+	// Delete removes the job (if present) with the specified Id.
+	Delete(jobID string) error
+
+	// List retrieves all stored jobs (ordered by start time).
+	List() ([]*Job, error)
+}
+
+// MemoryJobRegistry is an in-memory JobRegistry.
+type MemoryJobRegistry struct {
+	lock sync.Mutex
+	jobs map[string]Job
+}
+
+// NewMemoryJobRegistry creates a new, empty, in-memory JobRegistry.
+func NewMemoryJobRegistry() *MemoryJobRegistry {
+	return &MemoryJobRegistry{
+		jobs: make(map[string]Job),
+	}
+}
+
+// Save stores the specified job.
+func (registry *MemoryJobRegistry) Save(job *Job) error {
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+
+	registry.jobs[job.ID] = *job
+
+	return nil
+}
+
+// Load retrieves the job (if any) with the specified Id.
+func (registry *MemoryJobRegistry) Load(jobID string) (*Job, error) {
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+
+	job, ok := registry.jobs[jobID]
+	if !ok {
+		return nil, nil
+	}
+
+	return &job, nil
+}
+
+// Delete removes the job (if present) with the specified Id.
+func (registry *MemoryJobRegistry) Delete(jobID string) error {
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+
+	delete(registry.jobs, jobID)
+
+	return nil
+}
+
+// List retrieves all stored jobs (ordered by start time).
+func (registry *MemoryJobRegistry) List() ([]*Job, error) {
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+
+	jobs := make([]*Job, 0, len(registry.jobs))
+	for _, job := range registry.jobs {
+		job := job
+		jobs = append(jobs, &job)
+	}
+	sortJobs(jobs)
+
+	return jobs, nil
+}
+
+var _ JobRegistry = &MemoryJobRegistry{}
+
+// FileJobRegistry is a JobRegistry that stores each job as a JSON file in a directory (so jobs survive process restarts).
+type FileJobRegistry struct {
+	// The directory where job files are stored.
+	Directory string
+
+	lock sync.Mutex
+}
+
+// NewFileJobRegistry creates a JobRegistry that stores jobs in the specified directory (which is created if it does not already exist).
+func NewFileJobRegistry(directory string) (*FileJobRegistry, error) {
+	err := os.MkdirAll(directory, 0700)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to create job registry directory '%s': %s", directory, err.Error())
+	}
+
+	return &FileJobRegistry{
+		Directory: directory,
+	}, nil
+}
+
+// Save stores the specified job.
+func (registry *FileJobRegistry) Save(job *Job) error {
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+
+	jobData, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(registry.getJobFile(job.ID), jobData, 0600)
+}
+
+// Load retrieves the job (if any) with the specified Id.
+func (registry *FileJobRegistry) Load(jobID string) (*Job, error) {
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+
+	return registry.readJobFile(registry.getJobFile(jobID))
+}
+
+// Delete removes the job (if present) with the specified Id.
+func (registry *FileJobRegistry) Delete(jobID string) error {
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+
+	err := os.Remove(registry.getJobFile(jobID))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+// List retrieves all stored jobs (ordered by start time).
+func (registry *FileJobRegistry) List() ([]*Job, error) {
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+
+	jobFiles, err := filepath.Glob(filepath.Join(registry.Directory, "*.job.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make([]*Job, 0, len(jobFiles))
+	for _, jobFile := range jobFiles {
+		job, err := registry.readJobFile(jobFile)
+		if err != nil {
+			return nil, err
+		}
+		if job != nil {
+			jobs = append(jobs, job)
+		}
+	}
+	sortJobs(jobs)
+
+	return jobs, nil
+}
+
+// getJobFile gets the name of the file used to store the job with the specified Id.
+func (registry *FileJobRegistry) getJobFile(jobID string) string {
+	return filepath.Join(registry.Directory, strings.Replace(jobID, "/", "_", -1)+".job.json")
+}
+
+// readJobFile reads a job from the specified file (returns nil if the file does not exist).
+func (registry *FileJobRegistry) readJobFile(jobFile string) (*Job, error) {
+	jobData, err := ioutil.ReadFile(jobFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	job := &Job{}
+	err = json.Unmarshal(jobData, job)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid job file '%s': %s", jobFile, err.Error())
+	}
+
+	return job, nil
+}
+
+var _ JobRegistry = &FileJobRegistry{}
+
+// sortJobs sorts jobs by start time.
+func sortJobs(jobs []*Job) {
+	sort.SliceStable(jobs, func(index1 int, index2 int) bool {
+		return jobs[index1].StartTime.Before(jobs[index2].StartTime)
+	})
+}
+
+// SetJobRegistry configures the registry where the client records the jobs it starts (pass nil to stop recording jobs).
+func (client *Client) SetJobRegistry(registry JobRegistry) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	client.jobRegistry = registry
+}
+
+// getJobRegistry gets the client's job registry (if any).
+func (client *Client) getJobRegistry() JobRegistry {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	return client.jobRegistry
+}
+
+// ListJobs retrieves the jobs recorded in the client's job registry that have not yet been waited on to completion.
+func (client *Client) ListJobs() ([]*Job, error) {
+	registry := client.getJobRegistry()
+	if registry == nil {
+		return nil, nil
+	}
+
+	jobs, err := registry.List()
+	if err != nil {
+		return nil, err
+	}
+	for _, job := range jobs {
+		job.client = client
+	}
+
+	return jobs, nil
+}
+
+// ResumeJob retrieves the job with the specified Id so that it can be waited on (e.g. after a process restart).
+//
+// If the job is not present in the client's job registry, it is reconstructed from its Id.
+func (client *Client) ResumeJob(jobID string) (*Job, error) {
+	jobType, resourceID, exportID, err := parseJobID(jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	registry := client.getJobRegistry()
+	if registry != nil {
+		job, err := registry.Load(jobID)
+		if err != nil {
+			return nil, err
+		}
+		if job != nil {
+			job.client = client
+
+			return job, nil
+		}
+	}
+
+	job := newJob(client, jobType, resourceID, exportID)
+	job.StartTime = time.Time{} // Unknown
+
+	return job, nil
+}
+
+// startJob creates a job for an operation that has just been started, and records it in the client's job registry (if any).
+func (client *Client) startJob(jobType JobType, resourceID string, exportID string) (*Job, error) {
+	job := newJob(client, jobType, resourceID, exportID)
+
+	registry := client.getJobRegistry()
+	if registry != nil {
+		err := registry.Save(job)
+		if err != nil {
+			return job, err
+		}
+	}
+
+	return job, nil
+}
+
+// DeployServerJob deploys a new virtual machine, returning a Job that can be used to wait for the deployment to complete.
+func (client *Client) DeployServerJob(serverConfiguration ServerDeploymentConfiguration) (*Job, error) {
+	serverID, err := client.DeployServer(serverConfiguration)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.startJob(JobTypeDeployServer, serverID, "")
+}
+
+// DeleteServerJob deletes an existing server, returning a Job that can be used to wait for the deletion to complete.
+func (client *Client) DeleteServerJob(serverID string) (*Job, error) {
+	err := client.DeleteServer(serverID)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.startJob(JobTypeDeleteServer, serverID, "")
+}
+
+// CloneServerJob clones a server to create a customer image, returning a Job (targeting the new image) that can be used to wait for the clone to complete.
+func (client *Client) CloneServerJob(serverID string, imageName string, imageDescription string, preventGuestOSCustomisation bool) (*Job, error) {
+	imageID, err := client.CloneServer(serverID, imageName, imageDescription, preventGuestOSCustomisation)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.startJob(JobTypeCloneServer, imageID, "")
+}
+
+// CopyCustomerImageJob copies a customer image to another datacenter, returning a Job (targeting the new image) that can be used to wait for the copy to complete.
+func (client *Client) CopyCustomerImageJob(sourceImageID string, targetDatacenterID string, newName string) (*Job, error) {
+	imageID, err := client.CopyCustomerImage(sourceImageID, targetDatacenterID, newName)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.startJob(JobTypeCopyCustomerImage, imageID, "")
+}
+
+// ExportCustomerImageJob exports a customer image to an OVF package, returning a Job that can be used to wait for the export to complete.
+func (client *Client) ExportCustomerImageJob(imageID string, ovfPackagePrefix string) (*Job, error) {
+	exportID, err := client.ExportCustomerImage(imageID, ovfPackagePrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.startJob(JobTypeExportCustomerImage, imageID, exportID)
+}
+
+// ImportCustomerImageFromURLJob imports a customer image from a web URL, returning a Job (targeting the new image) that can be used to wait for the import to complete.
+func (client *Client) ImportCustomerImageFromURLJob(configuration *ImageImportFromURLConfiguration) (*Job, error) {
+	imageID, err := client.ImportCustomerImageFromURL(configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.startJob(JobTypeImportCustomerImage, imageID, "")
+}
@@ -0,0 +1,126 @@
+package compute
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Deploy server as a job; waiting is interrupted and the job is resumed from the registry.
+func TestClient_DeployServerJob_Resume(test *testing.T) {
+	expect := expect(test)
+
+	directory, err := ioutil.TempDir("", "jobs")
+	if err != nil {
+		test.Fatal(err)
+	}
+	defer os.RemoveAll(directory)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			registry, err := NewFileJobRegistry(directory)
+			if err != nil {
+				test.Fatal(err)
+			}
+			client.SetJobRegistry(registry)
+
+			job, err := client.DeployServerJob(ServerDeploymentConfiguration{
+				Name:    "Production FTPS Server",
+				ImageID: "02250336-de2b-4e99-ab96-78511b7f8f4b",
+			})
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsString("Job.ID", "DEPLOY_SERVER/7b62aae5-bdbe-4595-b58d-c78f95db2a7f", job.ID)
+			expect.EqualsString("Job.ResourceID", "7b62aae5-bdbe-4595-b58d-c78f95db2a7f", job.ResourceID)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			_, err = job.Wait(ctx)
+			expect.IsTrue("Wait.Error is context.Canceled", err == context.Canceled)
+
+			// Simulate a process restart.
+			restartedRegistry, err := NewFileJobRegistry(directory)
+			if err != nil {
+				test.Fatal(err)
+			}
+			client.SetJobRegistry(restartedRegistry)
+
+			jobs, err := client.ListJobs()
+			if err != nil {
+				test.Fatal(err)
+			}
+			expect.EqualsInt("Jobs.Length", 1, len(jobs))
+
+			resumedJob, err := client.ResumeJob(job.ID)
+			if err != nil {
+				test.Fatal(err)
+			}
+			expect.EqualsString("ResumedJob.Type", string(JobTypeDeployServer), string(resumedJob.Type))
+			expect.EqualsString("ResumedJob.ResourceID", job.ResourceID, resumedJob.ResourceID)
+			expect.IsTrue("ResumedJob.StartTime", resumedJob.StartTime.Equal(job.StartTime))
+		},
+		Respond: testRespondOK(deployServerTestResponse),
+	})
+}
+
+// Export customer image as a job; the image returns to NORMAL, but the export history indicates that the export has failed.
+func TestClient_ExportCustomerImageJob_Failed(test *testing.T) {
+	defer testWithResourceStatusPollInterval(time.Millisecond)()
+
+	expect := expect(test)
+
+	exportHistoryChecked := false
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			job, err := client.ExportCustomerImageJob("4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b", "golden-image-1")
+			if err != nil {
+				test.Fatal(err)
+			}
+			expect.EqualsString("Job.ExportID", "b2b2a0cf-6a2d-4ba5-8d26-e4eaed6f1f2a", job.ExportID)
+
+			resource, err := job.Wait(context.Background())
+			expect.IsTrue("IsImageExportFailedError", IsImageExportFailedError(err))
+			expect.IsTrue("Resource == nil", resource == nil)
+			expect.IsTrue("ExportHistoryChecked", exportHistoryChecked)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			switch {
+			case strings.HasSuffix(request.URL.Path, "/image/exportImage"):
+				return http.StatusOK, exportCustomerImageForLifecycleTestResponse
+			case strings.HasSuffix(request.URL.Path, "/image/exportHistory"):
+				exportHistoryChecked = true
+
+				return http.StatusOK, strings.Replace(getCustomerImageExportTestResponse, ImageExportStateCompleted, ImageExportStateFailed, 1)
+			}
+
+			return http.StatusOK, getCustomerImageV24TestResponse
+		},
+	})
+}
+
+// Resume job that is not in the registry.
+func TestClient_ResumeJob_FromID(test *testing.T) {
+	expect := expect(test)
+
+	client := NewClientWithBaseAddress("https://api.example.com", "user1", "password")
+
+	job, err := client.ResumeJob("EXPORT_CUSTOMER_IMAGE/5234e5c7-01de-4411-8b6e-baeb8d91cf5d/a6c1d3f2-5b7e-4f3c-9a1d-2e8b7c6f5d4e")
+	if err != nil {
+		test.Fatal(err)
+	}
+	expect.EqualsString("Job.Type", string(JobTypeExportCustomerImage), string(job.Type))
+	expect.EqualsString("Job.ResourceID", "5234e5c7-01de-4411-8b6e-baeb8d91cf5d", job.ResourceID)
+	expect.EqualsString("Job.ExportID", "a6c1d3f2-5b7e-4f3c-9a1d-2e8b7c6f5d4e", job.ExportID)
+
+	_, err = client.ResumeJob("DEPLOY_SERVER/7b62aae5-bdbe-4595-b58d-c78f95db2a7f/extra")
+	expect.NotNil("Error (unexpected export Id)", err)
+
+	_, err = client.ResumeJob("FROB_SERVER/7b62aae5-bdbe-4595-b58d-c78f95db2a7f")
+	expect.NotNil("Error (unknown job type)", err)
+}
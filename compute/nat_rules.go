@@ -10,15 +10,65 @@ import (
 // NATRule represents a Network Address Translation (NAT) rule.
 // NAT rules are used to forward IPv4 traffic from a public IP address to a server's private IP address.
 type NATRule struct {
-	ID                string `json:"id"`
-	NetworkDomainID   string `json:"networkDomainId"`
-	InternalIPAddress string `json:"internalIp"`
-	ExternalIPAddress string `json:"externalIp"`
-	CreateTime        string `json:"createTime"`
-	State             string `json:"state"`
-	DataCenterID      string `json:"datacenterId"`
+	ID                string    `json:"id"`
+	NetworkDomainID   string    `json:"networkDomainId"`
+	InternalIPAddress string    `json:"internalIp"`
+	ExternalIPAddress string    `json:"externalIp"`
+	CreateTime        Timestamp `json:"createTime"`
+	State             string    `json:"state"`
+	DataCenterID      string    `json:"datacenterId"`
 }
 
+// GetID returns the NAT rule's Id.
+func (rule *NATRule) GetID() string {
+	if rule == nil {
+		return ""
+	}
+
+	return rule.ID
+}
+
+// GetResourceType returns the NAT rule's resource type.
+func (rule *NATRule) GetResourceType() ResourceType {
+	return ResourceTypeNATRule
+}
+
+// GetName returns the NAT rule's name (actually Id, since NAT rules don't have names).
+func (rule *NATRule) GetName() string {
+	if rule == nil {
+		return ""
+	}
+
+	return rule.ID
+}
+
+// GetState returns the NAT rule's current state.
+func (rule *NATRule) GetState() string {
+	if rule == nil {
+		return ""
+	}
+
+	return rule.State
+}
+
+// IsDeleted determines whether the NAT rule has been deleted (is nil).
+func (rule *NATRule) IsDeleted() bool {
+	return rule == nil
+}
+
+// ToEntityReference creates an EntityReference representing the NATRule.
+func (rule *NATRule) ToEntityReference() EntityReference {
+	if rule == nil {
+		return EntityReference{}
+	}
+
+	return EntityReference{
+		ID: rule.ID,
+	}
+}
+
+var _ Resource = &NATRule{}
+
 // NATRules represents a page of NATRule results.
 type NATRules struct {
 	Rules []NATRule `json:"natRule"`
@@ -90,6 +140,11 @@ func (client *Client) ListNATRules(networkDomainID string, paging *Paging) (rule
 		return nil, err
 	}
 
+	err = paging.Validate()
+	if err != nil {
+		return nil, err
+	}
+
 	requestURI := fmt.Sprintf("%s/network/natRule?networkDomainId=%s&%s",
 		url.QueryEscape(organizationID),
 		url.QueryEscape(networkDomainID),
@@ -125,6 +180,8 @@ func (client *Client) ListNATRules(networkDomainID string, paging *Paging) (rule
 // AddNATRule creates a new NAT rule to forward traffic from the specified external IPv4 address to the specified internal IPv4 address.
 // If externalIPAddress is not specified, an unallocated IPv4 address will be used (if available).
 //
+// If externalIPAddress is already used by another NAT rule or a virtual listener in the same network domain, an AddressInUseError is returned.
+//
 // This operation is synchronous.
 func (client *Client) AddNATRule(networkDomainID string, internalIPAddress string, externalIPAddress *string) (natRuleID string, err error) {
 	organizationID, err := client.getOrganizationID()
@@ -132,6 +189,13 @@ func (client *Client) AddNATRule(networkDomainID string, internalIPAddress strin
 		return "", err
 	}
 
+	if externalIPAddress != nil {
+		err = client.checkExternalAddressNotInUse(networkDomainID, *externalIPAddress, nil)
+		if err != nil {
+			return "", err
+		}
+	}
+
 	requestURI := fmt.Sprintf("%s/network/createNatRule",
 		url.QueryEscape(organizationID),
 	)
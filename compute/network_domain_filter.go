@@ -0,0 +1,141 @@
+package compute
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// NetworkDomainFilter represents server-side filtering criteria for listing network domains.
+//
+// Create a NetworkDomainFilter by calling NewNetworkDomainFilter, then chain calls to its WithXXX methods:
+//
+//	filter := compute.NewNetworkDomainFilter().WithDatacenterID("AU9").WithState(compute.ResourceStatusNormal)
+type NetworkDomainFilter struct {
+	filter url.Values
+}
+
+// NewNetworkDomainFilter creates a new NetworkDomainFilter (which initially matches all network domains).
+func NewNetworkDomainFilter() *NetworkDomainFilter {
+	return &NetworkDomainFilter{
+		filter: url.Values{},
+	}
+}
+
+// WithName restricts the filter to network domains with the specified name.
+func (filter *NetworkDomainFilter) WithName(name string) *NetworkDomainFilter {
+	return filter.with("name", name)
+}
+
+// WithDatacenterID restricts the filter to network domains in the specified datacenter.
+func (filter *NetworkDomainFilter) WithDatacenterID(datacenterID string) *NetworkDomainFilter {
+	return filter.with("datacenterId", datacenterID)
+}
+
+// WithState restricts the filter to network domains in the specified state (e.g. ResourceStatusNormal).
+func (filter *NetworkDomainFilter) WithState(state string) *NetworkDomainFilter {
+	return filter.with("state", state)
+}
+
+// with sets a filter field (replacing any existing value for that field).
+func (filter *NetworkDomainFilter) with(field string, value string) *NetworkDomainFilter {
+	if filter.filter == nil {
+		filter.filter = url.Values{}
+	}
+	filter.filter.Set(field, value)
+
+	return filter
+}
+
+// toQueryParameters converts the filter to URL query parameters (a nil filter matches all network domains).
+func (filter *NetworkDomainFilter) toQueryParameters() url.Values {
+	query := url.Values{}
+	if filter == nil {
+		return query
+	}
+
+	for field, values := range filter.filter {
+		query[field] = append([]string(nil), values...)
+	}
+
+	return query
+}
+
+// ListNetworkDomainsWithFilter retrieves a page of the network domains that match the specified filter.
+//
+// Pass a nil filter to match all network domains.
+func (client *Client) ListNetworkDomainsWithFilter(filter *NetworkDomainFilter, paging *Paging) (domains *NetworkDomains, err error) {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return nil, err
+	}
+
+	err = paging.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	queryParameters := paging.EnsurePaging().toQueryParameters()
+	query := filter.toQueryParameters()
+	if len(query) > 0 {
+		queryParameters = query.Encode() + "&" + queryParameters
+	}
+
+	requestURI := fmt.Sprintf("%s/network/networkDomain?%s",
+		url.QueryEscape(organizationID),
+		queryParameters,
+	)
+	request, err := client.newRequestV24(requestURI, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode != http.StatusOK {
+		var apiResponse *APIResponseV2
+
+		apiResponse, err = readAPIResponseAsJSON(responseBody, statusCode)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, apiResponse.ToError("Request to list network domains failed with status code %d (%s): %s", statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	domains = &NetworkDomains{}
+	err = json.Unmarshal(responseBody, domains)
+	if err != nil {
+		return nil, err
+	}
+	domains.listPage = func(client *Client, paging *Paging) (*NetworkDomains, error) {
+		return client.ListNetworkDomainsWithFilter(filter, paging)
+	}
+
+	return domains, nil
+}
+
+// FindNetworkDomainByName finds the network domain (if any) with the specified name in the specified datacenter.
+//
+// Returns nil if no matching network domain was found, or an error if more than one network domain matches.
+func (client *Client) FindNetworkDomainByName(name string, datacenterID string) (domain *NetworkDomain, err error) {
+	filter := NewNetworkDomainFilter().WithName(name).WithDatacenterID(datacenterID)
+
+	domains, err := client.ListNetworkDomainsWithFilter(filter, nil)
+	if err != nil {
+		return nil, err
+	}
+	if domains.IsEmpty() {
+		return nil, nil // No matching network domain was found.
+	}
+
+	if len(domains.Domains) != 1 {
+		return nil, fmt.Errorf("Found multiple network domains (%d) named '%s' in data centre '%s'.", domains.TotalCount, name, datacenterID)
+	}
+
+	return &domains.Domains[0], nil
+}
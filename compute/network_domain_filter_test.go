@@ -0,0 +1,102 @@
+package compute
+
+import (
+	"net/http"
+	"testing"
+)
+
+// List network domains with filter (successful).
+func TestClient_ListNetworkDomainsWithFilter_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			filter := NewNetworkDomainFilter().
+				WithName("Domain 1").
+				WithDatacenterID("AU9").
+				WithState(ResourceStatusNormal)
+
+			domains, err := client.ListNetworkDomainsWithFilter(filter, nil)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("Domains.Length", 1, len(domains.Domains))
+			expect.EqualsString("Domains[0].Name", "Domain 1", domains.Domains[0].Name)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			query := request.URL.Query()
+			expect.EqualsString("Query.name", "Domain 1", query.Get("name"))
+			expect.EqualsString("Query.datacenterId", "AU9", query.Get("datacenterId"))
+			expect.EqualsString("Query.state", "NORMAL", query.Get("state"))
+			expect.EqualsString("Query.pageNumber", "1", query.Get("pageNumber"))
+
+			return http.StatusOK, listNetworkDomainsAU9TestResponse
+		},
+	})
+}
+
+// Find network domain by name (not found).
+func TestClient_FindNetworkDomainByName_NotFound(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			domain, err := client.FindNetworkDomainByName("Domain 3", "AU9")
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.IsTrue("Domain is nil", domain == nil)
+		},
+		Respond: testRespondOK(findNetworkDomainByNameNotFoundTestResponse),
+	})
+}
+
+// Find network domain by name (multiple matches).
+func TestClient_FindNetworkDomainByName_Multiple(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			_, err := client.FindNetworkDomainByName("Domain 1", "AU9")
+			expect.NotNil("Error", err)
+		},
+		Respond: testRespondOK(findNetworkDomainByNameMultipleTestResponse),
+	})
+}
+
+/*
+ * Test responses.
+ */
+
+const findNetworkDomainByNameNotFoundTestResponse = `
+{
+	"networkDomain": [],
+	"pageNumber": 1,
+	"pageCount": 0,
+	"totalCount": 0,
+	"pageSize": 50
+}
+`
+
+const findNetworkDomainByNameMultipleTestResponse = `
+{
+	"networkDomain": [
+		{
+			"name": "Domain 1",
+			"id": "75ab2a57-b75e-4ec6-945a-e8c60164fdf6",
+			"datacenterId": "AU9"
+		},
+		{
+			"name": "Domain 1",
+			"id": "3c4f5c9e-0d2a-4f1b-8a3e-6b7c8d9e0f1a",
+			"datacenterId": "AU9"
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 2,
+	"totalCount": 2,
+	"pageSize": 50
+}
+`
@@ -0,0 +1,92 @@
+package compute
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// VLANNic represents a server network adapter (NIC) that is attached to a VLAN.
+type VLANNic struct {
+	// The network adapter Id.
+	ID string `json:"id"`
+
+	// The network adapter's MAC address.
+	MACAddress string `json:"macAddress"`
+
+	// The network adapter's private IPv4 address.
+	PrivateIPv4Address string `json:"privateIpv4"`
+
+	// The network adapter's IPv6 address.
+	IPv6Address string `json:"ipv6"`
+
+	// The network adapter type (e.g. E1000, VMXNET3).
+	AdapterType string `json:"networkAdapter"`
+
+	// The Id of the VLAN to which the network adapter is attached.
+	VLANID string `json:"vlanId"`
+
+	// The name of the VLAN to which the network adapter is attached.
+	VLANName string `json:"vlanName"`
+
+	// The Id of the server that owns the network adapter.
+	ServerID string `json:"serverId"`
+
+	// The name of the server that owns the network adapter.
+	ServerName string `json:"serverName"`
+
+	// The network adapter's current state.
+	State string `json:"state"`
+}
+
+// VLANNics represents a page of VLANNic results.
+type VLANNics struct {
+	Items []VLANNic `json:"nic"`
+
+	PagedResult
+}
+
+// ListNicsInVLAN retrieves a page of the server network adapters (NICs) attached to the specified VLAN.
+func (client *Client) ListNicsInVLAN(vlanID string, paging *Paging) (nics *VLANNics, err error) {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return nil, err
+	}
+
+	err = paging.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	requestURI := fmt.Sprintf("%s/server/nic?vlanId=%s&%s",
+		url.QueryEscape(organizationID),
+		url.QueryEscape(vlanID),
+		paging.EnsurePaging().toQueryParameters(),
+	)
+	request, err := client.newRequestV24(requestURI, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode != http.StatusOK {
+		var apiResponse *APIResponseV2
+
+		apiResponse, err = readAPIResponseAsJSON(responseBody, statusCode)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, apiResponse.ToError("Request to list network adapters in VLAN '%s' failed with status code %d (%s): %s", vlanID, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	nics = &VLANNics{}
+	err = json.Unmarshal(responseBody, nics)
+
+	return nics, err
+}
@@ -0,0 +1,79 @@
+package compute
+
+import (
+	"net/http"
+	"testing"
+)
+
+// List network adapters in VLAN (successful).
+func TestClient_ListNicsInVLAN_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			nics, err := client.ListNicsInVLAN("0e56433f-d808-4669-821d-812769517ff8", nil)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("NICs.PageCount", 2, nics.PageCount)
+			expect.EqualsInt("NICs.Items.Length", 2, len(nics.Items))
+
+			nic := nics.Items[0]
+			expect.EqualsString("NIC[0].ID", "5e869800-df7b-4626-bcbf-8643b8be11fd", nic.ID)
+			expect.EqualsString("NIC[0].ServerID", "b6a4fd3b-7c2e-4d2d-8f25-ad3a3a7e0f3a", nic.ServerID)
+			expect.EqualsString("NIC[0].ServerName", "web01", nic.ServerName)
+			expect.EqualsString("NIC[0].PrivateIPv4Address", "10.0.3.12", nic.PrivateIPv4Address)
+			expect.EqualsString("NIC[0].AdapterType", NetworkAdapterTypeVMXNET3, nic.AdapterType)
+
+			nic = nics.Items[1]
+			expect.EqualsString("NIC[1].ServerName", "db01", nic.ServerName)
+			expect.EqualsString("NIC[1].PrivateIPv4Address", "10.0.3.13", nic.PrivateIPv4Address)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.EqualsString("Request.URL.Path", "/caas/2.4/my-organization-id/server/nic", request.URL.Path)
+			expect.EqualsString("Request.URL.Query.vlanId", "0e56433f-d808-4669-821d-812769517ff8", request.URL.Query().Get("vlanId"))
+
+			return http.StatusOK, listNicsInVLANTestResponse
+		},
+	})
+}
+
+/*
+ * Test responses.
+ */
+
+const listNicsInVLANTestResponse = `
+{
+	"nic": [
+		{
+			"id": "5e869800-df7b-4626-bcbf-8643b8be11fd",
+			"macAddress": "00:50:56:bd:54:a2",
+			"privateIpv4": "10.0.3.12",
+			"ipv6": "2607:f480:111:1575:c47:7479:2af8:3f1a",
+			"networkAdapter": "VMXNET3",
+			"vlanId": "0e56433f-d808-4669-821d-812769517ff8",
+			"vlanName": "vlan1",
+			"serverId": "b6a4fd3b-7c2e-4d2d-8f25-ad3a3a7e0f3a",
+			"serverName": "web01",
+			"state": "NORMAL"
+		},
+		{
+			"id": "fd9c6a2e-0b1f-4c53-91b6-1f8b3c4c72b0",
+			"macAddress": "00:50:56:bd:54:a3",
+			"privateIpv4": "10.0.3.13",
+			"ipv6": "2607:f480:111:1575:c47:7479:2af8:3f1b",
+			"networkAdapter": "E1000",
+			"vlanId": "0e56433f-d808-4669-821d-812769517ff8",
+			"vlanName": "vlan1",
+			"serverId": "7a1f0b9a-5e3c-4a8d-b7e2-2f5d8c9e1a44",
+			"serverName": "db01",
+			"state": "NORMAL"
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 2,
+	"totalCount": 2,
+	"pageSize": 50
+}
+`
@@ -0,0 +1,132 @@
+package compute
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// SupportedOperatingSystem represents an operating system supported by CloudControl in a specific datacenter.
+type SupportedOperatingSystem struct {
+	// The operating system Id (e.g. "CENTOS764").
+	ID string `json:"id"`
+
+	// The operating system family (e.g. "UNIX").
+	Family string `json:"family"`
+
+	// The operating system display-name.
+	DisplayName string `json:"displayName"`
+
+	// Does CloudControl support guest OS customisation for the operating system?
+	SupportsGuestOSCustomization bool `json:"supportsGuestOsCustomization"`
+
+	// The default network adapter type for servers running the operating system (e.g. "VMXNET3").
+	DefaultNetworkAdapter string `json:"defaultNetworkAdapter"`
+
+	// The network adapter types supported for servers running the operating system.
+	SupportedNetworkAdapters []string `json:"supportedNetworkAdapter"`
+}
+
+// ToOperatingSystem creates an OperatingSystem representing the supported operating system.
+func (operatingSystem *SupportedOperatingSystem) ToOperatingSystem() OperatingSystem {
+	return OperatingSystem{
+		ID:          operatingSystem.ID,
+		Family:      operatingSystem.Family,
+		DisplayName: operatingSystem.DisplayName,
+	}
+}
+
+// SupportsNetworkAdapter determines whether the operating system supports the specified network adapter type (case-insensitive).
+func (operatingSystem *SupportedOperatingSystem) SupportsNetworkAdapter(adapterType string) bool {
+	for _, supportedAdapterType := range operatingSystem.SupportedNetworkAdapters {
+		if strings.EqualFold(supportedAdapterType, adapterType) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SupportedOperatingSystems represents a list of operating systems supported in a datacenter.
+type SupportedOperatingSystems []SupportedOperatingSystem
+
+// GetByID retrieves the operating system (if any) with the specified Id (case-insensitive).
+// Returns nil if the operating system is not in the list.
+func (operatingSystems SupportedOperatingSystems) GetByID(id string) *SupportedOperatingSystem {
+	for index := range operatingSystems {
+		if strings.EqualFold(operatingSystems[index].ID, id) {
+			return &operatingSystems[index]
+		}
+	}
+
+	return nil
+}
+
+// ValidateID ensures that the specified operating system Id is in the list.
+func (operatingSystems SupportedOperatingSystems) ValidateID(id string) error {
+	if operatingSystems.GetByID(id) == nil {
+		return fmt.Errorf("Operating system Id '%s' is not supported in this datacenter", id)
+	}
+
+	return nil
+}
+
+// operatingSystemsPage represents a page of results from the "List Operating Systems" API.
+type operatingSystemsPage struct {
+	Items []SupportedOperatingSystem `json:"operatingSystem"`
+
+	PagedResult
+}
+
+// ListOperatingSystems retrieves all operating systems supported in the specified datacenter.
+//
+// Use the result to validate operating system Ids (e.g. for image import or uncustomised server deployment) before calling the API.
+func (client *Client) ListOperatingSystems(datacenterID string) (operatingSystems SupportedOperatingSystems, err error) {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return nil, err
+	}
+
+	err = ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		requestURI := fmt.Sprintf("%s/infrastructure/operatingSystem?datacenterId=%s&%s",
+			url.QueryEscape(organizationID),
+			url.QueryEscape(datacenterID),
+			paging.toQueryParameters(),
+		)
+		request, err := client.newRequestV24(requestURI, http.MethodGet, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		responseBody, statusCode, err := client.executeRequest(request)
+		if err != nil {
+			return nil, err
+		}
+
+		if statusCode != http.StatusOK {
+			apiResponse, err := readAPIResponseAsJSON(responseBody, statusCode)
+			if err != nil {
+				return nil, err
+			}
+
+			return nil, apiResponse.ToError("Request to list operating systems in datacenter '%s' failed with status code %d (%s): %s", datacenterID, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+		}
+
+		page := &operatingSystemsPage{}
+		err = json.Unmarshal(responseBody, page)
+		if err != nil {
+			return nil, err
+		}
+
+		operatingSystems = append(operatingSystems, page.Items...)
+
+		return &page.PagedResult, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return operatingSystems, nil
+}
@@ -0,0 +1,97 @@
+package compute
+
+import (
+	"net/http"
+	"testing"
+)
+
+// Operating system family helpers.
+func TestOperatingSystem_Family(test *testing.T) {
+	expect := expect(test)
+
+	windows := OperatingSystem{ID: "WIN2012R2S64", Family: "WINDOWS", DisplayName: "WIN2012R2S/64"}
+	expect.IsTrue("Windows.IsWindows", windows.IsWindows())
+	expect.IsFalse("Windows.IsUnix", windows.IsUnix())
+	expect.IsFalse("Windows.IsLinux", windows.IsLinux())
+	expect.IsTrue("Windows.NeedsWindowsLicense", windows.NeedsWindowsLicense())
+	expect.IsTrue("Windows.RequiresAdministratorPassword", windows.RequiresAdministratorPassword())
+
+	centOS := OperatingSystem{ID: "CENTOS764", Family: "UNIX", DisplayName: "CENTOS7/64"}
+	expect.IsFalse("CentOS.IsWindows", centOS.IsWindows())
+	expect.IsTrue("CentOS.IsUnix", centOS.IsUnix())
+	expect.IsTrue("CentOS.IsLinux", centOS.IsLinux())
+	expect.IsFalse("CentOS.NeedsWindowsLicense", centOS.NeedsWindowsLicense())
+	expect.IsFalse("CentOS.RequiresAdministratorPassword", centOS.RequiresAdministratorPassword())
+
+	solaris := OperatingSystem{ID: "SOLARIS1064", Family: "UNIX"}
+	expect.IsTrue("Solaris.IsUnix", solaris.IsUnix())
+	expect.IsFalse("Solaris.IsLinux", solaris.IsLinux())
+
+	// Family not populated.
+	expect.IsTrue("NoFamily.IsWindows", OperatingSystem{ID: "WIN2016DC64"}.IsWindows())
+	expect.IsTrue("NoFamily.IsLinux", OperatingSystem{ID: "UBUNTU1664"}.IsLinux())
+	expect.IsFalse("Empty.IsUnix", OperatingSystem{}.IsUnix())
+}
+
+// List operating systems (successful).
+func TestClient_ListOperatingSystems_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			operatingSystems, err := client.ListOperatingSystems("AU9")
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("OperatingSystems.Length", 2, len(operatingSystems))
+
+			operatingSystem := operatingSystems.GetByID("centos764")
+			expect.NotNil("OperatingSystem", operatingSystem)
+			expect.EqualsString("OperatingSystem.Family", "UNIX", operatingSystem.Family)
+			expect.EqualsString("OperatingSystem.DefaultNetworkAdapter", "VMXNET3", operatingSystem.DefaultNetworkAdapter)
+			expect.IsTrue("OperatingSystem.SupportsGuestOSCustomization", operatingSystem.SupportsGuestOSCustomization)
+			expect.IsTrue("OperatingSystem.SupportsNetworkAdapter(E1000)", operatingSystem.SupportsNetworkAdapter("e1000"))
+
+			expect.IsTrue("ValidateID(WIN2012R2S64)", operatingSystems.ValidateID("WIN2012R2S64") == nil)
+			expect.NotNil("ValidateID(OS2WARP)", operatingSystems.ValidateID("OS2WARP"))
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.EqualsString("Request.URL.Path", "/caas/2.4/my-organization-id/infrastructure/operatingSystem", request.URL.Path)
+			expect.EqualsString("Request.URL.Query.datacenterId", "AU9", request.URL.Query().Get("datacenterId"))
+
+			return http.StatusOK, listOperatingSystemsTestResponse
+		},
+	})
+}
+
+/*
+ * Test responses.
+ */
+
+const listOperatingSystemsTestResponse = `
+{
+	"operatingSystem": [
+		{
+			"id": "CENTOS764",
+			"family": "UNIX",
+			"displayName": "CENTOS7/64",
+			"supportsGuestOsCustomization": true,
+			"defaultNetworkAdapter": "VMXNET3",
+			"supportedNetworkAdapter": ["E1000", "VMXNET3"]
+		},
+		{
+			"id": "WIN2012R2S64",
+			"family": "WINDOWS",
+			"displayName": "WIN2012R2S/64",
+			"supportsGuestOsCustomization": true,
+			"defaultNetworkAdapter": "E1000",
+			"supportedNetworkAdapter": ["E1000", "E1000E", "VMXNET3"]
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 2,
+	"totalCount": 2,
+	"pageSize": 250
+}
+`
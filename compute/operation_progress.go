@@ -0,0 +1,101 @@
+package compute
+
+import "fmt"
+
+// OperationProgress represents the progress of a resource's pending operation (e.g. deploying or cloning a server, or importing an image).
+type OperationProgress struct {
+	// The action being performed (e.g. DEPLOY_SERVER).
+	Action string `json:"action"`
+
+	// The date / time that the action was requested.
+	RequestTime string `json:"requestTime"`
+
+	// The name of the user who requested the action.
+	UserName string `json:"userName"`
+
+	// The total number of steps in the action (if reported).
+	NumberOfSteps int `json:"numberOfSteps,omitempty"`
+
+	// The date / time that the progress was last updated.
+	UpdateTime string `json:"updateTime,omitempty"`
+
+	// The current step (if reported).
+	Step *OperationProgressStep `json:"step,omitempty"`
+}
+
+// OperationProgressStep represents the current step of a resource's pending operation.
+type OperationProgressStep struct {
+	// The step name.
+	Name string `json:"name"`
+
+	// The step number.
+	Number int `json:"number"`
+
+	// The percentage of the step that has been completed (if reported).
+	PercentComplete int `json:"percentComplete,omitempty"`
+}
+
+// ServerProgress represents the progress of a server's pending operation (if any).
+//
+// Deprecated: ServerProgress is an alias for OperationProgress (which is also used for customer images); use OperationProgress instead.
+type ServerProgress = OperationProgress
+
+// ServerProgressStep represents the current step of a server's pending operation.
+//
+// Deprecated: ServerProgressStep is an alias for OperationProgressStep; use OperationProgressStep instead.
+type ServerProgressStep = OperationProgressStep
+
+// GetPercentComplete estimates the percentage (0-100) of the overall operation that has been completed.
+//
+// If the total number of steps is not reported, this is the percentage of the current step that has been completed.
+func (progress *OperationProgress) GetPercentComplete() int {
+	if progress == nil || progress.Step == nil {
+		return 0
+	}
+
+	if progress.NumberOfSteps <= 0 {
+		return progress.Step.PercentComplete
+	}
+
+	completedSteps := progress.Step.Number - 1
+	if completedSteps < 0 {
+		completedSteps = 0
+	}
+
+	percentComplete := (completedSteps*100 + progress.Step.PercentComplete) / progress.NumberOfSteps
+	if percentComplete > 100 {
+		percentComplete = 100
+	}
+
+	return percentComplete
+}
+
+// GetServerProgress retrieves the progress of the specified server's pending operation.
+//
+// Returns nil if the server has no pending operation.
+func (client *Client) GetServerProgress(serverID string) (*OperationProgress, error) {
+	server, err := client.GetServer(serverID)
+	if err != nil {
+		return nil, err
+	}
+	if server == nil {
+		return nil, fmt.Errorf("No server was found with Id '%s'", serverID)
+	}
+
+	return server.Progress, nil
+}
+
+// GetImageProgress retrieves the progress of the specified customer image's pending operation (e.g. import, copy, or clone).
+//
+// Returns nil if the image has no pending operation.
+func (client *Client) GetImageProgress(imageID string) (*OperationProgress, error) {
+	image, err := client.GetCustomerImage(imageID)
+	if err != nil {
+		return nil, err
+	}
+	if image == nil {
+		return nil, fmt.Errorf("No customer image was found with Id '%s'", imageID)
+	}
+
+	return image.Progress, nil
+}
@@ -0,0 +1,86 @@
+package compute
+
+import (
+	"testing"
+)
+
+// Operation progress (overall percentage complete).
+func TestOperationProgress_GetPercentComplete(test *testing.T) {
+	expect := expect(test)
+
+	var progress *OperationProgress
+	expect.EqualsInt("Nil", 0, progress.GetPercentComplete())
+
+	progress = &OperationProgress{Action: "CLONE_SERVER"}
+	expect.EqualsInt("NoStep", 0, progress.GetPercentComplete())
+
+	progress.Step = &OperationProgressStep{Name: "COPY_DISKS", Number: 1, PercentComplete: 40}
+	expect.EqualsInt("NoStepCount", 40, progress.GetPercentComplete())
+
+	progress.NumberOfSteps = 4
+	progress.Step = &OperationProgressStep{Name: "CUSTOMIZE_GUEST", Number: 3, PercentComplete: 50}
+	expect.EqualsInt("Step3Of4", 62, progress.GetPercentComplete())
+}
+
+// Operation progress (server-specific type names are retained for compatibility).
+func TestServerProgress_Alias(test *testing.T) {
+	var progress *ServerProgress = &OperationProgress{
+		NumberOfSteps: 2,
+		Step:          &ServerProgressStep{Name: "DEPLOY", Number: 2, PercentComplete: 50},
+	}
+
+	server := &Server{Progress: progress}
+	expect(test).EqualsInt("PercentComplete", 75, server.Progress.GetPercentComplete())
+}
+
+// Get customer image progress (successful).
+func TestClient_GetImageProgress_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			progress, err := client.GetImageProgress("4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b")
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.NotNil("Progress", progress)
+			expect.EqualsString("Progress.Action", "IMPORT_IMAGE", progress.Action)
+			expect.EqualsInt("Progress.NumberOfSteps", 2, progress.NumberOfSteps)
+			expect.NotNil("Progress.Step", progress.Step)
+			expect.EqualsString("Progress.Step.Name", "COPY_FILES", progress.Step.Name)
+			expect.EqualsInt("Progress.PercentComplete", 25, progress.GetPercentComplete())
+		},
+		Respond: testRespondOK(getPendingCustomerImageTestResponse),
+	})
+}
+
+/*
+ * Test responses.
+ */
+
+const getPendingCustomerImageTestResponse = `
+{
+	"id": "4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b",
+	"name": "imported-image",
+	"description": "",
+	"datacenterId": "NA9",
+	"cpu": { "count": 2, "speed": "STANDARD", "coresPerSocket": 1 },
+	"memoryGb": 4,
+	"disk": [],
+	"createTime": "2016-03-01T10:15:00.000Z",
+	"state": "PENDING_ADD",
+	"progress": {
+		"action": "IMPORT_IMAGE",
+		"requestTime": "2016-03-01T10:15:00.000Z",
+		"userName": "devuser1",
+		"numberOfSteps": 2,
+		"updateTime": "2016-03-01T10:17:00.000Z",
+		"step": {
+			"name": "COPY_FILES",
+			"number": 1,
+			"percentComplete": 50
+		}
+	}
+}
+`
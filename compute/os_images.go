@@ -18,22 +18,34 @@ type OSImage struct {
 	MemoryGB        int                  `json:"memoryGb"`
 	Disks           []VirtualMachineDisk `json:"disk"`
 	State           string               `json:"state"`
-	CreateTime      string               `json:"createTime"`
+	CreateTime      Timestamp            `json:"createTime"`
 	OSImageKey      string               `json:"osImageKey"`
 }
 
 // GetID retrieves the image ID.
 func (image *OSImage) GetID() string {
+	if image == nil {
+		return ""
+	}
+
 	return image.ID
 }
 
 // GetName retrieves the image name.
 func (image *OSImage) GetName() string {
+	if image == nil {
+		return ""
+	}
+
 	return image.Name
 }
 
 // ToEntityReference creates an EntityReference representing the OSImage.
 func (image *OSImage) ToEntityReference() EntityReference {
+	if image == nil {
+		return EntityReference{}
+	}
+
 	return EntityReference{
 		ID:   image.ID,
 		Name: image.Name,
@@ -49,6 +61,10 @@ func (image *OSImage) GetResourceType() ResourceType {
 
 // GetState retrieves the resource's current state (e.g. ResourceStatusNormal, etc).
 func (image *OSImage) GetState() string {
+	if image == nil {
+		return ""
+	}
+
 	return image.State
 }
 
@@ -89,20 +105,40 @@ var _ Image = &OSImage{}
 
 // OSImages represents a page of OSImage results.
 type OSImages struct {
-	// The current page of network domains.
+	// The current page of OS images.
 	Images []OSImage `json:"osImage"`
 
-	// The current page number.
-	PageNumber int `json:"pageNumber"`
+	PagedResult
+
+	// Retrieves another page of results using the same criteria as this page (nil if the page was not retrieved via the CloudControl API).
+	listPage func(client *Client, paging *Paging) (*OSImages, error)
+}
 
-	// The number of OS images in the current page of results.
-	PageCount int `json:"pageCount"`
+// ForEach calls fn for each OS image in this page and each subsequent page of results (subsequent pages are retrieved using the specified client).
+//
+// Iteration stops at the first error returned by fn (or encountered while retrieving a page of results).
+func (page *OSImages) ForEach(client *Client, fn func(image *OSImage) error) error {
+	for {
+		for index := range page.Images {
+			err := fn(&page.Images[index])
+			if err != nil {
+				return err
+			}
+		}
 
-	// The total number of OS images that match the requested filter criteria (if any).
-	TotalCount int `json:"totalCount"`
+		if page.IsLastPage() || page.listPage == nil {
+			return nil
+		}
 
-	// The maximum number of OS images per page.
-	PageSize int `json:"pageSize"`
+		nextPage, err := page.listPage(client, page.NextPage())
+		if err != nil {
+			return err
+		}
+		if nextPage == nil {
+			return nil
+		}
+		page = nextPage
+	}
 }
 
 // GetOSImage retrieves a specific OS image by Id.
@@ -179,7 +215,7 @@ func (client *Client) FindOSImage(name string, dataCenterID string) (image *OSIm
 			return nil, err
 		}
 
-		return nil, fmt.Errorf("Request to find OS image '%s' in data centre '%s' failed with status code %d (%s): %s", name, dataCenterID, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+		return nil, apiResponse.ToError("Request to find OS image '%s' in data centre '%s' failed with status code %d (%s): %s", name, dataCenterID, statusCode, apiResponse.ResponseCode, apiResponse.Message)
 	}
 
 	images := &OSImages{}
@@ -206,6 +242,11 @@ func (client *Client) ListOSImagesInDatacenter(dataCenterID string, paging *Pagi
 		return nil, err
 	}
 
+	err = paging.Validate()
+	if err != nil {
+		return nil, err
+	}
+
 	requestURI := fmt.Sprintf("%s/image/osImage?datacenterId=%s&%s",
 		url.QueryEscape(organizationID),
 		url.QueryEscape(dataCenterID),
@@ -229,11 +270,74 @@ func (client *Client) ListOSImagesInDatacenter(dataCenterID string, paging *Pagi
 			return nil, err
 		}
 
-		return nil, fmt.Errorf("Request to list OS images in data centre '%s' failed with status code %d (%s): %s", dataCenterID, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+		return nil, apiResponse.ToError("Request to list OS images in data centre '%s' failed with status code %d (%s): %s", dataCenterID, statusCode, apiResponse.ResponseCode, apiResponse.Message)
 	}
 
 	images = &OSImages{}
 	err = json.Unmarshal(responseBody, images)
+	images.listPage = func(client *Client, paging *Paging) (*OSImages, error) {
+		return client.ListOSImagesInDatacenter(dataCenterID, paging)
+	}
 
 	return
 }
+
+// FindOSImagesByOperatingSystem finds all OS images in a given data centre that match the specified operating system family (e.g. "UNIX") and / or Id (e.g. "CENTOS764").
+//
+// Leave operatingSystemFamily or operatingSystemID empty to match any value.
+func (client *Client) FindOSImagesByOperatingSystem(operatingSystemFamily string, operatingSystemID string, dataCenterID string) (images []OSImage, err error) {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return nil, err
+	}
+
+	filter := url.Values{}
+	filter.Set("datacenterId", dataCenterID)
+	if operatingSystemFamily != "" {
+		filter.Set("operatingSystemFamily", operatingSystemFamily)
+	}
+	if operatingSystemID != "" {
+		filter.Set("operatingSystemId", operatingSystemID)
+	}
+
+	err = ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		requestURI := fmt.Sprintf("%s/image/osImage?%s&%s",
+			url.QueryEscape(organizationID),
+			filter.Encode(),
+			paging.toQueryParameters(),
+		)
+		request, err := client.newRequestV22(requestURI, http.MethodGet, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		responseBody, statusCode, err := client.executeRequest(request)
+		if err != nil {
+			return nil, err
+		}
+
+		if statusCode != http.StatusOK {
+			apiResponse, err := readAPIResponseAsJSON(responseBody, statusCode)
+			if err != nil {
+				return nil, err
+			}
+
+			return nil, apiResponse.ToError("Request to find OS images (family '%s', Id '%s') in data centre '%s' failed with status code %d (%s): %s", operatingSystemFamily, operatingSystemID, dataCenterID, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+		}
+
+		page := &OSImages{}
+		err = json.Unmarshal(responseBody, page)
+		if err != nil {
+			return nil, err
+		}
+
+		images = append(images, page.Images...)
+
+		return &page.PagedResult, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return images, nil
+}
@@ -73,6 +73,48 @@ var findOSImageTestResponse = `
 	}
 `
 
+var findOSImagesByOperatingSystemPage1TestResponse = `
+	{
+		"osImage": [
+			{
+				"id": "7e68acb4-bbb8-4206-b30b-0e6c878056bc",
+				"name": "CentOS 7 64-bit 2 CPU",
+				"datacenterId": "AU9",
+				"operatingSystem": {
+					"id": "CENTOS764",
+					"displayName": "CENTOS7/64",
+					"family": "UNIX"
+				}
+			}
+		],
+		"pageNumber": 1,
+		"pageCount": 1,
+		"totalCount": 2,
+		"pageSize": 1
+	}
+`
+
+var findOSImagesByOperatingSystemPage2TestResponse = `
+	{
+		"osImage": [
+			{
+				"id": "b2d8cc7e-1bb1-4b17-a1ad-f6f1e5d6f1a2",
+				"name": "Ubuntu 14.04 64-bit 2 CPU",
+				"datacenterId": "AU9",
+				"operatingSystem": {
+					"id": "UBUNTU1464",
+					"displayName": "UBUNTU14/64",
+					"family": "UNIX"
+				}
+			}
+		],
+		"pageNumber": 2,
+		"pageCount": 1,
+		"totalCount": 2,
+		"pageSize": 1
+	}
+`
+
 func verifyFindOSImageTestResponse(test *testing.T, image *OSImage) {
 	expect := expect(test)
 
@@ -98,6 +140,36 @@ func verifyFindOSImageTestResponse(test *testing.T, image *OSImage) {
 	expect.EqualsInt("OSImage.Disks[0].SizeGB", 10, disk1.SizeGB)
 	expect.EqualsString("OSImage.Disks[0].Speed", "STANDARD", disk1.Speed)
 
-	expect.EqualsString("OSImage.CreateTime", "2015-10-26T10:34:40.000Z", image.CreateTime)
+	expect.EqualsString("OSImage.CreateTime", "2015-10-26T10:34:40.000Z", image.CreateTime.Raw)
 	expect.EqualsString("OSImage.OSImageKey", "T-CENT-7-64-2-4-10", image.OSImageKey)
 }
+
+// Find OS images by operating system in data centre (successful, multiple pages).
+func TestClient_FindOSImagesByOperatingSystem_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			images, err := client.FindOSImagesByOperatingSystem("UNIX", "", "AU9")
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("Images.Length", 2, len(images))
+			expect.EqualsString("Images[0].ID", "7e68acb4-bbb8-4206-b30b-0e6c878056bc", images[0].ID)
+			expect.EqualsString("Images[1].ID", "b2d8cc7e-1bb1-4b17-a1ad-f6f1e5d6f1a2", images[1].ID)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			query := request.URL.Query()
+			expect.EqualsString("Request.DatacenterID", "AU9", query.Get("datacenterId"))
+			expect.EqualsString("Request.OperatingSystemFamily", "UNIX", query.Get("operatingSystemFamily"))
+			expect.EqualsString("Request.OperatingSystemID", "", query.Get("operatingSystemId"))
+
+			if query.Get("pageNumber") == "2" {
+				return http.StatusOK, findOSImagesByOperatingSystemPage2TestResponse
+			}
+
+			return http.StatusOK, findOSImagesByOperatingSystemPage1TestResponse
+		},
+	})
+}
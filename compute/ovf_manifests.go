@@ -0,0 +1,216 @@
+package compute
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// OVFManifestEntry represents a single file checksum in an OVF package's manifest (.mf) file.
+type OVFManifestEntry struct {
+	// The name of the file (relative to the manifest).
+	FileName string
+
+	// The checksum algorithm (e.g. "SHA1" or "SHA256").
+	Algorithm string
+
+	// The expected checksum (lower-case hex).
+	Checksum string
+}
+
+// OVFManifest represents the contents of an OVF package's manifest (.mf) file.
+type OVFManifest struct {
+	Entries []OVFManifestEntry
+}
+
+// OVFIntegrityError is returned when a file in a downloaded OVF package does not match the checksum in the package's manifest.
+type OVFIntegrityError struct {
+	// The name of the file that failed verification.
+	FileName string
+
+	// The checksum algorithm.
+	Algorithm string
+
+	// The checksum from the manifest.
+	ExpectedChecksum string
+
+	// The checksum of the downloaded file (empty if the file is missing).
+	ActualChecksum string
+}
+
+// Error returns the error message associated with the OVFIntegrityError.
+func (err *OVFIntegrityError) Error() string {
+	if err.ActualChecksum == "" {
+		return fmt.Sprintf("OVF package file '%s' is listed in the package manifest but was not found", err.FileName)
+	}
+
+	return fmt.Sprintf("OVF package file '%s' failed %s checksum verification (expected '%s', but was '%s')",
+		err.FileName,
+		err.Algorithm,
+		err.ExpectedChecksum,
+		err.ActualChecksum,
+	)
+}
+
+var _ error = &OVFIntegrityError{}
+
+// GetManifestFileName gets the name of the manifest (.mf) file for the exported OVF package.
+func (export *ImageExport) GetManifestFileName() string {
+	return export.OVFPackagePrefix + ".mf"
+}
+
+// ParseOVFManifest parses the contents of an OVF package's manifest (.mf) file.
+//
+// Each line of the manifest has the form "ALGORITHM(file-name)= checksum" (e.g. "SHA1(package.ovf)= 2b6e...").
+func ParseOVFManifest(reader io.Reader) (*OVFManifest, error) {
+	manifest := &OVFManifest{}
+
+	scanner := bufio.NewScanner(reader)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		openParenthesis := strings.Index(line, "(")
+		closeParenthesis := strings.LastIndex(line, ")=")
+		if openParenthesis <= 0 || closeParenthesis < openParenthesis {
+			return nil, fmt.Errorf("Invalid OVF manifest entry on line %d: '%s'", lineNumber, line)
+		}
+
+		entry := OVFManifestEntry{
+			Algorithm: strings.ToUpper(line[:openParenthesis]),
+			FileName:  line[openParenthesis+1 : closeParenthesis],
+			Checksum:  strings.ToLower(strings.TrimSpace(line[closeParenthesis+2:])),
+		}
+		if newManifestHash(entry.Algorithm) == nil {
+			return nil, fmt.Errorf("Unsupported checksum algorithm '%s' on line %d of OVF manifest", entry.Algorithm, lineNumber)
+		}
+		if entry.FileName == "" || entry.Checksum == "" {
+			return nil, fmt.Errorf("Invalid OVF manifest entry on line %d: '%s'", lineNumber, line)
+		}
+
+		manifest.Entries = append(manifest.Entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+// VerifyOVFPackage verifies the files of a downloaded OVF package against the checksums in its manifest.
+//
+// packageDirectory is the local directory to which the package was downloaded, and manifestFileName is the name of the package's manifest file (see ImageExport.GetManifestFileName).
+// Returns an *OVFIntegrityError if any file listed in the manifest is missing or does not match its checksum.
+func VerifyOVFPackage(packageDirectory string, manifestFileName string) error {
+	manifestFile, err := os.Open(filepath.Join(packageDirectory, manifestFileName))
+	if err != nil {
+		return err
+	}
+	defer manifestFile.Close()
+
+	manifest, err := ParseOVFManifest(manifestFile)
+	if err != nil {
+		return err
+	}
+
+	return manifest.Verify(packageDirectory)
+}
+
+// Verify verifies the files in the specified directory against the checksums in the manifest.
+//
+// Returns an *OVFIntegrityError if any file listed in the manifest is missing or does not match its checksum.
+// Returns an error if any file listed in the manifest is not located within the package directory.
+func (manifest *OVFManifest) Verify(packageDirectory string) error {
+	for _, entry := range manifest.Entries {
+		fileName, err := getOVFPackageFileName(entry.FileName)
+		if err != nil {
+			return err
+		}
+
+		actualChecksum, err := computeFileChecksum(filepath.Join(packageDirectory, fileName), entry.Algorithm)
+		if os.IsNotExist(err) {
+			return &OVFIntegrityError{
+				FileName:         entry.FileName,
+				Algorithm:        entry.Algorithm,
+				ExpectedChecksum: entry.Checksum,
+			}
+		}
+		if err != nil {
+			return err
+		}
+
+		if actualChecksum != entry.Checksum {
+			return &OVFIntegrityError{
+				FileName:         entry.FileName,
+				Algorithm:        entry.Algorithm,
+				ExpectedChecksum: entry.Checksum,
+				ActualChecksum:   actualChecksum,
+			}
+		}
+	}
+
+	return nil
+}
+
+// getOVFPackageFileName converts the name of a file listed in an OVF manifest to a (cleaned) local path relative to the package directory.
+//
+// Returns an error if the path is absolute or would leave the package directory (the manifest is downloaded, and therefore untrusted).
+func getOVFPackageFileName(manifestFileName string) (string, error) {
+	fileName := filepath.Clean(filepath.FromSlash(manifestFileName))
+	if filepath.IsAbs(fileName) || filepath.VolumeName(fileName) != "" || strings.HasPrefix(manifestFileName, "/") {
+		return "", fmt.Errorf("Invalid OVF manifest entry '%s' (file name must be relative to the package directory)", manifestFileName)
+	}
+	if fileName == ".." || strings.HasPrefix(fileName, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("Invalid OVF manifest entry '%s' (file must be located within the package directory)", manifestFileName)
+	}
+
+	return fileName, nil
+}
+
+// computeFileChecksum computes the checksum (lower-case hex) of the specified file.
+func computeFileChecksum(fileName string, algorithm string) (string, error) {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	fileHash := newManifestHash(algorithm)
+	if fileHash == nil {
+		return "", fmt.Errorf("Unsupported checksum algorithm '%s'", algorithm)
+	}
+
+	_, err = io.Copy(fileHash, file)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(fileHash.Sum(nil)), nil
+}
+
+// newManifestHash creates a hash for the specified OVF manifest checksum algorithm (or nil, if the algorithm is not supported).
+func newManifestHash(algorithm string) hash.Hash {
+	switch algorithm {
+	case "SHA1":
+		return sha1.New()
+	case "SHA256":
+		return sha256.New()
+	case "SHA512":
+		return sha512.New()
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,123 @@
+package compute
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// Parse OVF manifest (successful).
+func TestParseOVFManifest_Success(test *testing.T) {
+	expect := expect(test)
+
+	manifest, err := ParseOVFManifest(strings.NewReader(ovfManifestTestContent))
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	expect.EqualsInt("Manifest.Entries.Length", 2, len(manifest.Entries))
+	expect.EqualsString("Manifest.Entries[0].Algorithm", "SHA1", manifest.Entries[0].Algorithm)
+	expect.EqualsString("Manifest.Entries[0].FileName", "golden-image-1.ovf", manifest.Entries[0].FileName)
+	expect.EqualsString("Manifest.Entries[0].Checksum", "0a0a9f2a6772942557ab5355d76af442f8f65e01", manifest.Entries[0].Checksum)
+	expect.EqualsString("Manifest.Entries[1].Algorithm", "SHA256", manifest.Entries[1].Algorithm)
+	expect.EqualsString("Manifest.Entries[1].FileName", "golden-image-1-disk1.vmdk", manifest.Entries[1].FileName)
+}
+
+// Parse OVF manifest (invalid entry).
+func TestParseOVFManifest_Invalid(test *testing.T) {
+	_, err := ParseOVFManifest(strings.NewReader("MD4(golden-image-1.ovf)= 1234\n"))
+	expect(test).NotNil("Error", err)
+}
+
+// Verify downloaded OVF package (successful, then checksum mismatch, then missing file).
+func TestVerifyOVFPackage(test *testing.T) {
+	expect := expect(test)
+
+	packageDirectory, err := ioutil.TempDir("", "ovf-package")
+	if err != nil {
+		test.Fatal(err)
+	}
+	defer os.RemoveAll(packageDirectory)
+
+	export := &ImageExport{OVFPackagePrefix: "golden-image-1"}
+	writeTestFile(test, filepath.Join(packageDirectory, export.GetManifestFileName()), ovfManifestTestContent)
+	writeTestFile(test, filepath.Join(packageDirectory, "golden-image-1.ovf"), "Hello, World!")
+	writeTestFile(test, filepath.Join(packageDirectory, "golden-image-1-disk1.vmdk"), "hello world")
+
+	err = VerifyOVFPackage(packageDirectory, export.GetManifestFileName())
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	writeTestFile(test, filepath.Join(packageDirectory, "golden-image-1-disk1.vmdk"), "corrupted")
+	err = VerifyOVFPackage(packageDirectory, export.GetManifestFileName())
+	integrityError, ok := err.(*OVFIntegrityError)
+	expect.IsTrue("Error is OVFIntegrityError", ok)
+	expect.EqualsString("OVFIntegrityError.FileName", "golden-image-1-disk1.vmdk", integrityError.FileName)
+	expect.EqualsString("OVFIntegrityError.ExpectedChecksum", "b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9", integrityError.ExpectedChecksum)
+
+	err = os.Remove(filepath.Join(packageDirectory, "golden-image-1-disk1.vmdk"))
+	if err != nil {
+		test.Fatal(err)
+	}
+	err = VerifyOVFPackage(packageDirectory, export.GetManifestFileName())
+	integrityError, ok = err.(*OVFIntegrityError)
+	expect.IsTrue("Error is OVFIntegrityError", ok)
+	expect.EqualsString("OVFIntegrityError.ActualChecksum", "", integrityError.ActualChecksum)
+}
+
+// Verify downloaded OVF package (manifest entries outside the package directory are rejected).
+func TestVerifyOVFPackage_PathOutsidePackage(test *testing.T) {
+	expect := expect(test)
+
+	parentDirectory, err := ioutil.TempDir("", "ovf-package")
+	if err != nil {
+		test.Fatal(err)
+	}
+	defer os.RemoveAll(parentDirectory)
+
+	packageDirectory := filepath.Join(parentDirectory, "package")
+	err = os.Mkdir(packageDirectory, 0700)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	// A file outside the package directory whose checksum matches the manifest entries below.
+	outsideFileName := filepath.Join(parentDirectory, "outside.ovf")
+	writeTestFile(test, outsideFileName, "hello world")
+
+	manifestEntries := []string{
+		"SHA256(../outside.ovf)= b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9",
+		"SHA256(sub/../../outside.ovf)= b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9",
+		"SHA256(" + filepath.ToSlash(outsideFileName) + ")= b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9",
+	}
+	for _, manifestEntry := range manifestEntries {
+		manifest, err := ParseOVFManifest(strings.NewReader(manifestEntry + "\n"))
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		err = manifest.Verify(packageDirectory)
+		expect.NotNil("Error ("+manifestEntry+")", err)
+
+		_, isIntegrityError := err.(*OVFIntegrityError)
+		expect.IsFalse("Error is OVFIntegrityError ("+manifestEntry+")", isIntegrityError)
+	}
+}
+
+func writeTestFile(test *testing.T, fileName string, content string) {
+	err := ioutil.WriteFile(fileName, []byte(content), 0600)
+	if err != nil {
+		test.Fatal(err)
+	}
+}
+
+/*
+ * Test data.
+ */
+
+const ovfManifestTestContent = `SHA1(golden-image-1.ovf)= 0A0A9F2A6772942557AB5355D76AF442F8F65E01
+SHA256(golden-image-1-disk1.vmdk)= b94d27b9934d3e08a52e52d7da7dabfac484efe37a5380ee9088f7ace2efcde9
+`
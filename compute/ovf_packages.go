@@ -0,0 +1,86 @@
+package compute
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// OVFPackage represents an OVF package (uploaded via FTPS) that is available for import as a customer image.
+type OVFPackage struct {
+	// The name of the package's manifest (.mf) file.
+	Name string `json:"name"`
+
+	// The Id of the datacenter to which the package was uploaded.
+	DatacenterID string `json:"datacenterId"`
+
+	// The total size (in bytes) of the package's files.
+	SizeBytes int64 `json:"fileSizeBytes"`
+
+	// The date / time (RFC3339) when the package was uploaded.
+	UploadTime string `json:"uploadTime"`
+}
+
+// GetPrefix gets the package's prefix (i.e. the manifest name without its ".mf" extension), as expected by ImportCustomerImage.
+func (ovfPackage *OVFPackage) GetPrefix() string {
+	return strings.TrimSuffix(ovfPackage.Name, ".mf")
+}
+
+// GetUploadTime parses the date / time when the package was uploaded.
+func (ovfPackage *OVFPackage) GetUploadTime() (time.Time, error) {
+	return time.Parse(time.RFC3339, ovfPackage.UploadTime)
+}
+
+// OVFPackages represents a page of OVFPackage results.
+type OVFPackages struct {
+	Items []OVFPackage `json:"ovfPackage"`
+
+	PagedResult
+}
+
+// ListOVFPackages retrieves a page of the OVF packages (uploaded via FTPS) that are available for import into the specified datacenter.
+func (client *Client) ListOVFPackages(datacenterID string, paging *Paging) (packages *OVFPackages, err error) {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return nil, err
+	}
+
+	err = paging.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	requestURI := fmt.Sprintf("%s/image/ovfPackage?datacenterId=%s&%s",
+		url.QueryEscape(organizationID),
+		url.QueryEscape(datacenterID),
+		paging.EnsurePaging().toQueryParameters(),
+	)
+	request, err := client.newRequestV24(requestURI, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode != http.StatusOK {
+		var apiResponse *APIResponseV2
+
+		apiResponse, err = readAPIResponseAsJSON(responseBody, statusCode)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, apiResponse.ToError("Request to list OVF packages in datacenter '%s' failed with status code %d (%s): %s", datacenterID, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	packages = &OVFPackages{}
+	err = json.Unmarshal(responseBody, packages)
+
+	return packages, err
+}
@@ -0,0 +1,67 @@
+package compute
+
+import (
+	"net/http"
+	"testing"
+)
+
+// List OVF packages (successful).
+func TestClient_ListOVFPackages_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			packages, err := client.ListOVFPackages("NA9", nil)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("OVFPackages.Items.Length", 2, len(packages.Items))
+
+			ovfPackage := packages.Items[0]
+			expect.EqualsString("OVFPackage.Name", "web-server.mf", ovfPackage.Name)
+			expect.EqualsString("OVFPackage.Prefix", "web-server", ovfPackage.GetPrefix())
+			expect.EqualsString("OVFPackage.DatacenterID", "NA9", ovfPackage.DatacenterID)
+			expect.IsTrue("OVFPackage.SizeBytes", ovfPackage.SizeBytes == 2147483648)
+
+			uploadTime, err := ovfPackage.GetUploadTime()
+			if err != nil {
+				test.Fatal(err)
+			}
+			expect.EqualsInt("OVFPackage.UploadTime.Year", 2016, uploadTime.Year())
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.EqualsString("Request.URL.Path", "/caas/2.4/my-organization-id/image/ovfPackage", request.URL.Path)
+			expect.EqualsString("Query.datacenterId", "NA9", request.URL.Query().Get("datacenterId"))
+
+			return http.StatusOK, listOVFPackagesTestResponse
+		},
+	})
+}
+
+/*
+ * Test responses.
+ */
+
+const listOVFPackagesTestResponse = `
+{
+	"ovfPackage": [
+		{
+			"name": "web-server.mf",
+			"datacenterId": "NA9",
+			"fileSizeBytes": 2147483648,
+			"uploadTime": "2016-03-01T10:15:00.000Z"
+		},
+		{
+			"name": "db-server.mf",
+			"datacenterId": "NA9",
+			"fileSizeBytes": 10737418240,
+			"uploadTime": "2016-03-02T08:00:00.000Z"
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 2,
+	"totalCount": 2,
+	"pageSize": 50
+}
+`
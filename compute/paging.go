@@ -17,11 +17,35 @@ type PagedResult struct {
 	PageSize int `json:"pageSize"`
 }
 
+// MaxAddressableResults is the maximum number of results that can be addressed (via page number and page size) by a single list operation.
+//
+// CloudControl will not return results beyond this point, regardless of paging; to retrieve them, the list operation must be partitioned using filters.
+const MaxAddressableResults = 10000
+
 // IsEmpty determines whether the page contains no results.
 func (page *PagedResult) IsEmpty() bool {
 	return page.PageCount == 0
 }
 
+// IsLastPage determines whether the page is the last page of results.
+func (page *PagedResult) IsLastPage() bool {
+	if page.IsEmpty() || page.PageSize == 0 {
+		return true
+	}
+
+	if page.PageCount < page.PageSize {
+		return true
+	}
+
+	// Not all list operations populate the total count.
+	return page.TotalCount > 0 && page.PageNumber*page.PageSize >= page.TotalCount
+}
+
+// ExceedsAddressableRange determines whether the total number of results is greater than can be addressed via paging (see MaxAddressableResults).
+func (page *PagedResult) ExceedsAddressableRange() bool {
+	return page.TotalCount > MaxAddressableResults
+}
+
 // NextPage creates a Paging for the next page of results.
 func (page *PagedResult) NextPage() *Paging {
 	return &Paging{
@@ -30,32 +54,80 @@ func (page *PagedResult) NextPage() *Paging {
 	}
 }
 
+const (
+	// DefaultPageSize is the page size used when none is specified.
+	DefaultPageSize = 50
+
+	// MinPageSize is the smallest page size supported by the compute API.
+	MinPageSize = 5
+
+	// MaxPageSize is the largest page size supported by the compute API.
+	MaxPageSize = 250
+)
+
 // Paging contains the paging configuration for a compute API operation.
 type Paging struct {
 	PageNumber int
 	PageSize   int
 }
 
-// DefaultPaging creates Paging with default settings (page 1, 50 records per page).
+// DefaultPaging creates Paging with default settings (page 1, DefaultPageSize records per page).
 func DefaultPaging() *Paging {
 	return &Paging{
 		PageNumber: 1,
-		PageSize:   50,
+		PageSize:   DefaultPageSize,
 	}
 }
 
-// EnsurePaging always returns a paging configuration (if the supplied Paging is nil, it returns the default configuration).
+// EnsurePaging always returns a paging configuration.
+//
+// If the supplied Paging is nil, it returns the default configuration; if its page number or page size is 0, it returns a copy with the default value for that field.
 func (paging *Paging) EnsurePaging() *Paging {
-	if paging != nil {
+	if paging == nil {
+		return DefaultPaging()
+	}
+
+	if paging.PageNumber != 0 && paging.PageSize != 0 {
 		return paging
 	}
 
-	return DefaultPaging()
+	ensured := *paging
+	if ensured.PageNumber == 0 {
+		ensured.PageNumber = 1
+	}
+	if ensured.PageSize == 0 {
+		ensured.PageSize = DefaultPageSize
+	}
+
+	return &ensured
+}
+
+// Validate determines whether the paging configuration is valid.
+//
+// A nil Paging is valid (the default configuration will be used), as is a page number or page size of 0.
+func (paging *Paging) Validate() error {
+	if paging == nil {
+		return nil
+	}
+
+	if paging.PageNumber < 0 {
+		return fmt.Errorf("Invalid page number %d (must be 1 or greater)", paging.PageNumber)
+	}
+
+	if paging.PageSize != 0 && (paging.PageSize < MinPageSize || paging.PageSize > MaxPageSize) {
+		return fmt.Errorf("Invalid page size %d (must be between %d and %d)", paging.PageSize, MinPageSize, MaxPageSize)
+	}
+
+	return nil
 }
 
 func (paging *Paging) ensureValidPageSize() {
-	if paging.PageSize < 5 {
-		paging.PageSize = 5
+	if paging.PageSize == 0 {
+		paging.PageSize = DefaultPageSize
+	} else if paging.PageSize < MinPageSize {
+		paging.PageSize = MinPageSize
+	} else if paging.PageSize > MaxPageSize {
+		paging.PageSize = MaxPageSize
 	}
 }
 
@@ -76,3 +148,125 @@ func (paging *Paging) Next() {
 
 	paging.PageNumber++
 }
+
+// Cursor tracks the position of a list operation within a sequence of pages of results.
+//
+// Currently, the only type of cursor supported by the compute API is the offset cursor (page number and page size), but this may change in the future.
+type Cursor interface {
+	// GetPaging gets the paging configuration used to request the current page of results.
+	GetPaging() *Paging
+
+	// Advance moves the cursor past the specified page of results.
+	//
+	// Returns false if there are no more pages of results.
+	Advance(page *PagedResult) bool
+}
+
+// NewOffsetCursor creates a new Cursor that uses offset-based (page number and page size) paging.
+// If paging is nil, the cursor starts from the first page of results (using the default page size).
+func NewOffsetCursor(paging *Paging) Cursor {
+	cursor := &offsetCursor{
+		paging: &Paging{},
+	}
+	*cursor.paging = *paging.EnsurePaging()
+
+	return cursor
+}
+
+// offsetCursor is a Cursor that uses offset-based (page number and page size) paging.
+type offsetCursor struct {
+	paging *Paging
+}
+
+// GetPaging gets the paging configuration used to request the current page of results.
+func (cursor *offsetCursor) GetPaging() *Paging {
+	return cursor.paging
+}
+
+// Advance moves the cursor past the specified page of results.
+func (cursor *offsetCursor) Advance(page *PagedResult) bool {
+	if page == nil || page.IsLastPage() {
+		return false
+	}
+
+	cursor.paging.Next()
+
+	return true
+}
+
+var _ Cursor = &offsetCursor{}
+
+// ForEachPage retrieves successive pages of results, starting at the cursor's current position, until there are no more pages of results.
+//
+// listPage is called to retrieve each page of results; it should return nil (with no error) to stop iterating early.
+func ForEachPage(cursor Cursor, listPage func(paging *Paging) (*PagedResult, error)) error {
+	for {
+		page, err := listPage(cursor.GetPaging())
+		if err != nil {
+			return err
+		}
+
+		if !cursor.Advance(page) {
+			return nil
+		}
+	}
+}
+
+// ForEachPartitionedPage retrieves all pages of results for a list operation, partitioning the operation (e.g. by datacenter) if the total number of results exceeds MaxAddressableResults.
+//
+// Used by ListAllNetworkDomains, ListAllServers, and ListAllCustomerImages (which partition by datacenter).
+//
+// listPage is called to retrieve each page of results; partition is empty for the initial (unpartitioned) list operation.
+// If the unpartitioned results turn out to be too large to address, discardResults is called (so the caller can discard any results it has already accumulated),
+// and then listPartitions is called to determine the partitions that each need to be listed separately.
+func ForEachPartitionedPage(listPartitions func() ([]string, error), listPage func(partition string, paging *Paging) (*PagedResult, error), discardResults func()) error {
+	exceedsAddressableRange := false
+	err := ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		page, err := listPage("", paging)
+		if err != nil {
+			return nil, err
+		}
+
+		if page != nil && page.ExceedsAddressableRange() {
+			exceedsAddressableRange = true
+
+			return nil, nil // Stop; we'll need to partition the results.
+		}
+
+		return page, nil
+	})
+	if err != nil || !exceedsAddressableRange {
+		return err
+	}
+
+	discardResults()
+
+	partitions, err := listPartitions()
+	if err != nil {
+		return err
+	}
+
+	for _, partition := range partitions {
+		err = ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+			page, err := listPage(partition, paging)
+			if err != nil {
+				return nil, err
+			}
+
+			if page != nil && page.ExceedsAddressableRange() {
+				return nil, fmt.Errorf("Partition '%s' contains %d results (more than the maximum of %d that can be retrieved via paging)",
+					partition,
+					page.TotalCount,
+					MaxAddressableResults,
+				)
+			}
+
+			return page, nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package compute
+
+import (
+	"fmt"
+	"testing"
+)
+
+// Offset cursor (starts at the first page when no paging is specified).
+func TestOffsetCursor_DefaultPaging(test *testing.T) {
+	expect := expect(test)
+
+	cursor := NewOffsetCursor(nil)
+	expect.EqualsInt("Paging.PageNumber", 1, cursor.GetPaging().PageNumber)
+	expect.EqualsInt("Paging.PageSize", 50, cursor.GetPaging().PageSize)
+}
+
+// Offset cursor (does not modify the supplied paging configuration).
+func TestOffsetCursor_CopiesPaging(test *testing.T) {
+	expect := expect(test)
+
+	paging := &Paging{PageNumber: 3, PageSize: 10}
+	cursor := NewOffsetCursor(paging)
+	cursor.Advance(&PagedResult{PageNumber: 3, PageCount: 10, PageSize: 10, TotalCount: 100})
+
+	expect.EqualsInt("Paging.PageNumber", 3, paging.PageNumber)
+	expect.EqualsInt("Cursor.Paging.PageNumber", 4, cursor.GetPaging().PageNumber)
+}
+
+// Offset cursor (stops at an empty, partial, or final page).
+func TestOffsetCursor_Advance(test *testing.T) {
+	expect := expect(test)
+
+	cursor := NewOffsetCursor(&Paging{PageNumber: 1, PageSize: 5})
+	expect.IsFalse("Advance(nil)", cursor.Advance(nil))
+	expect.IsFalse("Advance(empty)", cursor.Advance(&PagedResult{PageNumber: 1, PageSize: 5}))
+	expect.IsFalse("Advance(partial)", cursor.Advance(&PagedResult{PageNumber: 1, PageCount: 1, PageSize: 5}))
+	expect.IsFalse("Advance(final)", cursor.Advance(&PagedResult{PageNumber: 2, PageCount: 5, PageSize: 5, TotalCount: 10}))
+	expect.EqualsInt("Paging.PageNumber", 1, cursor.GetPaging().PageNumber)
+
+	expect.IsTrue("Advance(full)", cursor.Advance(&PagedResult{PageNumber: 1, PageCount: 5, PageSize: 5, TotalCount: 10}))
+	expect.EqualsInt("Paging.PageNumber", 2, cursor.GetPaging().PageNumber)
+}
+
+// ForEachPage (visits each page until the last page).
+func TestForEachPage_AllPages(test *testing.T) {
+	expect := expect(test)
+
+	var pageNumbers []int
+	err := ForEachPage(NewOffsetCursor(&Paging{PageNumber: 1, PageSize: 5}), func(paging *Paging) (*PagedResult, error) {
+		pageNumbers = append(pageNumbers, paging.PageNumber)
+
+		pageCount := 5
+		if paging.PageNumber == 3 {
+			pageCount = 2
+		}
+
+		return &PagedResult{
+			PageNumber: paging.PageNumber,
+			PageCount:  pageCount,
+			PageSize:   paging.PageSize,
+			TotalCount: 12,
+		}, nil
+	})
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	expect.EqualsString("PageNumbers", "[1 2 3]", fmt.Sprint(pageNumbers))
+}
+
+// ForEachPage (stops early when the callback returns no page).
+func TestForEachPage_StopEarly(test *testing.T) {
+	expect := expect(test)
+
+	callCount := 0
+	err := ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		callCount++
+
+		return nil, nil
+	})
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	expect.EqualsInt("CallCount", 1, callCount)
+}
+
+// ForEachPage (stops when the callback returns an error).
+func TestForEachPage_Error(test *testing.T) {
+	expect := expect(test)
+
+	err := ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		return nil, fmt.Errorf("list failed")
+	})
+	expect.NotNil("Error", err)
+}
+
+// EnsurePaging (nil and partially-specified paging configurations).
+func TestPaging_EnsurePaging(test *testing.T) {
+	expect := expect(test)
+
+	var nilPaging *Paging
+	ensured := nilPaging.EnsurePaging()
+	expect.EqualsInt("Nil.PageNumber", 1, ensured.PageNumber)
+	expect.EqualsInt("Nil.PageSize", DefaultPageSize, ensured.PageSize)
+
+	paging := &Paging{PageNumber: 3}
+	ensured = paging.EnsurePaging()
+	expect.EqualsInt("Partial.PageNumber", 3, ensured.PageNumber)
+	expect.EqualsInt("Partial.PageSize", DefaultPageSize, ensured.PageSize)
+	expect.EqualsInt("Original.PageSize", 0, paging.PageSize)
+
+	paging = &Paging{PageNumber: 2, PageSize: 10}
+	expect.IsTrue("Complete.IsSame", paging.EnsurePaging() == paging)
+}
+
+// Paging validation (page sizes outside the supported range).
+func TestPaging_Validate(test *testing.T) {
+	expect := expect(test)
+
+	var nilPaging *Paging
+	expect.IsTrue("Nil.IsValid", nilPaging.Validate() == nil)
+	expect.IsTrue("Default.IsValid", DefaultPaging().Validate() == nil)
+	expect.IsTrue("Max.IsValid", (&Paging{PageNumber: 1, PageSize: MaxPageSize}).Validate() == nil)
+
+	expect.NotNil("TooSmall", (&Paging{PageNumber: 1, PageSize: MinPageSize - 1}).Validate())
+	expect.NotNil("TooLarge", (&Paging{PageNumber: 1, PageSize: MaxPageSize + 1}).Validate())
+	expect.NotNil("NegativePageNumber", (&Paging{PageNumber: -1, PageSize: 10}).Validate())
+}
+
+// Paging First / Next (clamp the page size to the supported range).
+func TestPaging_FirstNext(test *testing.T) {
+	expect := expect(test)
+
+	paging := &Paging{PageNumber: 4, PageSize: 1000}
+	paging.First()
+	expect.EqualsInt("First.PageNumber", 1, paging.PageNumber)
+	expect.EqualsInt("First.PageSize", MaxPageSize, paging.PageSize)
+
+	paging = &Paging{}
+	paging.Next()
+	expect.EqualsInt("Next.PageNumber", 1, paging.PageNumber)
+	expect.EqualsInt("Next.PageSize", DefaultPageSize, paging.PageSize)
+}
@@ -50,6 +50,11 @@ func (client *Client) ListDefaultPersistenceProfiles(networkDomainID string, pag
 		return nil, err
 	}
 
+	err = paging.Validate()
+	if err != nil {
+		return nil, err
+	}
+
 	requestURI := fmt.Sprintf("%s/networkDomainVip/defaultPersistenceProfile?networkDomainId=%s&%s",
 		url.QueryEscape(organizationID),
 		url.QueryEscape(networkDomainID),
@@ -84,3 +89,26 @@ func (client *Client) ListDefaultPersistenceProfiles(networkDomainID string, pag
 
 	return persistenceProfiles, nil
 }
+
+// GetDefaultPersistenceProfileByName retrieves the default load-balancing persistence profile (if any) with the specified name in the specified network domain.
+// Returns nil if no persistence profile is found with the specified name.
+func (client *Client) GetDefaultPersistenceProfileByName(name string, networkDomainID string) (persistenceProfile *PersistenceProfile, err error) {
+	err = ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		persistenceProfiles, err := client.ListDefaultPersistenceProfiles(networkDomainID, paging)
+		if err != nil {
+			return nil, err
+		}
+
+		for index := range persistenceProfiles.Items {
+			if persistenceProfiles.Items[index].Name == name {
+				persistenceProfile = &persistenceProfiles.Items[index]
+
+				return nil, nil // Found it.
+			}
+		}
+
+		return &persistenceProfiles.PagedResult, nil
+	})
+
+	return
+}
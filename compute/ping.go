@@ -0,0 +1,59 @@
+package compute
+
+import (
+	"time"
+)
+
+// PingResult represents the result of a connectivity self-test (see Client.Ping).
+type PingResult struct {
+	// The base address of the CloudControl end-point.
+	BaseAddress string
+
+	// The name of the user whose credentials were validated.
+	UserName string
+
+	// The Id of the user's organisation.
+	OrganizationID string
+
+	// Information about the API versions supported by the end-point.
+	APIVersions *APIVersionInfo
+
+	// The round-trip latency of the request to retrieve the user's account details.
+	AccountLatency time.Duration
+
+	// The round-trip latency of the request to retrieve API version information.
+	APIVersionLatency time.Duration
+}
+
+// Latency returns the average round-trip latency of the requests made by the self-test.
+func (result *PingResult) Latency() time.Duration {
+	return (result.AccountLatency + result.APIVersionLatency) / 2
+}
+
+// Ping performs a connectivity self-test against the CloudControl end-point.
+//
+// It validates the client's credentials (bypassing any cached account details), resolves the user's organisation Id, and retrieves the end-point's supported API versions.
+// Returns an error if any of these steps fail (e.g. the credentials are invalid or the end-point is unreachable).
+func (client *Client) Ping() (*PingResult, error) {
+	result := &PingResult{
+		BaseAddress: client.baseAddress,
+	}
+
+	startTime := time.Now()
+	account, err := client.ForceRefreshAccount()
+	if err != nil {
+		return nil, err
+	}
+	result.AccountLatency = time.Since(startTime)
+	result.UserName = account.UserName
+	result.OrganizationID = account.OrganizationID
+
+	startTime = time.Now()
+	result.APIVersions, err = client.GetAPIVersionInfo()
+	if err != nil {
+		return nil, err
+	}
+	result.APIVersionLatency = time.Since(startTime)
+
+	return result, nil
+}
@@ -0,0 +1,57 @@
+package compute
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// Connectivity self-test (successful).
+func TestClient_Ping_Success(test *testing.T) {
+	expect := expect(test)
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path == "/caas/apiVersion" {
+			writer.Header().Set("Content-Type", "application/json")
+			writer.WriteHeader(http.StatusOK)
+
+			fmt.Fprint(writer, apiVersionInfoTestResponse)
+
+			return
+		}
+
+		writer.Header().Set("Content-Type", "text/xml")
+		writer.WriteHeader(http.StatusOK)
+
+		fmt.Fprintln(writer, accountTestResponse)
+	}))
+	defer testServer.Close()
+
+	client := NewClientWithBaseAddress(testServer.URL, "user1", "password")
+
+	result, err := client.Ping()
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	expect.EqualsString("PingResult.BaseAddress", testServer.URL, result.BaseAddress)
+	expect.EqualsString("PingResult.UserName", "user1", result.UserName)
+	expect.EqualsString("PingResult.OrganizationID", "cc309bfe-1234-43b7-a6a6-2b7a1965cf63", result.OrganizationID)
+	expect.NotNil("PingResult.APIVersions", result.APIVersions)
+	expect.EqualsString("PingResult.APIVersions.Current", "2.10", result.APIVersions.Current)
+	expect.IsTrue("PingResult.Latency > 0", result.Latency() > 0)
+}
+
+// Connectivity self-test (invalid credentials).
+func TestClient_Ping_AccessDenied(test *testing.T) {
+	testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		http.Error(writer, "Invalid credentials.", http.StatusUnauthorized)
+	}))
+	defer testServer.Close()
+
+	client := NewClientWithBaseAddress(testServer.URL, "user1", "password")
+
+	_, err := client.Ping()
+	expect(test).NotNil("Error", err)
+}
@@ -15,7 +15,7 @@ type PortList struct {
 	Ports       []PortListEntry   `json:"port"`
 	ChildLists  []EntityReference `json:"childPortList"`
 	State       string            `json:"state"`
-	CreateTime  string            `json:"createTime"`
+	CreateTime  Timestamp         `json:"createTime"`
 }
 
 // BuildEditRequest creates an EditPortList using the existing ports and child list references in the port list.
@@ -74,7 +74,7 @@ func verifyGetPortListTestResponse(test *testing.T, portList *PortList) {
 	expect.EqualsString("PortList.Name", "MyPortList", portList.Name)
 	expect.EqualsString("PortList.Description", "Production Servers", portList.Description)
 	expect.EqualsString("PortList.State", ResourceStatusNormal, portList.State)
-	expect.EqualsString("PortList.CreateTime", "2008-09-29T02:49:45", portList.CreateTime)
+	expect.EqualsString("PortList.CreateTime", "2008-09-29T02:49:45", portList.CreateTime.Raw)
 
 	expect.EqualsInt("PortList.Ports.Length", 3, len(portList.Ports))
 
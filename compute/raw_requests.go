@@ -0,0 +1,55 @@
+package compute
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Do invokes a CloudControl (MCP 2.x) API end-point that the client does not (yet) model, and deserialises the JSON response into out.
+//
+// relativeURI is relative to the organisation (e.g. "network/networkDomain?name=foo"); the organisation Id is prepended automatically.
+// apiVersion is the minimum API version required by the end-point (a later version may be used if one has been negotiated or pinned).
+// body (if not nil) is serialised as JSON. out (if not nil) receives the response body for a successful (HTTP 200) response; note that
+// most write operations return an APIResponseV2 whose ResponseCode (e.g. ResponseCodeInProgress) should be checked by the caller.
+//
+// Returns an APIError if CloudControl responds with any other status code.
+func (client *Client) Do(method string, relativeURI string, apiVersion APIVersion, body interface{}, out interface{}) error {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return err
+	}
+
+	requestURI := fmt.Sprintf("%s/%s",
+		url.QueryEscape(organizationID),
+		strings.TrimPrefix(relativeURI, "/"),
+	)
+	request, err := client.newRequestV2(apiVersion, MaxAPIVersion, requestURI, method, body)
+	if err != nil {
+		return err
+	}
+
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return err
+	}
+
+	if statusCode != http.StatusOK {
+		var apiResponse *APIResponseV2
+
+		apiResponse, err = readAPIResponseAsJSON(responseBody, statusCode)
+		if err != nil {
+			return err
+		}
+
+		return apiResponse.ToError("Request to '%s' failed with status code %d (%s): %s", relativeURI, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	if out == nil || len(responseBody) == 0 {
+		return nil
+	}
+
+	return json.Unmarshal(responseBody, out)
+}
@@ -0,0 +1,65 @@
+package compute
+
+import (
+	"net/http"
+	"testing"
+)
+
+// Raw request to an unmodelled end-point (successful).
+func TestClient_Do_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			response := &APIResponseV2{}
+			err := client.Do(http.MethodPost, "/network/doSomething", APIVersion27, map[string]string{
+				"id": "75ab2a57-b75e-4ec6-945a-e8c60164fdf6",
+			}, response)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsString("Response.ResponseCode", ResponseCodeInProgress, response.ResponseCode)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.EqualsString("Request.Method", http.MethodPost, request.Method)
+			expect.EqualsString("Request.URL.Path", "/caas/2.7/my-organization-id/network/doSomething", request.URL.Path)
+
+			requestBody := make(map[string]string)
+			err := readRequestBodyAsJSON(request, &requestBody)
+			if err != nil {
+				test.Fatal(err)
+			}
+			expect.EqualsString("Request.Body.id", "75ab2a57-b75e-4ec6-945a-e8c60164fdf6", requestBody["id"])
+
+			return http.StatusOK, doSomethingTestResponse
+		},
+	})
+}
+
+// Raw request to an unmodelled end-point (error).
+func TestClient_Do_Error(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			err := client.Do(http.MethodGet, "network/networkDomain/8cdfd607-f429-4df6-9352-162cfc0891be", APIVersion24, nil, nil)
+			expect.NotNil("Error", err)
+			expect.IsTrue("IsResourceNotFoundError", IsResourceNotFoundError(err))
+		},
+		Respond: testRespond(http.StatusBadRequest, destroyerNetworkDomainNotFoundTestResponse),
+	})
+}
+
+/*
+ * Test responses.
+ */
+
+const doSomethingTestResponse = `
+{
+	"operation": "DO_SOMETHING",
+	"responseCode": "IN_PROGRESS",
+	"message": "Request to do something has been accepted.",
+	"requestId": "na9/2016-01-01T00:00:00.000Z/abcd"
+}
+`
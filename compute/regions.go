@@ -0,0 +1,170 @@
+package compute
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	// RegionAustralia represents the Australia geo.
+	RegionAustralia = "AU"
+
+	// RegionNorthAmerica represents the North America geo.
+	RegionNorthAmerica = "NA"
+
+	// RegionEurope represents the Europe geo.
+	RegionEurope = "EU"
+
+	// RegionAfrica represents the Africa geo (served by the Middle East & Africa end-point).
+	RegionAfrica = "AF"
+
+	// RegionAsiaPacific represents the Asia Pacific geo.
+	RegionAsiaPacific = "AP"
+
+	// RegionMiddleEastAfrica represents the Middle East & Africa geo.
+	RegionMiddleEastAfrica = "MEA"
+
+	// RegionCanada represents the Canada geo.
+	RegionCanada = "CA"
+)
+
+// The built-in catalog of CloudControl geo end-points (keyed by upper-case region identifier).
+var builtInRegionEndPoints = map[string]string{
+	RegionAustralia:        "https://api-au.dimensiondata.com",
+	RegionNorthAmerica:     "https://api-na.dimensiondata.com",
+	RegionEurope:           "https://api-eu.dimensiondata.com",
+	RegionAfrica:           "https://api-mea.dimensiondata.com",
+	RegionAsiaPacific:      "https://api-ap.dimensiondata.com",
+	RegionMiddleEastAfrica: "https://api-mea.dimensiondata.com",
+	RegionCanada:           "https://api-canada.dimensiondata.com",
+}
+
+// Custom (private / white-label) end-points registered via RegisterRegionEndPoint.
+var (
+	customRegionEndPoints     = make(map[string]string)
+	customRegionEndPointsLock sync.RWMutex
+)
+
+// NewClientForRegion creates a new cloud compute API client for the specified region (e.g. RegionAustralia).
+//
+// Unlike NewClient, the region must be present in the end-point catalog (either built-in, or registered via RegisterRegionEndPoint).
+func NewClientForRegion(region string, username string, password string) (*Client, error) {
+	baseAddress, err := GetRegionBaseAddress(region)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewClientWithBaseAddress(baseAddress, username, password), nil
+}
+
+// RegisterRegionEndPoint adds (or overrides) the end-point for a region in the end-point catalog.
+//
+// Use this to support private or white-label CloudControl end-points.
+// baseAddress is the base URL of the CloudControl API end-point (e.g. "https://api.cloud.example.com").
+func RegisterRegionEndPoint(region string, baseAddress string) error {
+	region = normalizeRegion(region)
+	if region == "" {
+		return fmt.Errorf("Region must not be empty")
+	}
+
+	err := validateBaseAddress(baseAddress)
+	if err != nil {
+		return err
+	}
+
+	customRegionEndPointsLock.Lock()
+	defer customRegionEndPointsLock.Unlock()
+
+	customRegionEndPoints[region] = strings.TrimSuffix(baseAddress, "/")
+
+	return nil
+}
+
+// UnregisterRegionEndPoint removes a custom end-point registered via RegisterRegionEndPoint (built-in end-points are restored).
+func UnregisterRegionEndPoint(region string) {
+	customRegionEndPointsLock.Lock()
+	defer customRegionEndPointsLock.Unlock()
+
+	delete(customRegionEndPoints, normalizeRegion(region))
+}
+
+// GetRegionBaseAddress gets the base address of the CloudControl API end-point for the specified region.
+//
+// Returns an error if the region is not present in the end-point catalog.
+func GetRegionBaseAddress(region string) (string, error) {
+	baseAddress, ok := lookupRegionBaseAddress(region)
+	if !ok {
+		return "", fmt.Errorf("Unknown region '%s' (expected one of: %s)", region, strings.Join(ListRegions(), ", "))
+	}
+
+	return baseAddress, nil
+}
+
+// ListRegions lists the identifiers of all regions in the end-point catalog (sorted alphabetically).
+func ListRegions() []string {
+	customRegionEndPointsLock.RLock()
+	defer customRegionEndPointsLock.RUnlock()
+
+	var regions []string
+	for region := range builtInRegionEndPoints {
+		regions = append(regions, region)
+	}
+	for region := range customRegionEndPoints {
+		if _, isBuiltIn := builtInRegionEndPoints[region]; !isBuiltIn {
+			regions = append(regions, region)
+		}
+	}
+	sort.Strings(regions)
+
+	return regions
+}
+
+// lookupRegionBaseAddress looks up the end-point for the specified region (custom end-points take precedence over built-in ones).
+func lookupRegionBaseAddress(region string) (baseAddress string, ok bool) {
+	region = normalizeRegion(region)
+
+	customRegionEndPointsLock.RLock()
+	defer customRegionEndPointsLock.RUnlock()
+
+	baseAddress, ok = customRegionEndPoints[region]
+	if !ok {
+		baseAddress, ok = builtInRegionEndPoints[region]
+	}
+
+	return
+}
+
+// getRegionBaseAddress gets the base address for the specified region, falling back to the conventional end-point name for regions that are not in the catalog.
+func getRegionBaseAddress(region string) string {
+	baseAddress, ok := lookupRegionBaseAddress(region)
+	if !ok {
+		baseAddress = fmt.Sprintf("https://api-%s.dimensiondata.com", region)
+	}
+
+	return baseAddress
+}
+
+// normalizeRegion converts a region identifier to its canonical (upper-case) form.
+func normalizeRegion(region string) string {
+	return strings.ToUpper(strings.TrimSpace(region))
+}
+
+// validateBaseAddress verifies that the specified base address is an absolute HTTP(S) URL.
+func validateBaseAddress(baseAddress string) error {
+	parsedURL, err := url.Parse(baseAddress)
+	if err != nil {
+		return fmt.Errorf("Invalid end-point base address '%s': %s", baseAddress, err.Error())
+	}
+
+	if parsedURL.Scheme != "https" && parsedURL.Scheme != "http" {
+		return fmt.Errorf("Invalid end-point base address '%s' (must be an absolute HTTP or HTTPS URL)", baseAddress)
+	}
+	if parsedURL.Host == "" {
+		return fmt.Errorf("Invalid end-point base address '%s' (host name is missing)", baseAddress)
+	}
+
+	return nil
+}
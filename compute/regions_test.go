@@ -0,0 +1,67 @@
+package compute
+
+import (
+	"testing"
+)
+
+// Built-in regions are resolved case-insensitively.
+func TestGetRegionBaseAddress_BuiltIn(test *testing.T) {
+	expect := expect(test)
+
+	baseAddress, err := GetRegionBaseAddress("au")
+	if err != nil {
+		test.Fatal(err)
+	}
+	expect.EqualsString("AU.BaseAddress", "https://api-au.dimensiondata.com", baseAddress)
+
+	baseAddress, err = GetRegionBaseAddress("CA")
+	if err != nil {
+		test.Fatal(err)
+	}
+	expect.EqualsString("CA.BaseAddress", "https://api-canada.dimensiondata.com", baseAddress)
+
+	_, err = GetRegionBaseAddress("XX")
+	expect.NotNil("Error", err)
+}
+
+// NewClientForRegion rejects unknown regions.
+func TestNewClientForRegion_UnknownRegion(test *testing.T) {
+	expect := expect(test)
+
+	client, err := NewClientForRegion("XX", "user", "password")
+	expect.IsTrue("Client == nil", client == nil)
+	expect.NotNil("Error", err)
+
+	client, err = NewClientForRegion(RegionEurope, "user", "password")
+	if err != nil {
+		test.Fatal(err)
+	}
+	expect.EqualsString("Client.BaseAddress", "https://api-eu.dimensiondata.com", client.baseAddress)
+}
+
+// Custom end-points override built-in ones and can be added for new regions.
+func TestRegisterRegionEndPoint(test *testing.T) {
+	expect := expect(test)
+
+	defer UnregisterRegionEndPoint("AU")
+	defer UnregisterRegionEndPoint("private")
+
+	err := RegisterRegionEndPoint("AU", "https://cloud.example.com/")
+	if err != nil {
+		test.Fatal(err)
+	}
+	err = RegisterRegionEndPoint("private", "https://private.example.com")
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	expect.EqualsString("AU.BaseAddress", "https://cloud.example.com", getRegionBaseAddress("AU"))
+	expect.EqualsString("PRIVATE.BaseAddress", "https://private.example.com", getRegionBaseAddress("Private"))
+	expect.EqualsInt("Regions.Length", 8, len(ListRegions()))
+
+	UnregisterRegionEndPoint("AU")
+	expect.EqualsString("AU.BaseAddress", "https://api-au.dimensiondata.com", getRegionBaseAddress("AU"))
+
+	err = RegisterRegionEndPoint("invalid", "cloud.example.com")
+	expect.NotNil("Error", err)
+}
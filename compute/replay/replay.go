@@ -0,0 +1,339 @@
+// Package replay records interactions with the CloudControl API to fixture files ("cassettes") and replays them in tests.
+//
+// To write a test for a new end-point, run the test once in ModeRecord (with real credentials) to capture the API's responses,
+// then commit the sanitised cassette; subsequent runs (in ModeReplay) need neither credentials nor network access:
+//
+//	recorder, err := replay.NewRecorder("fixtures/list_vlans.json", replay.ModeFromEnvironment(), nil)
+//	...
+//	defer recorder.Stop()
+//
+//	client := compute.NewClientWithBaseAddressAndHTTPClient(baseAddress, username, password, recorder.HTTPClient())
+//
+// Request headers (including credentials) are never recorded, and only the path and query of each request URL are recorded.
+// Use AddSanitizer (e.g. with ReplaceString) to remove other sensitive values such as organisation Ids.
+package replay
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Mode represents the mode in which a Recorder operates.
+type Mode int
+
+const (
+	// ModeReplay indicates that responses are replayed from the cassette (no requests are sent).
+	ModeReplay Mode = iota
+
+	// ModeRecord indicates that requests are sent to the API, and the interactions are recorded to the cassette.
+	ModeRecord
+)
+
+// RecordModeEnvironmentVariable is the name of the environment variable that, if set to "1" or "true", selects ModeRecord in ModeFromEnvironment.
+const RecordModeEnvironmentVariable = "MCP_RECORD"
+
+// ModeFromEnvironment determines the recorder mode from the MCP_RECORD environment variable (defaults to ModeReplay).
+func ModeFromEnvironment() Mode {
+	switch strings.ToLower(os.Getenv(RecordModeEnvironmentVariable)) {
+	case "1", "true":
+		return ModeRecord
+	default:
+		return ModeReplay
+	}
+}
+
+// Interaction represents a recorded request / response pair.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest represents a recorded request.
+type RecordedRequest struct {
+	// The request method (e.g. GET).
+	Method string `json:"method"`
+
+	// The request path and query (e.g. /caas/2.4/my-org/network/vlan?networkDomainId=xxx).
+	URI string `json:"uri"`
+
+	// The request body (if any).
+	Body string `json:"body,omitempty"`
+}
+
+// RecordedResponse represents a recorded response.
+type RecordedResponse struct {
+	// The response status code.
+	StatusCode int `json:"statusCode"`
+
+	// The response content type.
+	ContentType string `json:"contentType,omitempty"`
+
+	// The response body.
+	Body string `json:"body"`
+}
+
+// Cassette represents a sequence of recorded interactions.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Sanitizer modifies a recorded interaction (before it is saved) to remove sensitive information.
+type Sanitizer func(interaction *Interaction)
+
+// ReplaceString creates a Sanitizer that replaces all occurrences of a value in request URIs, request bodies, and response bodies.
+//
+// Requests are sanitised the same way before they are matched during replay, so the replacement is transparent to the code under test.
+func ReplaceString(value string, replacement string) Sanitizer {
+	return func(interaction *Interaction) {
+		if value == "" {
+			return
+		}
+
+		interaction.Request.URI = strings.Replace(interaction.Request.URI, value, replacement, -1)
+		interaction.Request.Body = strings.Replace(interaction.Request.Body, value, replacement, -1)
+		interaction.Response.Body = strings.Replace(interaction.Response.Body, value, replacement, -1)
+	}
+}
+
+// Recorder is an http.RoundTripper that records or replays API interactions.
+type Recorder struct {
+	cassettePath string
+	mode         Mode
+	transport    http.RoundTripper
+	sanitizers   []Sanitizer
+	stateLock    *sync.Mutex
+	cassette     *Cassette
+	replayed     []bool
+}
+
+var _ http.RoundTripper = &Recorder{}
+
+// NewRecorder creates a new Recorder.
+//
+// In ModeReplay, the cassette is loaded from cassettePath (which must exist).
+// In ModeRecord, requests are sent using transport (if nil, http.DefaultTransport is used), and the cassette is saved to cassettePath when Stop is called.
+func NewRecorder(cassettePath string, mode Mode, transport http.RoundTripper) (*Recorder, error) {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	recorder := &Recorder{
+		cassettePath: cassettePath,
+		mode:         mode,
+		transport:    transport,
+		stateLock:    &sync.Mutex{},
+		cassette:     &Cassette{},
+	}
+
+	if mode == ModeReplay {
+		cassetteData, err := ioutil.ReadFile(cassettePath)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to load cassette '%s' (run in record mode to create it): %s", cassettePath, err.Error())
+		}
+
+		err = json.Unmarshal(cassetteData, recorder.cassette)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid cassette '%s': %s", cassettePath, err.Error())
+		}
+
+		recorder.replayed = make([]bool, len(recorder.cassette.Interactions))
+	}
+
+	return recorder, nil
+}
+
+// Mode gets the mode in which the Recorder operates.
+func (recorder *Recorder) Mode() Mode {
+	return recorder.mode
+}
+
+// AddSanitizer adds a Sanitizer that is applied to each interaction.
+func (recorder *Recorder) AddSanitizer(sanitizer Sanitizer) {
+	recorder.stateLock.Lock()
+	defer recorder.stateLock.Unlock()
+
+	recorder.sanitizers = append(recorder.sanitizers, sanitizer)
+}
+
+// HTTPClient creates an HTTP client that uses the Recorder.
+func (recorder *Recorder) HTTPClient() *http.Client {
+	return &http.Client{
+		Transport: recorder,
+	}
+}
+
+// RoundTrip records or replays a single HTTP transaction.
+func (recorder *Recorder) RoundTrip(request *http.Request) (*http.Response, error) {
+	recordedRequest, err := newRecordedRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if recorder.mode == ModeReplay {
+		return recorder.replay(request, recordedRequest)
+	}
+
+	return recorder.record(request, recordedRequest)
+}
+
+// Stop stops the Recorder; in ModeRecord, the cassette is saved.
+func (recorder *Recorder) Stop() error {
+	if recorder.mode != ModeRecord {
+		return nil
+	}
+
+	recorder.stateLock.Lock()
+	defer recorder.stateLock.Unlock()
+
+	cassetteData, err := json.MarshalIndent(recorder.cassette, "", "\t")
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(recorder.cassettePath, cassetteData, 0644)
+}
+
+// record sends the request and records the resulting interaction.
+func (recorder *Recorder) record(request *http.Request, recordedRequest RecordedRequest) (*http.Response, error) {
+	if request.Body != nil {
+		request.Body = ioutil.NopCloser(strings.NewReader(recordedRequest.Body))
+	}
+
+	response, err := recorder.transport.RoundTrip(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	responseBody, err := readResponseBody(response)
+	if err != nil {
+		return nil, err
+	}
+
+	interaction := Interaction{
+		Request: recordedRequest,
+		Response: RecordedResponse{
+			StatusCode:  response.StatusCode,
+			ContentType: response.Header.Get("Content-Type"),
+			Body:        string(responseBody),
+		},
+	}
+
+	recorder.stateLock.Lock()
+	recorder.sanitize(&interaction)
+	recorder.cassette.Interactions = append(recorder.cassette.Interactions, interaction)
+	recorder.stateLock.Unlock()
+
+	response.Body = ioutil.NopCloser(bytes.NewReader(responseBody))
+
+	return response, nil
+}
+
+// readResponseBody reads the response body, decompressing it if necessary.
+//
+// Compressed bodies are recorded in decompressed form (so that sanitizers can see their content, and so they can be replayed without a Content-Encoding);
+// the response's headers are updated to match the decompressed body.
+func readResponseBody(response *http.Response) ([]byte, error) {
+	responseBody, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(responseBody) == 0 || !strings.EqualFold(response.Header.Get("Content-Encoding"), "gzip") {
+		return responseBody, nil
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(responseBody))
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	responseBody, err = ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	response.Header.Del("Content-Encoding")
+	response.Header.Del("Content-Length")
+	response.ContentLength = int64(len(responseBody))
+	response.Uncompressed = true
+
+	return responseBody, nil
+}
+
+// replay finds the first matching interaction that has not yet been replayed, and returns its response.
+func (recorder *Recorder) replay(request *http.Request, recordedRequest RecordedRequest) (*http.Response, error) {
+	recorder.stateLock.Lock()
+	defer recorder.stateLock.Unlock()
+
+	// Sanitise the request the same way it was sanitised when it was recorded.
+	interaction := Interaction{
+		Request: recordedRequest,
+	}
+	recorder.sanitize(&interaction)
+
+	for index, recorded := range recorder.cassette.Interactions {
+		if recorder.replayed[index] || !requestsMatch(recorded.Request, interaction.Request) {
+			continue
+		}
+		recorder.replayed[index] = true
+
+		response := &http.Response{
+			Status:        fmt.Sprintf("%d %s", recorded.Response.StatusCode, http.StatusText(recorded.Response.StatusCode)),
+			StatusCode:    recorded.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        make(http.Header),
+			Body:          ioutil.NopCloser(strings.NewReader(recorded.Response.Body)),
+			ContentLength: int64(len(recorded.Response.Body)),
+			Request:       request,
+		}
+		if recorded.Response.ContentType != "" {
+			response.Header.Set("Content-Type", recorded.Response.ContentType)
+		}
+
+		return response, nil
+	}
+
+	return nil, fmt.Errorf("No recorded interaction in cassette '%s' matches '%s' request to '%s'", recorder.cassettePath, interaction.Request.Method, interaction.Request.URI)
+}
+
+// sanitize applies the Recorder's sanitizers to the specified interaction (the caller must hold the state lock).
+func (recorder *Recorder) sanitize(interaction *Interaction) {
+	for _, sanitizer := range recorder.sanitizers {
+		sanitizer(interaction)
+	}
+}
+
+// newRecordedRequest creates a RecordedRequest from the specified request (consuming its body).
+func newRecordedRequest(request *http.Request) (recordedRequest RecordedRequest, err error) {
+	recordedRequest.Method = request.Method
+	recordedRequest.URI = request.URL.RequestURI()
+
+	if request.Body != nil {
+		var requestBody []byte
+		requestBody, err = ioutil.ReadAll(request.Body)
+		if err != nil {
+			return
+		}
+		request.Body.Close()
+
+		recordedRequest.Body = string(requestBody)
+	}
+
+	return
+}
+
+// requestsMatch determines whether a request matches a recorded request.
+func requestsMatch(recorded RecordedRequest, actual RecordedRequest) bool {
+	return recorded.Method == actual.Method && recorded.URI == actual.URI && recorded.Body == actual.Body
+}
@@ -0,0 +1,226 @@
+package replay
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/DimensionDataResearch/go-dd-cloud-compute/compute"
+)
+
+// Record interactions with a (fake) API, then replay them without the API.
+func TestRecorder_RecordAndReplay(test *testing.T) {
+	cassettePath := filepath.Join(test.TempDir(), "get_vlan.json")
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if strings.HasSuffix(request.URL.Path, "/myaccount") {
+			writer.Header().Set("Content-Type", "text/xml")
+			fmt.Fprint(writer, `<Account><userName>user1</userName><orgId>real-org-id</orgId></Account>`)
+
+			return
+		}
+
+		if request.URL.Path != "/caas/2.2/real-org-id/network/vlan/vlan1" {
+			http.NotFound(writer, request)
+
+			return
+		}
+
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(writer, `{"id": "vlan1", "name": "my-vlan", "state": "NORMAL"}`)
+	}))
+
+	// Record.
+	recorder, err := NewRecorder(cassettePath, ModeRecord, nil)
+	if err != nil {
+		test.Fatal(err)
+	}
+	recorder.AddSanitizer(ReplaceString("real-org-id", "my-org"))
+
+	client := compute.NewClientWithBaseAddressAndHTTPClient(testServer.URL, "user1", "secret-password", recorder.HTTPClient())
+	vlan, err := client.GetVLAN("vlan1")
+	if err != nil {
+		test.Fatal(err)
+	}
+	if vlan == nil || vlan.Name != "my-vlan" {
+		test.Fatalf("Unexpected VLAN while recording: %+v", vlan)
+	}
+
+	err = recorder.Stop()
+	if err != nil {
+		test.Fatal(err)
+	}
+	testServer.Close()
+
+	cassetteData, err := ioutil.ReadFile(cassettePath)
+	if err != nil {
+		test.Fatal(err)
+	}
+	for _, sensitiveValue := range []string{"real-org-id", "secret-password", "Authorization", testServer.URL} {
+		if strings.Contains(string(cassetteData), sensitiveValue) {
+			test.Fatalf("Cassette contains sensitive value '%s'.", sensitiveValue)
+		}
+	}
+
+	// Replay (the API is no longer available).
+	recorder, err = NewRecorder(cassettePath, ModeReplay, nil)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	client = compute.NewClientWithBaseAddressAndHTTPClient("https://api-au.dimensiondata.com", "user1", "", recorder.HTTPClient())
+	vlan, err = client.GetVLAN("vlan1")
+	if err != nil {
+		test.Fatal(err)
+	}
+	if vlan == nil || vlan.Name != "my-vlan" {
+		test.Fatalf("Unexpected VLAN while replaying: %+v", vlan)
+	}
+
+	// Each interaction is only replayed once.
+	_, err = client.GetVLAN("vlan1")
+	if err == nil {
+		test.Fatal("Expected an error when replaying an interaction that has not been recorded.")
+	}
+}
+
+// Record a gzip-compressed response, then replay it (the cassette holds the decompressed, sanitised body).
+func TestRecorder_RecordAndReplayCompressed(test *testing.T) {
+	cassettePath := filepath.Join(test.TempDir(), "get_vlan_gzip.json")
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if strings.HasSuffix(request.URL.Path, "/myaccount") {
+			writer.Header().Set("Content-Type", "text/xml")
+			fmt.Fprint(writer, `<Account><userName>user1</userName><orgId>real-org-id</orgId></Account>`)
+
+			return
+		}
+
+		// Always compress (regardless of whether the client or its transport asked for it).
+		writer.Header().Set("Content-Type", "application/json")
+		writer.Header().Set("Content-Encoding", "gzip")
+
+		gzipWriter := gzip.NewWriter(writer)
+		fmt.Fprint(gzipWriter, `{"id": "vlan1", "name": "my-vlan", "networkDomain": {"id": "real-org-id-domain"}, "state": "NORMAL"}`)
+		gzipWriter.Close()
+	}))
+
+	// Record.
+	recorder, err := NewRecorder(cassettePath, ModeRecord, nil)
+	if err != nil {
+		test.Fatal(err)
+	}
+	recorder.AddSanitizer(ReplaceString("real-org-id", "my-org"))
+
+	client := compute.NewClientWithBaseAddressAndHTTPClient(testServer.URL, "user1", "secret-password", recorder.HTTPClient())
+	vlan, err := client.GetVLAN("vlan1")
+	if err != nil {
+		test.Fatal(err)
+	}
+	if vlan == nil || vlan.Name != "my-vlan" {
+		test.Fatalf("Unexpected VLAN while recording: %+v", vlan)
+	}
+
+	err = recorder.Stop()
+	if err != nil {
+		test.Fatal(err)
+	}
+	testServer.Close()
+
+	cassetteData, err := ioutil.ReadFile(cassettePath)
+	if err != nil {
+		test.Fatal(err)
+	}
+	if strings.Contains(string(cassetteData), "real-org-id") {
+		test.Fatal("Cassette contains sensitive value 'real-org-id'.")
+	}
+	if !strings.Contains(string(cassetteData), "my-org-domain") {
+		test.Fatal("Cassette does not contain the decompressed (sanitised) response body.")
+	}
+
+	// Replay.
+	recorder, err = NewRecorder(cassettePath, ModeReplay, nil)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	client = compute.NewClientWithBaseAddressAndHTTPClient("https://api-au.dimensiondata.com", "user1", "", recorder.HTTPClient())
+	vlan, err = client.GetVLAN("vlan1")
+	if err != nil {
+		test.Fatal(err)
+	}
+	if vlan == nil || vlan.Name != "my-vlan" || vlan.NetworkDomain.ID != "my-org-domain" {
+		test.Fatalf("Unexpected VLAN while replaying: %+v", vlan)
+	}
+}
+
+// Record a response that the caller explicitly asked to be gzip-compressed (so the HTTP transport does not decompress it).
+func TestRecorder_RecordExplicitlyCompressed(test *testing.T) {
+	cassettePath := filepath.Join(test.TempDir(), "explicit_gzip.json")
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.Header().Set("Content-Type", "application/json")
+		writer.Header().Set("Content-Encoding", "gzip")
+
+		gzipWriter := gzip.NewWriter(writer)
+		fmt.Fprint(gzipWriter, `{"id": "vlan1"}`)
+		gzipWriter.Close()
+	}))
+	defer testServer.Close()
+
+	getVLAN := func(client *http.Client, baseAddress string) (*http.Response, string) {
+		request, err := http.NewRequest(http.MethodGet, baseAddress+"/caas/2.4/my-org/network/vlan/vlan1", nil)
+		if err != nil {
+			test.Fatal(err)
+		}
+		request.Header.Set("Accept-Encoding", "gzip")
+
+		response, err := client.Do(request)
+		if err != nil {
+			test.Fatal(err)
+		}
+		defer response.Body.Close()
+
+		body, err := ioutil.ReadAll(response.Body)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		return response, string(body)
+	}
+
+	recorder, err := NewRecorder(cassettePath, ModeRecord, nil)
+	if err != nil {
+		test.Fatal(err)
+	}
+	response, body := getVLAN(recorder.HTTPClient(), testServer.URL)
+	if body != `{"id": "vlan1"}` || response.Header.Get("Content-Encoding") != "" {
+		test.Fatalf("Unexpected response while recording (Content-Encoding = '%s'): %q", response.Header.Get("Content-Encoding"), body)
+	}
+	err = recorder.Stop()
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	recorder, err = NewRecorder(cassettePath, ModeReplay, nil)
+	if err != nil {
+		test.Fatal(err)
+	}
+	response, body = getVLAN(recorder.HTTPClient(), "https://api-au.dimensiondata.com")
+	if body != `{"id": "vlan1"}` || response.Header.Get("Content-Encoding") != "" {
+		test.Fatalf("Unexpected response while replaying (Content-Encoding = '%s'): %q", response.Header.Get("Content-Encoding"), body)
+	}
+}
+
+// Replay fails if the cassette does not exist.
+func TestRecorder_MissingCassette(test *testing.T) {
+	_, err := NewRecorder(filepath.Join(test.TempDir(), "missing.json"), ModeReplay, nil)
+	if err == nil {
+		test.Fatal("Expected an error when loading a missing cassette.")
+	}
+}
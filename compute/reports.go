@@ -0,0 +1,470 @@
+package compute
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The date format used for report queries.
+const reportQueryDateFormat = "2006-01-02"
+
+// ReportRecord represents a single row from a CSV report (keyed by column name).
+type ReportRecord map[string]string
+
+// Get gets the value of the first of the specified columns that is present in the record (column names are case-insensitive).
+func (record ReportRecord) Get(columnNames ...string) string {
+	for _, columnName := range columnNames {
+		if value, ok := record[columnName]; ok {
+			return value
+		}
+
+		for recordColumnName, value := range record {
+			if strings.EqualFold(recordColumnName, columnName) {
+				return value
+			}
+		}
+	}
+
+	return ""
+}
+
+// GetFloat gets the value of the first of the specified columns that is present in the record, as a floating-point number.
+//
+// Empty values are treated as 0.
+func (record ReportRecord) GetFloat(columnNames ...string) (float64, error) {
+	value := strings.TrimSpace(record.Get(columnNames...))
+	if value == "" {
+		return 0, nil
+	}
+
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("Invalid numeric value '%s' for column '%s' in report", value, columnNames[0])
+	}
+
+	return parsed, nil
+}
+
+// ReportReader reads records from a CSV report (with a header row) as they are received.
+//
+// Callers must call Close when they have finished reading.
+type ReportReader struct {
+	body      io.Closer
+	csvReader *csv.Reader
+	header    []string
+}
+
+// newReportReader creates a new ReportReader that reads from the specified CSV data.
+func newReportReader(body io.Reader) (*ReportReader, error) {
+	reader := &ReportReader{
+		csvReader: csv.NewReader(body),
+	}
+	if closer, ok := body.(io.Closer); ok {
+		reader.body = closer
+	}
+	reader.csvReader.FieldsPerRecord = -1
+	reader.csvReader.TrimLeadingSpace = true
+
+	header, err := reader.csvReader.Read()
+	if err == io.EOF {
+		return reader, nil // Empty report.
+	}
+	if err != nil {
+		reader.Close()
+
+		return nil, fmt.Errorf("Error reading report header: %s", err.Error())
+	}
+	for index := range header {
+		header[index] = strings.TrimSpace(header[index])
+	}
+	reader.header = header
+
+	return reader, nil
+}
+
+// Columns returns the report's column names (from its header row).
+func (reader *ReportReader) Columns() []string {
+	return reader.header
+}
+
+// Read reads the next record from the report.
+//
+// Returns io.EOF when there are no more records.
+func (reader *ReportReader) Read() (ReportRecord, error) {
+	if reader.header == nil {
+		return nil, io.EOF
+	}
+
+	values, err := reader.csvReader.Read()
+	if err == io.EOF {
+		return nil, io.EOF
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Error reading report: %s", err.Error())
+	}
+
+	record := make(ReportRecord, len(reader.header))
+	for index, value := range values {
+		if index < len(reader.header) {
+			record[reader.header[index]] = value
+		}
+	}
+
+	return record, nil
+}
+
+// Close closes the underlying response body.
+func (reader *ReportReader) Close() error {
+	if reader.body == nil {
+		return nil
+	}
+
+	return reader.body.Close()
+}
+
+// SummaryUsageReportEntry represents an entry (one day in one location) from the summary usage report.
+type SummaryUsageReportEntry struct {
+	// The day covered by the entry.
+	Date string
+
+	// The location (datacenter) covered by the entry.
+	Location string
+
+	// The number of standard CPU hours consumed.
+	CPUHours float64
+
+	// The number of high-performance CPU hours consumed.
+	HighPerformanceCPUHours float64
+
+	// The number of RAM (GB) hours consumed.
+	RAMHours float64
+
+	// The number of standard storage (GB) hours consumed.
+	StorageHours float64
+
+	// The number of high-performance storage (GB) hours consumed.
+	HighPerformanceStorageHours float64
+
+	// The number of economy storage (GB) hours consumed.
+	EconomyStorageHours float64
+
+	// The number of public IP address hours consumed.
+	PublicIPHours float64
+
+	// All fields from the report entry (keyed by column name).
+	Fields ReportRecord
+}
+
+// DetailedUsageReportEntry represents an entry (one asset in one location) from the detailed usage report.
+type DetailedUsageReportEntry struct {
+	// The asset name.
+	Name string
+
+	// The asset type (e.g. Server).
+	Type string
+
+	// The asset Id.
+	ID string
+
+	// The location (datacenter) in which the asset is located.
+	Location string
+
+	// The asset's private IP address (if applicable).
+	PrivateIPAddress string
+
+	// The asset's status (e.g. Deployed, Deleted).
+	Status string
+
+	// The start of the period covered by the entry.
+	StartDate string
+
+	// The end of the period covered by the entry.
+	EndDate string
+
+	// The number of hours covered by the entry.
+	DurationHours float64
+
+	// The number of CPU hours consumed.
+	CPUHours float64
+
+	// The number of RAM (GB) hours consumed.
+	RAMHours float64
+
+	// The number of storage (GB) hours consumed.
+	StorageHours float64
+
+	// All fields from the report entry (keyed by column name).
+	Fields ReportRecord
+}
+
+// BackupUsageReportEntry represents an entry (one server on one day) from the backup usage report.
+type BackupUsageReportEntry struct {
+	// The day covered by the entry.
+	Date string
+
+	// The location (datacenter) in which the server is located.
+	Location string
+
+	// The Id of the server being backed up.
+	ServerID string
+
+	// The name of the server being backed up.
+	ServerName string
+
+	// The backup service plan.
+	ServicePlan string
+
+	// The backup storage policy.
+	StoragePolicy string
+
+	// The volume of backup storage consumed (in GB).
+	StorageGB float64
+
+	// All fields from the report entry (keyed by column name).
+	Fields ReportRecord
+}
+
+// GetSummaryUsageReport retrieves the summary usage report for the specified range of dates (inclusive).
+func (client *Client) GetSummaryUsageReport(startDate time.Time, endDate time.Time) (entries []SummaryUsageReportEntry, err error) {
+	err = client.StreamSummaryUsageReport(startDate, endDate, func(entry *SummaryUsageReportEntry) error {
+		entries = append(entries, *entry)
+
+		return nil
+	})
+
+	return
+}
+
+// StreamSummaryUsageReport retrieves the summary usage report for the specified range of dates (inclusive), calling the handler for each entry as it is received.
+//
+// If the handler returns an error, reading stops and that error is returned.
+func (client *Client) StreamSummaryUsageReport(startDate time.Time, endDate time.Time, handler func(entry *SummaryUsageReportEntry) error) error {
+	return client.streamReport("summary usage", "report/usage", startDate, endDate, nil, func(record ReportRecord) (err error) {
+		entry := &SummaryUsageReportEntry{
+			Date:     record.Get("Date"),
+			Location: record.Get("Location"),
+			Fields:   record,
+		}
+		if entry.CPUHours, err = record.GetFloat("CPU Hours", "Standard CPU Hours"); err != nil {
+			return err
+		}
+		if entry.HighPerformanceCPUHours, err = record.GetFloat("High Performance CPU Hours"); err != nil {
+			return err
+		}
+		if entry.RAMHours, err = record.GetFloat("RAM Hours"); err != nil {
+			return err
+		}
+		if entry.StorageHours, err = record.GetFloat("Storage Hours", "Standard Storage Hours"); err != nil {
+			return err
+		}
+		if entry.HighPerformanceStorageHours, err = record.GetFloat("High Performance Storage Hours"); err != nil {
+			return err
+		}
+		if entry.EconomyStorageHours, err = record.GetFloat("Economy Storage Hours"); err != nil {
+			return err
+		}
+		if entry.PublicIPHours, err = record.GetFloat("Public IP Hours"); err != nil {
+			return err
+		}
+
+		return handler(entry)
+	})
+}
+
+// GetDetailedUsageReport retrieves the detailed usage report for the specified range of dates (inclusive).
+func (client *Client) GetDetailedUsageReport(startDate time.Time, endDate time.Time) (entries []DetailedUsageReportEntry, err error) {
+	err = client.StreamDetailedUsageReport(startDate, endDate, func(entry *DetailedUsageReportEntry) error {
+		entries = append(entries, *entry)
+
+		return nil
+	})
+
+	return
+}
+
+// StreamDetailedUsageReport retrieves the detailed usage report for the specified range of dates (inclusive), calling the handler for each entry as it is received.
+//
+// If the handler returns an error, reading stops and that error is returned.
+func (client *Client) StreamDetailedUsageReport(startDate time.Time, endDate time.Time, handler func(entry *DetailedUsageReportEntry) error) error {
+	return client.streamReport("detailed usage", "report/usageDetailed", startDate, endDate, nil, func(record ReportRecord) (err error) {
+		entry := &DetailedUsageReportEntry{
+			Name:             record.Get("Name"),
+			Type:             record.Get("Type"),
+			ID:               record.Get("UUID", "Id"),
+			Location:         record.Get("Location"),
+			PrivateIPAddress: record.Get("Private IP"),
+			Status:           record.Get("Status"),
+			StartDate:        record.Get("Start Date"),
+			EndDate:          record.Get("End Date"),
+			Fields:           record,
+		}
+		if entry.DurationHours, err = record.GetFloat("Duration (Hours)", "Duration"); err != nil {
+			return err
+		}
+		if entry.CPUHours, err = record.GetFloat("CPU Hours", "Standard CPU Hours"); err != nil {
+			return err
+		}
+		if entry.RAMHours, err = record.GetFloat("RAM Hours"); err != nil {
+			return err
+		}
+		if entry.StorageHours, err = record.GetFloat("Storage Hours", "Standard Storage Hours"); err != nil {
+			return err
+		}
+
+		return handler(entry)
+	})
+}
+
+// GetAuditLogReport retrieves the audit log report for the specified range of dates (inclusive).
+//
+// Unlike GetAuditLog, entries are returned in the order they appear in the report.
+func (client *Client) GetAuditLogReport(startDate time.Time, endDate time.Time) (entries []AuditLogEntry, err error) {
+	err = client.StreamAuditLogReport(startDate, endDate, func(entry *AuditLogEntry) error {
+		entries = append(entries, *entry)
+
+		return nil
+	})
+
+	return
+}
+
+// StreamAuditLogReport retrieves the audit log report for the specified range of dates (inclusive), calling the handler for each entry as it is received.
+//
+// If the handler returns an error, reading stops and that error is returned.
+func (client *Client) StreamAuditLogReport(startDate time.Time, endDate time.Time, handler func(entry *AuditLogEntry) error) error {
+	return client.streamReport("audit log", "auditlog", startDate, endDate, nil, func(record ReportRecord) error {
+		entry, err := newAuditLogEntry(record)
+		if err != nil {
+			return err
+		}
+
+		return handler(entry)
+	})
+}
+
+// GetBackupUsageReport retrieves the backup usage report for the specified datacenter and range of dates (inclusive).
+func (client *Client) GetBackupUsageReport(datacenterID string, startDate time.Time, endDate time.Time) (entries []BackupUsageReportEntry, err error) {
+	err = client.StreamBackupUsageReport(datacenterID, startDate, endDate, func(entry *BackupUsageReportEntry) error {
+		entries = append(entries, *entry)
+
+		return nil
+	})
+
+	return
+}
+
+// StreamBackupUsageReport retrieves the backup usage report for the specified datacenter and range of dates (inclusive), calling the handler for each entry as it is received.
+//
+// If the handler returns an error, reading stops and that error is returned.
+func (client *Client) StreamBackupUsageReport(datacenterID string, startDate time.Time, endDate time.Time, handler func(entry *BackupUsageReportEntry) error) error {
+	query := url.Values{
+		"datacenterLocation": []string{datacenterID},
+	}
+
+	return client.streamReport("backup usage", "backup/detailedUsageReport", startDate, endDate, query, func(record ReportRecord) (err error) {
+		entry := &BackupUsageReportEntry{
+			Date:          record.Get("Date"),
+			Location:      record.Get("Location", "Datacenter"),
+			ServerID:      record.Get("Server Id", "Asset Id"),
+			ServerName:    record.Get("Server Name", "Asset Name"),
+			ServicePlan:   record.Get("Service Plan", "Backup Service Plan"),
+			StoragePolicy: record.Get("Storage Policy"),
+			Fields:        record,
+		}
+		if entry.StorageGB, err = record.GetFloat("Storage (GB)", "Backup Storage (GB)", "Storage GB"); err != nil {
+			return err
+		}
+
+		return handler(entry)
+	})
+}
+
+// streamReport retrieves the specified CSV report for a range of dates, calling the handler for each record as it is received.
+func (client *Client) streamReport(reportName string, reportPath string, startDate time.Time, endDate time.Time, query url.Values, handler func(record ReportRecord) error) error {
+	reader, err := client.openReport(reportName, reportPath, startDate, endDate, query)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		err = handler(record)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// openReport requests the specified CSV report for a range of dates, returning a ReportReader for the response body.
+//
+// The response body is not buffered (reports can be large).
+func (client *Client) openReport(reportName string, reportPath string, startDate time.Time, endDate time.Time, query url.Values) (*ReportReader, error) {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return nil, err
+	}
+
+	if query == nil {
+		query = url.Values{}
+	}
+	query.Set("startDate", startDate.UTC().Format(reportQueryDateFormat))
+	query.Set("endDate", endDate.UTC().Format(reportQueryDateFormat))
+
+	requestURI := fmt.Sprintf("%s/%s?%s",
+		url.QueryEscape(organizationID),
+		reportPath,
+		query.Encode(),
+	)
+	request, err := client.newRequestV1(requestURI, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Accept", "text/csv")
+
+	response, err := client.executeStreamingRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	contentType := response.Header.Get("Content-Type")
+	isHTML := false
+	if mediaType, _, err := mime.ParseMediaType(contentType); err == nil {
+		isHTML = mediaType == "text/html"
+	}
+
+	if response.StatusCode != http.StatusOK || isHTML {
+		defer response.Body.Close()
+
+		responseBody, err := ioutil.ReadAll(response.Body)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading response body for '%s': %s", request.URL.String(), err.Error())
+		}
+
+		if !isHTML {
+			apiResponse, err := readAPIResponseV1(responseBody, response.StatusCode)
+			if err == nil {
+				return nil, apiResponse.ToError("Request to retrieve %s report failed with status code %d (%s): %s", reportName, response.StatusCode, apiResponse.ResultCode, apiResponse.Message)
+			}
+		}
+
+		return nil, newUnexpectedContentError(request, response.StatusCode, contentType, responseBody)
+	}
+
+	return newReportReader(response.Body)
+}
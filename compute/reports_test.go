@@ -0,0 +1,127 @@
+package compute
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Get summary usage report (successful).
+func TestClient_GetSummaryUsageReport_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			entries, err := client.GetSummaryUsageReport(
+				time.Date(2017, time.March, 1, 0, 0, 0, 0, time.UTC),
+				time.Date(2017, time.March, 2, 0, 0, 0, 0, time.UTC),
+			)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("Entries.Length", 2, len(entries))
+			expect.EqualsString("Entries[0].Date", "2017-03-01", entries[0].Date)
+			expect.EqualsString("Entries[0].Location", "NA9", entries[0].Location)
+			expect.IsTrue("Entries[0].CPUHours", entries[0].CPUHours == 48)
+			expect.IsTrue("Entries[1].RAMHours", entries[1].RAMHours == 192.5)
+			expect.EqualsString("Entries[1].Fields[Bandwidth In (GB)]", "1.25", entries[1].Fields.Get("bandwidth in (gb)"))
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.IsTrue("Request.URL", request.URL.Path == "/oec/0.9/my-organization-id/report/usage")
+			expect.EqualsString("Request.Query.startDate", "2017-03-01", request.URL.Query().Get("startDate"))
+			expect.EqualsString("Request.Query.endDate", "2017-03-02", request.URL.Query().Get("endDate"))
+			expect.EqualsString("Request.Header.Accept", "text/csv", request.Header.Get("Accept"))
+
+			return http.StatusOK, summaryUsageReportTestResponse
+		},
+	})
+}
+
+// Stream detailed usage report (handler stops reading).
+func TestClient_StreamDetailedUsageReport_HandlerError(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			var names []string
+			err := client.StreamDetailedUsageReport(time.Now(), time.Now(), func(entry *DetailedUsageReportEntry) error {
+				names = append(names, entry.Name)
+
+				return fmt.Errorf("stop")
+			})
+			expect.NotNil("Error", err)
+			expect.EqualsString("Error", "stop", err.Error())
+
+			expect.EqualsInt("Names.Length", 1, len(names))
+			expect.EqualsString("Names[0]", "web1", names[0])
+		},
+		Respond: testRespondOK(detailedUsageReportTestResponse),
+	})
+}
+
+// Get backup usage report (successful).
+func TestClient_GetBackupUsageReport_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			entries, err := client.GetBackupUsageReport("NA9", time.Now(), time.Now())
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("Entries.Length", 1, len(entries))
+			expect.EqualsString("Entries[0].ServerName", "web1", entries[0].ServerName)
+			expect.IsTrue("Entries[0].StorageGB", entries[0].StorageGB == 12.5)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.EqualsString("Request.Query.datacenterLocation", "NA9", request.URL.Query().Get("datacenterLocation"))
+
+			return http.StatusOK, backupUsageReportTestResponse
+		},
+	})
+}
+
+// Get summary usage report (API error).
+func TestClient_GetSummaryUsageReport_Error(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			_, err := client.GetSummaryUsageReport(time.Now(), time.Now())
+			expect.NotNil("Error", err)
+
+			apiError, ok := err.(*APIError)
+			expect.IsTrue("Error is APIError", ok)
+			expect.IsTrue("Error.Message", strings.Contains(apiError.Message, "summary usage"))
+		},
+		Respond: testRespond(http.StatusBadRequest, reportErrorTestResponse),
+	})
+}
+
+/*
+ * Test responses.
+ */
+
+const summaryUsageReportTestResponse = `Date,Location,CPU Hours,High Performance CPU Hours,RAM Hours,Storage Hours,Economy Storage Hours,Bandwidth In (GB)
+2017-03-01,NA9,48,0,192,2400,0,0.5
+2017-03-02,NA9,48,0,192.5,2400,100,1.25`
+
+const detailedUsageReportTestResponse = `Name,Type,UUID,Location,Private IP,Status,Start Date,End Date,Duration (Hours),CPU Hours,RAM Hours,Storage Hours
+web1,Server,5a32d6e4-9707-4813-a269-56ab4d989f4d,NA9,10.0.3.11,Deployed,2017-03-01 00:00:00,2017-03-02 00:00:00,24,48,192,2400
+web2,Server,0a1b2c3d-4e5f-4a6b-8c7d-9e0f1a2b3c4d,NA9,10.0.3.12,Deployed,2017-03-01 00:00:00,2017-03-02 00:00:00,24,48,192,2400`
+
+const backupUsageReportTestResponse = `Date,Location,Server Id,Server Name,Service Plan,Storage Policy,Storage (GB)
+2017-03-01,NA9,5a32d6e4-9707-4813-a269-56ab4d989f4d,web1,Enterprise,14 Day Storage Policy,12.5`
+
+const reportErrorTestResponse = `
+<Status>
+	<operation>Summary Usage Report</operation>
+	<result>ERROR</result>
+	<resultDetail>Start date must be before end date.</resultDetail>
+	<resultCode>REASON_400</resultCode>
+</Status>
+`
@@ -0,0 +1,131 @@
+package compute
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+)
+
+// The maximum delay between retries of a request that was rejected because the target resource was busy.
+const maxResourceBusyRetryDelay = 1 * time.Minute
+
+// ConfigureResourceBusyRetry configures the client to automatically retry mutating requests (i.e. anything other than GET or HEAD)
+// that CloudControl rejects with RESOURCE_BUSY (because another operation is in progress for the target resource).
+//
+// The first retry occurs after initialDelay, and the delay doubles with each subsequent retry (up to a maximum of 1 minute);
+// no delay extends past the timeout, so if initialDelay is longer than timeout, the request is retried once when the timeout elapses.
+// Once timeout has elapsed since the original request, the RESOURCE_BUSY response is returned to the caller as usual.
+// Set timeout to 0 (the default) to disable automatic retry of RESOURCE_BUSY responses.
+func (client *Client) ConfigureResourceBusyRetry(timeout time.Duration, initialDelay time.Duration) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if timeout < 0 {
+		timeout = 0
+	}
+
+	if initialDelay <= 0 {
+		initialDelay = 5 * time.Second
+	}
+
+	client.resourceBusyTimeout = timeout
+	client.resourceBusyDelay = initialDelay
+}
+
+// getResourceBusyRetry gets the client's configuration for retrying RESOURCE_BUSY responses.
+func (client *Client) getResourceBusyRetry() (timeout time.Duration, initialDelay time.Duration) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	return client.resourceBusyTimeout, client.resourceBusyDelay
+}
+
+// shouldRetryResourceBusy determines whether the specified response should be retried because the target resource is busy.
+func (client *Client) shouldRetryResourceBusy(request *http.Request, responseBody []byte, statusCode int) bool {
+	switch request.Method {
+	case http.MethodGet, http.MethodHead:
+		return false
+	}
+
+	timeout, _ := client.getResourceBusyRetry()
+	if timeout == 0 {
+		return false
+	}
+
+	return isResourceBusyResponse(responseBody, statusCode)
+}
+
+// retryResourceBusy repeatedly performs a request (with exponential backoff) until its target resource is no longer busy, or the configured timeout has elapsed.
+//
+// requestTime is the time at which the original request was issued (the timeout is measured from then).
+// If the timeout elapses, the last RESOURCE_BUSY response is returned.
+func (client *Client) retryResourceBusy(request *http.Request, requestTime time.Time, responseBody []byte, statusCode int, executeRequest func() ([]byte, int, error)) ([]byte, int, error) {
+	timeout, delay := client.getResourceBusyRetry()
+	deadline := requestTime.Add(timeout)
+
+	for {
+		remaining := deadline.Sub(time.Now())
+		if remaining <= 0 {
+			if client.IsExtendedLoggingEnabled() {
+				log.Printf("Target resource for '%s' request to '%s' is still busy after %s; giving up.",
+					request.Method,
+					request.URL.String(),
+					timeout,
+				)
+			}
+
+			return responseBody, statusCode, nil
+		}
+
+		// Don't wait past the deadline (but make sure the request is retried at least once before giving up).
+		if delay > remaining {
+			delay = remaining
+		}
+
+		if client.IsExtendedLoggingEnabled() {
+			log.Printf("Target resource for '%s' request to '%s' is busy; will retry in %s.",
+				request.Method,
+				request.URL.String(),
+				delay,
+			)
+		}
+		time.Sleep(delay)
+
+		if client.IsCancellationRequested() {
+			return nil, 0, &OperationCancelledError{
+				OperationDescription: fmt.Sprintf("%s of '%s'",
+					request.Method,
+					request.URL.String(),
+				),
+			}
+		}
+
+		var err error
+		responseBody, statusCode, err = executeRequest()
+		if err != nil || !isResourceBusyResponse(responseBody, statusCode) {
+			return responseBody, statusCode, err
+		}
+
+		delay *= 2
+		if delay > maxResourceBusyRetryDelay {
+			delay = maxResourceBusyRetryDelay
+		}
+	}
+}
+
+// isResourceBusyResponse determines whether the specified response body represents a RESOURCE_BUSY response from CloudControl.
+func isResourceBusyResponse(responseBody []byte, statusCode int) bool {
+	if statusCode < http.StatusBadRequest {
+		return false
+	}
+
+	apiResponse := &APIResponseV2{}
+	err := json.Unmarshal(responseBody, apiResponse)
+	if err != nil {
+		return false // Not a V2 (JSON) response.
+	}
+
+	return apiResponse.ResponseCode == ResponseCodeResourceBusy
+}
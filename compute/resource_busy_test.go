@@ -0,0 +1,141 @@
+package compute
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+// Delete VLAN (retried after RESOURCE_BUSY).
+func TestClient_ResourceBusyRetry_Success(test *testing.T) {
+	expect := expect(test)
+
+	requestCount := 0
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			client.ConfigureResourceBusyRetry(5*time.Second, 10*time.Millisecond)
+
+			err := client.DeleteVLAN("0e56433f-d808-4669-821d-812769517ff8")
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("RequestCount", 3, requestCount)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			requestCount++
+			if requestCount < 3 {
+				return http.StatusBadRequest, resourceBusyTestResponse
+			}
+
+			return http.StatusOK, deleteVLANTestResponse
+		},
+	})
+}
+
+// Delete VLAN (RESOURCE_BUSY, retry disabled).
+func TestClient_ResourceBusyRetry_Disabled(test *testing.T) {
+	expect := expect(test)
+
+	requestCount := 0
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			err := client.DeleteVLAN("0e56433f-d808-4669-821d-812769517ff8")
+			expect.IsTrue("IsResourceBusyError", IsResourceBusyError(err))
+			expect.EqualsInt("RequestCount", 1, requestCount)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			requestCount++
+
+			return http.StatusBadRequest, resourceBusyTestResponse
+		},
+	})
+}
+
+// Delete VLAN (still RESOURCE_BUSY when the timeout elapses).
+func TestClient_ResourceBusyRetry_Timeout(test *testing.T) {
+	expect := expect(test)
+
+	requestCount := 0
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			client.ConfigureResourceBusyRetry(50*time.Millisecond, 20*time.Millisecond)
+
+			err := client.DeleteVLAN("0e56433f-d808-4669-821d-812769517ff8")
+			expect.IsTrue("IsResourceBusyError", IsResourceBusyError(err))
+
+			// Retried after 20ms, then (with the second delay shortened to fit) when the timeout elapses.
+			expect.EqualsInt("RequestCount", 3, requestCount)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			requestCount++
+
+			return http.StatusBadRequest, resourceBusyTestResponse
+		},
+	})
+}
+
+// Delete VLAN (initial delay is longer than the timeout, so the single retry happens when the timeout elapses).
+func TestClient_ResourceBusyRetry_InitialDelayExceedsTimeout(test *testing.T) {
+	expect := expect(test)
+
+	requestCount := 0
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			client.ConfigureResourceBusyRetry(20*time.Millisecond, 1*time.Hour)
+
+			started := time.Now()
+			err := client.DeleteVLAN("0e56433f-d808-4669-821d-812769517ff8")
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("RequestCount", 2, requestCount)
+			expect.IsTrue("Waited less than initial delay", time.Since(started) < 10*time.Second)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			requestCount++
+			if requestCount < 2 {
+				return http.StatusBadRequest, resourceBusyTestResponse
+			}
+
+			return http.StatusOK, deleteVLANTestResponse
+		},
+	})
+}
+
+// Delete VLAN (the original request takes longer than the timeout, so it is not retried).
+func TestClient_ResourceBusyRetry_SlowOriginalRequest(test *testing.T) {
+	expect := expect(test)
+
+	requestCount := 0
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			client.ConfigureResourceBusyRetry(20*time.Millisecond, 5*time.Millisecond)
+
+			err := client.DeleteVLAN("0e56433f-d808-4669-821d-812769517ff8")
+			expect.IsTrue("IsResourceBusyError", IsResourceBusyError(err))
+
+			expect.EqualsInt("RequestCount", 1, requestCount)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			requestCount++
+			time.Sleep(30 * time.Millisecond)
+
+			return http.StatusBadRequest, resourceBusyTestResponse
+		},
+	})
+}
+
+/*
+ * Test responses.
+ */
+
+const resourceBusyTestResponse = `
+{
+	"operation": "DELETE_VLAN",
+	"responseCode": "RESOURCE_BUSY",
+	"message": "VLAN 0e56433f-d808-4669-821d-812769517ff8 is busy.",
+	"requestId": "na9/2016-01-01T00:00:00.000Z/abcd"
+}
+`
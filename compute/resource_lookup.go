@@ -0,0 +1,328 @@
+package compute
+
+import (
+	"fmt"
+)
+
+// Lookup helpers resolve a resource from a value that may be either its Id or its name (e.g. as supplied by a user when importing existing infrastructure).
+//
+// If the value looks like an Id (i.e. a UUID), the resource is first retrieved by Id; if that fails to find a matching resource, it is then searched for by name.
+// The scope Id (a data centre or network domain Id, depending on the resource type) is used to constrain name searches, and to verify the location of resources retrieved by Id.
+// Each helper returns nil if no matching resource is found.
+
+// LookupNetworkDomain finds a network domain by Id or name within the specified data centre.
+func (client *Client) LookupNetworkDomain(nameOrID string, datacenterID string) (*NetworkDomain, error) {
+	if isUUID(nameOrID) {
+		domain, err := client.GetNetworkDomain(nameOrID)
+		if err != nil {
+			return nil, err
+		}
+		if domain != nil && isInLookupScope(domain.DatacenterID, datacenterID) {
+			return domain, nil
+		}
+	}
+
+	return client.FindNetworkDomainByName(nameOrID, datacenterID)
+}
+
+// LookupVLAN finds a VLAN by Id or name within the specified network domain.
+func (client *Client) LookupVLAN(nameOrID string, networkDomainID string) (*VLAN, error) {
+	if isUUID(nameOrID) {
+		vlan, err := client.GetVLAN(nameOrID)
+		if err != nil {
+			return nil, err
+		}
+		if vlan != nil && isInLookupScope(vlan.NetworkDomain.ID, networkDomainID) {
+			return vlan, nil
+		}
+	}
+
+	return client.GetVLANByName(nameOrID, networkDomainID)
+}
+
+// LookupServer finds a server by Id or name within the specified network domain.
+func (client *Client) LookupServer(nameOrID string, networkDomainID string) (*Server, error) {
+	if isUUID(nameOrID) {
+		server, err := client.GetServer(nameOrID)
+		if err != nil {
+			return nil, err
+		}
+		if server != nil && isInLookupScope(server.Network.NetworkDomainID, networkDomainID) {
+			return server, nil
+		}
+	}
+
+	return client.FindServerByName(nameOrID, networkDomainID)
+}
+
+// LookupOSImage finds an OS image by Id or name within the specified data centre.
+func (client *Client) LookupOSImage(nameOrID string, datacenterID string) (*OSImage, error) {
+	if isUUID(nameOrID) {
+		image, err := client.GetOSImage(nameOrID)
+		if err != nil {
+			return nil, err
+		}
+		if image != nil && isInLookupScope(image.DataCenterID, datacenterID) {
+			return image, nil
+		}
+	}
+
+	return client.FindOSImage(nameOrID, datacenterID)
+}
+
+// LookupCustomerImage finds a customer image by Id or name within the specified data centre.
+func (client *Client) LookupCustomerImage(nameOrID string, datacenterID string) (*CustomerImage, error) {
+	if isUUID(nameOrID) {
+		image, err := client.GetCustomerImage(nameOrID)
+		if err != nil {
+			return nil, err
+		}
+		if image != nil && isInLookupScope(image.DataCenterID, datacenterID) {
+			return image, nil
+		}
+	}
+
+	return client.FindCustomerImage(nameOrID, datacenterID)
+}
+
+// LookupImage finds an OS or customer image by Id or name within the specified data centre (equivalent to FindImage).
+func (client *Client) LookupImage(nameOrID string, datacenterID string) (Image, error) {
+	return client.FindImage(nameOrID, datacenterID)
+}
+
+// LookupFirewallRule finds a firewall rule by Id or name within the specified network domain.
+func (client *Client) LookupFirewallRule(nameOrID string, networkDomainID string) (*FirewallRule, error) {
+	if isUUID(nameOrID) {
+		rule, err := client.GetFirewallRule(nameOrID)
+		if err != nil {
+			return nil, err
+		}
+		if rule != nil && isInLookupScope(rule.NetworkDomainID, networkDomainID) {
+			return rule, nil
+		}
+	}
+
+	var matchingRules []FirewallRule
+	err := ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		rules, err := client.ListFirewallRules(networkDomainID, paging)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, rule := range rules.Rules {
+			if rule.Name == nameOrID {
+				matchingRules = append(matchingRules, rule)
+			}
+		}
+
+		return &rules.PagedResult, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(matchingRules) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &matchingRules[0], nil
+	default:
+		return nil, fmt.Errorf("Found multiple firewall rules (%d) named '%s' in network domain '%s'.", len(matchingRules), nameOrID, networkDomainID)
+	}
+}
+
+// LookupVIPNode finds a VIP node by Id or name within the specified network domain.
+func (client *Client) LookupVIPNode(nameOrID string, networkDomainID string) (*VIPNode, error) {
+	if isUUID(nameOrID) {
+		node, err := client.GetVIPNode(nameOrID)
+		if err != nil {
+			return nil, err
+		}
+		if node != nil && isInLookupScope(node.NetworkDomainID, networkDomainID) {
+			return node, nil
+		}
+	}
+
+	var matchingNodes []VIPNode
+	err := ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		nodes, err := client.ListVIPNodesInNetworkDomain(networkDomainID, paging)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, node := range nodes.Items {
+			if node.Name == nameOrID {
+				matchingNodes = append(matchingNodes, node)
+			}
+		}
+
+		return &nodes.PagedResult, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(matchingNodes) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &matchingNodes[0], nil
+	default:
+		return nil, fmt.Errorf("Found multiple VIP nodes (%d) named '%s' in network domain '%s'.", len(matchingNodes), nameOrID, networkDomainID)
+	}
+}
+
+// LookupVIPPool finds a VIP pool by Id or name within the specified network domain.
+func (client *Client) LookupVIPPool(nameOrID string, networkDomainID string) (*VIPPool, error) {
+	if isUUID(nameOrID) {
+		pool, err := client.GetVIPPool(nameOrID)
+		if err != nil {
+			return nil, err
+		}
+		if pool != nil && isInLookupScope(pool.NetworkDomainID, networkDomainID) {
+			return pool, nil
+		}
+	}
+
+	var matchingPools []VIPPool
+	err := ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		pools, err := client.ListVIPPoolsInNetworkDomain(networkDomainID, paging)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pool := range pools.Items {
+			if pool.Name == nameOrID {
+				matchingPools = append(matchingPools, pool)
+			}
+		}
+
+		return &pools.PagedResult, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(matchingPools) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &matchingPools[0], nil
+	default:
+		return nil, fmt.Errorf("Found multiple VIP pools (%d) named '%s' in network domain '%s'.", len(matchingPools), nameOrID, networkDomainID)
+	}
+}
+
+// LookupVirtualListener finds a virtual listener by Id or name within the specified network domain.
+func (client *Client) LookupVirtualListener(nameOrID string, networkDomainID string) (*VirtualListener, error) {
+	if isUUID(nameOrID) {
+		listener, err := client.GetVirtualListener(nameOrID)
+		if err != nil {
+			return nil, err
+		}
+		if listener != nil && isInLookupScope(listener.NetworkDomainID, networkDomainID) {
+			return listener, nil
+		}
+	}
+
+	var matchingListeners []VirtualListener
+	err := ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		listeners, err := client.ListVirtualListenersInNetworkDomain(networkDomainID, paging)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, listener := range listeners.Items {
+			if listener.Name == nameOrID {
+				matchingListeners = append(matchingListeners, listener)
+			}
+		}
+
+		return &listeners.PagedResult, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(matchingListeners) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &matchingListeners[0], nil
+	default:
+		return nil, fmt.Errorf("Found multiple virtual listeners (%d) named '%s' in network domain '%s'.", len(matchingListeners), nameOrID, networkDomainID)
+	}
+}
+
+// LookupIPAddressList finds an IP address list by Id or name within the specified network domain.
+func (client *Client) LookupIPAddressList(nameOrID string, networkDomainID string) (*IPAddressList, error) {
+	if isUUID(nameOrID) {
+		addressList, err := client.GetIPAddressList(nameOrID)
+		if err != nil {
+			return nil, err
+		}
+		if addressList != nil {
+			return addressList, nil
+		}
+	}
+
+	addressLists, err := client.ListIPAddressLists(networkDomainID)
+	if err != nil {
+		return nil, err
+	}
+
+	var matchingAddressList *IPAddressList
+	for index := range addressLists.AddressLists {
+		addressList := &addressLists.AddressLists[index]
+		if addressList.Name != nameOrID {
+			continue
+		}
+		if matchingAddressList != nil {
+			return nil, fmt.Errorf("Found multiple IP address lists named '%s' in network domain '%s'.", nameOrID, networkDomainID)
+		}
+
+		matchingAddressList = addressList
+	}
+
+	return matchingAddressList, nil
+}
+
+// LookupPortList finds a port list by Id or name within the specified network domain.
+func (client *Client) LookupPortList(nameOrID string, networkDomainID string) (*PortList, error) {
+	if isUUID(nameOrID) {
+		portList, err := client.GetPortList(nameOrID)
+		if err != nil {
+			return nil, err
+		}
+		if portList != nil {
+			return portList, nil
+		}
+	}
+
+	portLists, err := client.ListPortLists(networkDomainID)
+	if err != nil {
+		return nil, err
+	}
+
+	var matchingPortList *PortList
+	for index := range portLists.PortLists {
+		portList := &portLists.PortLists[index]
+		if portList.Name != nameOrID {
+			continue
+		}
+		if matchingPortList != nil {
+			return nil, fmt.Errorf("Found multiple port lists named '%s' in network domain '%s'.", nameOrID, networkDomainID)
+		}
+
+		matchingPortList = portList
+	}
+
+	return matchingPortList, nil
+}
+
+// isInLookupScope determines whether a resource retrieved by Id is located within the requested scope.
+//
+// Resources whose scope is not reported are assumed to be in scope.
+func isInLookupScope(resourceScopeID string, scopeID string) bool {
+	return scopeID == "" || resourceScopeID == "" || resourceScopeID == scopeID
+}
@@ -0,0 +1,117 @@
+package compute
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// Look up network domain by Id (successful).
+func TestClient_LookupNetworkDomain_ByID(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			domain, err := client.LookupNetworkDomain("75ab2a57-b75e-4ec6-945a-e8c60164fdf6", "AU9")
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.NotNil("NetworkDomain", domain)
+			expect.EqualsString("NetworkDomain.Name", "Domain 1", domain.Name)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.IsTrue("Request is by Id", strings.HasSuffix(request.URL.Path, "/network/networkDomain/75ab2a57-b75e-4ec6-945a-e8c60164fdf6"))
+
+			return http.StatusOK, resolveNetworkDomainTestResponse
+		},
+	})
+}
+
+// Look up network domain by name (successful).
+func TestClient_LookupNetworkDomain_ByName(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			domain, err := client.LookupNetworkDomain("Domain 1", "AU9")
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.NotNil("NetworkDomain", domain)
+			expect.EqualsString("NetworkDomain.ID", "75ab2a57-b75e-4ec6-945a-e8c60164fdf6", domain.ID)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.IsTrue("Request is by name", strings.HasSuffix(request.URL.Path, "/network/networkDomain"))
+			expect.EqualsString("Request.Query.name", "Domain 1", request.URL.Query().Get("name"))
+
+			return http.StatusOK, listNetworkDomainsAU9TestResponse
+		},
+	})
+}
+
+// Look up network domain by Id in the wrong data centre (falls back to name search).
+func TestClient_LookupNetworkDomain_ByID_WrongDatacenter(test *testing.T) {
+	expect := expect(test)
+
+	requestCount := 0
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			domain, err := client.LookupNetworkDomain("75ab2a57-b75e-4ec6-945a-e8c60164fdf6", "AU10")
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.IsTrue("NetworkDomain == nil", domain == nil)
+			expect.EqualsInt("RequestCount", 2, requestCount)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			requestCount++
+
+			if strings.HasSuffix(request.URL.Path, "/75ab2a57-b75e-4ec6-945a-e8c60164fdf6") {
+				return http.StatusOK, resolveNetworkDomainTestResponse
+			}
+
+			return http.StatusOK, emptyNetworkDomainsTestResponse
+		},
+	})
+}
+
+// Look up VIP pool by name (multiple matches).
+func TestClient_LookupVIPPool_ByName_Ambiguous(test *testing.T) {
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			_, err := client.LookupVIPPool("pool-1", "484174a2-ae74-4658-9e56-50fc90e086cf")
+			expect(test).NotNil("Error", err)
+		},
+		Respond: testRespondOK(lookupVIPPoolsAmbiguousTestResponse),
+	})
+}
+
+/*
+ * Test responses.
+ */
+
+const emptyNetworkDomainsTestResponse = `
+{
+	"networkDomain": [],
+	"pageNumber": 1,
+	"pageCount": 0,
+	"totalCount": 0,
+	"pageSize": 50
+}
+`
+
+const lookupVIPPoolsAmbiguousTestResponse = `
+{
+	"vipPool": [
+		{ "id": "4d360b1f-bc2c-4ab7-9884-1f03ba2768f7", "name": "pool-1", "networkDomainId": "484174a2-ae74-4658-9e56-50fc90e086cf" },
+		{ "id": "6c2f4a7e-2a43-4c6d-8b1e-0d2a7f3c9b51", "name": "pool-1", "networkDomainId": "484174a2-ae74-4658-9e56-50fc90e086cf" }
+	],
+	"pageNumber": 1,
+	"pageCount": 2,
+	"totalCount": 2,
+	"pageSize": 50
+}
+`
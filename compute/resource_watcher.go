@@ -0,0 +1,123 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ResourceStateChange represents a change in a resource's state observed by WatchResource.
+type ResourceStateChange struct {
+	// The resource type.
+	ResourceType ResourceType
+
+	// The resource Id.
+	ID string
+
+	// The resource's previous state (empty for the first state observed).
+	PreviousState string
+
+	// The resource's new state (empty if the resource has been deleted).
+	State string
+
+	// The resource, as of the time the new state was observed (nil if the resource has been deleted).
+	Resource Resource
+
+	// Has the resource been deleted?
+	Deleted bool
+}
+
+// IsPending determines whether the resource's new state indicates that an operation is in progress.
+func (change ResourceStateChange) IsPending() bool {
+	return IsPendingStatus(change.State)
+}
+
+// IsFailed determines whether the resource's new state indicates that an operation has failed.
+func (change ResourceStateChange) IsFailed() bool {
+	return IsFailedStatus(change.State)
+}
+
+// WatchResource polls a resource (at the specified interval) and emits each change in its state on the returned channel
+// (e.g. so a controller can react when a resource moves from PENDING_ADD to NORMAL or FAILED_ADD).
+//
+// The first state observed is always emitted; after that, a change is only emitted when the resource's state differs from the previous poll.
+// If the resource is deleted (or was never found), a change with Deleted set is emitted and both channels are closed.
+// Cancel the context to stop watching; both channels are then closed.
+// If polling fails (or the interval is not greater than zero), the error is sent on the error channel and both channels are closed.
+func (client *Client) WatchResource(ctx context.Context, resourceType ResourceType, id string, interval time.Duration) (<-chan ResourceStateChange, <-chan error) {
+	changes := make(chan ResourceStateChange)
+	errors := make(chan error, 1)
+
+	if interval <= 0 {
+		errors <- fmt.Errorf("Invalid polling interval %s for resource '%s' (must be greater than zero)", interval, id)
+		close(errors)
+		close(changes)
+
+		return changes, errors
+	}
+
+	go func() {
+		defer close(errors)
+		defer close(changes)
+
+		_, err := GetResourceDescription(resourceType)
+		if err != nil {
+			errors <- err
+
+			return
+		}
+
+		pollTicker := time.NewTicker(interval)
+		defer pollTicker.Stop()
+
+		// Cached responses may be stale; always retrieve the resource's current status.
+		defer client.bypassResponseCache(id)()
+
+		var previousState string
+		observed := false
+		for {
+			resource, err := client.GetResource(id, resourceType)
+			if err != nil {
+				if ctx.Err() == nil {
+					errors <- err
+				}
+
+				return
+			}
+
+			change := ResourceStateChange{
+				ResourceType:  resourceType,
+				ID:            id,
+				PreviousState: previousState,
+			}
+			if resource == nil || resource.IsDeleted() {
+				change.Deleted = true
+			} else {
+				change.State = resource.GetState()
+				change.Resource = resource
+			}
+
+			if !observed || change.Deleted || change.State != previousState {
+				select {
+				case changes <- change:
+				case <-ctx.Done():
+					return
+				}
+			}
+			if change.Deleted {
+				return
+			}
+
+			observed = true
+			previousState = change.State
+
+			select {
+			case <-pollTicker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return changes, errors
+}
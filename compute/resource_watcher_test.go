@@ -0,0 +1,125 @@
+package compute
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Watch resource (invalid polling interval).
+func TestClient_WatchResource_InvalidInterval(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			changes, errors := client.WatchResource(context.Background(), ResourceTypeCustomerImage, "4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b", 0)
+
+			_, ok := <-changes
+			expect.IsFalse("Changes channel is open", ok)
+
+			err := <-errors
+			expect.NotNil("Error", err)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			test.Fatalf("Unexpected request: %s %s", request.Method, request.URL.Path)
+
+			return http.StatusInternalServerError, ""
+		},
+	})
+}
+
+// Watch resource (state transitions are emitted once each).
+func TestClient_WatchResource_Transitions(test *testing.T) {
+	expect := expect(test)
+
+	var (
+		stateLock sync.Mutex
+		pollCount int
+	)
+	states := []string{
+		ResourceStatusPendingAdd,
+		ResourceStatusPendingAdd,
+		ResourceStatusPendingAdd,
+		ResourceStatusNormal,
+	}
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			changes, errors := client.WatchResource(ctx, ResourceTypeCustomerImage, "4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b", 10*time.Millisecond)
+
+			var observed []ResourceStateChange
+			for change := range changes {
+				observed = append(observed, change)
+				if !change.IsPending() {
+					cancel()
+				}
+			}
+
+			expect.EqualsInt("Changes.Length", 2, len(observed))
+			expect.EqualsString("Changes[0].PreviousState", "", observed[0].PreviousState)
+			expect.EqualsString("Changes[0].State", ResourceStatusPendingAdd, observed[0].State)
+			expect.EqualsString("Changes[1].PreviousState", ResourceStatusPendingAdd, observed[1].PreviousState)
+			expect.EqualsString("Changes[1].State", ResourceStatusNormal, observed[1].State)
+			expect.EqualsString("Changes[1].Resource.Name", "Golden.Image.1", observed[1].Resource.GetName())
+
+			err, ok := <-errors
+			expect.IsFalse("Error channel received an error", ok && err != nil)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			stateLock.Lock()
+			defer stateLock.Unlock()
+
+			state := states[len(states)-1]
+			if pollCount < len(states) {
+				state = states[pollCount]
+			}
+			pollCount++
+
+			return http.StatusOK, strings.Replace(watchCustomerImageTestResponse, "{{state}}", state, 1)
+		},
+	})
+}
+
+// Watch resource (resource is deleted).
+func TestClient_WatchResource_Deleted(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			changes, errors := client.WatchResource(context.Background(), ResourceTypeCustomerImage, "4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b", 10*time.Millisecond)
+
+			change, ok := <-changes
+			expect.IsTrue("Change received", ok)
+			expect.IsTrue("Change.Deleted", change.Deleted)
+
+			_, ok = <-changes
+			expect.IsFalse("Changes channel is open", ok)
+
+			err, ok := <-errors
+			expect.IsFalse("Error channel received an error", ok && err != nil)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			return http.StatusBadRequest, imageNotFoundTestResponse
+		},
+	})
+}
+
+/*
+ * Test responses.
+ */
+
+const watchCustomerImageTestResponse = `
+{
+	"id": "4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b",
+	"name": "Golden.Image.1",
+	"datacenterId": "AU9",
+	"createTime": "2016-09-12T06:40:13.000Z",
+	"state": "{{state}}"
+}
+`
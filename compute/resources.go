@@ -47,6 +47,9 @@ const (
 
 	// ResourceTypeCustomerImage represents a customer image.
 	ResourceTypeCustomerImage
+
+	// ResourceTypeNATRule represents a NAT rule.
+	ResourceTypeNATRule
 )
 
 // Resource represents a compute resource.
@@ -102,6 +105,9 @@ func GetResourceDescription(resourceType ResourceType) (string, error) {
 	case ResourceTypeCustomerImage:
 		return "customer image", nil
 
+	case ResourceTypeNATRule:
+		return "NAT rule", nil
+
 	default:
 		return "", fmt.Errorf("Unrecognised resource type (value = %d).", resourceType)
 	}
@@ -147,6 +153,9 @@ func (client *Client) GetResource(id string, resourceType ResourceType) (Resourc
 
 	case ResourceTypeCustomerImage:
 		return client.GetCustomerImage(id)
+
+	case ResourceTypeNATRule:
+		return client.GetNATRule(id)
 	}
 
 	return nil, fmt.Errorf("Unrecognised resource type (value = %d).", resourceType)
@@ -0,0 +1,63 @@
+package compute
+
+import (
+	"fmt"
+	"testing"
+)
+
+// nilResources is a nil instance of every type that implements Resource.
+//
+// When adding a new Resource implementation, add it here too.
+var nilResources = []Resource{
+	(*NetworkDomain)(nil),
+	(*VLAN)(nil),
+	(*Server)(nil),
+	(*ServerAntiAffinityRule)(nil),
+	(*VirtualMachineNetworkAdapter)(nil),
+	(*PublicIPBlock)(nil),
+	(*FirewallRule)(nil),
+	(*VIPNode)(nil),
+	(*VIPPool)(nil),
+	(*VirtualListener)(nil),
+	(*OSImage)(nil),
+	(*CustomerImage)(nil),
+	(*NATRule)(nil),
+}
+
+// Every Resource implementation is safe to use via a nil pointer.
+func TestResource_NilReceiver(test *testing.T) {
+	expect := expect(test)
+
+	for _, resource := range nilResources {
+		description := fmt.Sprintf("%T", resource)
+
+		expect.IsTrue(description+".IsDeleted", resource.IsDeleted())
+		expect.EqualsString(description+".GetID", "", resource.GetID())
+		expect.EqualsString(description+".GetName", "", resource.GetName())
+		expect.EqualsString(description+".GetState", "", resource.GetState())
+
+		reference := resource.ToEntityReference()
+		expect.EqualsString(description+".ToEntityReference().ID", "", reference.ID)
+		expect.EqualsString(description+".ToEntityReference().Name", "", reference.Name)
+
+		_, err := GetResourceDescription(resource.GetResourceType())
+		if err != nil {
+			test.Error(err)
+		}
+	}
+}
+
+// Every resource type has an entry in the nil-receiver test suite.
+func TestResource_NilReceiver_CoversAllResourceTypes(test *testing.T) {
+	covered := make(map[ResourceType]bool)
+	for _, resource := range nilResources {
+		covered[resource.GetResourceType()] = true
+	}
+
+	for resourceType := ResourceTypeNetworkDomain; resourceType <= ResourceTypeNATRule; resourceType++ {
+		if !covered[resourceType] {
+			description, _ := GetResourceDescription(resourceType)
+			test.Errorf("No nil-receiver test coverage for resource type %d (%s).", resourceType, description)
+		}
+	}
+}
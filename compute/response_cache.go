@@ -0,0 +1,258 @@
+package compute
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Well-known response cache categories (see EnableResponseCache).
+//
+// A category is the first two segments of an end-point's path (relative to the organisation).
+const (
+	// ResponseCacheCategoryDatacenters is the response cache category for datacenters (e.g. GetDatacenter, ListDatacenters).
+	ResponseCacheCategoryDatacenters = "infrastructure/datacenter"
+
+	// ResponseCacheCategoryOSImages is the response cache category for OS images (e.g. GetOSImage, ListOSImagesInDatacenter).
+	ResponseCacheCategoryOSImages = "image/osImage"
+
+	// ResponseCacheCategoryNetworkDomains is the response cache category for network domains (e.g. GetNetworkDomain, ListNetworkDomains).
+	ResponseCacheCategoryNetworkDomains = "network/networkDomain"
+)
+
+// DefaultResponseCacheTTLs returns the default time-to-live for each well-known response cache category.
+func DefaultResponseCacheTTLs() map[string]time.Duration {
+	return map[string]time.Duration{
+		ResponseCacheCategoryDatacenters:    1 * time.Hour,
+		ResponseCacheCategoryOSImages:       15 * time.Minute,
+		ResponseCacheCategoryNetworkDomains: 30 * time.Second,
+	}
+}
+
+// ResponseCache is a store for the bodies of successful read (GET) responses.
+//
+// Implementations must be safe for concurrent use.
+type ResponseCache interface {
+	// Get retrieves the cached response body (if any, and if it has not expired) for the specified key.
+	Get(key string) (responseBody []byte, ok bool)
+
+	// Set caches a response body under the specified key for (up to) the specified time-to-live.
+	Set(key string, responseBody []byte, ttl time.Duration)
+
+	// Invalidate removes all cached response bodies whose keys start with the specified prefix.
+	Invalidate(keyPrefix string)
+}
+
+// MemoryResponseCache is an in-memory ResponseCache.
+type MemoryResponseCache struct {
+	lock    sync.Mutex
+	entries map[string]memoryResponseCacheEntry
+}
+
+// memoryResponseCacheEntry represents a single entry in a MemoryResponseCache.
+type memoryResponseCacheEntry struct {
+	responseBody []byte
+	expires      time.Time
+}
+
+// NewMemoryResponseCache creates a new, empty, in-memory ResponseCache.
+func NewMemoryResponseCache() *MemoryResponseCache {
+	return &MemoryResponseCache{
+		entries: make(map[string]memoryResponseCacheEntry),
+	}
+}
+
+// Get retrieves the cached response body (if any, and if it has not expired) for the specified key.
+func (cache *MemoryResponseCache) Get(key string) (responseBody []byte, ok bool) {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+
+	entry, ok := cache.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expires) {
+		delete(cache.entries, key)
+
+		return nil, false
+	}
+
+	return entry.responseBody, true
+}
+
+// Set caches a response body under the specified key for (up to) the specified time-to-live.
+func (cache *MemoryResponseCache) Set(key string, responseBody []byte, ttl time.Duration) {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+
+	cache.entries[key] = memoryResponseCacheEntry{
+		responseBody: append([]byte(nil), responseBody...),
+		expires:      time.Now().Add(ttl),
+	}
+}
+
+// Invalidate removes all cached response bodies whose keys start with the specified prefix.
+func (cache *MemoryResponseCache) Invalidate(keyPrefix string) {
+	cache.lock.Lock()
+	defer cache.lock.Unlock()
+
+	for key := range cache.entries {
+		if strings.HasPrefix(key, keyPrefix) {
+			delete(cache.entries, key)
+		}
+	}
+}
+
+var _ ResponseCache = &MemoryResponseCache{}
+
+// responseCacheSettings represents a client's response cache configuration.
+type responseCacheSettings struct {
+	cache ResponseCache
+	ttls  map[string]time.Duration
+}
+
+// EnableResponseCache configures the client to cache the responses of read (GET) requests in the specified categories (e.g. ResponseCacheCategoryDatacenters),
+// each for its specified time-to-live (see DefaultResponseCacheTTLs).
+//
+// Any successful write (i.e. non-GET) request invalidates all cached responses in categories under the same top-level path segment
+// (for example, a request to "network/editNetworkDomain" invalidates everything cached for "network/..."); call InvalidateResponseCache
+// to explicitly invalidate a category (e.g. after changes made outside of this client).
+//
+// Requests made while polling a resource's status (e.g. WaitForChange or WatchResource) always bypass the cache.
+func (client *Client) EnableResponseCache(cache ResponseCache, ttls map[string]time.Duration) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if cache == nil {
+		client.responseCache = nil
+
+		return
+	}
+
+	settings := &responseCacheSettings{
+		cache: cache,
+		ttls:  make(map[string]time.Duration, len(ttls)),
+	}
+	for category, ttl := range ttls {
+		settings.ttls[category] = ttl
+	}
+	client.responseCache = settings
+}
+
+// DisableResponseCache stops the client from caching responses.
+func (client *Client) DisableResponseCache() {
+	client.EnableResponseCache(nil, nil)
+}
+
+// InvalidateResponseCache removes all cached responses in the specified category (e.g. ResponseCacheCategoryNetworkDomains).
+func (client *Client) InvalidateResponseCache(category string) {
+	settings := client.getResponseCacheSettings()
+	if settings == nil {
+		return
+	}
+
+	settings.cache.Invalidate(category + " ")
+}
+
+// getResponseCacheSettings gets the client's response cache configuration (nil if response caching is not enabled).
+func (client *Client) getResponseCacheSettings() *responseCacheSettings {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	return client.responseCache
+}
+
+// bypassResponseCache causes read requests that refer to the specified resource Id to bypass the response cache
+// (e.g. while polling the resource's status) until the returned function is called.
+func (client *Client) bypassResponseCache(id string) (restore func()) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	if client.responseCacheBypassIDs == nil {
+		client.responseCacheBypassIDs = make(map[string]int)
+	}
+	client.responseCacheBypassIDs[id]++
+
+	return func() {
+		client.stateLock.Lock()
+		defer client.stateLock.Unlock()
+
+		client.responseCacheBypassIDs[id]--
+		if client.responseCacheBypassIDs[id] <= 0 {
+			delete(client.responseCacheBypassIDs, id)
+		}
+	}
+}
+
+// isResponseCacheBypassed determines whether the specified request refers (by path or query) to a resource whose requests bypass the response cache.
+func (client *Client) isResponseCacheBypassed(request *http.Request) bool {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	for id := range client.responseCacheBypassIDs {
+		if strings.Contains(request.URL.Path, id) || strings.Contains(request.URL.RawQuery, url.QueryEscape(id)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// executeCachedRequest performs a request, using (and updating) the response cache where appropriate.
+func (client *Client) executeCachedRequest(request *http.Request, execute func(request *http.Request) ([]byte, int, error)) (responseBody []byte, statusCode int, err error) {
+	settings := client.getResponseCacheSettings()
+	if settings == nil {
+		return execute(request)
+	}
+
+	category := getResponseCacheCategory(request)
+	if request.Method != http.MethodGet {
+		responseBody, statusCode, err = execute(request)
+		if err == nil && statusCode == http.StatusOK && category != "" {
+			topLevelSegment := strings.SplitN(category, "/", 2)[0]
+			settings.cache.Invalidate(topLevelSegment + "/")
+		}
+
+		return
+	}
+
+	ttl, isCached := settings.ttls[category]
+	if !isCached || ttl <= 0 || client.isResponseCacheBypassed(request) {
+		return execute(request)
+	}
+
+	cacheKey := category + " " + request.URL.String()
+	if responseBody, ok := settings.cache.Get(cacheKey); ok {
+		return responseBody, http.StatusOK, nil
+	}
+
+	responseBody, statusCode, err = execute(request)
+	if err == nil && statusCode == http.StatusOK {
+		settings.cache.Set(cacheKey, responseBody, ttl)
+	}
+
+	return
+}
+
+// getResponseCacheCategory determines the response cache category (e.g. "network/networkDomain") for the specified CloudControl (MCP 2.x) request.
+//
+// Returns an empty string if the request is not for an MCP 2.x end-point.
+func getResponseCacheCategory(request *http.Request) string {
+	// Path: [base-path]/caas/{version}/{organizationId}/{segment1}/{segment2}[/...]
+	pathSegments := strings.Split(request.URL.Path, "/")
+	for index, pathSegment := range pathSegments {
+		if pathSegment != "caas" {
+			continue
+		}
+
+		if len(pathSegments) < index+5 {
+			return ""
+		}
+
+		return pathSegments[index+3] + "/" + pathSegments[index+4]
+	}
+
+	return ""
+}
@@ -0,0 +1,129 @@
+package compute
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Cached network domain is retrieved once, then invalidated by a write to the same top-level path.
+func TestClient_ResponseCache_NetworkDomain(test *testing.T) {
+	expect := expect(test)
+
+	getCount := 0
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			client.EnableResponseCache(NewMemoryResponseCache(), DefaultResponseCacheTTLs())
+
+			for attempt := 0; attempt < 3; attempt++ {
+				domain, err := client.GetNetworkDomain("75ab2a57-b75e-4ec6-945a-e8c60164fdf6")
+				if err != nil {
+					test.Fatal(err)
+				}
+				expect.EqualsString("Domain.Name", "Domain 1", domain.Name)
+			}
+			expect.EqualsInt("GetCount (cached)", 1, getCount)
+
+			newName := "Domain 1a"
+			err := client.EditNetworkDomain("75ab2a57-b75e-4ec6-945a-e8c60164fdf6", &newName, nil, nil)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			_, err = client.GetNetworkDomain("75ab2a57-b75e-4ec6-945a-e8c60164fdf6")
+			if err != nil {
+				test.Fatal(err)
+			}
+			expect.EqualsInt("GetCount (after write)", 2, getCount)
+
+			client.InvalidateResponseCache(ResponseCacheCategoryNetworkDomains)
+			_, err = client.GetNetworkDomain("75ab2a57-b75e-4ec6-945a-e8c60164fdf6")
+			if err != nil {
+				test.Fatal(err)
+			}
+			expect.EqualsInt("GetCount (after invalidation)", 3, getCount)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			if request.Method == http.MethodGet {
+				getCount++
+
+				return http.StatusOK, resolveNetworkDomainTestResponse
+			}
+
+			return http.StatusOK, editNetworkDomainForCacheTestResponse
+		},
+	})
+}
+
+// Waiting for a pending operation bypasses the response cache (so a cached pending state is not observed).
+func TestClient_ResponseCache_WaitBypassesCache(test *testing.T) {
+	defer testWithResourceStatusPollInterval(time.Millisecond)()
+
+	expect := expect(test)
+
+	getCount := 0
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			client.EnableResponseCache(NewMemoryResponseCache(), DefaultResponseCacheTTLs())
+
+			_, err := client.WaitForChange(ResourceTypeNetworkDomain, "75ab2a57-b75e-4ec6-945a-e8c60164fdf6", "Edit", time.Second)
+			if err != nil {
+				test.Fatal(err)
+			}
+			expect.EqualsInt("GetCount (wait)", 3, getCount)
+
+			// Once the wait is complete, the cache is used again.
+			for attempt := 0; attempt < 2; attempt++ {
+				_, err = client.GetNetworkDomain("75ab2a57-b75e-4ec6-945a-e8c60164fdf6")
+				if err != nil {
+					test.Fatal(err)
+				}
+			}
+			expect.EqualsInt("GetCount (cached)", 4, getCount)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			getCount++
+			if getCount < 3 {
+				return http.StatusOK, strings.Replace(resolveNetworkDomainTestResponse, `"NORMAL"`, `"PENDING_CHANGE"`, 1)
+			}
+
+			return http.StatusOK, resolveNetworkDomainTestResponse
+		},
+	})
+}
+
+// Response cache entries expire.
+func TestMemoryResponseCache_Expiry(test *testing.T) {
+	expect := expect(test)
+
+	cache := NewMemoryResponseCache()
+	cache.Set("network/networkDomain a", []byte("a"), 1*time.Hour)
+	cache.Set("network/networkDomain b", []byte("b"), -1*time.Second)
+	cache.Set("network/vlan c", []byte("c"), 1*time.Hour)
+
+	_, ok := cache.Get("network/networkDomain a")
+	expect.IsTrue("a (cached)", ok)
+
+	_, ok = cache.Get("network/networkDomain b")
+	expect.IsFalse("b (expired)", ok)
+
+	cache.Invalidate("network/networkDomain ")
+	_, ok = cache.Get("network/networkDomain a")
+	expect.IsFalse("a (invalidated)", ok)
+	_, ok = cache.Get("network/vlan c")
+	expect.IsTrue("c (not invalidated)", ok)
+}
+
+/*
+ * Test responses.
+ */
+
+const editNetworkDomainForCacheTestResponse = `
+{
+	"operation": "EDIT_NETWORK_DOMAIN",
+	"responseCode": "OK",
+	"message": "Network Domain 'Domain 1a' was edited successfully.",
+	"requestId": "na9/2016-01-01T00:00:00.000Z/abcd"
+}
+`
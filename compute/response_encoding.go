@@ -0,0 +1,118 @@
+package compute
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// ResponseTooLargeError is returned when the body of a response from CloudControl exceeds the client's maximum response size.
+type ResponseTooLargeError struct {
+	// The method of the request whose response was too large.
+	Method string
+
+	// The URL of the request whose response was too large.
+	URL string
+
+	// The maximum response size (in bytes, after decompression).
+	MaxResponseSize int64
+}
+
+// Error returns the error message associated with the ResponseTooLargeError.
+func (err *ResponseTooLargeError) Error() string {
+	return fmt.Sprintf("Response to '%s' request to '%s' exceeds the maximum response size (%d bytes)",
+		err.Method,
+		err.URL,
+		err.MaxResponseSize,
+	)
+}
+
+var _ error = &ResponseTooLargeError{}
+
+// SetMaxResponseSize configures the maximum size (in bytes, after decompression) of a response body that the client will read.
+//
+// Responses larger than this cause requests to fail with a ResponseTooLargeError (streaming requests are not affected). Specify 0 for no limit (the default).
+func (client *Client) SetMaxResponseSize(maxResponseSize int64) {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	client.maxResponseSize = maxResponseSize
+}
+
+// EnableCompression configures the client to accept gzip-compressed responses from CloudControl (the default).
+//
+// Compression is negotiated by the client's HTTP transport, which also transparently decompresses responses
+// (so any custom transport that wraps it, such as a recorder, only ever sees decompressed response bodies).
+func (client *Client) EnableCompression() {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	client.isCompressionDisabled = false
+}
+
+// DisableCompression configures the client to ask CloudControl not to compress its responses.
+func (client *Client) DisableCompression() {
+	client.stateLock.Lock()
+	defer client.stateLock.Unlock()
+
+	client.isCompressionDisabled = true
+}
+
+// setAcceptEncoding asks for an uncompressed response if compression is disabled (unless the request already specifies an encoding).
+//
+// When compression is enabled, Accept-Encoding is deliberately left unset so that the HTTP transport can negotiate gzip itself;
+// setting it here would disable the transport's transparent decompression.
+func (client *Client) setAcceptEncoding(request *http.Request) {
+	client.stateLock.Lock()
+	isCompressionDisabled := client.isCompressionDisabled
+	client.stateLock.Unlock()
+
+	if !isCompressionDisabled || request.Header.Get("Accept-Encoding") != "" {
+		return
+	}
+
+	request.Header.Set("Accept-Encoding", "identity")
+}
+
+// readResponseBody reads the body of a response, enforcing the client's maximum response size.
+//
+// Responses are normally decompressed by the HTTP transport; a gzip-compressed body is only decompressed here if the transport did not do so
+// (e.g. because Accept-Encoding was set by a custom request header function).
+func (client *Client) readResponseBody(request *http.Request, response *http.Response) ([]byte, error) {
+	client.stateLock.Lock()
+	maxResponseSize := client.maxResponseSize
+	client.stateLock.Unlock()
+
+	var body io.Reader = response.Body
+	if !response.Uncompressed && strings.EqualFold(response.Header.Get("Content-Encoding"), "gzip") {
+		gzipReader, err := gzip.NewReader(response.Body)
+		if err != nil {
+			return nil, fmt.Errorf("Error decompressing response body for '%s': %s", request.URL.String(), err.Error())
+		}
+		defer gzipReader.Close()
+
+		body = gzipReader
+	}
+	if maxResponseSize > 0 {
+		// Read one byte more than the limit so we can tell whether it was exceeded.
+		body = io.LimitReader(body, maxResponseSize+1)
+	}
+
+	// Don't rely on Content-Length here (chunked responses won't have it).
+	responseBody, err := ioutil.ReadAll(body)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading response body for '%s': %s", request.URL.String(), err.Error())
+	}
+	if maxResponseSize > 0 && int64(len(responseBody)) > maxResponseSize {
+		return nil, &ResponseTooLargeError{
+			Method:          request.Method,
+			URL:             request.URL.String(),
+			MaxResponseSize: maxResponseSize,
+		}
+	}
+
+	return responseBody, nil
+}
@@ -0,0 +1,144 @@
+package compute
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// Get VLAN (gzip-compressed response, negotiated and decompressed by the HTTP transport).
+func TestClient_GzipResponse(test *testing.T) {
+	expect := expect(test)
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		expect.EqualsString("Request.Header[Accept-Encoding]", "gzip", request.Header.Get("Accept-Encoding"))
+
+		writer.Header().Set("Content-Type", "application/json")
+		writer.Header().Set("Content-Encoding", "gzip")
+		writer.WriteHeader(http.StatusOK)
+
+		gzipWriter := gzip.NewWriter(writer)
+		defer gzipWriter.Close()
+
+		gzipWriter.Write([]byte(getVLANForAPIVersionTestResponse))
+	}))
+	defer testServer.Close()
+
+	client := NewClientWithBaseAddress(testServer.URL, "user1", "password")
+	client.setAccount(&Account{
+		OrganizationID: "dummy-organization-id",
+	})
+
+	vlan, err := client.GetVLAN("0e56433f-d808-4669-821d-812769517ff8")
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	expect.NotNil("VLAN", vlan)
+	expect.EqualsString("VLAN.ID", "0e56433f-d808-4669-821d-812769517ff8", vlan.ID)
+}
+
+// Get VLAN (compression disabled).
+func TestClient_DisableCompression(test *testing.T) {
+	expect := expect(test)
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		expect.EqualsString("Request.Header[Accept-Encoding]", "identity", request.Header.Get("Accept-Encoding"))
+
+		writer.Header().Set("Content-Type", "application/json")
+		writer.WriteHeader(http.StatusOK)
+
+		writer.Write([]byte(getVLANForAPIVersionTestResponse))
+	}))
+	defer testServer.Close()
+
+	client := NewClientWithBaseAddressAndConfiguration(testServer.URL, "user1", "password", ClientConfiguration{
+		DisableCompression: true,
+	})
+	client.setAccount(&Account{
+		OrganizationID: "dummy-organization-id",
+	})
+
+	_, err := client.GetVLAN("0e56433f-d808-4669-821d-812769517ff8")
+	if err != nil {
+		test.Fatal(err)
+	}
+}
+
+// Get VLAN (gzip-compressed response via a wrapping transport, which must only see the decompressed body).
+func TestClient_GzipResponse_WrappingTransport(test *testing.T) {
+	expect := expect(test)
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.Header().Set("Content-Type", "application/json")
+		writer.Header().Set("Content-Encoding", "gzip")
+		writer.WriteHeader(http.StatusOK)
+
+		gzipWriter := gzip.NewWriter(writer)
+		defer gzipWriter.Close()
+
+		gzipWriter.Write([]byte(getVLANForAPIVersionTestResponse))
+	}))
+	defer testServer.Close()
+
+	var observedBody []byte
+	httpClient := &http.Client{
+		Transport: testRoundTripperFunc(func(request *http.Request) (*http.Response, error) {
+			response, err := http.DefaultTransport.RoundTrip(request)
+			if err != nil {
+				return nil, err
+			}
+			defer response.Body.Close()
+
+			observedBody, err = ioutil.ReadAll(response.Body)
+			if err != nil {
+				return nil, err
+			}
+			response.Body = ioutil.NopCloser(bytes.NewReader(observedBody))
+
+			return response, nil
+		}),
+	}
+
+	client := NewClientWithBaseAddressAndHTTPClient(testServer.URL, "user1", "password", httpClient)
+	client.setAccount(&Account{
+		OrganizationID: "dummy-organization-id",
+	})
+
+	vlan, err := client.GetVLAN("0e56433f-d808-4669-821d-812769517ff8")
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	expect.NotNil("VLAN", vlan)
+	expect.IsTrue("Wrapping transport saw decompressed body", bytes.HasPrefix(bytes.TrimSpace(observedBody), []byte("{")))
+}
+
+// testRoundTripperFunc adapts a function to the http.RoundTripper interface.
+type testRoundTripperFunc func(request *http.Request) (*http.Response, error)
+
+func (roundTrip testRoundTripperFunc) RoundTrip(request *http.Request) (*http.Response, error) {
+	return roundTrip(request)
+}
+
+// Get VLAN (response exceeds maximum size).
+func TestClient_MaxResponseSize_Exceeded(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			client.SetMaxResponseSize(64)
+
+			_, err := client.GetVLAN("0e56433f-d808-4669-821d-812769517ff8")
+			expect.NotNil("Error", err)
+
+			responseTooLargeError, ok := err.(*ResponseTooLargeError)
+			expect.IsTrue("Error is ResponseTooLargeError", ok)
+			expect.IsTrue("MaxResponseSize", responseTooLargeError.MaxResponseSize == 64)
+		},
+		Respond: testRespondOK(getVLANForAPIVersionTestResponse),
+	})
+}
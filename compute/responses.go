@@ -33,6 +33,11 @@ func IsResourceBusyError(err error) bool {
 	return IsAPIErrorCode(err, ResponseCodeResourceBusy)
 }
 
+// IsResourceInUseError determines whether the specified error represents an IN_USE response from CloudControl.
+func IsResourceInUseError(err error) bool {
+	return IsAPIErrorCode(err, ResponseCodeInUse)
+}
+
 // IsResourceNotFoundError determines whether the specified error represents a RESOURCE_NOT_FOUND response from CloudControl.
 func IsResourceNotFoundError(err error) bool {
 	return IsAPIErrorCode(err, ResponseCodeResourceNotFound)
@@ -99,6 +104,9 @@ const (
 	// ResponseCodeResourceBusy indicates that an operation cannot be performed on a resource because the resource is busy.
 	ResponseCodeResourceBusy = "RESOURCE_BUSY"
 
+	// ResponseCodeInUse indicates that an operation cannot be performed on a resource because the resource is in use (e.g. an image that is being used to deploy a server).
+	ResponseCodeInUse = "IN_USE"
+
 	// ResponseCodeResourceLocked indicates that an operation cannot be performed on a resource because the resource is locked.
 	ResponseCodeResourceLocked = "RESOURCE_LOCKED"
 
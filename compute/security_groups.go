@@ -0,0 +1,346 @@
+package compute
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const (
+	// SecurityGroupTypeVLAN indicates a VLAN-level security group (applies to NICs on a single VLAN).
+	SecurityGroupTypeVLAN = "VLAN"
+
+	// SecurityGroupTypeServer indicates a server-level security group (applies to NICs on any VLAN in a network domain).
+	SecurityGroupTypeServer = "SERVER"
+)
+
+// SecurityGroup represents a CloudControl security group (a set of NICs that can be referenced by firewall rules).
+type SecurityGroup struct {
+	// The security group Id.
+	ID string `json:"id"`
+
+	// The security group name.
+	Name string `json:"name"`
+
+	// The security group description.
+	Description string `json:"description"`
+
+	// The security group type (SecurityGroupTypeVLAN or SecurityGroupTypeServer).
+	Type string `json:"type"`
+
+	// The Id of the network domain in which the security group is located.
+	NetworkDomainID string `json:"networkDomainId"`
+
+	// The Id of the VLAN with which the security group is associated (VLAN-level security groups only).
+	VLANID string `json:"vlanId,omitempty"`
+
+	// The NICs that are members of the security group.
+	NICs SecurityGroupNICs `json:"nics"`
+
+	// The date / time that the security group was created.
+	CreateTime Timestamp `json:"createTime"`
+
+	// The security group's current state.
+	State string `json:"state"`
+
+	// The Id of the data center in which the security group is located.
+	DataCenterID string `json:"datacenterId"`
+}
+
+// GetID returns the security group's Id.
+func (group *SecurityGroup) GetID() string {
+	return group.ID
+}
+
+// GetName returns the security group's name.
+func (group *SecurityGroup) GetName() string {
+	return group.Name
+}
+
+// ToEntityReference creates an EntityReference representing the security group.
+func (group *SecurityGroup) ToEntityReference() EntityReference {
+	return EntityReference{
+		ID:   group.ID,
+		Name: group.Name,
+	}
+}
+
+var _ NamedEntity = &SecurityGroup{}
+
+// SecurityGroupNICs represents the NICs that are members of a security group.
+type SecurityGroupNICs struct {
+	// The member NICs.
+	Items []SecurityGroupNIC `json:"nic"`
+}
+
+// SecurityGroupNIC represents a NIC that is a member of a security group.
+type SecurityGroupNIC struct {
+	// The NIC Id.
+	ID string `json:"id"`
+
+	// The NIC's primary private IPv4 address.
+	PrivateIPv4Address string `json:"primaryIpv4,omitempty"`
+
+	// The NIC's IPv6 address.
+	IPv6Address string `json:"ipv6,omitempty"`
+
+	// The Id of the VLAN to which the NIC is attached.
+	VLANID string `json:"vlanId,omitempty"`
+
+	// The server to which the NIC belongs.
+	Server EntityReference `json:"server"`
+}
+
+// SecurityGroups represents a page of SecurityGroup results.
+type SecurityGroups struct {
+	// The current page of security groups.
+	Items []SecurityGroup `json:"securityGroup"`
+
+	PagedResult
+}
+
+// SecurityGroupConfiguration represents the configuration for a new security group.
+//
+// Specify VLANID to create a VLAN-level security group, or NetworkDomainID to create a server-level security group.
+type SecurityGroupConfiguration struct {
+	// The security group name.
+	Name string `json:"name"`
+
+	// The security group description.
+	Description string `json:"description,omitempty"`
+
+	// The Id of the VLAN with which the security group is associated (VLAN-level security groups only).
+	VLANID string `json:"vlanId,omitempty"`
+
+	// The Id of the network domain in which the security group is created (server-level security groups only).
+	NetworkDomainID string `json:"networkDomainId,omitempty"`
+}
+
+// EditSecurityGroup represents the request body when editing a security group.
+type EditSecurityGroup struct {
+	// The Id of the security group to edit.
+	ID string `json:"id"`
+
+	// The security group name (optional).
+	Name *string `json:"name,omitempty"`
+
+	// The security group description (optional).
+	Description *string `json:"description,omitempty"`
+}
+
+// DeleteSecurityGroup represents the request body when deleting a security group.
+type DeleteSecurityGroup struct {
+	// The Id of the security group to delete.
+	ID string `json:"id"`
+}
+
+// SecurityGroupNICMembership represents the request body when adding a NIC to (or removing a NIC from) a security group.
+type SecurityGroupNICMembership struct {
+	// The Id of the NIC.
+	NICID string `json:"nicId"`
+
+	// The Id of the security group.
+	SecurityGroupID string `json:"securityGroupId"`
+}
+
+// CreateSecurityGroup creates a new security group.
+// Returns the Id of the new security group.
+func (client *Client) CreateSecurityGroup(configuration SecurityGroupConfiguration) (securityGroupID string, err error) {
+	if configuration.VLANID == "" && configuration.NetworkDomainID == "" {
+		return "", fmt.Errorf("Cannot create security group '%s' (must specify either a VLAN Id or a network domain Id)", configuration.Name)
+	}
+	if configuration.VLANID != "" && configuration.NetworkDomainID != "" {
+		return "", fmt.Errorf("Cannot create security group '%s' (cannot specify both a VLAN Id and a network domain Id)", configuration.Name)
+	}
+
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return "", err
+	}
+
+	requestURI := fmt.Sprintf("%s/securityGroup/createSecurityGroup",
+		url.QueryEscape(organizationID),
+	)
+	request, err := client.newRequestV29(requestURI, http.MethodPost, &configuration)
+	if err != nil {
+		return "", err
+	}
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return "", err
+	}
+
+	apiResponse, err := readAPIResponseAsJSON(responseBody, statusCode)
+	if err != nil {
+		return "", err
+	}
+
+	if apiResponse.ResponseCode != ResponseCodeOK {
+		return "", apiResponse.ToError("Request to create security group '%s' failed with status code %d (%s): %s", configuration.Name, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	// Expected: "info" { "name": "securityGroupId", "value": "the-Id-of-the-new-security-group" }
+	securityGroupIDMessage := apiResponse.GetFieldMessage("securityGroupId")
+	if securityGroupIDMessage == nil {
+		return "", apiResponse.ToError("Received an unexpected response (missing 'securityGroupId') with status code %d (%s): %s", statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	return *securityGroupIDMessage, nil
+}
+
+// GetSecurityGroup retrieves the security group with the specified Id.
+// Returns nil if no security group is found with the specified Id.
+func (client *Client) GetSecurityGroup(id string) (securityGroup *SecurityGroup, err error) {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return nil, err
+	}
+
+	requestURI := fmt.Sprintf("%s/securityGroup/securityGroup/%s",
+		url.QueryEscape(organizationID),
+		url.QueryEscape(id),
+	)
+	request, err := client.newRequestV29(requestURI, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode != http.StatusOK {
+		var apiResponse *APIResponseV2
+
+		apiResponse, err = readAPIResponseAsJSON(responseBody, statusCode)
+		if err != nil {
+			return nil, err
+		}
+
+		if apiResponse.ResponseCode == ResponseCodeResourceNotFound {
+			return nil, nil // Not an error, but was not found.
+		}
+
+		return nil, apiResponse.ToError("Request to retrieve security group '%s' failed with status code %d (%s): %s", id, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	securityGroup = &SecurityGroup{}
+	err = json.Unmarshal(responseBody, securityGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	return securityGroup, nil
+}
+
+// ListSecurityGroups retrieves a page of security groups in the specified network domain.
+func (client *Client) ListSecurityGroups(networkDomainID string, paging *Paging) (securityGroups *SecurityGroups, err error) {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return nil, err
+	}
+
+	err = paging.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	requestURI := fmt.Sprintf("%s/securityGroup/securityGroup?networkDomainId=%s&%s",
+		url.QueryEscape(organizationID),
+		url.QueryEscape(networkDomainID),
+		paging.EnsurePaging().toQueryParameters(),
+	)
+	request, err := client.newRequestV29(requestURI, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode != http.StatusOK {
+		var apiResponse *APIResponseV2
+
+		apiResponse, err = readAPIResponseAsJSON(responseBody, statusCode)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, apiResponse.ToError("Request to list security groups in network domain '%s' failed with status code %d (%s): %s", networkDomainID, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	securityGroups = &SecurityGroups{}
+	err = json.Unmarshal(responseBody, securityGroups)
+	if err != nil {
+		return nil, err
+	}
+
+	return securityGroups, nil
+}
+
+// EditSecurityGroup updates the name and / or description of an existing security group.
+func (client *Client) EditSecurityGroup(id string, name *string, description *string) error {
+	return client.postSecurityGroupRequest("editSecurityGroup", id, &EditSecurityGroup{
+		ID:          id,
+		Name:        name,
+		Description: description,
+	})
+}
+
+// DeleteSecurityGroup deletes an existing security group.
+func (client *Client) DeleteSecurityGroup(id string) error {
+	return client.postSecurityGroupRequest("deleteSecurityGroup", id, &DeleteSecurityGroup{
+		ID: id,
+	})
+}
+
+// AddNicToSecurityGroup adds the specified NIC to a security group.
+func (client *Client) AddNicToSecurityGroup(nicID string, securityGroupID string) error {
+	return client.postSecurityGroupRequest("addNic", securityGroupID, &SecurityGroupNICMembership{
+		NICID:           nicID,
+		SecurityGroupID: securityGroupID,
+	})
+}
+
+// RemoveNicFromSecurityGroup removes the specified NIC from a security group.
+func (client *Client) RemoveNicFromSecurityGroup(nicID string, securityGroupID string) error {
+	return client.postSecurityGroupRequest("removeNic", securityGroupID, &SecurityGroupNICMembership{
+		NICID:           nicID,
+		SecurityGroupID: securityGroupID,
+	})
+}
+
+// postSecurityGroupRequest posts a request to the specified security group operation.
+func (client *Client) postSecurityGroupRequest(operation string, securityGroupID string, requestBody interface{}) error {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return err
+	}
+
+	requestURI := fmt.Sprintf("%s/securityGroup/%s",
+		url.QueryEscape(organizationID),
+		operation,
+	)
+	request, err := client.newRequestV29(requestURI, http.MethodPost, requestBody)
+	if err != nil {
+		return err
+	}
+
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return err
+	}
+
+	apiResponse, err := readAPIResponseAsJSON(responseBody, statusCode)
+	if err != nil {
+		return err
+	}
+
+	if apiResponse.ResponseCode != ResponseCodeOK && apiResponse.ResponseCode != ResponseCodeInProgress {
+		return apiResponse.ToError("Request to %s for security group '%s' failed with status code %d (%s): %s", operation, securityGroupID, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	return nil
+}
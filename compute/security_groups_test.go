@@ -0,0 +1,182 @@
+package compute
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// Create security group (successful).
+func TestClient_CreateSecurityGroup_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			securityGroupID, err := client.CreateSecurityGroup(SecurityGroupConfiguration{
+				Name:   "web-servers",
+				VLANID: "0e56433f-d808-4669-821d-812769517ff8",
+			})
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsString("SecurityGroupID", "b3f0f6a8-a6d1-4c64-b47b-a5e4bdc3f2a7", securityGroupID)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.IsTrue("Request.URL", strings.HasPrefix(request.URL.Path, "/caas/2.9/") && strings.HasSuffix(request.URL.Path, "/securityGroup/createSecurityGroup"))
+
+			requestBody := &SecurityGroupConfiguration{}
+			err := readRequestBodyAsJSON(request, requestBody)
+			if err != nil {
+				test.Fatal(err)
+			}
+			expect.EqualsString("Configuration.Name", "web-servers", requestBody.Name)
+			expect.EqualsString("Configuration.VLANID", "0e56433f-d808-4669-821d-812769517ff8", requestBody.VLANID)
+			expect.EqualsString("Configuration.NetworkDomainID", "", requestBody.NetworkDomainID)
+
+			return http.StatusOK, createSecurityGroupTestResponse
+		},
+	})
+}
+
+// Create security group (neither VLAN nor network domain specified).
+func TestClient_CreateSecurityGroup_NoScope(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			_, err := client.CreateSecurityGroup(SecurityGroupConfiguration{
+				Name: "web-servers",
+			})
+			expect.NotNil("Error", err)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			test.Fatalf("Unexpected request to '%s'.", request.URL.Path)
+
+			return http.StatusInternalServerError, ""
+		},
+	})
+}
+
+// List security groups (successful).
+func TestClient_ListSecurityGroups_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			securityGroups, err := client.ListSecurityGroups("484174a2-ae74-4658-9e56-50fc90e086cf", nil)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("SecurityGroups.Items.Length", 1, len(securityGroups.Items))
+
+			securityGroup := securityGroups.Items[0]
+			expect.EqualsString("SecurityGroup.Name", "web-servers", securityGroup.Name)
+			expect.EqualsString("SecurityGroup.Type", SecurityGroupTypeVLAN, securityGroup.Type)
+			expect.EqualsInt("SecurityGroup.NICs.Items.Length", 1, len(securityGroup.NICs.Items))
+			expect.EqualsString("SecurityGroup.NICs.Items[0].Server.Name", "web1", securityGroup.NICs.Items[0].Server.Name)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.EqualsString("Request.URL.Query.networkDomainId", "484174a2-ae74-4658-9e56-50fc90e086cf", request.URL.Query().Get("networkDomainId"))
+
+			return http.StatusOK, listSecurityGroupsTestResponse
+		},
+	})
+}
+
+// Add NIC to security group (successful).
+func TestClient_AddNicToSecurityGroup_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			err := client.AddNicToSecurityGroup("5999db1d-725c-46ba-9d4e-d33991e61ab1", "b3f0f6a8-a6d1-4c64-b47b-a5e4bdc3f2a7")
+			if err != nil {
+				test.Fatal(err)
+			}
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.IsTrue("Request.URL", strings.HasSuffix(request.URL.Path, "/securityGroup/addNic"))
+
+			requestBody := &SecurityGroupNICMembership{}
+			err := readRequestBodyAsJSON(request, requestBody)
+			if err != nil {
+				test.Fatal(err)
+			}
+			expect.EqualsString("Membership.NICID", "5999db1d-725c-46ba-9d4e-d33991e61ab1", requestBody.NICID)
+			expect.EqualsString("Membership.SecurityGroupID", "b3f0f6a8-a6d1-4c64-b47b-a5e4bdc3f2a7", requestBody.SecurityGroupID)
+
+			return http.StatusOK, addNicToSecurityGroupTestResponse
+		},
+	})
+}
+
+/*
+ * Test responses.
+ */
+
+const createSecurityGroupTestResponse = `
+{
+	"operation": "CREATE_SECURITY_GROUP",
+	"responseCode": "OK",
+	"message": "Security Group 'web-servers' has been created.",
+	"info": [
+		{
+			"name": "securityGroupId",
+			"value": "b3f0f6a8-a6d1-4c64-b47b-a5e4bdc3f2a7"
+		}
+	],
+	"warning": [],
+	"error": [],
+	"requestId": "na9_20170321T074626030-0400_7e9fffe7-190b-46f2-9107-9d52fe57d0ad"
+}
+`
+
+const listSecurityGroupsTestResponse = `
+{
+	"securityGroup": [
+		{
+			"id": "b3f0f6a8-a6d1-4c64-b47b-a5e4bdc3f2a7",
+			"name": "web-servers",
+			"description": "",
+			"type": "VLAN",
+			"networkDomainId": "484174a2-ae74-4658-9e56-50fc90e086cf",
+			"vlanId": "0e56433f-d808-4669-821d-812769517ff8",
+			"nics": {
+				"nic": [
+					{
+						"id": "5999db1d-725c-46ba-9d4e-d33991e61ab1",
+						"primaryIpv4": "10.0.3.11",
+						"ipv6": "2607:f480:111:1336:6503:544c:74a6:3a28",
+						"vlanId": "0e56433f-d808-4669-821d-812769517ff8",
+						"server": {
+							"id": "5a32d6e4-9707-4813-a269-56ab4d989f4d",
+							"name": "web1"
+						}
+					}
+				]
+			},
+			"createTime": "2017-03-21T07:46:26.000Z",
+			"state": "NORMAL",
+			"datacenterId": "NA9"
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 1,
+	"totalCount": 1,
+	"pageSize": 250
+}
+`
+
+const addNicToSecurityGroupTestResponse = `
+{
+	"operation": "ADD_NIC_TO_SECURITY_GROUP",
+	"responseCode": "OK",
+	"message": "NIC '5999db1d-725c-46ba-9d4e-d33991e61ab1' has been added to Security Group 'web-servers'.",
+	"info": [],
+	"warning": [],
+	"error": [],
+	"requestId": "na9_20170321T074626030-0400_7e9fffe7-190b-46f2-9107-9d52fe57d0ad"
+}
+`
@@ -6,29 +6,73 @@ import (
 	"net/url"
 )
 
+// CloneOptions represents the options for cloning a server to create a customer image.
+//
+// Note that the zero value enables guest OS customisation and does not preserve MAC addresses; since some licensing schemes are tied to MAC addresses, review these options carefully.
+type CloneOptions struct {
+	// The name of the new customer image.
+	ImageName string
+
+	// An optional description for the new customer image.
+	ImageDescription string
+
+	// Prevent guest OS customisation when deploying servers from the new image?
+	PreventGuestOSCustomization bool
+
+	// Preserve the MAC addresses of the server's network adapters in the new image?
+	PreserveMACAddresses bool
+
+	// The Id of the cluster in which to create the new image (optional; only applicable to datacenters with multiple clusters).
+	ClusterID string
+}
+
 type cloneServer struct {
 	ServerID             string `json:"id"`
 	ImageName            string `json:"imageName"`
 	ImageDescription     string `json:"description,omitempty"`
+	ClusterID            string `json:"clusterId,omitempty"`
 	GuestOsCustomization bool   `json:"guestOsCustomization"`
+	PreserveMacAddresses *bool  `json:"preserveMacAddresses,omitempty"`
 }
 
 // CloneServer clones a server to create a customer image.
 func (client *Client) CloneServer(serverID string, imageName string, imageDescription string, preventGuestOSCustomisation bool) (imageID string, err error) {
+	return client.CloneServerWithOptions(serverID, &CloneOptions{
+		ImageName:                   imageName,
+		ImageDescription:            imageDescription,
+		PreventGuestOSCustomization: preventGuestOSCustomisation,
+	})
+}
+
+// CloneServerWithOptions clones a server to create a customer image, using the specified options.
+func (client *Client) CloneServerWithOptions(serverID string, options *CloneOptions) (imageID string, err error) {
+	if options == nil {
+		return "", fmt.Errorf("Must specify options when cloning server '%s'", serverID)
+	}
+
 	organizationID, err := client.getOrganizationID()
 	if err != nil {
 		return "", err
 	}
 
+	requestBody := &cloneServer{
+		ServerID:             serverID,
+		ImageName:            options.ImageName,
+		ImageDescription:     options.ImageDescription,
+		ClusterID:            options.ClusterID,
+		GuestOsCustomization: !options.PreventGuestOSCustomization,
+	}
+	if options.PreserveMACAddresses {
+		requestBody.PreserveMacAddresses = boolToPtr(true)
+	}
+
 	requestURI := fmt.Sprintf("%s/server/cloneServer",
 		url.QueryEscape(organizationID),
 	)
-	request, err := client.newRequestV24(requestURI, http.MethodPost, &cloneServer{
-		ServerID:             serverID,
-		ImageName:            imageName,
-		ImageDescription:     imageDescription,
-		GuestOsCustomization: !preventGuestOSCustomisation,
-	})
+	request, err := client.newRequestV24(requestURI, http.MethodPost, requestBody)
+	if err != nil {
+		return "", err
+	}
 	responseBody, statusCode, err := client.executeRequest(request)
 	if err != nil {
 		return "", err
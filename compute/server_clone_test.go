@@ -0,0 +1,93 @@
+package compute
+
+import (
+	"testing"
+)
+
+// Clone server (successful).
+func TestClient_CloneServer_Success(test *testing.T) {
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			imageID, err := client.CloneServer("5a32d6e4-9707-4813-a269-56ab4d989f4d", "Golden.Image.1", "My golden image", false)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect(test).EqualsString("ImageID", "e2c9d5a1-7b3f-4c8e-9a6d-2f1b0c3e4d5a", imageID)
+		},
+		Respond: testValidateJSONRequestAndRespondOK(cloneServerTestResponse, &cloneServer{}, verifyCloneServerTestRequest),
+	})
+}
+
+// Clone server with options (successful).
+func TestClient_CloneServerWithOptions_Success(test *testing.T) {
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			imageID, err := client.CloneServerWithOptions("5a32d6e4-9707-4813-a269-56ab4d989f4d", &CloneOptions{
+				ImageName:                   "Golden.Image.1",
+				PreventGuestOSCustomization: true,
+				PreserveMACAddresses:        true,
+				ClusterID:                   "AU9-01",
+			})
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect(test).EqualsString("ImageID", "e2c9d5a1-7b3f-4c8e-9a6d-2f1b0c3e4d5a", imageID)
+		},
+		Respond: testValidateJSONRequestAndRespondOK(cloneServerTestResponse, &cloneServer{}, verifyCloneServerWithOptionsTestRequest),
+	})
+}
+
+/*
+ * Test requests.
+ */
+
+func verifyCloneServerTestRequest(test *testing.T, requestBody interface{}) {
+	expect := expect(test)
+
+	expect.NotNil("CloneServer", requestBody)
+	request := requestBody.(*cloneServer)
+
+	expect.EqualsString("CloneServer.ServerID", "5a32d6e4-9707-4813-a269-56ab4d989f4d", request.ServerID)
+	expect.EqualsString("CloneServer.ImageName", "Golden.Image.1", request.ImageName)
+	expect.EqualsString("CloneServer.ImageDescription", "My golden image", request.ImageDescription)
+	expect.EqualsString("CloneServer.ClusterID", "", request.ClusterID)
+	expect.IsTrue("CloneServer.GuestOsCustomization", request.GuestOsCustomization)
+	expect.IsTrue("CloneServer.PreserveMacAddresses is nil", request.PreserveMacAddresses == nil)
+}
+
+func verifyCloneServerWithOptionsTestRequest(test *testing.T, requestBody interface{}) {
+	expect := expect(test)
+
+	expect.NotNil("CloneServer", requestBody)
+	request := requestBody.(*cloneServer)
+
+	expect.EqualsString("CloneServer.ServerID", "5a32d6e4-9707-4813-a269-56ab4d989f4d", request.ServerID)
+	expect.EqualsString("CloneServer.ImageName", "Golden.Image.1", request.ImageName)
+	expect.EqualsString("CloneServer.ClusterID", "AU9-01", request.ClusterID)
+	expect.IsFalse("CloneServer.GuestOsCustomization", request.GuestOsCustomization)
+	expect.NotNil("CloneServer.PreserveMacAddresses", request.PreserveMacAddresses)
+	expect.IsTrue("CloneServer.PreserveMacAddresses", *request.PreserveMacAddresses)
+}
+
+/*
+ * Test responses.
+ */
+
+const cloneServerTestResponse = `
+{
+	"operation": "CLONE_SERVER",
+	"responseCode": "IN_PROGRESS",
+	"message": "Request to Clone Server '5a32d6e4-9707-4813-a269-56ab4d989f4d' has been accepted and is being processed.",
+	"info": [
+		{
+			"name": "imageId",
+			"value": "e2c9d5a1-7b3f-4c8e-9a6d-2f1b0c3e4d5a"
+		}
+	],
+	"warning": [],
+	"error": [],
+	"requestId": "na9_20161001T000000.000-0400_9b8a7c6d-5e4f-4321-a0b9-c8d7e6f5a4b3"
+}
+`
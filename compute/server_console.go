@@ -0,0 +1,85 @@
+package compute
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ServerConsole represents a virtual console session for a server.
+type ServerConsole struct {
+	// The Id of the server to which the console session provides access.
+	ServerID string
+
+	// The URL used to access the server's console (via a web browser).
+	URL string
+
+	// The time at which the console URL expires (zero if no expiry was reported).
+	Expires time.Time
+}
+
+// IsExpired determines whether the console URL has expired.
+func (console *ServerConsole) IsExpired() bool {
+	return !console.Expires.IsZero() && time.Now().After(console.Expires)
+}
+
+// Request body when starting a server console session.
+type startServerConsoleSession struct {
+	ServerID string `json:"serverId"`
+}
+
+// GetServerConsoleURL starts a virtual console session for the specified server and returns a (short-lived) URL that can be used to access it.
+//
+// The server must be running.
+func (client *Client) GetServerConsoleURL(serverID string) (console *ServerConsole, err error) {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return nil, err
+	}
+
+	requestURI := fmt.Sprintf("%s/server/startConsoleSession",
+		url.QueryEscape(organizationID),
+	)
+	request, err := client.newRequestV27(requestURI, http.MethodPost, &startServerConsoleSession{
+		ServerID: serverID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	apiResponse, err := readAPIResponseAsJSON(responseBody, statusCode)
+	if err != nil {
+		return nil, err
+	}
+
+	if apiResponse.ResponseCode != ResponseCodeOK {
+		return nil, apiResponse.ToError("Request to start console session for server '%s' failed with status code %d (%s): %s", serverID, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	// Expected: "info" { "name": "consoleUrl", "value": "https://..." }
+	consoleURLMessage := apiResponse.GetFieldMessage("consoleUrl")
+	if consoleURLMessage == nil {
+		return nil, apiResponse.ToError("Received an unexpected response (missing 'consoleUrl') with status code %d (%s): %s", statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	console = &ServerConsole{
+		ServerID: serverID,
+		URL:      *consoleURLMessage,
+	}
+
+	// Optional: "info" { "name": "expiryTime", "value": "2017-03-21T08:46:26.000Z" }
+	expiryTimeMessage := apiResponse.GetFieldMessage("expiryTime")
+	if expiryTimeMessage != nil {
+		console.Expires, err = time.Parse(time.RFC3339, *expiryTimeMessage)
+		if err != nil {
+			return nil, fmt.Errorf("Received an invalid console session expiry time '%s' for server '%s'", *expiryTimeMessage, serverID)
+		}
+	}
+
+	return console, nil
+}
@@ -0,0 +1,53 @@
+package compute
+
+import (
+	"testing"
+	"time"
+)
+
+// Get server console URL (successful).
+func TestClient_GetServerConsoleURL_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			console, err := client.GetServerConsoleURL("5a32d6e4-9707-4813-a269-56ab4d989f4d")
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsString("Console.ServerID", "5a32d6e4-9707-4813-a269-56ab4d989f4d", console.ServerID)
+			expect.EqualsString("Console.URL", "https://console-na9.example.com/session/d7f3a1c2", console.URL)
+			expect.IsTrue("Console.Expires", console.Expires.Equal(time.Date(2017, time.March, 21, 8, 46, 26, 0, time.UTC)))
+			expect.IsTrue("Console.IsExpired", console.IsExpired())
+		},
+		Respond: testValidateJSONRequestAndRespondOK(startConsoleSessionTestResponse, &startServerConsoleSession{}, func(test *testing.T, requestBody interface{}) {
+			expect.EqualsString("Request.ServerID", "5a32d6e4-9707-4813-a269-56ab4d989f4d", requestBody.(*startServerConsoleSession).ServerID)
+		}),
+	})
+}
+
+/*
+ * Test responses.
+ */
+
+const startConsoleSessionTestResponse = `
+{
+	"operation": "START_CONSOLE_SESSION",
+	"responseCode": "OK",
+	"message": "Console session started.",
+	"info": [
+		{
+			"name": "consoleUrl",
+			"value": "https://console-na9.example.com/session/d7f3a1c2"
+		},
+		{
+			"name": "expiryTime",
+			"value": "2017-03-21T08:46:26.000Z"
+		}
+	],
+	"warning": [],
+	"error": [],
+	"requestId": "na9_20170321T074626030-0400_7e9fffe7-190b-46f2-9107-9d52fe57d0ad"
+}
+`
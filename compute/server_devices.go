@@ -0,0 +1,264 @@
+package compute
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const (
+	// SATAControllerAdapterTypeAHCI represents the AHCI SATA controller adapter type.
+	SATAControllerAdapterTypeAHCI = "AHCI"
+)
+
+// ServerDevice represents a removable-media device (CD-ROM or floppy drive) attached to a server.
+type ServerDevice struct {
+	// The device Id.
+	ID string `json:"id"`
+
+	// The device's position on its controller.
+	Position int `json:"position,omitempty"`
+
+	// The device's virtual hardware key.
+	Key int `json:"key,omitempty"`
+
+	// The name of the ISO or FLP image (if any) attached to the device.
+	ImageName string `json:"isoName,omitempty"`
+
+	// The device's current state.
+	State string `json:"state,omitempty"`
+}
+
+// ServerControllerDevice represents a device attached to a server's IDE or SATA controller.
+//
+// Exactly one of the fields will be populated.
+type ServerControllerDevice struct {
+	// The disk (if the device is a disk).
+	Disk *VirtualMachineDisk `json:"disk,omitempty"`
+
+	// The CD-ROM drive (if the device is a CD-ROM drive).
+	Cdrom *ServerDevice `json:"cdrom,omitempty"`
+
+	// The floppy drive (if the device is a floppy drive).
+	Floppy *ServerDevice `json:"floppy,omitempty"`
+}
+
+// ServerIDEController represents an IDE controller on a server.
+type ServerIDEController struct {
+	// The controller Id.
+	ID string `json:"id"`
+
+	// The controller's IDE channel.
+	Channel int `json:"channel"`
+
+	// The controller's adapter type.
+	AdapterType string `json:"adapterType,omitempty"`
+
+	// The controller's virtual hardware key.
+	Key int `json:"key,omitempty"`
+
+	// The devices attached to the controller.
+	Devices []ServerControllerDevice `json:"deviceOrDisk,omitempty"`
+
+	// The controller's current state.
+	State string `json:"state,omitempty"`
+}
+
+// ServerSCSIController represents a SCSI controller (and its disks) on a server.
+type ServerSCSIController struct {
+	// The controller Id.
+	ID string `json:"id"`
+
+	// The controller's SCSI bus number.
+	BusNumber int `json:"busNumber"`
+
+	// The controller's adapter type (e.g. "LSI_LOGIC_PARALLEL").
+	AdapterType string `json:"adapterType,omitempty"`
+
+	// The controller's virtual hardware key.
+	Key int `json:"key,omitempty"`
+
+	// The disks attached to the controller.
+	Disks []VirtualMachineDisk `json:"disk"`
+
+	// The controller's current state.
+	State string `json:"state,omitempty"`
+}
+
+// ServerSATAController represents a SATA controller on a server.
+type ServerSATAController struct {
+	// The controller Id.
+	ID string `json:"id"`
+
+	// The controller's bus number.
+	BusNumber int `json:"busNumber"`
+
+	// The controller's adapter type (e.g. SATAControllerAdapterTypeAHCI).
+	AdapterType string `json:"adapterType,omitempty"`
+
+	// The controller's virtual hardware key.
+	Key int `json:"key,omitempty"`
+
+	// The devices attached to the controller.
+	Devices []ServerControllerDevice `json:"deviceOrDisk,omitempty"`
+
+	// The controller's current state.
+	State string `json:"state,omitempty"`
+}
+
+// GetCdromDevices retrieves the CD-ROM drives attached to the server (via its IDE and SATA controllers).
+func (server *Server) GetCdromDevices() (devices []ServerDevice) {
+	for _, controller := range server.IDEControllers {
+		for _, device := range controller.Devices {
+			if device.Cdrom != nil {
+				devices = append(devices, *device.Cdrom)
+			}
+		}
+	}
+	for _, controller := range server.SATAControllers {
+		for _, device := range controller.Devices {
+			if device.Cdrom != nil {
+				devices = append(devices, *device.Cdrom)
+			}
+		}
+	}
+
+	return
+}
+
+// Request body when adding a SATA controller to a server.
+type addSataController struct {
+	ServerID    string `json:"serverId"`
+	AdapterType string `json:"adapterType,omitempty"`
+}
+
+// Request body when adding a CD-ROM device to a server.
+type addCdromDevice struct {
+	ServerID     string `json:"serverId"`
+	ControllerID string `json:"controllerId"`
+	Position     int    `json:"position"`
+}
+
+// Request body when removing a device from a server.
+type removeDevice struct {
+	DeviceID string `json:"id"`
+}
+
+// AddSataController adds a SATA controller to a server.
+//
+// adapterType is optional (if empty, CloudControl's default adapter type is used).
+func (client *Client) AddSataController(serverID string, adapterType string) (controllerID string, err error) {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return "", err
+	}
+
+	requestURI := fmt.Sprintf("%s/server/addSataController",
+		url.QueryEscape(organizationID),
+	)
+	request, err := client.newRequestV27(requestURI, http.MethodPost, &addSataController{
+		ServerID:    serverID,
+		AdapterType: adapterType,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return "", err
+	}
+
+	apiResponse, err := readAPIResponseAsJSON(responseBody, statusCode)
+	if err != nil {
+		return "", err
+	}
+
+	if apiResponse.ResponseCode != ResponseCodeInProgress {
+		return "", apiResponse.ToError("Request to add SATA controller to server '%s' failed with status code %d (%s): %s", serverID, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	// Expected: "info" { "name": "controllerId", "value": "the-Id-of-the-new-controller" }
+	controllerIDMessage := apiResponse.GetFieldMessage("controllerId")
+	if controllerIDMessage == nil {
+		return "", apiResponse.ToError("Received an unexpected response (missing 'controllerId') with status code %d (%s): %s", statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	return *controllerIDMessage, nil
+}
+
+// AddCdromDevice adds a CD-ROM drive to a server's IDE or SATA controller.
+func (client *Client) AddCdromDevice(serverID string, controllerID string, position int) (deviceID string, err error) {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return "", err
+	}
+
+	requestURI := fmt.Sprintf("%s/server/addCdromDevice",
+		url.QueryEscape(organizationID),
+	)
+	request, err := client.newRequestV27(requestURI, http.MethodPost, &addCdromDevice{
+		ServerID:     serverID,
+		ControllerID: controllerID,
+		Position:     position,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return "", err
+	}
+
+	apiResponse, err := readAPIResponseAsJSON(responseBody, statusCode)
+	if err != nil {
+		return "", err
+	}
+
+	if apiResponse.ResponseCode != ResponseCodeInProgress {
+		return "", apiResponse.ToError("Request to add CD-ROM device to controller '%s' on server '%s' failed with status code %d (%s): %s", controllerID, serverID, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	// Expected: "info" { "name": "deviceId", "value": "the-Id-of-the-new-device" }
+	deviceIDMessage := apiResponse.GetFieldMessage("deviceId")
+	if deviceIDMessage == nil {
+		return "", apiResponse.ToError("Received an unexpected response (missing 'deviceId') with status code %d (%s): %s", statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	return *deviceIDMessage, nil
+}
+
+// RemoveDevice removes a device (CD-ROM drive, floppy drive, or controller) from a server.
+func (client *Client) RemoveDevice(deviceID string) error {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return err
+	}
+
+	requestURI := fmt.Sprintf("%s/server/removeDevice",
+		url.QueryEscape(organizationID),
+	)
+	request, err := client.newRequestV27(requestURI, http.MethodPost, &removeDevice{
+		DeviceID: deviceID,
+	})
+	if err != nil {
+		return err
+	}
+
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return err
+	}
+
+	apiResponse, err := readAPIResponseAsJSON(responseBody, statusCode)
+	if err != nil {
+		return err
+	}
+
+	if apiResponse.ResponseCode != ResponseCodeInProgress {
+		return apiResponse.ToError("Request to remove device '%s' failed with status code %d (%s): %s", deviceID, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	return nil
+}
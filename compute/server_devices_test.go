@@ -0,0 +1,182 @@
+package compute
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+// Server with IDE / SATA controllers and removable-media devices (round-trip).
+func TestServer_Devices_RoundTrip(test *testing.T) {
+	expect := expect(test)
+
+	server := &Server{}
+	err := json.Unmarshal([]byte(serverWithDevicesTestResponse), server)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	serialized, err := json.Marshal(server)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	server = &Server{}
+	err = json.Unmarshal(serialized, server)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	expect.EqualsInt("Server.IDEControllers.Length", 1, len(server.IDEControllers))
+	expect.EqualsInt("Server.IDEControllers[0].Devices.Length", 1, len(server.IDEControllers[0].Devices))
+
+	expect.EqualsInt("Server.SATAControllers.Length", 1, len(server.SATAControllers))
+	expect.EqualsString("Server.SATAControllers[0].AdapterType", SATAControllerAdapterTypeAHCI, server.SATAControllers[0].AdapterType)
+	expect.EqualsInt("Server.SATAControllers[0].Devices.Length", 2, len(server.SATAControllers[0].Devices))
+	expect.NotNil("Server.SATAControllers[0].Devices[0].Disk", server.SATAControllers[0].Devices[0].Disk)
+	expect.EqualsInt("Server.SATAControllers[0].Devices[0].Disk.SizeGB", 20, server.SATAControllers[0].Devices[0].Disk.SizeGB)
+
+	expect.EqualsInt("Server.Floppies.Length", 1, len(server.Floppies))
+
+	cdroms := server.GetCdromDevices()
+	expect.EqualsInt("CdromDevices.Length", 2, len(cdroms))
+	expect.EqualsString("CdromDevices[0].ID", "b2f7d3a1-6c8e-4f5a-9d2b-3e4f5a6b7c8d", cdroms[0].ID)
+	expect.EqualsString("CdromDevices[1].ID", "3c9e1b2a-7d4f-4e6a-8b5c-9d0e1f2a3b4c", cdroms[1].ID)
+	expect.EqualsString("CdromDevices[1].ImageName", "ubuntu-16.04-server-amd64.iso", cdroms[1].ImageName)
+}
+
+// Add CD-ROM device to server (successful).
+func TestClient_AddCdromDevice_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			deviceID, err := client.AddCdromDevice("7b62aae5-bdbe-4595-b58d-c78f95db2a7f", "0fd1ee3e-9f5d-4cd8-9b2e-3b6c8b7a9f10", 1)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsString("DeviceID", "3c9e1b2a-7d4f-4e6a-8b5c-9d0e1f2a3b4c", deviceID)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.EqualsString("Request.URL.Path", "/caas/2.7/my-organization-id/server/addCdromDevice", request.URL.Path)
+
+			requestBody := &addCdromDevice{}
+			err := readRequestBodyAsJSON(request, requestBody)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsString("AddCdromDevice.ServerID", "7b62aae5-bdbe-4595-b58d-c78f95db2a7f", requestBody.ServerID)
+			expect.EqualsString("AddCdromDevice.ControllerID", "0fd1ee3e-9f5d-4cd8-9b2e-3b6c8b7a9f10", requestBody.ControllerID)
+			expect.EqualsInt("AddCdromDevice.Position", 1, requestBody.Position)
+
+			return http.StatusOK, addCdromDeviceTestResponse
+		},
+	})
+}
+
+// Remove device from server (successful).
+func TestClient_RemoveDevice_Success(test *testing.T) {
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			err := client.RemoveDevice("3c9e1b2a-7d4f-4e6a-8b5c-9d0e1f2a3b4c")
+			if err != nil {
+				test.Fatal(err)
+			}
+		},
+		Respond: testValidateJSONRequestAndRespondOK(removeDeviceTestResponse, &removeDevice{}, func(test *testing.T, requestBody interface{}) {
+			expect(test).EqualsString("RemoveDevice.DeviceID", "3c9e1b2a-7d4f-4e6a-8b5c-9d0e1f2a3b4c", requestBody.(*removeDevice).DeviceID)
+		}),
+	})
+}
+
+/*
+ * Test responses.
+ */
+
+const serverWithDevicesTestResponse = `
+{
+	"id": "7b62aae5-bdbe-4595-b58d-c78f95db2a7f",
+	"name": "rescue01",
+	"ideController": [
+		{
+			"id": "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d",
+			"channel": 0,
+			"adapterType": "IDE",
+			"key": 200,
+			"state": "NORMAL",
+			"deviceOrDisk": [
+				{
+					"cdrom": {
+						"id": "b2f7d3a1-6c8e-4f5a-9d2b-3e4f5a6b7c8d",
+						"position": 0,
+						"key": 3000,
+						"state": "NORMAL"
+					}
+				}
+			]
+		}
+	],
+	"sataController": [
+		{
+			"id": "0fd1ee3e-9f5d-4cd8-9b2e-3b6c8b7a9f10",
+			"busNumber": 0,
+			"adapterType": "AHCI",
+			"key": 15000,
+			"state": "NORMAL",
+			"deviceOrDisk": [
+				{
+					"disk": {
+						"id": "92b1819e-6f91-4abe-88c7-607841959f90",
+						"scsiId": 0,
+						"sizeGb": 20,
+						"speed": "STANDARD"
+					}
+				},
+				{
+					"cdrom": {
+						"id": "3c9e1b2a-7d4f-4e6a-8b5c-9d0e1f2a3b4c",
+						"position": 1,
+						"key": 16001,
+						"isoName": "ubuntu-16.04-server-amd64.iso",
+						"state": "NORMAL"
+					}
+				}
+			]
+		}
+	],
+	"floppy": [
+		{
+			"id": "c4d5e6f7-a8b9-4c0d-9e1f-2a3b4c5d6e7f",
+			"position": 0,
+			"key": 8000,
+			"state": "NORMAL"
+		}
+	]
+}
+`
+
+const addCdromDeviceTestResponse = `
+{
+	"operation": "ADD_CDROM_DEVICE",
+	"responseCode": "IN_PROGRESS",
+	"message": "Request to add CD-ROM device to Server 'rescue01' has been accepted. Please use appropriate Get or List API for status.",
+	"info": [
+		{
+			"name": "deviceId",
+			"value": "3c9e1b2a-7d4f-4e6a-8b5c-9d0e1f2a3b4c"
+		}
+	],
+	"requestId": "na9/2016-01-01T00:00:00.000Z/abcd"
+}
+`
+
+const removeDeviceTestResponse = `
+{
+	"operation": "REMOVE_DEVICE",
+	"responseCode": "IN_PROGRESS",
+	"message": "Request to remove device '3c9e1b2a-7d4f-4e6a-8b5c-9d0e1f2a3b4c' has been accepted. Please use appropriate Get or List API for status.",
+	"requestId": "na9/2016-01-01T00:00:00.000Z/abcd"
+}
+`
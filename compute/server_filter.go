@@ -0,0 +1,179 @@
+package compute
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// ServerFilter represents server-side filtering criteria for listing servers.
+//
+// Create a ServerFilter by calling NewServerFilter, then chain calls to its WithXXX methods:
+//
+//	filter := compute.NewServerFilter().WithNetworkDomainID(networkDomainID).WithStarted(true)
+type ServerFilter struct {
+	filter url.Values
+}
+
+// NewServerFilter creates a new ServerFilter (which initially matches all servers).
+func NewServerFilter() *ServerFilter {
+	return &ServerFilter{
+		filter: url.Values{},
+	}
+}
+
+// WithName restricts the filter to servers with the specified name.
+func (filter *ServerFilter) WithName(name string) *ServerFilter {
+	return filter.with("name", name)
+}
+
+// WithNetworkDomainID restricts the filter to servers in the specified network domain.
+func (filter *ServerFilter) WithNetworkDomainID(networkDomainID string) *ServerFilter {
+	return filter.with("networkDomainId", networkDomainID)
+}
+
+// WithVLANID restricts the filter to servers with a network adapter attached to the specified VLAN.
+func (filter *ServerFilter) WithVLANID(vlanID string) *ServerFilter {
+	return filter.with("vlanId", vlanID)
+}
+
+// WithState restricts the filter to servers in the specified state (e.g. ResourceStatusNormal).
+func (filter *ServerFilter) WithState(state string) *ServerFilter {
+	return filter.with("state", state)
+}
+
+// WithStarted restricts the filter to servers that are (or are not) running.
+func (filter *ServerFilter) WithStarted(started bool) *ServerFilter {
+	return filter.with("started", strconv.FormatBool(started))
+}
+
+// WithDeployed restricts the filter to servers that have (or have not) been deployed.
+func (filter *ServerFilter) WithDeployed(deployed bool) *ServerFilter {
+	return filter.with("deployed", strconv.FormatBool(deployed))
+}
+
+// WithPrivateIPv4Address restricts the filter to servers with a network adapter that has the specified private IPv4 address.
+func (filter *ServerFilter) WithPrivateIPv4Address(privateIPv4Address string) *ServerFilter {
+	return filter.with("privateIpv4", privateIPv4Address)
+}
+
+// WithIPv6Address restricts the filter to servers with a network adapter that has the specified IPv6 address.
+func (filter *ServerFilter) WithIPv6Address(ipv6Address string) *ServerFilter {
+	return filter.with("ipv6", ipv6Address)
+}
+
+// with sets a filter field (replacing any existing value for that field).
+func (filter *ServerFilter) with(field string, value string) *ServerFilter {
+	if filter.filter == nil {
+		filter.filter = url.Values{}
+	}
+	filter.filter.Set(field, value)
+
+	return filter
+}
+
+// toQueryParameters converts the filter to URL query parameters (a nil filter matches all servers).
+func (filter *ServerFilter) toQueryParameters() url.Values {
+	query := url.Values{}
+	if filter == nil {
+		return query
+	}
+
+	for field, values := range filter.filter {
+		query[field] = append([]string(nil), values...)
+	}
+
+	return query
+}
+
+// ListServers retrieves a page of servers in the specified datacenter that match the specified filter.
+//
+// Leave datacenterID empty to list servers in all datacenters; pass a nil filter to match all servers.
+func (client *Client) ListServers(datacenterID string, filter *ServerFilter, paging *Paging) (servers *Servers, err error) {
+	request, err := client.newListServersRequest(datacenterID, filter, paging)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode != http.StatusOK {
+		var apiResponse *APIResponseV2
+
+		apiResponse, err = readAPIResponseAsJSON(responseBody, statusCode)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, apiResponse.ToError("Request to list servers in datacenter '%s' failed with status code %d (%s): %s", datacenterID, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	servers = &Servers{}
+	err = json.Unmarshal(responseBody, servers)
+	if err != nil {
+		return nil, err
+	}
+	servers.listPage = func(client *Client, paging *Paging) (*Servers, error) {
+		return client.ListServers(datacenterID, filter, paging)
+	}
+
+	return servers, nil
+}
+
+// ListAllServers retrieves all servers (in all datacenters, across all pages of results) that match the specified filter.
+//
+// Pass a nil filter to match all servers.
+// If there are more matching servers than can be retrieved via paging, they are retrieved separately for each datacenter.
+func (client *Client) ListAllServers(filter *ServerFilter) (servers []Server, err error) {
+	err = ForEachPartitionedPage(client.listDatacenterIDs, func(datacenterID string, paging *Paging) (*PagedResult, error) {
+		page, err := client.ListServers(datacenterID, filter, paging)
+		if err != nil {
+			return nil, err
+		}
+
+		servers = append(servers, page.Items...)
+
+		return &page.PagedResult, nil
+	}, func() {
+		servers = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return servers, nil
+}
+
+// newListServersRequest creates a request to list a page of servers in the specified datacenter that match the specified filter.
+func (client *Client) newListServersRequest(datacenterID string, filter *ServerFilter, paging *Paging) (*http.Request, error) {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return nil, err
+	}
+
+	err = paging.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	query := filter.toQueryParameters()
+	if datacenterID != "" {
+		query.Set("datacenterId", datacenterID)
+	}
+
+	queryParameters := paging.EnsurePaging().toQueryParameters()
+	if len(query) > 0 {
+		queryParameters = query.Encode() + "&" + queryParameters
+	}
+
+	requestURI := fmt.Sprintf("%s/server/server?%s",
+		url.QueryEscape(organizationID),
+		queryParameters,
+	)
+
+	return client.newRequestV27(requestURI, http.MethodGet, nil)
+}
@@ -0,0 +1,172 @@
+package compute
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// List servers with filter (successful).
+func TestClient_ListServers_WithFilter_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			filter := NewServerFilter().
+				WithNetworkDomainID("484174a2-ae74-4658-9e56-50fc90e086cf").
+				WithVLANID("0e56433f-d808-4669-821d-812769517ff8").
+				WithName("web1").
+				WithState(ResourceStatusNormal).
+				WithStarted(true).
+				WithPrivateIPv4Address("10.0.3.11")
+
+			servers, err := client.ListServers("NA9", filter, nil)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("Servers.Items.Length", 1, len(servers.Items))
+			expect.EqualsString("Servers.Items[0].Name", "web1", servers.Items[0].Name)
+			expect.IsTrue("Servers.Items[0].Started", servers.Items[0].Started)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			query := request.URL.Query()
+			expect.EqualsString("Query.datacenterId", "NA9", query.Get("datacenterId"))
+			expect.EqualsString("Query.networkDomainId", "484174a2-ae74-4658-9e56-50fc90e086cf", query.Get("networkDomainId"))
+			expect.EqualsString("Query.vlanId", "0e56433f-d808-4669-821d-812769517ff8", query.Get("vlanId"))
+			expect.EqualsString("Query.name", "web1", query.Get("name"))
+			expect.EqualsString("Query.state", "NORMAL", query.Get("state"))
+			expect.EqualsString("Query.started", "true", query.Get("started"))
+			expect.EqualsString("Query.privateIpv4", "10.0.3.11", query.Get("privateIpv4"))
+			expect.EqualsString("Query.pageNumber", "1", query.Get("pageNumber"))
+
+			return http.StatusOK, listServersWithFilterTestResponse
+		},
+	})
+}
+
+// List servers without filter (successful).
+func TestClient_ListServers_NoFilter_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			_, err := client.ListServers("", nil, nil)
+			if err != nil {
+				test.Fatal(err)
+			}
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.EqualsString("Request.URL.RawQuery", "pageNumber=1&pageSize=50", request.URL.RawQuery)
+
+			return http.StatusOK, listServersWithFilterTestResponse
+		},
+	})
+}
+
+// List servers in VLAN (successful).
+func TestClient_ListServersInVLAN_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			servers, err := client.ListServersInVLAN("0e56433f-d808-4669-821d-812769517ff8", &Paging{PageNumber: 1, PageSize: 10})
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("Servers.Items.Length", 1, len(servers.Items))
+			expect.EqualsString("Servers.Items[0].Name", "web1", servers.Items[0].Name)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			query := request.URL.Query()
+			expect.EqualsString("Query.vlanId", "0e56433f-d808-4669-821d-812769517ff8", query.Get("vlanId"))
+			expect.EqualsString("Query.datacenterId", "", query.Get("datacenterId"))
+			expect.EqualsString("Query.pageSize", "10", query.Get("pageSize"))
+
+			return http.StatusOK, listServersWithFilterTestResponse
+		},
+	})
+}
+
+// List all servers (too many to page through, so partitioned by datacenter).
+func TestClient_ListAllServers_Partitioned(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			servers, err := client.ListAllServers(NewServerFilter().WithState(ResourceStatusNormal))
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("Servers.Length", 2, len(servers))
+			expect.EqualsString("Servers[0].DatacenterID", "AU9", servers[0].DatacenterID)
+			expect.EqualsString("Servers[1].DatacenterID", "AU10", servers[1].DatacenterID)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			if strings.HasSuffix(request.URL.Path, "/infrastructure/datacenter") {
+				return http.StatusOK, listDatacenterIDsTestResponse
+			}
+
+			query := request.URL.Query()
+			expect.EqualsString("Query.state", "NORMAL", query.Get("state"))
+
+			switch query.Get("datacenterId") {
+			case "":
+				return http.StatusOK, strings.NewReplacer("{{datacenterId}}", "AU9", "{{totalCount}}", "12000").Replace(listAllServersTestResponse)
+			case "AU9":
+				return http.StatusOK, strings.NewReplacer("{{datacenterId}}", "AU9", "{{totalCount}}", "1").Replace(listAllServersTestResponse)
+			case "AU10":
+				return http.StatusOK, strings.NewReplacer("{{datacenterId}}", "AU10", "{{totalCount}}", "1").Replace(listAllServersTestResponse)
+			}
+
+			test.Fatalf("Unexpected request: %s", request.URL.String())
+
+			return http.StatusBadRequest, ""
+		},
+	})
+}
+
+/*
+ * Test responses.
+ */
+
+const listAllServersTestResponse = `
+{
+	"server": [
+		{
+			"id": "5a32d6e4-9707-4813-a269-56ab4d989f4d",
+			"name": "web1",
+			"datacenterId": "{{datacenterId}}",
+			"deployed": true,
+			"started": true,
+			"state": "NORMAL"
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 1,
+	"totalCount": {{totalCount}},
+	"pageSize": 50
+}
+`
+
+const listServersWithFilterTestResponse = `
+{
+	"server": [
+		{
+			"id": "5a32d6e4-9707-4813-a269-56ab4d989f4d",
+			"name": "web1",
+			"description": "",
+			"datacenterId": "NA9",
+			"deployed": true,
+			"started": true,
+			"state": "NORMAL"
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 1,
+	"totalCount": 1,
+	"pageSize": 50
+}
+`
@@ -0,0 +1,113 @@
+package compute
+
+import (
+	"fmt"
+	"time"
+)
+
+// ServerLifecycleReportConfiguration represents the configuration for a server lifecycle report.
+type ServerLifecycleReportConfiguration struct {
+	// The Id of the network domain whose servers will be included in the report.
+	NetworkDomainID string
+
+	// The Ids of images that are considered to be deprecated.
+	DeprecatedImageIDs []string
+
+	// If not empty, customer images that have a tag with this name are also considered to be deprecated.
+	DeprecationTagName string
+
+	// The time against which server ages are calculated (if zero, the current time is used).
+	ReferenceTime time.Time
+}
+
+// ServerLifecycleReportEntry represents the lifecycle information for a single server.
+type ServerLifecycleReportEntry struct {
+	ServerID                string        `json:"serverId"`
+	ServerName              string        `json:"serverName"`
+	SourceImageID           string        `json:"sourceImageId"`
+	CreateTime              time.Time     `json:"createTime"`
+	Age                     time.Duration `json:"age"`
+	AgeDays                 int           `json:"ageDays"`
+	IsSourceImageDeprecated bool          `json:"sourceImageDeprecated"`
+}
+
+// GetServerLifecycleReport computes the age of each server in a network domain, and determines whether it was deployed from a deprecated image.
+func (client *Client) GetServerLifecycleReport(configuration ServerLifecycleReportConfiguration) (entries []ServerLifecycleReportEntry, err error) {
+	referenceTime := configuration.ReferenceTime
+	if referenceTime.IsZero() {
+		referenceTime = time.Now()
+	}
+
+	deprecatedImages := make(map[string]bool)
+	for _, imageID := range configuration.DeprecatedImageIDs {
+		deprecatedImages[imageID] = true
+	}
+
+	err = ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		servers, err := client.ListServersInNetworkDomain(configuration.NetworkDomainID, paging)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, server := range servers.Items {
+			if server.CreateTime.IsZero() {
+				return nil, fmt.Errorf("Server '%s' has invalid create time '%s'", server.ID, server.CreateTime.Raw)
+			}
+			createTime := server.CreateTime.Time
+
+			isDeprecated, err := client.isImageDeprecated(server.SourceImageID, configuration.DeprecationTagName, deprecatedImages)
+			if err != nil {
+				return nil, err
+			}
+
+			age := referenceTime.Sub(createTime)
+			entries = append(entries, ServerLifecycleReportEntry{
+				ServerID:                server.ID,
+				ServerName:              server.Name,
+				SourceImageID:           server.SourceImageID,
+				CreateTime:              createTime,
+				Age:                     age,
+				AgeDays:                 int(age / (24 * time.Hour)),
+				IsSourceImageDeprecated: isDeprecated,
+			})
+		}
+
+		return &servers.PagedResult, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// isImageDeprecated determines whether the specified image is deprecated (results are cached in deprecatedImages).
+func (client *Client) isImageDeprecated(imageID string, deprecationTagName string, deprecatedImages map[string]bool) (bool, error) {
+	isDeprecated, isKnown := deprecatedImages[imageID]
+	if isKnown || deprecationTagName == "" || imageID == "" {
+		return isDeprecated, nil
+	}
+
+	// Only customer images can be tagged.
+	image, err := client.GetCustomerImage(imageID)
+	if err != nil {
+		return false, err
+	}
+	if image != nil {
+		tags, err := client.getAllAssetTags(imageID, AssetTypeCustomerImage)
+		if err != nil {
+			return false, err
+		}
+
+		for _, tag := range tags {
+			if tag.Name == deprecationTagName {
+				isDeprecated = true
+
+				break
+			}
+		}
+	}
+	deprecatedImages[imageID] = isDeprecated
+
+	return isDeprecated, nil
+}
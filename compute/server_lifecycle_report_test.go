@@ -0,0 +1,119 @@
+package compute
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Server lifecycle report (deprecated images from list and tags).
+func TestClient_GetServerLifecycleReport_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			entries, err := client.GetServerLifecycleReport(ServerLifecycleReportConfiguration{
+				NetworkDomainID:    "553f26b6-2a73-42c3-a78b-6116f11291d0",
+				DeprecatedImageIDs: []string{"02250336-de2b-4e99-ab96-78511b7f8f4b"},
+				DeprecationTagName: "deprecated",
+				ReferenceTime:      time.Date(2016, 10, 1, 0, 0, 0, 0, time.UTC),
+			})
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("Entries.Length", 3, len(entries))
+
+			expect.EqualsString("Entries[0].ServerID", "5a32d6e4-9707-4813-a269-56ab4d989f4d", entries[0].ServerID)
+			expect.EqualsInt("Entries[0].AgeDays", 30, entries[0].AgeDays)
+			expect.IsTrue("Entries[0].IsSourceImageDeprecated", entries[0].IsSourceImageDeprecated)
+
+			expect.EqualsString("Entries[1].ServerID", "b0a6c3e2-1d4f-4a5b-9c8d-7e6f5a4b3c2d", entries[1].ServerID)
+			expect.EqualsInt("Entries[1].AgeDays", 10, entries[1].AgeDays)
+			expect.IsTrue("Entries[1].IsSourceImageDeprecated", entries[1].IsSourceImageDeprecated)
+
+			expect.EqualsString("Entries[2].ServerID", "e1d2c3b4-a5f6-4e7d-8c9b-0a1b2c3d4e5f", entries[2].ServerID)
+			expect.EqualsInt("Entries[2].AgeDays", 1, entries[2].AgeDays)
+			expect.IsFalse("Entries[2].IsSourceImageDeprecated", entries[2].IsSourceImageDeprecated)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			switch {
+			case strings.HasSuffix(request.URL.Path, "/server/server"):
+				return http.StatusOK, listServersForLifecycleReportTestResponse
+			case strings.HasSuffix(request.URL.Path, "/image/customerImage/4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b"):
+				return http.StatusOK, `{"id": "4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b", "name": "Golden.Image.1", "state": "NORMAL"}`
+			case strings.HasSuffix(request.URL.Path, "/image/customerImage/7d3f1c2b-8a9e-4b5c-a6d7-e8f9a0b1c2d3"):
+				return http.StatusOK, `{"id": "7d3f1c2b-8a9e-4b5c-a6d7-e8f9a0b1c2d3", "name": "Golden.Image.2", "state": "NORMAL"}`
+			case strings.HasSuffix(request.URL.Path, "/tag/tag"):
+				if request.URL.Query().Get("assetId") == "4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b" {
+					return http.StatusOK, deprecatedImageTagsTestResponse
+				}
+
+				return http.StatusOK, noImageTagsTestResponse
+			}
+
+			test.Fatalf("Unexpected request to '%s'.", request.URL.Path)
+
+			return http.StatusNotFound, ""
+		},
+	})
+}
+
+/*
+ * Test responses.
+ */
+
+const listServersForLifecycleReportTestResponse = `
+{
+	"server": [
+		{
+			"id": "5a32d6e4-9707-4813-a269-56ab4d989f4d",
+			"name": "Production Web Server",
+			"sourceImageId": "02250336-de2b-4e99-ab96-78511b7f8f4b",
+			"createTime": "2016-09-01T00:00:00.000Z",
+			"state": "NORMAL"
+		},
+		{
+			"id": "b0a6c3e2-1d4f-4a5b-9c8d-7e6f5a4b3c2d",
+			"name": "Production App Server",
+			"sourceImageId": "4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b",
+			"createTime": "2016-09-21T00:00:00.000Z",
+			"state": "NORMAL"
+		},
+		{
+			"id": "e1d2c3b4-a5f6-4e7d-8c9b-0a1b2c3d4e5f",
+			"name": "Production DB Server",
+			"sourceImageId": "7d3f1c2b-8a9e-4b5c-a6d7-e8f9a0b1c2d3",
+			"createTime": "2016-09-30T00:00:00.000Z",
+			"state": "NORMAL"
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 3,
+	"totalCount": 3,
+	"pageSize": 250
+}
+`
+
+const deprecatedImageTagsTestResponse = `
+{
+	"tag": [
+		{
+			"assetType": "CUSTOMER_IMAGE",
+			"assetId": "4d2a2d91-9b3f-4f6e-9d8c-6c7a5e3f1a2b",
+			"assetName": "Golden.Image.1",
+			"datacenterId": "NA9",
+			"tagKeyId": "9e8d7c6b-5a4f-4e3d-2c1b-0a9f8e7d6c5b",
+			"tagKeyName": "deprecated",
+			"value": "",
+			"valueRequired": false,
+			"displayOnReport": true
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 1,
+	"totalCount": 1,
+	"pageSize": 250
+}
+`
@@ -0,0 +1,98 @@
+package compute
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const (
+	// ServerMonitoringPlanEssentials represents the Essentials service plan for server monitoring.
+	ServerMonitoringPlanEssentials = "ESSENTIALS"
+
+	// ServerMonitoringPlanAdvanced represents the Advanced service plan for server monitoring.
+	ServerMonitoringPlanAdvanced = "ADVANCED"
+)
+
+// ServerMonitoring represents the monitoring configuration for a server.
+type ServerMonitoring struct {
+	// The server's monitoring Id.
+	MonitoringID string `json:"monitoringId"`
+
+	// The server's monitoring service plan (ESSENTIALS or ADVANCED).
+	ServicePlan string `json:"servicePlan"`
+
+	// The current state of the server's monitoring.
+	State string `json:"state"`
+}
+
+// Request body when enabling monitoring for a server, or changing its monitoring service plan.
+type serverMonitoringPlan struct {
+	// The server Id.
+	ID string `json:"id"`
+
+	// The monitoring service plan.
+	ServicePlan string `json:"servicePlan"`
+}
+
+// Request body when disabling monitoring for a server.
+type disableServerMonitoring struct {
+	// The server Id.
+	ID string `json:"id"`
+}
+
+// EnableServerMonitoring enables monitoring for the specified server, using the specified service plan.
+func (client *Client) EnableServerMonitoring(serverID string, servicePlan string) error {
+	return client.postServerMonitoringRequest("enableServerMonitoring", serverID, &serverMonitoringPlan{
+		ID:          serverID,
+		ServicePlan: servicePlan,
+	})
+}
+
+// ChangeServerMonitoringPlan changes the monitoring service plan for the specified server.
+func (client *Client) ChangeServerMonitoringPlan(serverID string, servicePlan string) error {
+	return client.postServerMonitoringRequest("changeServerMonitoringPlan", serverID, &serverMonitoringPlan{
+		ID:          serverID,
+		ServicePlan: servicePlan,
+	})
+}
+
+// DisableServerMonitoring disables monitoring for the specified server.
+func (client *Client) DisableServerMonitoring(serverID string) error {
+	return client.postServerMonitoringRequest("disableServerMonitoring", serverID, &disableServerMonitoring{
+		ID: serverID,
+	})
+}
+
+// postServerMonitoringRequest posts a request to the specified server monitoring operation.
+func (client *Client) postServerMonitoringRequest(operation string, serverID string, requestBody interface{}) error {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return err
+	}
+
+	requestURI := fmt.Sprintf("%s/server/%s",
+		url.QueryEscape(organizationID),
+		operation,
+	)
+	request, err := client.newRequestV22(requestURI, http.MethodPost, requestBody)
+	if err != nil {
+		return err
+	}
+
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return err
+	}
+
+	apiResponse, err := readAPIResponseAsJSON(responseBody, statusCode)
+	if err != nil {
+		return err
+	}
+
+	if apiResponse.ResponseCode != ResponseCodeOK {
+		return apiResponse.ToError("Request to %s for server '%s' failed with status code %d (%s): %s", operation, serverID, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package compute
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// Enable server monitoring (successful).
+func TestClient_EnableServerMonitoring_Success(test *testing.T) {
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			err := client.EnableServerMonitoring("5a32d6e4-9707-4813-a269-56ab4d989f4d", ServerMonitoringPlanEssentials)
+			if err != nil {
+				test.Fatal(err)
+			}
+		},
+		Respond: testValidateJSONRequestAndRespondOK(enableServerMonitoringTestResponse, &serverMonitoringPlan{}, verifyEnableServerMonitoringTestRequest),
+	})
+}
+
+// Change server monitoring plan (successful).
+func TestClient_ChangeServerMonitoringPlan_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			err := client.ChangeServerMonitoringPlan("5a32d6e4-9707-4813-a269-56ab4d989f4d", ServerMonitoringPlanAdvanced)
+			if err != nil {
+				test.Fatal(err)
+			}
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.IsTrue("Request.URL", strings.HasSuffix(request.URL.Path, "/server/changeServerMonitoringPlan"))
+
+			requestBody := &serverMonitoringPlan{}
+			err := readRequestBodyAsJSON(request, requestBody)
+			if err != nil {
+				test.Fatal(err)
+			}
+			expect.EqualsString("ServerMonitoringPlan.ServicePlan", ServerMonitoringPlanAdvanced, requestBody.ServicePlan)
+
+			return http.StatusOK, changeServerMonitoringPlanTestResponse
+		},
+	})
+}
+
+// Disable server monitoring (server not found).
+func TestClient_DisableServerMonitoring_NotFound(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			err := client.DisableServerMonitoring("5a32d6e4-9707-4813-a269-56ab4d989f4d")
+			expect.NotNil("Error", err)
+			expect.IsTrue("IsResourceNotFoundError", IsResourceNotFoundError(err))
+		},
+		Respond: testRespond(http.StatusBadRequest, disableServerMonitoringNotFoundTestResponse),
+	})
+}
+
+/*
+ * Test requests.
+ */
+
+const enableServerMonitoringTestRequest = `
+{
+	"id": "5a32d6e4-9707-4813-a269-56ab4d989f4d",
+	"servicePlan": "ESSENTIALS"
+}
+`
+
+func verifyEnableServerMonitoringTestRequest(test *testing.T, requestBody interface{}) {
+	expect := expect(test)
+
+	expect.NotNil("ServerMonitoringPlan", requestBody)
+	request := requestBody.(*serverMonitoringPlan)
+
+	expect.EqualsString("ServerMonitoringPlan.ID", "5a32d6e4-9707-4813-a269-56ab4d989f4d", request.ID)
+	expect.EqualsString("ServerMonitoringPlan.ServicePlan", ServerMonitoringPlanEssentials, request.ServicePlan)
+}
+
+/*
+ * Test responses.
+ */
+
+const enableServerMonitoringTestResponse = `
+{
+	"operation": "ENABLE_SERVER_MONITORING",
+	"responseCode": "OK",
+	"message": "Monitoring has been enabled on Server (id:5a32d6e4-9707-4813-a269-56ab4d989f4d).",
+	"info": [],
+	"warning": [],
+	"error": [],
+	"requestId": "na9_20160321T074626030-0400_7e9fffe7-190b-46f2-9107-9d52fe57d0ad"
+}
+`
+
+const changeServerMonitoringPlanTestResponse = `
+{
+	"operation": "CHANGE_SERVER_MONITORING_PLAN",
+	"responseCode": "OK",
+	"message": "Monitoring Service Plan has been changed on Server (id:5a32d6e4-9707-4813-a269-56ab4d989f4d).",
+	"info": [],
+	"warning": [],
+	"error": [],
+	"requestId": "na9_20160321T074626030-0400_7e9fffe7-190b-46f2-9107-9d52fe57d0ad"
+}
+`
+
+const disableServerMonitoringNotFoundTestResponse = `
+{
+	"operation": "DISABLE_SERVER_MONITORING",
+	"responseCode": "RESOURCE_NOT_FOUND",
+	"message": "Server 5a32d6e4-9707-4813-a269-56ab4d989f4d not found.",
+	"info": [],
+	"warning": [],
+	"error": [],
+	"requestId": "na9_20160321T074626030-0400_7e9fffe7-190b-46f2-9107-9d52fe57d0ad"
+}
+`
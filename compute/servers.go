@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"time"
 )
 
 const (
@@ -22,26 +23,188 @@ const (
 
 	// ServerDiskSpeedHighPerformance represents the high-performance speed for server disks.
 	ServerDiskSpeedHighPerformance = "HIGHPERFORMANCE"
+
+	// ServerDiskSpeedProvisionedIOPS represents the provisioned-IOPS speed for server disks (the disk's IOPS must also be specified).
+	ServerDiskSpeedProvisionedIOPS = "PROVISIONEDIOPS"
+
+	// ServerDiskSpeedEconomy represents the economy speed for server disks.
+	ServerDiskSpeedEconomy = "ECONOMY"
+)
+
+const (
+	// ServerCPUSpeedStandard represents the standard speed for server CPUs.
+	ServerCPUSpeedStandard = "STANDARD"
+
+	// ServerCPUSpeedHighPerformance represents the high-performance speed for server CPUs.
+	ServerCPUSpeedHighPerformance = "HIGHPERFORMANCE"
 )
 
 // Server represents a virtual machine.
 type Server struct {
-	ID              string                `json:"id"`
-	Name            string                `json:"name"`
-	Description     string                `json:"description"`
-	OperatingSystem OperatingSystem       `json:"operatingSystem"`
-	CPU             VirtualMachineCPU     `json:"cpu"`
-	MemoryGB        int                   `json:"memoryGb"`
-	Disks           []VirtualMachineDisk  `json:"disk"`
-	Network         VirtualMachineNetwork `json:"networkInfo"`
-	SourceImageID   string                `json:"sourceImageId"`
-	State           string                `json:"state"`
-	Deployed        bool                  `json:"deployed"`
-	Started         bool                  `json:"started"`
+	ID              string                 `json:"id"`
+	Name            string                 `json:"name"`
+	Description     string                 `json:"description"`
+	OperatingSystem OperatingSystem        `json:"operatingSystem"`
+	CPU             VirtualMachineCPU      `json:"cpu"`
+	MemoryGB        int                    `json:"memoryGb"`
+	Disks           []VirtualMachineDisk   `json:"disk"`
+	Network         VirtualMachineNetwork  `json:"networkInfo"`
+	SourceImageID   string                 `json:"sourceImageId"`
+	CreateTime      Timestamp              `json:"createTime"`
+	State           string                 `json:"state"`
+	Deployed        bool                   `json:"deployed"`
+	Started         bool                   `json:"started"`
+	DatacenterID    string                 `json:"datacenterId"`
+	Backup          *ServerBackup          `json:"backup,omitempty"`
+	Monitoring      *ServerMonitoring      `json:"monitoring,omitempty"`
+	SnapshotService *ServerSnapshotService `json:"snapshotService,omitempty"`
+	VMwareTools     *ServerVMwareTools     `json:"vmwareTools,omitempty"`
+	Guest           *ServerGuest           `json:"guest,omitempty"` // CloudControl v2.4 and higher
+	VirtualHardware *ServerVirtualHardware `json:"virtualHardware,omitempty"`
+	Progress        *OperationProgress     `json:"progress,omitempty"`
+	IDEControllers  []ServerIDEController  `json:"ideController,omitempty"`  // CloudControl v2.7 and higher
+	SATAControllers []ServerSATAController `json:"sataController,omitempty"` // CloudControl v2.7 and higher
+	Floppies        []ServerDevice         `json:"floppy,omitempty"`         // CloudControl v2.7 and higher
+	SCSIControllers []ServerSCSIController `json:"scsiController,omitempty"` // CloudControl v2.7 and higher (see UnmarshalJSON)
+}
+
+// UnmarshalJSON deserialises a Server from JSON.
+//
+// CloudControl v2.7 groups a server's disks by SCSI controller ("scsiController") rather than listing them directly ("disk");
+// in that case, Disks is populated from the controllers' disks (ordered by bus number, then SCSI unit Id, with each disk's SCSIBusNumber set)
+// so that existing code continues to see every disk.
+func (server *Server) UnmarshalJSON(data []byte) error {
+	type serverFields Server // Prevent recursion.
+
+	var fields serverFields
+	err := json.Unmarshal(data, &fields)
+	if err != nil {
+		return err
+	}
+	*server = Server(fields)
+
+	if len(server.Disks) == 0 && len(server.SCSIControllers) > 0 {
+		controllers := make([]scsiControllerDisks, len(server.SCSIControllers))
+		for index, controller := range server.SCSIControllers {
+			controllers[index] = scsiControllerDisks{
+				BusNumber: controller.BusNumber,
+				Disks:     controller.Disks,
+			}
+		}
+		server.Disks = flattenSCSIControllerDisks(controllers)
+	}
+
+	return nil
+}
+
+const (
+	// VMwareToolsVersionStatusCurrent indicates that a server's VMware Tools are up-to-date.
+	VMwareToolsVersionStatusCurrent = "CURRENT"
+
+	// VMwareToolsVersionStatusNeedsUpgrade indicates that a server's VMware Tools should be upgraded.
+	VMwareToolsVersionStatusNeedsUpgrade = "NEEDS_UPGRADE"
+
+	// VMwareToolsVersionStatusNotInstalled indicates that VMware Tools are not installed on a server.
+	VMwareToolsVersionStatusNotInstalled = "NOT_INSTALLED"
+
+	// VMwareToolsRunningStatusRunning indicates that a server's VMware Tools are running.
+	VMwareToolsRunningStatusRunning = "RUNNING"
+
+	// VMwareToolsRunningStatusNotRunning indicates that a server's VMware Tools are not running.
+	VMwareToolsRunningStatusNotRunning = "NOT_RUNNING"
+)
+
+// ServerVMwareTools represents the status of VMware Tools on a server.
+type ServerVMwareTools struct {
+	// The VMware Tools version status (e.g. VMwareToolsVersionStatusCurrent).
+	VersionStatus string `json:"versionStatus"`
+
+	// The VMware Tools running status (e.g. VMwareToolsRunningStatusRunning).
+	RunningStatus string `json:"runningStatus"`
+
+	// The VMware Tools API version.
+	APIVersion int `json:"apiVersion"`
+}
+
+// NeedsUpgrade determines whether the server's VMware Tools should be upgraded (see UpdateVMwareTools).
+func (tools *ServerVMwareTools) NeedsUpgrade() bool {
+	return tools.VersionStatus == VMwareToolsVersionStatusNeedsUpgrade
+}
+
+// ServerGuest represents the guest OS configuration of a server (CloudControl v2.4 and higher).
+type ServerGuest struct {
+	// The server's operating system.
+	OperatingSystem OperatingSystem `json:"operatingSystem"`
+
+	// Was guest OS customisation performed when the server was deployed?
+	//
+	// Some operations (e.g. notifying CloudControl of IP address changes) behave differently for servers deployed without guest OS customisation.
+	OSCustomization bool `json:"osCustomization"`
+
+	// The status of VMware Tools on the server.
+	VMTools *ServerVMwareTools `json:"vmTools,omitempty"`
+}
+
+// ServerVirtualHardware represents the status of a server's virtual hardware.
+type ServerVirtualHardware struct {
+	// The virtual hardware version (e.g. "vmx-08").
+	Version string `json:"version"`
+
+	// Is the virtual hardware version up-to-date?
+	UpToDate bool `json:"upToDate"`
+}
+
+// NeedsUpgrade determines whether the server's virtual hardware should be upgraded (see UpgradeVirtualHardware).
+func (hardware *ServerVirtualHardware) NeedsUpgrade() bool {
+	return hardware != nil && !hardware.UpToDate
+}
+
+// GetVirtualHardwareVersion retrieves the server's virtual hardware version (e.g. "vmx-10").
+//
+// Returns an empty string if the virtual hardware version is not available.
+func (server *Server) GetVirtualHardwareVersion() string {
+	if server.VirtualHardware == nil {
+		return ""
+	}
+
+	return server.VirtualHardware.Version
+}
+
+// GetOS retrieves information about the server's operating system.
+func (server *Server) GetOS() OperatingSystem {
+	if server.Guest != nil && server.Guest.OperatingSystem.ID != "" {
+		return server.Guest.OperatingSystem
+	}
+
+	return server.OperatingSystem
+}
+
+// GetVMwareTools retrieves the status of VMware Tools on the server (or nil, if the status is not available).
+func (server *Server) GetVMwareTools() *ServerVMwareTools {
+	if server.Guest != nil && server.Guest.VMTools != nil {
+		return server.Guest.VMTools
+	}
+
+	return server.VMwareTools
+}
+
+// IsGuestOSCustomized determines whether guest OS customisation was performed when the server was deployed.
+//
+// Servers retrieved via CloudControl versions earlier than v2.4 are assumed to have been customised.
+func (server *Server) IsGuestOSCustomized() bool {
+	if server.Guest == nil {
+		return true
+	}
+
+	return server.Guest.OSCustomization
 }
 
 // GetID returns the server's Id.
 func (server *Server) GetID() string {
+	if server == nil {
+		return ""
+	}
+
 	return server.ID
 }
 
@@ -52,14 +215,40 @@ func (server *Server) GetResourceType() ResourceType {
 
 // GetName returns the server's name.
 func (server *Server) GetName() string {
+	if server == nil {
+		return ""
+	}
+
 	return server.Name
 }
 
 // GetState returns the server's current state.
 func (server *Server) GetState() string {
+	if server == nil {
+		return ""
+	}
+
 	return server.State
 }
 
+// GetPrimaryIPv4Address retrieves the private IPv4 address of the server's primary network adapter (empty if none has been assigned).
+func (server *Server) GetPrimaryIPv4Address() string {
+	if server.Network.PrimaryAdapter.PrivateIPv4Address == nil {
+		return ""
+	}
+
+	return *server.Network.PrimaryAdapter.PrivateIPv4Address
+}
+
+// GetPrimaryIPv6Address retrieves the IPv6 address of the server's primary network adapter (empty if none has been assigned).
+func (server *Server) GetPrimaryIPv6Address() string {
+	if server.Network.PrimaryAdapter.PrivateIPv6Address == nil {
+		return ""
+	}
+
+	return *server.Network.PrimaryAdapter.PrivateIPv6Address
+}
+
 // IsDeleted determines whether the server has been deleted (is nil).
 func (server *Server) IsDeleted() bool {
 	return server == nil
@@ -69,6 +258,10 @@ var _ Resource = &Server{}
 
 // ToEntityReference creates an EntityReference representing the Server.
 func (server *Server) ToEntityReference() EntityReference {
+	if server == nil {
+		return EntityReference{}
+	}
+
 	return EntityReference{
 		ID:   server.ID,
 		Name: server.Name,
@@ -82,6 +275,36 @@ type Servers struct {
 	Items []Server `json:"server"`
 
 	PagedResult
+
+	// Retrieves another page of results using the same criteria as this page (nil if the page was not retrieved via the CloudControl API).
+	listPage func(client *Client, paging *Paging) (*Servers, error)
+}
+
+// ForEach calls fn for each server in this page and each subsequent page of results (subsequent pages are retrieved using the specified client).
+//
+// Iteration stops at the first error returned by fn (or encountered while retrieving a page of results).
+func (page *Servers) ForEach(client *Client, fn func(server *Server) error) error {
+	for {
+		for index := range page.Items {
+			err := fn(&page.Items[index])
+			if err != nil {
+				return err
+			}
+		}
+
+		if page.IsLastPage() || page.listPage == nil {
+			return nil
+		}
+
+		nextPage, err := page.listPage(client, page.NextPage())
+		if err != nil {
+			return err
+		}
+		if nextPage == nil {
+			return nil
+		}
+		page = nextPage
+	}
 }
 
 // ServerSummary respresents summary information for a server.
@@ -113,7 +336,51 @@ type ServerDeploymentConfiguration struct {
 	Network               VirtualMachineNetwork `json:"networkInfo"`
 	PrimaryDNS            string                `json:"primaryDns,omitempty"`
 	SecondaryDNS          string                `json:"secondaryDns,omitempty"`
+	IPv4Gateway           string                `json:"ipv4Gateway,omitempty"`
+	IPv6Gateway           string                `json:"ipv6Gateway,omitempty"`
+	MicrosoftTimeZone     string                `json:"microsoftTimeZone,omitempty"` // Windows only (e.g. "035" for Eastern Standard Time)
 	Start                 bool                  `json:"start"`
+
+	// Set to false to deploy the server without guest OS customisation (CloudControl v2.4 and higher); leave nil to use the default.
+	GuestOSCustomization *bool `json:"guestOsCustomization,omitempty"`
+
+	// OS-specific first-boot configuration for the server's guest OS (CloudControl v2.7 and higher); leave nil to use the default.
+	GuestCustomization *ServerGuestCustomization `json:"guest,omitempty"`
+}
+
+// ServerGuestCustomization represents OS-specific first-boot configuration for a server's guest OS.
+//
+// At most one of Windows / Linux should be specified (matching the image's operating system family).
+type ServerGuestCustomization struct {
+	// First-boot configuration for a Windows guest OS.
+	Windows *WindowsGuestCustomization `json:"windows,omitempty"`
+
+	// First-boot configuration for a Linux guest OS.
+	Linux *LinuxGuestCustomization `json:"linux,omitempty"`
+}
+
+// WindowsGuestCustomization represents first-boot configuration for a Windows guest OS.
+type WindowsGuestCustomization struct {
+	// The guest's computer name (defaults to the server name).
+	ComputerName string `json:"computerName,omitempty"`
+
+	// The name of the workgroup that the guest will join.
+	Workgroup string `json:"workgroup,omitempty"`
+
+	// Commands to run (in order) the first time that the administrator logs on.
+	RunOnceCommands []string `json:"runOnceCommand,omitempty"`
+}
+
+// LinuxGuestCustomization represents first-boot configuration for a Linux guest OS.
+type LinuxGuestCustomization struct {
+	// The guest's host name (defaults to the server name).
+	HostName string `json:"hostName,omitempty"`
+
+	// The guest's DNS domain name.
+	DomainName string `json:"domainName,omitempty"`
+
+	// A script to run the first time that the guest boots.
+	BootScript string `json:"bootScript,omitempty"`
 }
 
 // editServerMetadata represents the request body when modifying server metadata.
@@ -153,6 +420,12 @@ type addDiskToServer struct {
 	SCSIUnitID int    `json:"scsiId"`
 }
 
+// changeDiskIops represents the request body when changing a provisioned-IOPS disk's IOPS.
+type changeDiskIops struct {
+	DiskID string `json:"id"`
+	IOPS   int    `json:"iops"`
+}
+
 // removeDiskFromServer represents the request body when removing an existing disk from a server.
 type removeDiskFromServer struct {
 	DiskID string `json:"id"`
@@ -206,6 +479,18 @@ type stopServer struct {
 	ID string `json:"id"`
 }
 
+// Request body when updating VMware Tools on a server.
+type updateVMwareTools struct {
+	// The server Id.
+	ID string `json:"id"`
+}
+
+// Request body when upgrading a server's virtual hardware.
+type upgradeVirtualHardware struct {
+	// The server Id.
+	ID string `json:"id"`
+}
+
 // Request body when deleting a network adapter.
 type deleteNic struct {
 	// The network adapter Id.
@@ -221,6 +506,15 @@ type changeNicType struct {
 	Type string `json:"networkAdapter"`
 }
 
+// Request body when changing network adapter connectivity.
+type setNicConnectivity struct {
+	// The network adapter Id.
+	ID string `json:"nicId"`
+
+	// Is the network adapter connected?
+	Connected bool `json:"connected"`
+}
+
 // GetServer retrieves the server with the specified Id.
 // id is the Id of the server to retrieve.
 // Returns nil if no server is found with the specified Id.
@@ -234,7 +528,7 @@ func (client *Client) GetServer(id string) (server *Server, err error) {
 		url.QueryEscape(organizationID),
 		url.QueryEscape(id),
 	)
-	request, err := client.newRequestV24(requestURI, http.MethodGet, nil)
+	request, err := client.newRequestV27(requestURI, http.MethodGet, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -264,30 +558,50 @@ func (client *Client) GetServer(id string) (server *Server, err error) {
 	return server, err
 }
 
-// ListServersInNetworkDomain retrieves a page of servers in the specified network domain.
-func (client *Client) ListServersInNetworkDomain(networkDomainID string, paging *Paging) (servers Servers, err error) {
-	if paging == nil {
-		paging = &Paging{
-			PageNumber: 1,
-		}
+// FindServerByName finds the server (if any) with the specified name in the specified network domain.
+// Returns nil if no server is found with the specified name.
+func (client *Client) FindServerByName(name string, networkDomainID string) (server *Server, err error) {
+	filter := NewServerFilter().
+		WithName(name).
+		WithNetworkDomainID(networkDomainID)
+
+	servers, err := client.ListServers("", filter, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if servers.PageCount == 0 {
+		return nil, nil
 	}
-	paging.ensureValidPageSize()
 
+	if servers.PageCount != 1 {
+		return nil, fmt.Errorf("Found multiple servers (%d) named '%s' in network domain '%s'.", servers.TotalCount, name, networkDomainID)
+	}
+
+	return &servers.Items[0], nil
+}
+
+// ListServersInNetworkDomain retrieves a page of servers in the specified network domain.
+func (client *Client) ListServersInNetworkDomain(networkDomainID string, paging *Paging) (servers Servers, err error) {
 	var organizationID string
 	organizationID, err = client.getOrganizationID()
 	if err != nil {
 		return
 	}
 
-	requestURI := fmt.Sprintf("%s/server/server?networkDomainId=%s&pageNumber=%d&pageSize=%d",
+	err = paging.Validate()
+	if err != nil {
+		return
+	}
+
+	requestURI := fmt.Sprintf("%s/server/server?networkDomainId=%s&%s",
 		url.QueryEscape(organizationID),
 		url.QueryEscape(networkDomainID),
-		paging.PageNumber,
-		paging.PageSize,
+		paging.EnsurePaging().toQueryParameters(),
 	)
 
 	var request *http.Request
-	request, err = client.newRequestV23(requestURI, http.MethodGet, nil)
+	request, err = client.newRequestV27(requestURI, http.MethodGet, nil)
 	if err != nil {
 		return
 	}
@@ -315,10 +629,28 @@ func (client *Client) ListServersInNetworkDomain(networkDomainID string, paging
 
 	servers = Servers{}
 	err = json.Unmarshal(responseBody, &servers)
+	servers.listPage = func(client *Client, paging *Paging) (*Servers, error) {
+		page, err := client.ListServersInNetworkDomain(networkDomainID, paging)
+		if err != nil {
+			return nil, err
+		}
+
+		return &page, nil
+	}
 
 	return
 }
 
+// ListServersInVLAN retrieves a page of servers with a network adapter attached to the specified VLAN.
+func (client *Client) ListServersInVLAN(vlanID string, paging *Paging) (servers Servers, err error) {
+	page, err := client.ListServers("", NewServerFilter().WithVLANID(vlanID), paging)
+	if err != nil {
+		return
+	}
+
+	return *page, nil
+}
+
 // DeployServer deploys a new virtual machine.
 func (client *Client) DeployServer(serverConfiguration ServerDeploymentConfiguration) (serverID string, err error) {
 	organizationID, err := client.getOrganizationID()
@@ -329,7 +661,24 @@ func (client *Client) DeployServer(serverConfiguration ServerDeploymentConfigura
 	requestURI := fmt.Sprintf("%s/server/deployServer",
 		url.QueryEscape(organizationID),
 	)
-	request, err := client.newRequestV23(requestURI, http.MethodPost, &serverConfiguration)
+	if serverConfiguration.GuestCustomization != nil {
+		guest := serverConfiguration.GuestCustomization
+		if guest.Windows != nil && guest.Linux != nil {
+			return "", fmt.Errorf("Cannot deploy server '%s' with both Windows and Linux guest customisation", serverConfiguration.Name)
+		}
+	}
+
+	var request *http.Request
+	if serverConfiguration.GuestCustomization != nil {
+		request, err = client.newRequestV27(requestURI, http.MethodPost, &serverConfiguration)
+	} else if serverConfiguration.GuestOSCustomization != nil {
+		request, err = client.newRequestV24(requestURI, http.MethodPost, &serverConfiguration)
+	} else {
+		request, err = client.newRequestV23(requestURI, http.MethodPost, &serverConfiguration)
+	}
+	if err != nil {
+		return "", err
+	}
 	responseBody, statusCode, err := client.executeRequest(request)
 	if err != nil {
 		return "", err
@@ -353,6 +702,39 @@ func (client *Client) DeployServer(serverConfiguration ServerDeploymentConfigura
 	return *serverIDMessage, nil
 }
 
+// DeployServerOptions represents options for DeployServerWithOptions.
+type DeployServerOptions struct {
+	// Generate a random administrator password (overriding the configuration's AdministratorPassword)?
+	GenerateAdministratorPassword bool
+
+	// The length of the generated administrator password (if 0, DefaultAdministratorPasswordLength is used).
+	AdministratorPasswordLength int
+}
+
+// DeployServerWithOptions deploys a new virtual machine, optionally generating its administrator password.
+//
+// Returns the administrator password that was used for the deployment (whether generated or supplied).
+func (client *Client) DeployServerWithOptions(serverConfiguration ServerDeploymentConfiguration, options DeployServerOptions) (serverID string, administratorPassword string, err error) {
+	if options.GenerateAdministratorPassword {
+		passwordLength := options.AdministratorPasswordLength
+		if passwordLength == 0 {
+			passwordLength = DefaultAdministratorPasswordLength
+		}
+
+		serverConfiguration.AdministratorPassword, err = GenerateAdministratorPassword(passwordLength)
+		if err != nil {
+			return "", "", err
+		}
+	}
+
+	serverID, err = client.DeployServer(serverConfiguration)
+	if err != nil {
+		return "", "", err
+	}
+
+	return serverID, serverConfiguration.AdministratorPassword, nil
+}
+
 // EditServerMetadata modifies a server's name and / or description.
 //
 // Pass nil for values you don't want to modify.
@@ -479,6 +861,41 @@ func (client *Client) ChangeServerDiskSpeed(serverID string, diskID string, newS
 	return
 }
 
+// ChangeDiskIops changes the IOPS of a server's provisioned-IOPS disk.
+func (client *Client) ChangeDiskIops(serverID string, diskID string, iops int) error {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return err
+	}
+
+	requestURI := fmt.Sprintf("%s/server/changeDiskIops",
+		url.QueryEscape(organizationID),
+	)
+	request, err := client.newRequestV27(requestURI, http.MethodPost, &changeDiskIops{
+		DiskID: diskID,
+		IOPS:   iops,
+	})
+	if err != nil {
+		return err
+	}
+
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return err
+	}
+
+	apiResponse, err := readAPIResponseAsJSON(responseBody, statusCode)
+	if err != nil {
+		return err
+	}
+
+	if apiResponse.ResponseCode != ResponseCodeInProgress {
+		return apiResponse.ToError("Request to change IOPS of disk '%s' on server '%s' failed with status code %d (%s): %s", diskID, serverID, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	return nil
+}
+
 // RemoveDiskFromServer removes an existing disk from a server.
 func (client *Client) RemoveDiskFromServer(diskID string) error {
 	organizationID, err := client.getOrganizationID()
@@ -538,6 +955,134 @@ func (client *Client) DeleteServer(id string) (err error) {
 	return nil
 }
 
+// UpdateVMwareTools requests that VMware Tools be updated on the specified server.
+//
+// The server must be running, and VMware Tools must already be installed.
+func (client *Client) UpdateVMwareTools(serverID string) error {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return err
+	}
+
+	requestURI := fmt.Sprintf("%s/server/updateVmwareTools",
+		url.QueryEscape(organizationID),
+	)
+	request, err := client.newRequestV24(requestURI, http.MethodPost, &updateVMwareTools{serverID})
+	if err != nil {
+		return err
+	}
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return err
+	}
+
+	apiResponse, err := readAPIResponseAsJSON(responseBody, statusCode)
+	if err != nil {
+		return err
+	}
+
+	if apiResponse.ResponseCode != ResponseCodeInProgress {
+		return apiResponse.ToError("Request to update VMware Tools on server '%s' failed with unexpected status code %d (%s): %s", serverID, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	return nil
+}
+
+// UpgradeVirtualHardware requests that the specified server's virtual hardware be upgraded to the latest version supported by the underlying infrastructure.
+//
+// The server must be stopped. Upgrade servers before cloning them so that the resulting customer images use the latest virtual hardware version.
+func (client *Client) UpgradeVirtualHardware(serverID string) error {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return err
+	}
+
+	requestURI := fmt.Sprintf("%s/server/upgradeVirtualHardware",
+		url.QueryEscape(organizationID),
+	)
+	request, err := client.newRequestV24(requestURI, http.MethodPost, &upgradeVirtualHardware{serverID})
+	if err != nil {
+		return err
+	}
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return err
+	}
+
+	apiResponse, err := readAPIResponseAsJSON(responseBody, statusCode)
+	if err != nil {
+		return err
+	}
+
+	if apiResponse.ResponseCode != ResponseCodeInProgress {
+		return apiResponse.ToError("Request to upgrade virtual hardware on server '%s' failed with unexpected status code %d (%s): %s", serverID, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	return nil
+}
+
+// DefaultDeleteServerTimeout is the default maximum amount of time that DeleteServerWithOptions waits for each operation to complete.
+const DefaultDeleteServerTimeout = 10 * time.Minute
+
+// DeleteServerOptions represents options for DeleteServerWithOptions.
+type DeleteServerOptions struct {
+	// Power off the server first (non-gracefully), if it is running?
+	PowerOff bool
+
+	// Wait for the deletion to complete?
+	Wait bool
+
+	// The maximum amount of time to wait for each operation (power off, delete) to complete (if zero, DefaultDeleteServerTimeout is used).
+	Timeout time.Duration
+}
+
+// DeleteServerWithOptions deletes an existing Server, optionally powering it off first and waiting for the deletion to complete.
+//
+// If the server does not exist, no error is returned.
+func (client *Client) DeleteServerWithOptions(id string, options DeleteServerOptions) error {
+	if options.Timeout < 0 {
+		return fmt.Errorf("Invalid timeout %s for deletion of server '%s' (must not be negative)", options.Timeout, id)
+	}
+	timeout := options.Timeout
+	if timeout == 0 {
+		timeout = DefaultDeleteServerTimeout
+	}
+
+	server, err := client.GetServer(id)
+	if err != nil {
+		return err
+	}
+	if server == nil {
+		return nil // Already gone.
+	}
+
+	if server.Started {
+		if !options.PowerOff {
+			return fmt.Errorf("Cannot delete server '%s' ('%s') because it is running", server.ID, server.Name)
+		}
+
+		err = client.PowerOffServer(server.ID)
+		if err != nil {
+			return err
+		}
+		_, err = client.WaitForChange(ResourceTypeServer, server.ID, "Power off", timeout)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = client.DeleteServer(server.ID)
+	if err != nil {
+		return err
+	}
+
+	if options.Wait {
+		return client.WaitForDelete(ResourceTypeServer, server.ID, timeout)
+	}
+
+	return nil
+}
+
 // StartServer requests that the specified server be started.
 func (client *Client) StartServer(id string) error {
 	organizationID, err := client.getOrganizationID()
@@ -809,3 +1354,48 @@ func (client *Client) ChangeNetworkAdapterType(networkAdapterID string, networkA
 
 	return nil
 }
+
+// EnableNic connects a server's network adapter (e.g. to restore network connectivity after isolating a server).
+func (client *Client) EnableNic(networkAdapterID string) error {
+	return client.setNicConnectivity(networkAdapterID, true)
+}
+
+// DisableNic disconnects a server's network adapter (e.g. to isolate a server from the network) without removing it from the server.
+func (client *Client) DisableNic(networkAdapterID string) error {
+	return client.setNicConnectivity(networkAdapterID, false)
+}
+
+// setNicConnectivity connects or disconnects a server's network adapter.
+func (client *Client) setNicConnectivity(networkAdapterID string, connected bool) error {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return err
+	}
+
+	requestURI := fmt.Sprintf("%s/server/setNicConnectivity",
+		url.QueryEscape(organizationID),
+	)
+	request, err := client.newRequestV27(requestURI, http.MethodPost, &setNicConnectivity{
+		ID:        networkAdapterID,
+		Connected: connected,
+	})
+	if err != nil {
+		return err
+	}
+
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return err
+	}
+
+	apiResponse, err := readAPIResponseAsJSON(responseBody, statusCode)
+	if err != nil {
+		return err
+	}
+
+	if apiResponse.ResponseCode != ResponseCodeInProgress {
+		return apiResponse.ToError("Request to set connectivity for network adapter '%s' failed with unexpected status code %d (%s): %s", networkAdapterID, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	return nil
+}
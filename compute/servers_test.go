@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestClient_GetServer_ById_Success(test *testing.T) {
@@ -29,6 +31,251 @@ func TestClient_GetServer_ById_Success(test *testing.T) {
 	verifyGetServerTestResponse(test, server)
 }
 
+// Get server (CloudControl v2.4 guest information).
+func TestClient_GetServer_Guest_Success(test *testing.T) {
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			expect := expect(test)
+
+			server, err := client.GetServer("5a32d6e4-9707-4813-a269-56ab4d989f4d")
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.NotNil("Server", server)
+			expect.NotNil("Server.Guest", server.Guest)
+			expect.IsFalse("Server.Guest.OSCustomization", server.Guest.OSCustomization)
+			expect.IsFalse("Server.IsGuestOSCustomized", server.IsGuestOSCustomized())
+			expect.EqualsString("Server.GetOS().ID", "CENTOS764", server.GetOS().ID)
+			expect.EqualsString("Server.GetOS().Family", "UNIX", server.GetOS().Family)
+
+			vmwareTools := server.GetVMwareTools()
+			expect.NotNil("Server.GetVMwareTools", vmwareTools)
+			expect.EqualsString("Server.GetVMwareTools().VersionStatus", VMwareToolsVersionStatusNeedsUpgrade, vmwareTools.VersionStatus)
+			expect.EqualsInt("Server.GetVMwareTools().APIVersion", 10240, vmwareTools.APIVersion)
+		},
+		Respond: testRespondOK(getServerGuestTestResponse),
+	})
+}
+
+// Get server (CloudControl v2.7 response, with disks grouped by SCSI controller).
+func TestClient_GetServer_V27_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			server, err := client.GetServer("5a32d6e4-9707-4813-a269-56ab4d989f4d")
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			verifyGetServerV27TestResponse(test, server)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.EqualsString("Request.URL.Path", "/caas/2.7/my-organization-id/server/server/5a32d6e4-9707-4813-a269-56ab4d989f4d", request.URL.Path)
+
+			return http.StatusOK, getServerV27TestResponse
+		},
+	})
+}
+
+// Find server by name (successful).
+func TestClient_FindServerByName_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			server, err := client.FindServerByName("Production Web Server", "484174a2-ae74-4658-9e56-50fc90e086cf")
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			verifyGetServerTestResponse(test, server)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.EqualsString("Query.name", "Production Web Server", request.URL.Query().Get("name"))
+			expect.EqualsString("Query.networkDomainId", "484174a2-ae74-4658-9e56-50fc90e086cf", request.URL.Query().Get("networkDomainId"))
+
+			return http.StatusOK, fmt.Sprintf(`{"server": [%s], "pageNumber": 1, "pageCount": 1, "totalCount": 1, "pageSize": 50}`, getServerTestResponse)
+		},
+	})
+}
+
+// Find server by name (not found).
+func TestClient_FindServerByName_NotFound(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			server, err := client.FindServerByName("Production Web Server", "484174a2-ae74-4658-9e56-50fc90e086cf")
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.IsTrue("Server is nil", server == nil)
+		},
+		Respond: testRespondOK(`{"server": [], "pageNumber": 1, "pageCount": 0, "totalCount": 0, "pageSize": 50}`),
+	})
+}
+
+// Delete server with options (server is stopped).
+func TestClient_DeleteServerWithOptions_Stopped_Success(test *testing.T) {
+	expect := expect(test)
+
+	requestCount := 0
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			err := client.DeleteServerWithOptions("5a32d6e4-9707-4813-a269-56ab4d989f4d", DeleteServerOptions{
+				PowerOff: true,
+			})
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("RequestCount", 2, requestCount)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			requestCount++
+
+			if request.Method == http.MethodGet {
+				return http.StatusOK, `{"id": "5a32d6e4-9707-4813-a269-56ab4d989f4d", "name": "web1", "started": false, "state": "NORMAL"}`
+			}
+
+			expect.IsTrue("Request.URL", strings.HasSuffix(request.URL.Path, "/server/deleteServer"))
+
+			return http.StatusOK, deleteServerTestResponse
+		},
+	})
+}
+
+// Delete server with options (server is running, and power-off was not requested).
+func TestClient_DeleteServerWithOptions_Running_Error(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			err := client.DeleteServerWithOptions("5a32d6e4-9707-4813-a269-56ab4d989f4d", DeleteServerOptions{})
+			expect.NotNil("Error", err)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			if request.Method != http.MethodGet {
+				test.Fatalf("Unexpected '%s' request to '%s'.", request.Method, request.URL.Path)
+			}
+
+			return http.StatusOK, getServerTestResponse
+		},
+	})
+}
+
+// Delete server with options (server is running, power-off is requested, and the default timeout is used).
+func TestClient_DeleteServerWithOptions_DefaultTimeout_Success(test *testing.T) {
+	defer testWithResourceStatusPollInterval(time.Millisecond)()
+
+	expect := expect(test)
+
+	var requestPaths []string
+	started := true
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			err := client.DeleteServerWithOptions("5a32d6e4-9707-4813-a269-56ab4d989f4d", DeleteServerOptions{
+				PowerOff: true,
+			})
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("RequestPaths.Length", 4, len(requestPaths))
+			expect.IsTrue("RequestPaths[1]", strings.HasSuffix(requestPaths[1], "/server/powerOffServer"))
+			expect.IsTrue("RequestPaths[3]", strings.HasSuffix(requestPaths[3], "/server/deleteServer"))
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			requestPaths = append(requestPaths, request.URL.Path)
+
+			switch {
+			case strings.HasSuffix(request.URL.Path, "/server/powerOffServer"):
+				started = false
+
+				return http.StatusOK, powerOffServerForDeleteTestResponse
+			case strings.HasSuffix(request.URL.Path, "/server/deleteServer"):
+				return http.StatusOK, deleteServerTestResponse
+			}
+
+			return http.StatusOK, fmt.Sprintf(`{"id": "5a32d6e4-9707-4813-a269-56ab4d989f4d", "name": "web1", "started": %t, "state": "NORMAL"}`, started)
+		},
+	})
+}
+
+// Delete server with options (negative timeout).
+func TestClient_DeleteServerWithOptions_InvalidTimeout(test *testing.T) {
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			err := client.DeleteServerWithOptions("5a32d6e4-9707-4813-a269-56ab4d989f4d", DeleteServerOptions{
+				Timeout: -time.Minute,
+			})
+			expect(test).NotNil("Error", err)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			test.Fatalf("Unexpected '%s' request to '%s'.", request.Method, request.URL.Path)
+
+			return http.StatusNotFound, ""
+		},
+	})
+}
+
+// Update VMware Tools (successful).
+func TestClient_UpdateVMwareTools_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			err := client.UpdateVMwareTools("5a32d6e4-9707-4813-a269-56ab4d989f4d")
+			if err != nil {
+				test.Fatal(err)
+			}
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.IsTrue("Request.URL", strings.HasSuffix(request.URL.Path, "/server/updateVmwareTools"))
+
+			requestBody := &updateVMwareTools{}
+			err := readRequestBodyAsJSON(request, requestBody)
+			if err != nil {
+				test.Fatal(err)
+			}
+			expect.EqualsString("Request.ID", "5a32d6e4-9707-4813-a269-56ab4d989f4d", requestBody.ID)
+
+			return http.StatusOK, updateVMwareToolsTestResponse
+		},
+	})
+}
+
+// Upgrade virtual hardware (successful).
+func TestClient_UpgradeVirtualHardware_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			err := client.UpgradeVirtualHardware("5a32d6e4-9707-4813-a269-56ab4d989f4d")
+			if err != nil {
+				test.Fatal(err)
+			}
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.IsTrue("Request.URL", strings.HasSuffix(request.URL.Path, "/server/upgradeVirtualHardware"))
+
+			requestBody := &upgradeVirtualHardware{}
+			err := readRequestBodyAsJSON(request, requestBody)
+			if err != nil {
+				test.Fatal(err)
+			}
+			expect.EqualsString("Request.ID", "5a32d6e4-9707-4813-a269-56ab4d989f4d", requestBody.ID)
+
+			return http.StatusOK, upgradeVirtualHardwareTestResponse
+		},
+	})
+}
+
 // Deploy server (successful).
 func TestClient_DeployServer_Success(test *testing.T) {
 	expect := expect(test)
@@ -102,6 +349,67 @@ func TestClient_DeployServer_Success(test *testing.T) {
 	expect.EqualsString("serverID", "7b62aae5-bdbe-4595-b58d-c78f95db2a7f", serverID)
 }
 
+// Deploy server with guest customisation (successful).
+func TestClient_DeployServer_GuestCustomization_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			serverID, err := client.DeployServer(ServerDeploymentConfiguration{
+				Name:              "web-1",
+				ImageID:           "02250336-de2b-4e99-ab96-78511b7f8f4b",
+				MicrosoftTimeZone: "035",
+				GuestCustomization: &ServerGuestCustomization{
+					Windows: &WindowsGuestCustomization{
+						ComputerName:    "WEB1",
+						RunOnceCommands: []string{"powershell -File C:\\setup.ps1"},
+					},
+				},
+			})
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsString("ServerID", "7b62aae5-bdbe-4595-b58d-c78f95db2a7f", serverID)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.IsTrue("Request uses CloudControl v2.7", strings.Contains(request.URL.Path, "/caas/2.7/"))
+
+			requestBody := &ServerDeploymentConfiguration{}
+			err := readRequestBodyAsJSON(request, requestBody)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsString("MicrosoftTimeZone", "035", requestBody.MicrosoftTimeZone)
+			expect.NotNil("GuestCustomization", requestBody.GuestCustomization)
+			expect.NotNil("GuestCustomization.Windows", requestBody.GuestCustomization.Windows)
+			expect.EqualsString("GuestCustomization.Windows.ComputerName", "WEB1", requestBody.GuestCustomization.Windows.ComputerName)
+			expect.EqualsInt("GuestCustomization.Windows.RunOnceCommands.Length", 1, len(requestBody.GuestCustomization.Windows.RunOnceCommands))
+			expect.IsTrue("GuestCustomization.Linux == nil", requestBody.GuestCustomization.Linux == nil)
+
+			return http.StatusOK, deployServerTestResponse
+		},
+	})
+}
+
+// Deploy server with both Windows and Linux guest customisation (invalid).
+func TestClient_DeployServer_GuestCustomization_Conflict(test *testing.T) {
+	client := NewClientWithBaseAddress("https://api.example.com", "user1", "password")
+	client.setAccount(&Account{
+		OrganizationID: "dummy-organization-id",
+	})
+
+	_, err := client.DeployServer(ServerDeploymentConfiguration{
+		Name: "web-1",
+		GuestCustomization: &ServerGuestCustomization{
+			Windows: &WindowsGuestCustomization{},
+			Linux:   &LinuxGuestCustomization{},
+		},
+	})
+	expect(test).NotNil("Error", err)
+}
+
 // Add disk to server (successful).
 func TestClient_AddServerDisk_Success(test *testing.T) {
 	expect := expect(test)
@@ -213,6 +521,34 @@ func TestClient_ChangeServerDiskSpeed_Success(test *testing.T) {
 	verifyChangeServerDiskSpeedTestResponse(test, response)
 }
 
+// Change disk IOPS (successful).
+func TestClient_ChangeDiskIops_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			err := client.ChangeDiskIops("7b62aae5-bdbe-4595-b58d-c78f95db2a7f", "92b1819e-6f91-4abe-88c7-607841959f90", 1500)
+			if err != nil {
+				test.Fatal(err)
+			}
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.EqualsString("Request.URL.Path", "/caas/2.7/my-organization-id/server/changeDiskIops", request.URL.Path)
+
+			requestBody := &changeDiskIops{}
+			err := readRequestBodyAsJSON(request, requestBody)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsString("ChangeDiskIops.DiskID", "92b1819e-6f91-4abe-88c7-607841959f90", requestBody.DiskID)
+			expect.EqualsInt("ChangeDiskIops.IOPS", 1500, requestBody.IOPS)
+
+			return http.StatusOK, changeDiskIopsTestResponse
+		},
+	})
+}
+
 // Add Nic (successful).
 func TestClient_AddServerNic_Success(test *testing.T) {
 	expect := expect(test)
@@ -280,6 +616,48 @@ func TestClient_RemoveServerNic_Success(test *testing.T) {
 
 }
 
+// Disable server NIC (successful).
+func TestClient_DisableNic_Success(test *testing.T) {
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			err := client.DisableNic("5999db1d-725c-46ba-9d4e-d33991e61ab1")
+			if err != nil {
+				test.Fatal(err)
+			}
+		},
+		Respond: testRespondSetNicConnectivity(`{"nicId":"5999db1d-725c-46ba-9d4e-d33991e61ab1","connected":false}`),
+	})
+}
+
+// Enable server NIC (successful).
+func TestClient_EnableNic_Success(test *testing.T) {
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			err := client.EnableNic("5999db1d-725c-46ba-9d4e-d33991e61ab1")
+			if err != nil {
+				test.Fatal(err)
+			}
+		},
+		Respond: testRespondSetNicConnectivity(`{"nicId":"5999db1d-725c-46ba-9d4e-d33991e61ab1","connected":true}`),
+	})
+}
+
+func testRespondSetNicConnectivity(expectedRequestBody string) ClientTestResponder {
+	return func(test *testing.T, request *http.Request) (int, string) {
+		expect := expect(test)
+
+		expect.IsTrue("Request.URL", strings.HasSuffix(request.URL.Path, "/server/setNicConnectivity"))
+
+		requestBody, err := readRequestBodyAsString(request)
+		if err != nil {
+			test.Fatal("Failed to read request body: ", err)
+		}
+		expect.EqualsString("Request.Body", expectedRequestBody, requestBody)
+
+		return http.StatusOK, setNicConnectivityTestResponse
+	}
+}
+
 // Delete Server (successful).
 func TestClient_DeleteServer_Success(test *testing.T) {
 	expect := expect(test)
@@ -599,10 +977,222 @@ func verifyGetServerTestResponse(test *testing.T, server *Server) {
 
 	expect.NotNil("Server", server)
 	expect.EqualsString("Server.Name", "Production Web Server", server.Name)
-	// TODO: Verify the rest of these fields.
+	expect.EqualsString("Server.DatacenterID", "NA9", server.DatacenterID)
 	expect.EqualsString("Server.State", ResourceStatusPendingChange, server.State)
+
+	expect.EqualsInt("Server.Disks.Length", 1, len(server.Disks))
+	expect.EqualsInt("Server.Disks[0].SCSIUnitID", 0, server.Disks[0].SCSIUnitID)
+
+	expect.NotNil("Server.Network.PrimaryAdapter.PrivateIPv4Address", server.Network.PrimaryAdapter.PrivateIPv4Address)
+	expect.EqualsString("Server.Network.PrimaryAdapter.PrivateIPv4Address", "10.0.4.8", *server.Network.PrimaryAdapter.PrivateIPv4Address)
+	expect.EqualsString("Server.GetPrimaryIPv4Address", "10.0.4.8", server.GetPrimaryIPv4Address())
+	expect.EqualsString("Server.GetPrimaryIPv6Address", "2607:f480:1111:1282:2960:fb72:7154:6160", server.GetPrimaryIPv6Address())
+	expect.EqualsInt("Server.Network.GetIPv6Addresses.Length", len(server.Network.GetNetworkAdapters()), len(server.Network.GetIPv6Addresses()))
+
+	expect.NotNil("Server.VMwareTools", server.VMwareTools)
+	expect.EqualsString("Server.VMwareTools.VersionStatus", VMwareToolsVersionStatusCurrent, server.VMwareTools.VersionStatus)
+	expect.EqualsString("Server.VMwareTools.RunningStatus", VMwareToolsRunningStatusRunning, server.VMwareTools.RunningStatus)
+	expect.EqualsInt("Server.VMwareTools.APIVersion", 9354, server.VMwareTools.APIVersion)
+	expect.IsFalse("Server.VMwareTools.NeedsUpgrade", server.VMwareTools.NeedsUpgrade())
+
+	expect.NotNil("Server.VirtualHardware", server.VirtualHardware)
+	expect.EqualsString("Server.VirtualHardware.Version", "vmx-08", server.VirtualHardware.Version)
+	expect.IsFalse("Server.VirtualHardware.UpToDate", server.VirtualHardware.UpToDate)
+	expect.IsTrue("Server.VirtualHardware.NeedsUpgrade", server.VirtualHardware.NeedsUpgrade())
+	expect.EqualsString("Server.GetVirtualHardwareVersion", "vmx-08", server.GetVirtualHardwareVersion())
+
+	expect.NotNil("Server.Progress", server.Progress)
+	expect.EqualsString("Server.Progress.Action", "SHUTDOWN_SERVER", server.Progress.Action)
+	expect.EqualsString("Server.Progress.UserName", "devuser1", server.Progress.UserName)
+
+	expect.NotNil("Server.Backup", server.Backup)
+	expect.EqualsString("Server.Backup.AssetID", "91002e08-8dc1-47a1-ad33-04f501c06f87", server.Backup.AssetID)
+	expect.EqualsString("Server.Backup.ServicePlan", BackupServicePlanAdvanced, server.Backup.ServicePlan)
+
+	expect.NotNil("Server.Monitoring", server.Monitoring)
+	expect.EqualsString("Server.Monitoring.MonitoringID", "11049", server.Monitoring.MonitoringID)
+	expect.EqualsString("Server.Monitoring.ServicePlan", ServerMonitoringPlanEssentials, server.Monitoring.ServicePlan)
 }
 
+const getServerV27TestResponse = `
+{
+	"id": "5a32d6e4-9707-4813-a269-56ab4d989f4d",
+	"name": "Production Web Server",
+	"description": "Server to host our main web application.",
+	"datacenterId": "NA9",
+	"operatingSystem": {
+		"id": "CENTOS764",
+		"displayName": "CENTOS7/64",
+		"family": "UNIX"
+	},
+	"cpu": {
+		"count": 2,
+		"speed": "STANDARD",
+		"coresPerSocket": 1
+	},
+	"memoryGb": 4,
+	"scsiController": [
+		{
+			"id": "7bbe2b27-5c6a-4d8f-a8c5-6d1c7b4e2f10",
+			"adapterType": "LSI_LOGIC_PARALLEL",
+			"key": 1000,
+			"disk": [
+				{
+					"id": "0e1a3e9c-3b5d-4f6c-8a7b-9c0d1e2f3a4b",
+					"scsiId": 1,
+					"sizeGb": 20,
+					"speed": "HIGHPERFORMANCE",
+					"state": "NORMAL"
+				},
+				{
+					"id": "c2e1f199-116e-4dbc-9960-68720b832b0a",
+					"scsiId": 0,
+					"sizeGb": 50,
+					"speed": "STANDARD",
+					"state": "NORMAL"
+				}
+			],
+			"busNumber": 0,
+			"state": "NORMAL"
+		}
+	],
+	"sataController": [
+		{
+			"id": "8e2f1c3d-4b5a-4c6d-9e7f-0a1b2c3d4e5f",
+			"adapterType": "AHCI",
+			"key": 15000,
+			"deviceOrDisk": [
+				{
+					"cdrom": {
+						"id": "b2f7d3a1-6c8e-4f5a-9d2b-3e4f5a6b7c8d",
+						"position": 0,
+						"key": 16000,
+						"state": "NORMAL"
+					}
+				}
+			],
+			"busNumber": 0,
+			"state": "NORMAL"
+		}
+	],
+	"ideController": [
+		{
+			"id": "9f3a2d4e-5c6b-4d7e-8f9a-1b2c3d4e5f6a",
+			"channel": 0,
+			"adapterType": "IDE",
+			"key": 200,
+			"deviceOrDisk": [],
+			"state": "NORMAL"
+		}
+	],
+	"floppy": [
+		{
+			"id": "a4b5c6d7-e8f9-4a0b-9c1d-2e3f4a5b6c7d",
+			"key": 8000,
+			"state": "NORMAL"
+		}
+	],
+	"networkInfo": {
+		"primaryNic": {
+			"id": "5e869800-df7b-4626-bcbf-8643b8be11fd",
+			"privateIpv4": "10.0.4.8",
+			"ipv6": "2607:f480:1111:1282:2960:fb72:7154:6160",
+			"vlanId": "bc529e20-dc6f-42ba-be20-0ffe44d1993f",
+			"vlanName": "Production Server",
+			"networkAdapter": "VMXNET3",
+			"connected": true,
+			"key": 4000,
+			"state": "NORMAL"
+		},
+		"additionalNic": [],
+		"networkDomainId": "553f26b6-2a73-42c3-a78b-6116f11291d0"
+	},
+	"guest": {
+		"operatingSystem": {
+			"id": "CENTOS764",
+			"displayName": "CENTOS7/64",
+			"family": "UNIX"
+		},
+		"osCustomization": true
+	},
+	"virtualHardware": {
+		"version": "vmx-10",
+		"upToDate": true
+	},
+	"vmwareTools": {
+		"versionStatus": "CURRENT",
+		"runningStatus": "RUNNING",
+		"apiVersion": 10240
+	},
+	"sourceImageId": "3ebf3c0f-90fe-4a8b-8585-6e65b316592c",
+	"createTime": "2017-03-02T10:31:33.000Z",
+	"deployed": true,
+	"started": true,
+	"state": "NORMAL"
+}
+`
+
+func verifyGetServerV27TestResponse(test *testing.T, server *Server) {
+	expect := expect(test)
+
+	expect.NotNil("Server", server)
+	expect.EqualsString("Server.Name", "Production Web Server", server.Name)
+
+	expect.EqualsInt("Server.SCSIControllers.Length", 1, len(server.SCSIControllers))
+	expect.EqualsString("Server.SCSIControllers[0].AdapterType", "LSI_LOGIC_PARALLEL", server.SCSIControllers[0].AdapterType)
+
+	expect.EqualsInt("Server.Disks.Length", 2, len(server.Disks))
+	expect.EqualsString("Server.Disks[0].ID", "c2e1f199-116e-4dbc-9960-68720b832b0a", *server.Disks[0].ID)
+	expect.EqualsInt("Server.Disks[0].SCSIUnitID", 0, server.Disks[0].SCSIUnitID)
+	expect.EqualsInt("Server.Disks[0].SizeGB", 50, server.Disks[0].SizeGB)
+	expect.EqualsString("Server.Disks[1].ID", "0e1a3e9c-3b5d-4f6c-8a7b-9c0d1e2f3a4b", *server.Disks[1].ID)
+	expect.EqualsInt("Server.Disks[1].SCSIUnitID", 1, server.Disks[1].SCSIUnitID)
+	expect.EqualsString("Server.Disks[1].Speed", "HIGHPERFORMANCE", server.Disks[1].Speed)
+
+	expect.EqualsInt("Server.IDEControllers.Length", 1, len(server.IDEControllers))
+	expect.EqualsInt("Server.SATAControllers.Length", 1, len(server.SATAControllers))
+	expect.EqualsInt("Server.Floppies.Length", 1, len(server.Floppies))
+	expect.EqualsInt("Server.GetCdromDevices.Length", 1, len(server.GetCdromDevices()))
+
+	expect.EqualsString("Server.GetPrimaryIPv4Address", "10.0.4.8", server.GetPrimaryIPv4Address())
+	expect.NotNil("Server.Guest", server.Guest)
+	expect.IsTrue("Server.IsGuestOSCustomized", server.IsGuestOSCustomized())
+}
+
+const getServerGuestTestResponse = `
+	{
+		"name": "Uncustomized Server",
+		"description": "Server deployed without guest OS customisation.",
+		"cpu": {
+			"count": 2,
+			"speed": "STANDARD",
+			"coresPerSocket": 1
+		},
+		"memoryGb": 4,
+		"guest": {
+			"operatingSystem": {
+				"id": "CENTOS764",
+				"displayName": "CENTOS7/64",
+				"family": "UNIX"
+			},
+			"osCustomization": false,
+			"vmTools": {
+				"type": "VMWARE_TOOLS",
+				"versionStatus": "NEEDS_UPGRADE",
+				"runningStatus": "RUNNING",
+				"apiVersion": 10240
+			}
+		},
+		"sourceImageId": "3ebf3c0f-90fe-4a8b-8585-6e65b316592c",
+		"createTime": "2017-02-01T10:31:33.000Z",
+		"deployed": true,
+		"started": true,
+		"state": "NORMAL",
+		"id": "5a32d6e4-9707-4813-a269-56ab4d989f4d",
+		"datacenterId": "NA9"
+	}
+`
+
 const deployServerTestResponse = `
 	{
 		"operation": "DEPLOY_SERVER",
@@ -713,6 +1303,18 @@ const deleteServerTestResponse = `
 	}
 `
 
+const powerOffServerForDeleteTestResponse = `
+	{
+		"operation": "POWER_OFF_SERVER",
+		"responseCode": "IN_PROGRESS",
+		"message": "Request to Power Off Server (Id:5a32d6e4-9707-4813-a269-56ab4d989f4d) has been accepted and is being processed",
+		"info": [],
+		"warning": [],
+		"error": [],
+		"requestId": "na9_20160321T074626030-0400_8a0f0ff8-2a1b-47c3-a218-0e63ff68e1be"
+	}
+`
+
 func verifyDeleteServerTestResponse(test *testing.T, response *APIResponseV2) {
 	expect := expect(test)
 
@@ -764,6 +1366,18 @@ const removeNicFromServerTestResponse = `
 	}
 `
 
+const setNicConnectivityTestResponse = `
+	{
+		"operation": "SET_NIC_CONNECTIVITY",
+		"responseCode": "IN_PROGRESS",
+		"message": "Request to set connectivity for NIC 5999db1d-725c-46ba-9d4e-d33991e61ab1 on Server 'Production Mail Server' has been accepted and is being processed.",
+		"info": [],
+		"warning": [],
+		"error": [],
+		"requestId": "na9_20160321T074626030-0400_2b8c1f3e-4a5d-4e6f-9a0b-1c2d3e4f5a6b"
+	}
+`
+
 func verifyRemoveNicFromServerTestResponse(test *testing.T, response *APIResponseV2) {
 	expect := expect(test)
 
@@ -773,3 +1387,36 @@ func verifyRemoveNicFromServerTestResponse(test *testing.T, response *APIRespons
 	expect.EqualsString("Response.Message", "Request to Remove NIC 5999db1d-725c-46ba-9d4e-d33991e61ab1 for VLAN 'Subsystem VLAN' from Server 'Production Mail Server' has been accepted and is being processed.", response.Message)
 	expect.EqualsString("Response.RequestID", "na9_20160321T074626030-0400_7e9fffe7-190b-46f2-9107-9d52fe57d0ad", response.RequestID)
 }
+
+const upgradeVirtualHardwareTestResponse = `
+	{
+		"operation": "UPGRADE_VIRTUAL_HARDWARE",
+		"responseCode": "IN_PROGRESS",
+		"message": "Request to Upgrade Virtual Hardware on Server 'Production Web Server' has been accepted and is being processed.",
+		"info": [],
+		"warning": [],
+		"error": [],
+		"requestId": "na9_20160321T074626030-0400_3c4d5e6f-7a8b-4c9d-8e0f-1a2b3c4d5e6f"
+	}
+`
+
+const updateVMwareToolsTestResponse = `
+{
+	"operation": "UPDATE_VMWARE_TOOLS",
+	"responseCode": "IN_PROGRESS",
+	"message": "Request to Update VMware Tools for Server 'Production Web Server' has been accepted and is being processed.",
+	"info": [],
+	"warning": [],
+	"error": [],
+	"requestId": "na9_20160321T074626030-0400_7e9fffe7-190b-46f2-9107-9d52fe57d0ad"
+}
+`
+
+const changeDiskIopsTestResponse = `
+{
+	"operation": "CHANGE_DISK_IOPS",
+	"responseCode": "IN_PROGRESS",
+	"message": "Request to change IOPS of Disk '92b1819e-6f91-4abe-88c7-607841959f90' has been accepted. Please use appropriate Get or List API for status.",
+	"requestId": "na9/2016-01-01T00:00:00.000Z/abcd"
+}
+`
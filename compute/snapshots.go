@@ -0,0 +1,442 @@
+package compute
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const (
+	// SnapshotServicePlanOneMonth represents the snapshot service plan that retains snapshots for one month.
+	SnapshotServicePlanOneMonth = "ONE_MONTH"
+
+	// SnapshotServicePlanThreeMonth represents the snapshot service plan that retains snapshots for three months.
+	SnapshotServicePlanThreeMonth = "THREE_MONTH"
+
+	// SnapshotServicePlanTwelveMonth represents the snapshot service plan that retains snapshots for twelve months.
+	SnapshotServicePlanTwelveMonth = "TWELVE_MONTH"
+)
+
+const (
+	// SnapshotTypeSystem represents a snapshot taken automatically by the snapshot service.
+	SnapshotTypeSystem = "SYSTEM"
+
+	// SnapshotTypeManual represents a snapshot initiated by a user.
+	SnapshotTypeManual = "MANUAL"
+)
+
+// ServerSnapshotService represents the snapshot service configuration for a server.
+type ServerSnapshotService struct {
+	// The server's snapshot service plan (e.g. ONE_MONTH).
+	ServicePlan string `json:"servicePlan"`
+
+	// The current state of the server's snapshot service.
+	State string `json:"state"`
+
+	// The window during which the server's daily snapshot is taken.
+	Window ServerSnapshotServiceWindow `json:"window"`
+
+	// Is a manual snapshot currently in progress for the server?
+	ManualSnapshotInProgress bool `json:"manualSnapshotInProgress"`
+
+	// The Id of the datacenter to which the server's snapshots are replicated (if replication is enabled).
+	ReplicationTargetDatacenterID string `json:"replicationTargetDatacenterId,omitempty"`
+}
+
+// ServerSnapshotServiceWindow represents the window during which a server's snapshots are taken.
+type ServerSnapshotServiceWindow struct {
+	// The day of the week on which snapshots are taken (e.g. DAILY).
+	DayOfWeek string `json:"dayOfWeek"`
+
+	// The hour (0-23, UTC) at which the snapshot window starts.
+	StartHour int `json:"startHour"`
+}
+
+// Snapshot represents a server snapshot.
+type Snapshot struct {
+	// The snapshot Id.
+	ID string `json:"id"`
+
+	// The Id of the server that the snapshot was taken from.
+	ServerID string `json:"serverId"`
+
+	// The snapshot type (SYSTEM or MANUAL).
+	Type string `json:"type"`
+
+	// The snapshot description (manual snapshots only).
+	Description string `json:"description,omitempty"`
+
+	// The time at which the snapshot was taken.
+	StartTime string `json:"startTime"`
+
+	// The time at which the snapshot will expire.
+	ExpiryTime string `json:"expiryTime"`
+
+	// The snapshot's consistency level (e.g. CRASH_CONSISTENT).
+	ConsistencyLevel string `json:"consistencyLevel"`
+
+	// The snapshot's current state.
+	State string `json:"state"`
+}
+
+// Snapshots represents a page of Snapshot results.
+type Snapshots struct {
+	// The current page of snapshots.
+	Items []Snapshot `json:"snapshot"`
+
+	PagedResult
+}
+
+// SnapshotWindow represents a snapshot window available in a datacenter.
+type SnapshotWindow struct {
+	// The snapshot window Id.
+	ID string `json:"id"`
+
+	// The day of the week on which snapshots are taken (e.g. DAILY).
+	DayOfWeek string `json:"dayOfWeek"`
+
+	// The hour (0-23, UTC) at which the snapshot window starts.
+	StartHour int `json:"startHour"`
+
+	// The snapshot window's availability (e.g. AVAILABLE, RESERVED_AVAILABLE, OCCUPIED).
+	AvailabilityStatus string `json:"availabilityStatus"`
+}
+
+// SnapshotWindows represents a page of SnapshotWindow results.
+type SnapshotWindows struct {
+	// The current page of snapshot windows.
+	Items []SnapshotWindow `json:"snapshotWindow"`
+
+	PagedResult
+}
+
+// Request body when enabling the snapshot service for a server.
+type enableSnapshotService struct {
+	ServerID    string                      `json:"serverId"`
+	ServicePlan string                      `json:"servicePlan"`
+	Window      ServerSnapshotServiceWindow `json:"window"`
+}
+
+// Request body when changing the snapshot service plan for a server.
+type changeSnapshotServicePlan struct {
+	ServerID    string `json:"serverId"`
+	ServicePlan string `json:"newServicePlan"`
+}
+
+// Request body when disabling the snapshot service for a server.
+type disableSnapshotService struct {
+	ServerID string `json:"serverId"`
+}
+
+// Request body when initiating a manual snapshot of a server.
+type initiateManualSnapshot struct {
+	ServerID    string `json:"serverId"`
+	Description string `json:"description,omitempty"`
+}
+
+// SnapshotPreviewServerConfiguration represents the configuration for a server created from a snapshot (a "snapshot preview server").
+type SnapshotPreviewServerConfiguration struct {
+	// The Id of the snapshot from which the server will be created.
+	SnapshotID string `json:"snapshotId"`
+
+	// The name of the new server.
+	ServerName string `json:"serverName"`
+
+	// The description of the new server.
+	ServerDescription string `json:"serverDescription,omitempty"`
+
+	// Start the new server once it has been created?
+	ServerStarted bool `json:"serverStarted"`
+
+	// Connect the new server's network adapters?
+	//
+	// Leave this false if the original server is still running (to avoid IP address conflicts).
+	NICsConnected bool `json:"nicsConnected"`
+
+	// Give the new server's network adapters the same MAC addresses as the original server's?
+	PreserveMACAddresses bool `json:"preserveMacAddresses"`
+}
+
+// Request body when migrating a snapshot preview server.
+type migrateSnapshotPreviewServer struct {
+	ServerID string `json:"serverId"`
+}
+
+// Request body when enabling snapshot replication for a server.
+type enableSnapshotReplication struct {
+	ServerID           string `json:"serverId"`
+	TargetDatacenterID string `json:"targetDatacenterId"`
+}
+
+// Request body when disabling snapshot replication for a server.
+type disableSnapshotReplication struct {
+	ServerID string `json:"serverId"`
+}
+
+// EnableSnapshotService enables the snapshot service for the specified server, using the specified service plan and snapshot window.
+//
+// Call ListSnapshotWindows to determine which snapshot windows are available for the server's datacenter.
+func (client *Client) EnableSnapshotService(serverID string, servicePlan string, windowDayOfWeek string, windowStartHour int) error {
+	return client.postSnapshotServiceRequest("enableSnapshotService", serverID, &enableSnapshotService{
+		ServerID:    serverID,
+		ServicePlan: servicePlan,
+		Window: ServerSnapshotServiceWindow{
+			DayOfWeek: windowDayOfWeek,
+			StartHour: windowStartHour,
+		},
+	})
+}
+
+// ChangeSnapshotServicePlan changes the snapshot service plan for the specified server.
+func (client *Client) ChangeSnapshotServicePlan(serverID string, servicePlan string) error {
+	return client.postSnapshotServiceRequest("changeSnapshotServicePlan", serverID, &changeSnapshotServicePlan{
+		ServerID:    serverID,
+		ServicePlan: servicePlan,
+	})
+}
+
+// DisableSnapshotService disables the snapshot service for the specified server.
+//
+// All of the server's existing snapshots are deleted.
+func (client *Client) DisableSnapshotService(serverID string) error {
+	return client.postSnapshotServiceRequest("disableSnapshotService", serverID, &disableSnapshotService{
+		ServerID: serverID,
+	})
+}
+
+// CreateManualSnapshot initiates a manual snapshot of the specified server.
+//
+// The snapshot service must already be enabled for the server.
+func (client *Client) CreateManualSnapshot(serverID string, description string) (snapshotID string, err error) {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return "", err
+	}
+
+	requestURI := fmt.Sprintf("%s/snapshot/initiateManualSnapshot",
+		url.QueryEscape(organizationID),
+	)
+	request, err := client.newRequestV27(requestURI, http.MethodPost, &initiateManualSnapshot{
+		ServerID:    serverID,
+		Description: description,
+	})
+	if err != nil {
+		return "", err
+	}
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return "", err
+	}
+
+	apiResponse, err := readAPIResponseAsJSON(responseBody, statusCode)
+	if err != nil {
+		return "", err
+	}
+
+	if apiResponse.ResponseCode != ResponseCodeInProgress {
+		return "", apiResponse.ToError("Request to create manual snapshot of server '%s' failed with status code %d (%s): %s", serverID, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	// Expected: "info" { "name": "snapshotId", "value": "the-Id-of-the-new-snapshot" }
+	snapshotIDMessage := apiResponse.GetFieldMessage("snapshotId")
+	if snapshotIDMessage == nil {
+		return "", apiResponse.ToError("Received an unexpected response (missing 'snapshotId') with status code %d (%s): %s", statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	return *snapshotIDMessage, nil
+}
+
+// ListSnapshots retrieves a page of snapshots for the specified server.
+func (client *Client) ListSnapshots(serverID string, paging *Paging) (snapshots *Snapshots, err error) {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return nil, err
+	}
+
+	err = paging.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	requestURI := fmt.Sprintf("%s/snapshot/snapshot?serverId=%s&%s",
+		url.QueryEscape(organizationID),
+		url.QueryEscape(serverID),
+		paging.EnsurePaging().toQueryParameters(),
+	)
+	request, err := client.newRequestV27(requestURI, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode != http.StatusOK {
+		var apiResponse *APIResponseV2
+
+		apiResponse, err = readAPIResponseAsJSON(responseBody, statusCode)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, apiResponse.ToError("Request to list snapshots for server '%s' failed with status code %d (%s): %s", serverID, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	snapshots = &Snapshots{}
+	err = json.Unmarshal(responseBody, snapshots)
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshots, nil
+}
+
+// ListSnapshotWindows retrieves a page of the snapshot windows available in the specified datacenter for the specified service plan.
+func (client *Client) ListSnapshotWindows(datacenterID string, servicePlan string, paging *Paging) (windows *SnapshotWindows, err error) {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return nil, err
+	}
+
+	err = paging.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	requestURI := fmt.Sprintf("%s/infrastructure/snapshotWindow?datacenterId=%s&servicePlan=%s&%s",
+		url.QueryEscape(organizationID),
+		url.QueryEscape(datacenterID),
+		url.QueryEscape(servicePlan),
+		paging.EnsurePaging().toQueryParameters(),
+	)
+	request, err := client.newRequestV27(requestURI, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode != http.StatusOK {
+		var apiResponse *APIResponseV2
+
+		apiResponse, err = readAPIResponseAsJSON(responseBody, statusCode)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, apiResponse.ToError("Request to list snapshot windows for datacenter '%s' failed with status code %d (%s): %s", datacenterID, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	windows = &SnapshotWindows{}
+	err = json.Unmarshal(responseBody, windows)
+	if err != nil {
+		return nil, err
+	}
+
+	return windows, nil
+}
+
+// CreateSnapshotPreviewServer creates a new server from the specified snapshot.
+//
+// The new server's status will be ResourceStatusPendingAdd while it is being created (call WaitForDeploy to wait for it to complete).
+// Once it has been verified, the server can be converted to a regular server by calling MigrateSnapshotPreviewServer.
+func (client *Client) CreateSnapshotPreviewServer(configuration SnapshotPreviewServerConfiguration) (serverID string, err error) {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return "", err
+	}
+
+	requestURI := fmt.Sprintf("%s/snapshot/createSnapshotPreviewServer",
+		url.QueryEscape(organizationID),
+	)
+	request, err := client.newRequestV27(requestURI, http.MethodPost, &configuration)
+	if err != nil {
+		return "", err
+	}
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return "", err
+	}
+
+	apiResponse, err := readAPIResponseAsJSON(responseBody, statusCode)
+	if err != nil {
+		return "", err
+	}
+
+	if apiResponse.ResponseCode != ResponseCodeInProgress {
+		return "", apiResponse.ToError("Request to create preview server from snapshot '%s' failed with status code %d (%s): %s", configuration.SnapshotID, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	// Expected: "info" { "name": "serverId", "value": "the-Id-of-the-new-server" }
+	serverIDMessage := apiResponse.GetFieldMessage("serverId")
+	if serverIDMessage == nil {
+		return "", apiResponse.ToError("Received an unexpected response (missing 'serverId') with status code %d (%s): %s", statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	return *serverIDMessage, nil
+}
+
+// MigrateSnapshotPreviewServer converts the specified snapshot preview server to a regular server.
+//
+// The server's status will be ResourceStatusPendingChange while the migration is in progress.
+func (client *Client) MigrateSnapshotPreviewServer(serverID string) error {
+	return client.postSnapshotServiceRequest("migrateSnapshotPreviewServer", serverID, &migrateSnapshotPreviewServer{
+		ServerID: serverID,
+	})
+}
+
+// EnableSnapshotReplication enables replication of the specified server's snapshots to another datacenter.
+//
+// The snapshot service must already be enabled for the server.
+func (client *Client) EnableSnapshotReplication(serverID string, targetDatacenterID string) error {
+	return client.postSnapshotServiceRequest("enableReplication", serverID, &enableSnapshotReplication{
+		ServerID:           serverID,
+		TargetDatacenterID: targetDatacenterID,
+	})
+}
+
+// DisableSnapshotReplication disables replication of the specified server's snapshots.
+//
+// Replicated snapshots in the target datacenter are deleted.
+func (client *Client) DisableSnapshotReplication(serverID string) error {
+	return client.postSnapshotServiceRequest("disableReplication", serverID, &disableSnapshotReplication{
+		ServerID: serverID,
+	})
+}
+
+// postSnapshotServiceRequest posts a request to the specified snapshot service operation.
+func (client *Client) postSnapshotServiceRequest(operation string, serverID string, requestBody interface{}) error {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return err
+	}
+
+	requestURI := fmt.Sprintf("%s/snapshot/%s",
+		url.QueryEscape(organizationID),
+		operation,
+	)
+	request, err := client.newRequestV27(requestURI, http.MethodPost, requestBody)
+	if err != nil {
+		return err
+	}
+
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return err
+	}
+
+	apiResponse, err := readAPIResponseAsJSON(responseBody, statusCode)
+	if err != nil {
+		return err
+	}
+
+	if apiResponse.ResponseCode != ResponseCodeOK && apiResponse.ResponseCode != ResponseCodeInProgress {
+		return apiResponse.ToError("Request to %s for server '%s' failed with status code %d (%s): %s", operation, serverID, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	return nil
+}
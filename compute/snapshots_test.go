@@ -0,0 +1,336 @@
+package compute
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// Enable snapshot service (successful).
+func TestClient_EnableSnapshotService_Success(test *testing.T) {
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			err := client.EnableSnapshotService("5a32d6e4-9707-4813-a269-56ab4d989f4d", SnapshotServicePlanOneMonth, "DAILY", 8)
+			if err != nil {
+				test.Fatal(err)
+			}
+		},
+		Respond: testValidateJSONRequestAndRespondOK(enableSnapshotServiceTestResponse, &enableSnapshotService{}, verifyEnableSnapshotServiceTestRequest),
+	})
+}
+
+// Change snapshot service plan (successful).
+func TestClient_ChangeSnapshotServicePlan_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			err := client.ChangeSnapshotServicePlan("5a32d6e4-9707-4813-a269-56ab4d989f4d", SnapshotServicePlanTwelveMonth)
+			if err != nil {
+				test.Fatal(err)
+			}
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.IsTrue("Request.URL", strings.HasSuffix(request.URL.Path, "/snapshot/changeSnapshotServicePlan"))
+
+			requestBody := &changeSnapshotServicePlan{}
+			err := readRequestBodyAsJSON(request, requestBody)
+			if err != nil {
+				test.Fatal(err)
+			}
+			expect.EqualsString("ChangeSnapshotServicePlan.ServicePlan", SnapshotServicePlanTwelveMonth, requestBody.ServicePlan)
+
+			return http.StatusOK, changeSnapshotServicePlanTestResponse
+		},
+	})
+}
+
+// Disable snapshot service (server not found).
+func TestClient_DisableSnapshotService_NotFound(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			err := client.DisableSnapshotService("5a32d6e4-9707-4813-a269-56ab4d989f4d")
+			expect.NotNil("Error", err)
+			expect.IsTrue("IsResourceNotFoundError", IsResourceNotFoundError(err))
+		},
+		Respond: testRespond(http.StatusBadRequest, disableSnapshotServiceNotFoundTestResponse),
+	})
+}
+
+// Create manual snapshot (successful).
+func TestClient_CreateManualSnapshot_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			snapshotID, err := client.CreateManualSnapshot("5a32d6e4-9707-4813-a269-56ab4d989f4d", "Before upgrade")
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsString("SnapshotID", "f4b2c4e6-8e3a-4d3c-9a0b-1c2d3e4f5a6b", snapshotID)
+		},
+		Respond: testRespond(http.StatusAccepted, createManualSnapshotTestResponse),
+	})
+}
+
+// List snapshots (successful).
+func TestClient_ListSnapshots_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			snapshots, err := client.ListSnapshots("5a32d6e4-9707-4813-a269-56ab4d989f4d", nil)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("Snapshots.Items.Length", 2, len(snapshots.Items))
+			expect.EqualsString("Snapshots.Items[0].Type", SnapshotTypeSystem, snapshots.Items[0].Type)
+			expect.EqualsString("Snapshots.Items[1].Type", SnapshotTypeManual, snapshots.Items[1].Type)
+			expect.EqualsString("Snapshots.Items[1].Description", "Before upgrade", snapshots.Items[1].Description)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.EqualsString("Request.ServerID", "5a32d6e4-9707-4813-a269-56ab4d989f4d", request.URL.Query().Get("serverId"))
+
+			return http.StatusOK, listSnapshotsTestResponse
+		},
+	})
+}
+
+// List snapshot windows (successful).
+func TestClient_ListSnapshotWindows_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			windows, err := client.ListSnapshotWindows("NA9", SnapshotServicePlanOneMonth, nil)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("Windows.Items.Length", 1, len(windows.Items))
+			expect.EqualsString("Windows.Items[0].DayOfWeek", "DAILY", windows.Items[0].DayOfWeek)
+			expect.EqualsInt("Windows.Items[0].StartHour", 8, windows.Items[0].StartHour)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.EqualsString("Request.DatacenterID", "NA9", request.URL.Query().Get("datacenterId"))
+			expect.EqualsString("Request.ServicePlan", SnapshotServicePlanOneMonth, request.URL.Query().Get("servicePlan"))
+
+			return http.StatusOK, listSnapshotWindowsTestResponse
+		},
+	})
+}
+
+// Create snapshot preview server (successful).
+func TestClient_CreateSnapshotPreviewServer_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			serverID, err := client.CreateSnapshotPreviewServer(SnapshotPreviewServerConfiguration{
+				SnapshotID:           "f4b2c4e6-8e3a-4d3c-9a0b-1c2d3e4f5a6b",
+				ServerName:           "restored-server",
+				ServerStarted:        true,
+				PreserveMACAddresses: true,
+			})
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsString("ServerID", "b1e2d3c4-5a6b-4c7d-8e9f-0a1b2c3d4e5f", serverID)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.IsTrue("Request.URL", strings.HasSuffix(request.URL.Path, "/snapshot/createSnapshotPreviewServer"))
+
+			requestBody := &SnapshotPreviewServerConfiguration{}
+			err := readRequestBodyAsJSON(request, requestBody)
+			if err != nil {
+				test.Fatal(err)
+			}
+			expect.EqualsString("Configuration.SnapshotID", "f4b2c4e6-8e3a-4d3c-9a0b-1c2d3e4f5a6b", requestBody.SnapshotID)
+			expect.EqualsString("Configuration.ServerName", "restored-server", requestBody.ServerName)
+			expect.IsTrue("Configuration.ServerStarted", requestBody.ServerStarted)
+			expect.IsFalse("Configuration.NICsConnected", requestBody.NICsConnected)
+
+			return http.StatusAccepted, createSnapshotPreviewServerTestResponse
+		},
+	})
+}
+
+// Enable snapshot replication (successful).
+func TestClient_EnableSnapshotReplication_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			err := client.EnableSnapshotReplication("5a32d6e4-9707-4813-a269-56ab4d989f4d", "NA12")
+			if err != nil {
+				test.Fatal(err)
+			}
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.IsTrue("Request.URL", strings.HasSuffix(request.URL.Path, "/snapshot/enableReplication"))
+
+			requestBody := &enableSnapshotReplication{}
+			err := readRequestBodyAsJSON(request, requestBody)
+			if err != nil {
+				test.Fatal(err)
+			}
+			expect.EqualsString("EnableReplication.TargetDatacenterID", "NA12", requestBody.TargetDatacenterID)
+
+			return http.StatusAccepted, enableSnapshotReplicationTestResponse
+		},
+	})
+}
+
+/*
+ * Test requests.
+ */
+
+func verifyEnableSnapshotServiceTestRequest(test *testing.T, requestBody interface{}) {
+	expect := expect(test)
+
+	expect.NotNil("EnableSnapshotService", requestBody)
+	request := requestBody.(*enableSnapshotService)
+
+	expect.EqualsString("EnableSnapshotService.ServerID", "5a32d6e4-9707-4813-a269-56ab4d989f4d", request.ServerID)
+	expect.EqualsString("EnableSnapshotService.ServicePlan", SnapshotServicePlanOneMonth, request.ServicePlan)
+	expect.EqualsString("EnableSnapshotService.Window.DayOfWeek", "DAILY", request.Window.DayOfWeek)
+	expect.EqualsInt("EnableSnapshotService.Window.StartHour", 8, request.Window.StartHour)
+}
+
+/*
+ * Test responses.
+ */
+
+const enableSnapshotServiceTestResponse = `
+{
+	"operation": "ENABLE_SNAPSHOT_SERVICE",
+	"responseCode": "OK",
+	"message": "Snapshot Service has been enabled on Server (id:5a32d6e4-9707-4813-a269-56ab4d989f4d).",
+	"info": [],
+	"warning": [],
+	"error": [],
+	"requestId": "na9_20170321T074626030-0400_7e9fffe7-190b-46f2-9107-9d52fe57d0ad"
+}
+`
+
+const changeSnapshotServicePlanTestResponse = `
+{
+	"operation": "CHANGE_SNAPSHOT_SERVICE_PLAN",
+	"responseCode": "OK",
+	"message": "Snapshot Service Plan has been changed on Server (id:5a32d6e4-9707-4813-a269-56ab4d989f4d).",
+	"info": [],
+	"warning": [],
+	"error": [],
+	"requestId": "na9_20170321T074626030-0400_7e9fffe7-190b-46f2-9107-9d52fe57d0ad"
+}
+`
+
+const disableSnapshotServiceNotFoundTestResponse = `
+{
+	"operation": "DISABLE_SNAPSHOT_SERVICE",
+	"responseCode": "RESOURCE_NOT_FOUND",
+	"message": "Server 5a32d6e4-9707-4813-a269-56ab4d989f4d not found.",
+	"info": [],
+	"warning": [],
+	"error": [],
+	"requestId": "na9_20170321T074626030-0400_7e9fffe7-190b-46f2-9107-9d52fe57d0ad"
+}
+`
+
+const createManualSnapshotTestResponse = `
+{
+	"operation": "INITIATE_MANUAL_SNAPSHOT",
+	"responseCode": "IN_PROGRESS",
+	"message": "Request to initiate Manual Snapshot on Server (id:5a32d6e4-9707-4813-a269-56ab4d989f4d) has been accepted.",
+	"info": [
+		{
+			"name": "snapshotId",
+			"value": "f4b2c4e6-8e3a-4d3c-9a0b-1c2d3e4f5a6b"
+		}
+	],
+	"warning": [],
+	"error": [],
+	"requestId": "na9_20170321T074626030-0400_7e9fffe7-190b-46f2-9107-9d52fe57d0ad"
+}
+`
+
+const listSnapshotsTestResponse = `
+{
+	"snapshot": [
+		{
+			"id": "d7b4e1a2-3c5f-4e6a-8b9c-0d1e2f3a4b5c",
+			"serverId": "5a32d6e4-9707-4813-a269-56ab4d989f4d",
+			"type": "SYSTEM",
+			"startTime": "2017-03-20T08:00:00.000Z",
+			"expiryTime": "2017-04-20T08:00:00.000Z",
+			"consistencyLevel": "CRASH_CONSISTENT",
+			"state": "NORMAL"
+		},
+		{
+			"id": "f4b2c4e6-8e3a-4d3c-9a0b-1c2d3e4f5a6b",
+			"serverId": "5a32d6e4-9707-4813-a269-56ab4d989f4d",
+			"type": "MANUAL",
+			"description": "Before upgrade",
+			"startTime": "2017-03-21T07:46:26.000Z",
+			"expiryTime": "2017-04-21T07:46:26.000Z",
+			"consistencyLevel": "CRASH_CONSISTENT",
+			"state": "NORMAL"
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 2,
+	"totalCount": 2,
+	"pageSize": 250
+}
+`
+
+const listSnapshotWindowsTestResponse = `
+{
+	"snapshotWindow": [
+		{
+			"id": "2a6b8c0d-1e2f-4a3b-8c4d-5e6f7a8b9c0d",
+			"dayOfWeek": "DAILY",
+			"startHour": 8,
+			"availabilityStatus": "AVAILABLE"
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 1,
+	"totalCount": 1,
+	"pageSize": 250
+}
+`
+
+const createSnapshotPreviewServerTestResponse = `
+{
+	"operation": "CREATE_SNAPSHOT_PREVIEW_SERVER",
+	"responseCode": "IN_PROGRESS",
+	"message": "Request to Create Snapshot Preview Server has been accepted. Please use appropriate Get or List API for status.",
+	"info": [
+		{
+			"name": "serverId",
+			"value": "b1e2d3c4-5a6b-4c7d-8e9f-0a1b2c3d4e5f"
+		}
+	],
+	"warning": [],
+	"error": [],
+	"requestId": "na9_20170321T074626030-0400_7e9fffe7-190b-46f2-9107-9d52fe57d0ad"
+}
+`
+
+const enableSnapshotReplicationTestResponse = `
+{
+	"operation": "ENABLE_REPLICATION",
+	"responseCode": "IN_PROGRESS",
+	"message": "Request to Enable Replication for Server (id:5a32d6e4-9707-4813-a269-56ab4d989f4d) has been accepted.",
+	"info": [],
+	"warning": [],
+	"error": [],
+	"requestId": "na9_20170321T074626030-0400_7e9fffe7-190b-46f2-9107-9d52fe57d0ad"
+}
+`
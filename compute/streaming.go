@@ -0,0 +1,161 @@
+package compute
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+)
+
+// ListServersStream retrieves all servers in the specified datacenter that match the specified filter, calling the handler for each server as it is decoded.
+//
+// Unlike ListServers, each page of results is decoded incrementally from the response body (rather than being read into memory in its entirety),
+// so memory usage remains flat regardless of page size. If the handler returns an error, streaming stops and that error is returned.
+func (client *Client) ListServersStream(datacenterID string, filter *ServerFilter, handler func(server *Server) error) error {
+	return ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		request, err := client.newListServersRequest(datacenterID, filter, paging)
+		if err != nil {
+			return nil, err
+		}
+
+		return client.streamListResponse(request, "server", func(decoder *json.Decoder) error {
+			server := &Server{}
+			err := decoder.Decode(server)
+			if err != nil {
+				return err
+			}
+
+			return handler(server)
+		})
+	})
+}
+
+// streamListResponse performs a request to a (V2) list end-point, decoding the items in the specified field of the response one at a time.
+//
+// decodeItem is called once for each item in the list (it must consume exactly one JSON value from the decoder).
+func (client *Client) streamListResponse(request *http.Request, itemsFieldName string, decodeItem func(decoder *json.Decoder) error) (*PagedResult, error) {
+	response, err := client.executeStreamingRequest(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		responseBody, err := ioutil.ReadAll(response.Body)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading response body for '%s': %s", request.URL.String(), err.Error())
+		}
+
+		apiResponse, err := readAPIResponseAsJSON(responseBody, response.StatusCode)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, apiResponse.ToError("Request to '%s' failed with status code %d (%s): %s", request.URL.Path, response.StatusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	return decodeStreamingPage(response.Body, itemsFieldName, decodeItem)
+}
+
+// decodeStreamingPage incrementally decodes a page of results (a JSON object with a field containing an array of items, as well as paging information).
+func decodeStreamingPage(reader io.Reader, itemsFieldName string, decodeItem func(decoder *json.Decoder) error) (*PagedResult, error) {
+	decoder := json.NewDecoder(reader)
+
+	err := expectJSONDelimiter(decoder, '{')
+	if err != nil {
+		return nil, err
+	}
+
+	page := &PagedResult{}
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		fieldName, ok := token.(string)
+		if !ok {
+			return nil, fmt.Errorf("Unexpected token '%v' in list response (expected field name)", token)
+		}
+
+		switch fieldName {
+		case itemsFieldName:
+			err = expectJSONDelimiter(decoder, '[')
+			if err != nil {
+				return nil, err
+			}
+			for decoder.More() {
+				err = decodeItem(decoder)
+				if err != nil {
+					return nil, err
+				}
+			}
+			err = expectJSONDelimiter(decoder, ']')
+		case "pageNumber":
+			err = decoder.Decode(&page.PageNumber)
+		case "pageCount":
+			err = decoder.Decode(&page.PageCount)
+		case "totalCount":
+			err = decoder.Decode(&page.TotalCount)
+		case "pageSize":
+			err = decoder.Decode(&page.PageSize)
+		default:
+			var ignored json.RawMessage
+			err = decoder.Decode(&ignored)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = expectJSONDelimiter(decoder, '}')
+	if err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+// expectJSONDelimiter reads the next token from the decoder, and returns an error if it is not the specified delimiter.
+func expectJSONDelimiter(decoder *json.Decoder, expected json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	if delimiter, ok := token.(json.Delim); !ok || delimiter != expected {
+		return fmt.Errorf("Unexpected token '%v' in list response (expected '%s')", token, expected)
+	}
+
+	return nil
+}
+
+// executeStreamingRequest performs the specified request, returning the response without reading its body (the caller must close it).
+//
+// Unlike executeRequest, failed requests are not retried (since the request body is not cached); this includes requests rejected because the client's credentials have changed.
+func (client *Client) executeStreamingRequest(request *http.Request) (*http.Response, error) {
+	if client.IsExtendedLoggingEnabled() {
+		log.Printf("Invoking '%s' request to '%s' (streaming response)...",
+			request.Method,
+			request.URL.String(),
+		)
+	}
+
+	err := client.authenticateRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	client.throttle.Wait()
+	response, err := client.httpClient.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("Unexpected error while performing '%s' request to '%s': %s",
+			request.Method,
+			request.URL.String(),
+			err.Error(),
+		)
+	}
+
+	return response, nil
+}
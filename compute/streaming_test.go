@@ -0,0 +1,121 @@
+package compute
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+)
+
+// Stream servers (successful).
+func TestClient_ListServersStream_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			var serverNames []string
+			err := client.ListServersStream("NA9", NewServerFilter().WithState(ResourceStatusNormal), func(server *Server) error {
+				serverNames = append(serverNames, server.Name)
+
+				return nil
+			})
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("ServerNames.Length", 2, len(serverNames))
+			expect.EqualsString("ServerNames[0]", "web1", serverNames[0])
+			expect.EqualsString("ServerNames[1]", "web2", serverNames[1])
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			query := request.URL.Query()
+			expect.EqualsString("Query.datacenterId", "NA9", query.Get("datacenterId"))
+			expect.EqualsString("Query.state", "NORMAL", query.Get("state"))
+			expect.EqualsString("Query.pageNumber", "1", query.Get("pageNumber"))
+
+			return http.StatusOK, listServersStreamTestResponse
+		},
+	})
+}
+
+// Stream servers (handler returns an error).
+func TestClient_ListServersStream_HandlerError(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			handlerError := errors.New("stop")
+
+			serverCount := 0
+			err := client.ListServersStream("NA9", nil, func(server *Server) error {
+				serverCount++
+
+				return handlerError
+			})
+			if err != handlerError {
+				test.Fatalf("Expected handler error, but got: %v", err)
+			}
+
+			expect.EqualsInt("ServerCount", 1, serverCount)
+		},
+		Respond: testRespondOK(listServersStreamTestResponse),
+	})
+}
+
+// Stream servers (API error).
+func TestClient_ListServersStream_Error(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			err := client.ListServersStream("NA9", nil, func(server *Server) error {
+				test.Fatal("Handler should not be called.")
+
+				return nil
+			})
+			expect.NotNil("Error", err)
+		},
+		Respond: testRespond(http.StatusBadRequest, listServersStreamErrorTestResponse),
+	})
+}
+
+/*
+ * Test responses.
+ */
+
+const listServersStreamTestResponse = `
+{
+	"pageNumber": 1,
+	"server": [
+		{
+			"id": "5a32d6e4-9707-4813-a269-56ab4d989f4d",
+			"name": "web1",
+			"datacenterId": "NA9",
+			"deployed": true,
+			"started": true,
+			"state": "NORMAL",
+			"tag": [ { "key": "role", "value": "web" } ]
+		},
+		{
+			"id": "7b63a0a0-d5f5-4b8b-9b7d-7d3c0e1a1a51",
+			"name": "web2",
+			"datacenterId": "NA9",
+			"deployed": true,
+			"started": false,
+			"state": "NORMAL"
+		}
+	],
+	"pageCount": 1,
+	"totalCount": 2,
+	"pageSize": 50,
+	"requestId": "na9/2016-01-01T00:00:00.000Z/abcd"
+}
+`
+
+const listServersStreamErrorTestResponse = `
+{
+	"operation": "LIST_SERVERS",
+	"responseCode": "INVALID_INPUT_DATA",
+	"message": "Invalid datacenter Id.",
+	"requestId": "na9/2016-01-01T00:00:00.000Z/abcd"
+}
+`
@@ -0,0 +1,207 @@
+package compute
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Accounts represents the accounts (primary and sub-administrator) in an organisation.
+type Accounts struct {
+	// The XML name for the "Accounts" data contract
+	XMLName xml.Name `xml:"Accounts"`
+
+	// The accounts.
+	Items []Account `xml:"Account"`
+}
+
+// SubAccountConfiguration represents the configuration for a new sub-administrator account.
+type SubAccountConfiguration struct {
+	// The user name for the new account.
+	UserName string
+
+	// The password for the new account.
+	Password string
+
+	// The user's e-mail address.
+	EmailAddress string
+
+	// The user's full name (display name).
+	FullName string
+
+	// The user's first name.
+	FirstName string
+
+	// The user's last name.
+	LastName string
+
+	// The user's department (optional).
+	Department string
+
+	// The names of the roles to assign to the account.
+	RoleNames []string
+}
+
+// Request body when creating a sub-administrator account.
+type newSubAccount struct {
+	// The XML name for the "newSubAccount" data contract
+	XMLName xml.Name `xml:"http://oec.api.opsource.net/schemas/directory Account"`
+
+	UserName     string `xml:"userName"`
+	Password     string `xml:"password"`
+	EmailAddress string `xml:"emailAddress"`
+	FullName     string `xml:"fullName"`
+	FirstName    string `xml:"firstName"`
+	LastName     string `xml:"lastName"`
+	Department   string `xml:"department,omitempty"`
+	Roles        []Role `xml:"roles>role"`
+}
+
+// Request body when modifying the roles assigned to a sub-administrator account.
+type editSubAccountRoles struct {
+	// The XML name for the "editSubAccountRoles" data contract
+	XMLName xml.Name `xml:"http://oec.api.opsource.net/schemas/directory Account"`
+
+	Roles []Role `xml:"roles>role"`
+}
+
+// ListAccounts retrieves all accounts (primary and sub-administrator) in the current organisation.
+func (client *Client) ListAccounts() (accounts []Account, err error) {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return nil, err
+	}
+
+	requestURI := fmt.Sprintf("%s/account",
+		url.QueryEscape(organizationID),
+	)
+	request, err := client.newRequestV1(requestURI, http.MethodGet, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode != http.StatusOK {
+		var apiResponse *APIResponseV1
+
+		apiResponse, err = readAPIResponseV1(responseBody, statusCode)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, apiResponse.ToError("Request to list accounts failed with status code %d (%s): %s", statusCode, apiResponse.ResultCode, apiResponse.Message)
+	}
+
+	result := &Accounts{}
+	err = xml.Unmarshal(responseBody, result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result.Items, nil
+}
+
+// AddSubAccount creates a new sub-administrator account in the current organisation.
+func (client *Client) AddSubAccount(configuration SubAccountConfiguration) error {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return err
+	}
+
+	requestURI := fmt.Sprintf("%s/account",
+		url.QueryEscape(organizationID),
+	)
+	request, err := client.newRequestV1(requestURI, http.MethodPost, &newSubAccount{
+		UserName:     configuration.UserName,
+		Password:     configuration.Password,
+		EmailAddress: configuration.EmailAddress,
+		FullName:     configuration.FullName,
+		FirstName:    configuration.FirstName,
+		LastName:     configuration.LastName,
+		Department:   configuration.Department,
+		Roles:        newRoles(configuration.RoleNames),
+	})
+	if err != nil {
+		return err
+	}
+
+	return client.executeSubAccountRequest(request, "create sub-administrator account '%s'", configuration.UserName)
+}
+
+// EditSubAccountRoles replaces the roles assigned to the specified sub-administrator account.
+func (client *Client) EditSubAccountRoles(userName string, roleNames []string) error {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return err
+	}
+
+	requestURI := fmt.Sprintf("%s/account/%s",
+		url.QueryEscape(organizationID),
+		url.QueryEscape(userName),
+	)
+	request, err := client.newRequestV1(requestURI, http.MethodPost, &editSubAccountRoles{
+		Roles: newRoles(roleNames),
+	})
+	if err != nil {
+		return err
+	}
+
+	return client.executeSubAccountRequest(request, "modify roles for sub-administrator account '%s'", userName)
+}
+
+// DeleteSubAccount deletes the specified sub-administrator account.
+func (client *Client) DeleteSubAccount(userName string) error {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return err
+	}
+
+	requestURI := fmt.Sprintf("%s/account/%s?delete",
+		url.QueryEscape(organizationID),
+		url.QueryEscape(userName),
+	)
+	request, err := client.newRequestV1(requestURI, http.MethodGet, nil)
+	if err != nil {
+		return err
+	}
+
+	return client.executeSubAccountRequest(request, "delete sub-administrator account '%s'", userName)
+}
+
+// executeSubAccountRequest executes a request to the account-management API and verifies that it was successful.
+func (client *Client) executeSubAccountRequest(request *http.Request, operationDescriptionOrFormat string, formatArgs ...interface{}) error {
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return err
+	}
+
+	apiResponse, err := readAPIResponseV1(responseBody, statusCode)
+	if err != nil {
+		return err
+	}
+
+	if apiResponse.Result != ResultSuccess {
+		operationDescription := fmt.Sprintf(operationDescriptionOrFormat, formatArgs...)
+
+		return apiResponse.ToError("Request to %s failed with status code %d (%s): %s", operationDescription, statusCode, apiResponse.ResultCode, apiResponse.Message)
+	}
+
+	return nil
+}
+
+// newRoles creates Roles with the specified names.
+func newRoles(roleNames []string) []Role {
+	roles := make([]Role, len(roleNames))
+	for index, roleName := range roleNames {
+		roles[index] = Role{
+			Name: roleName,
+		}
+	}
+
+	return roles
+}
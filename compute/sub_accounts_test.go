@@ -0,0 +1,155 @@
+package compute
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// List accounts (successful).
+func TestClient_ListAccounts_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			accounts, err := client.ListAccounts()
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("Accounts.Length", 2, len(accounts))
+			expect.EqualsString("Accounts[0].UserName", "admin", accounts[0].UserName)
+			expect.IsTrue("Accounts[0].HasRole(PrimaryAdministrator)", accounts[0].HasRole(RolePrimaryAdministrator))
+			expect.EqualsString("Accounts[1].UserName", "deployer", accounts[1].UserName)
+			expect.IsTrue("Accounts[1].HasRole(Server)", accounts[1].HasRole(RoleServer))
+			expect.IsFalse("Accounts[1].HasRole(Network)", accounts[1].HasRole(RoleNetwork))
+		},
+		Respond: testRespondOK(listAccountsTestResponse),
+	})
+}
+
+// Add sub-administrator account (successful).
+func TestClient_AddSubAccount_Success(test *testing.T) {
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			err := client.AddSubAccount(SubAccountConfiguration{
+				UserName:     "deployer",
+				Password:     "Passw0rd!",
+				EmailAddress: "deployer@example.com",
+				FullName:     "Deployment Robot",
+				FirstName:    "Deployment",
+				LastName:     "Robot",
+				RoleNames:    []string{RoleServer, RoleCreateImage},
+			})
+			if err != nil {
+				test.Fatal(err)
+			}
+		},
+		Respond: testValidateXMLRequestAndRespondOK(addSubAccountTestResponse, &newSubAccount{}, verifyAddSubAccountTestRequest),
+	})
+}
+
+// Delete sub-administrator account (successful).
+func TestClient_DeleteSubAccount_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			err := client.DeleteSubAccount("deployer")
+			if err != nil {
+				test.Fatal(err)
+			}
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			expect.IsTrue("Request.URL", strings.HasSuffix(request.URL.Path, "/account/deployer"))
+			expect.EqualsString("Request.Query", "delete", request.URL.RawQuery)
+
+			return http.StatusOK, deleteSubAccountTestResponse
+		},
+	})
+}
+
+/*
+ * Test requests.
+ */
+
+const addSubAccountTestRequest = `
+<Account xmlns="http://oec.api.opsource.net/schemas/directory">
+	<userName>deployer</userName>
+	<password>Passw0rd!</password>
+	<emailAddress>deployer@example.com</emailAddress>
+	<fullName>Deployment Robot</fullName>
+	<firstName>Deployment</firstName>
+	<lastName>Robot</lastName>
+	<roles>
+		<role><name>server</name></role>
+		<role><name>create image</name></role>
+	</roles>
+</Account>
+`
+
+func verifyAddSubAccountTestRequest(test *testing.T, requestBody interface{}) {
+	expect := expect(test)
+
+	expect.NotNil("NewSubAccount", requestBody)
+	request := requestBody.(*newSubAccount)
+
+	expect.EqualsString("NewSubAccount.UserName", "deployer", request.UserName)
+	expect.EqualsString("NewSubAccount.EmailAddress", "deployer@example.com", request.EmailAddress)
+	expect.EqualsString("NewSubAccount.FullName", "Deployment Robot", request.FullName)
+	expect.EqualsInt("NewSubAccount.Roles.Length", 2, len(request.Roles))
+	expect.EqualsString("NewSubAccount.Roles[0].Name", RoleServer, request.Roles[0].Name)
+	expect.EqualsString("NewSubAccount.Roles[1].Name", RoleCreateImage, request.Roles[1].Name)
+}
+
+/*
+ * Test responses.
+ */
+
+const listAccountsTestResponse = `
+<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<ns3:Accounts xmlns:ns3="http://oec.api.opsource.net/schemas/directory">
+	<ns3:Account>
+		<ns3:userName>admin</ns3:userName>
+		<ns3:fullName>Primary Administrator</ns3:fullName>
+		<ns3:firstName>Primary</ns3:firstName>
+		<ns3:lastName>Administrator</ns3:lastName>
+		<ns3:emailAddress>admin@example.com</ns3:emailAddress>
+		<ns3:roles>
+			<ns3:role>
+				<ns3:name>primary administrator</ns3:name>
+			</ns3:role>
+		</ns3:roles>
+	</ns3:Account>
+	<ns3:Account>
+		<ns3:userName>deployer</ns3:userName>
+		<ns3:fullName>Deployment Robot</ns3:fullName>
+		<ns3:firstName>Deployment</ns3:firstName>
+		<ns3:lastName>Robot</ns3:lastName>
+		<ns3:emailAddress>deployer@example.com</ns3:emailAddress>
+		<ns3:roles>
+			<ns3:role>
+				<ns3:name>server</ns3:name>
+			</ns3:role>
+		</ns3:roles>
+	</ns3:Account>
+</ns3:Accounts>
+`
+
+const addSubAccountTestResponse = `
+<Status>
+	<operation>Add Sub-Administrator Account</operation>
+	<result>SUCCESS</result>
+	<resultDetail>Account created successfully</resultDetail>
+	<resultCode>RESULT_0</resultCode>
+</Status>
+`
+
+const deleteSubAccountTestResponse = `
+<Status>
+	<operation>Delete Sub-Administrator Account</operation>
+	<result>SUCCESS</result>
+	<resultDetail>Account deleted successfully</resultDetail>
+	<resultCode>RESULT_0</resultCode>
+</Status>
+`
@@ -0,0 +1,150 @@
+package compute
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// TagSchema represents a set of rules for the tags that must / may be applied to resources.
+type TagSchema struct {
+	// The rules for each tag key covered by the schema.
+	Keys []TagSchemaKey
+
+	// If true, tags whose keys are not covered by the schema are reported as violations.
+	DisallowUnknownKeys bool
+}
+
+// TagSchemaKey represents the rules for a single tag key in a TagSchema.
+type TagSchemaKey struct {
+	// The tag key name.
+	Name string
+
+	// Must the tag be applied?
+	Required bool
+
+	// If not empty, the tag's value must be one of these values.
+	AllowedValues []string
+
+	// If not nil, the tag's value must match this pattern.
+	Pattern *regexp.Regexp
+}
+
+// TagSchemaViolation represents a resource's violation of a TagSchema.
+type TagSchemaViolation struct {
+	// The type of resource that violates the schema.
+	ResourceType ResourceType
+
+	// The Id of the resource that violates the schema.
+	ResourceID string
+
+	// The name of the resource that violates the schema.
+	ResourceName string
+
+	// The name of the tag key that the violation relates to.
+	TagName string
+
+	// The tag value (if any) that violates the schema.
+	Value string
+
+	// A description of the violation.
+	Reason string
+}
+
+// Get a string representation of the violation.
+func (violation TagSchemaViolation) String() string {
+	resourceDescription, _ := GetResourceDescription(violation.ResourceType)
+
+	return fmt.Sprintf("%s '%s' ('%s'): tag '%s': %s",
+		resourceDescription,
+		violation.ResourceName,
+		violation.ResourceID,
+		violation.TagName,
+		violation.Reason,
+	)
+}
+
+// Validate checks the specified tags against the schema.
+//
+// Returns the violations (if any); the resource-related fields of each violation are left empty.
+func (schema *TagSchema) Validate(tags []Tag) (violations []TagSchemaViolation) {
+	tagsByName := make(map[string]Tag)
+	for _, tag := range tags {
+		tagsByName[tag.Name] = tag
+	}
+
+	knownKeys := make(map[string]bool)
+	for _, key := range schema.Keys {
+		knownKeys[key.Name] = true
+
+		tag, isPresent := tagsByName[key.Name]
+		if !isPresent {
+			if key.Required {
+				violations = append(violations, TagSchemaViolation{
+					TagName: key.Name,
+					Reason:  "required tag is missing",
+				})
+			}
+
+			continue
+		}
+
+		if len(key.AllowedValues) > 0 && !containsString(key.AllowedValues, tag.Value) {
+			violations = append(violations, TagSchemaViolation{
+				TagName: key.Name,
+				Value:   tag.Value,
+				Reason:  fmt.Sprintf("value '%s' is not one of the allowed values %v", tag.Value, key.AllowedValues),
+			})
+		}
+
+		if key.Pattern != nil && !key.Pattern.MatchString(tag.Value) {
+			violations = append(violations, TagSchemaViolation{
+				TagName: key.Name,
+				Value:   tag.Value,
+				Reason:  fmt.Sprintf("value '%s' does not match pattern '%s'", tag.Value, key.Pattern.String()),
+			})
+		}
+	}
+
+	if schema.DisallowUnknownKeys {
+		for _, tag := range tags {
+			if !knownKeys[tag.Name] {
+				violations = append(violations, TagSchemaViolation{
+					TagName: tag.Name,
+					Value:   tag.Value,
+					Reason:  "tag key is not defined in the schema",
+				})
+			}
+		}
+	}
+
+	return
+}
+
+// ValidateResourceTags retrieves the tags applied to the specified resource and checks them against the schema.
+//
+// Only resources that can be tagged (servers, network domains, VLANs, customer images, and public IP blocks) are supported.
+func (client *Client) ValidateResourceTags(resource Resource, schema *TagSchema) (violations []TagSchemaViolation, err error) {
+	tags, err := client.GetResourceTags(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	violations = schema.Validate(tags)
+	for index := range violations {
+		violations[index].ResourceType = resource.GetResourceType()
+		violations[index].ResourceID = resource.GetID()
+		violations[index].ResourceName = resource.GetName()
+	}
+
+	return violations, nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,112 @@
+package compute
+
+import (
+	"regexp"
+	"testing"
+)
+
+// Validate tags against schema (valid tags).
+func TestTagSchema_Validate_Valid(test *testing.T) {
+	violations := testTagSchema.Validate([]Tag{
+		Tag{Name: "environment", Value: "production"},
+		Tag{Name: "costCentre", Value: "CC-1234"},
+	})
+
+	expect(test).EqualsInt("Violations.Length", 0, len(violations))
+}
+
+// Validate tags against schema (invalid tags).
+func TestTagSchema_Validate_Invalid(test *testing.T) {
+	expect := expect(test)
+
+	violations := testTagSchema.Validate([]Tag{
+		Tag{Name: "environment", Value: "staging"},
+		Tag{Name: "costCentre", Value: "1234"},
+		Tag{Name: "owner", Value: "devuser1"},
+	})
+
+	expect.EqualsInt("Violations.Length", 3, len(violations))
+	expect.EqualsString("Violations[0].TagName", "environment", violations[0].TagName)
+	expect.EqualsString("Violations[0].Value", "staging", violations[0].Value)
+	expect.EqualsString("Violations[1].TagName", "costCentre", violations[1].TagName)
+	expect.EqualsString("Violations[2].TagName", "owner", violations[2].TagName)
+}
+
+// Validate tags against schema (required tag missing).
+func TestTagSchema_Validate_MissingRequiredTag(test *testing.T) {
+	expect := expect(test)
+
+	violations := testTagSchema.Validate([]Tag{
+		Tag{Name: "costCentre", Value: "CC-1234"},
+	})
+
+	expect.EqualsInt("Violations.Length", 1, len(violations))
+	expect.EqualsString("Violations[0].TagName", "environment", violations[0].TagName)
+	expect.EqualsString("Violations[0].Reason", "required tag is missing", violations[0].Reason)
+}
+
+// Validate server tags against schema.
+func TestClient_ValidateResourceTags_Server(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			server := &Server{
+				ID:   "5a32d6e4-9707-4813-a269-56ab4d989f4d",
+				Name: "Production Web Server",
+			}
+
+			violations, err := client.ValidateResourceTags(server, testTagSchema)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("Violations.Length", 1, len(violations))
+			expect.EqualsString("Violations[0].ResourceID", "5a32d6e4-9707-4813-a269-56ab4d989f4d", violations[0].ResourceID)
+			expect.EqualsString("Violations[0].ResourceName", "Production Web Server", violations[0].ResourceName)
+			expect.EqualsString("Violations[0].TagName", "environment", violations[0].TagName)
+		},
+		Respond: testRespondOK(serverTagsForSchemaTestResponse),
+	})
+}
+
+var testTagSchema = &TagSchema{
+	Keys: []TagSchemaKey{
+		TagSchemaKey{
+			Name:          "environment",
+			Required:      true,
+			AllowedValues: []string{"production", "development"},
+		},
+		TagSchemaKey{
+			Name:    "costCentre",
+			Pattern: regexp.MustCompile(`^CC-\d+$`),
+		},
+	},
+	DisallowUnknownKeys: true,
+}
+
+/*
+ * Test responses.
+ */
+
+const serverTagsForSchemaTestResponse = `
+{
+	"tag": [
+		{
+			"assetType": "SERVER",
+			"assetId": "5a32d6e4-9707-4813-a269-56ab4d989f4d",
+			"assetName": "Production Web Server",
+			"datacenterId": "NA9",
+			"tagKeyId": "4a1c7e2d-3b5f-4c8a-9d6e-1f2a3b4c5d6e",
+			"tagKeyName": "costCentre",
+			"value": "CC-1234",
+			"valueRequired": true,
+			"displayOnReport": true
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 1,
+	"totalCount": 1,
+	"pageSize": 250
+}
+`
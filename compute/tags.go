@@ -77,6 +77,15 @@ type tagKey struct {
 	DisplayOnReports bool   `json:"displayOnReport"`
 }
 
+// Request body for editing a tag key.
+type editTagKey struct {
+	ID               string  `json:"id"`
+	Name             *string `json:"name,omitempty"`
+	Description      *string `json:"description,omitempty"`
+	IsValueRequired  *bool   `json:"valueRequired,omitempty"`
+	DisplayOnReports *bool   `json:"displayOnReport,omitempty"`
+}
+
 // Request body for deleting a tag key.
 type deleteTagKey struct {
 	ID string `json:"id"`
@@ -128,6 +137,72 @@ func (client *Client) GetAssetTags(assetID string, assetType string, paging *Pag
 	return tags, err
 }
 
+// ListAssetTags lists all tags (across all pages of results) applied to the specified asset.
+func (client *Client) ListAssetTags(assetType string, assetID string) (tags []TagDetail, err error) {
+	err = ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		tagDetails, err := client.GetAssetTags(assetID, assetType, paging)
+		if err != nil {
+			return nil, err
+		}
+
+		tags = append(tags, tagDetails.Items...)
+
+		return &tagDetails.PagedResult, nil
+	})
+
+	return
+}
+
+// getAllAssetTags gets all tags (across all pages of results) applied to the specified asset.
+func (client *Client) getAllAssetTags(assetID string, assetType string) (tags []Tag, err error) {
+	tagDetails, err := client.ListAssetTags(assetType, assetID)
+	if err != nil {
+		return nil, err
+	}
+
+	for index := range tagDetails {
+		tags = append(tags, tagDetails[index].ToTag())
+	}
+
+	return tags, nil
+}
+
+// ResourceWithTags represents a Resource, together with the tags applied to it.
+type ResourceWithTags struct {
+	Resource Resource
+	Tags     []Tag
+}
+
+// GetResourceTags gets all tags applied to the specified resource.
+//
+// Only resources that can be tagged (servers, network domains, VLANs, customer images, and public IP blocks) are supported.
+func (client *Client) GetResourceTags(resource Resource) (tags []Tag, err error) {
+	assetType, err := getAssetType(resource.GetResourceType())
+	if err != nil {
+		return nil, err
+	}
+
+	return client.getAllAssetTags(resource.GetID(), assetType)
+}
+
+// GetResourcesWithTags gets the tags applied to each of the specified resources.
+func (client *Client) GetResourcesWithTags(resources ...Resource) (resourcesWithTags []ResourceWithTags, err error) {
+	for _, resource := range resources {
+		var tags []Tag
+		tags, err = client.GetResourceTags(resource)
+		if err != nil {
+			return nil, err
+		}
+
+		resourcesWithTags = append(resourcesWithTags, ResourceWithTags{
+			Resource: resource,
+			Tags:     tags,
+		})
+	}
+
+	return resourcesWithTags, nil
+}
+
 // ApplyAssetTags applies the specified tags to an asset.
 func (client *Client) ApplyAssetTags(assetID string, assetType string, tags ...Tag) (response *APIResponseV2, err error) {
 	organizationID, err := client.getOrganizationID()
@@ -188,7 +263,7 @@ func (client *Client) GetTagKey(id string) (tagKey *TagKey, err error) {
 		return nil, err
 	}
 
-	requestURI := fmt.Sprintf("%s/tags/tagKey/%s",
+	requestURI := fmt.Sprintf("%s/tag/tagKey/%s",
 		url.QueryEscape(organizationID),
 		url.QueryEscape(id),
 	)
@@ -225,16 +300,21 @@ func (client *Client) GetTagKey(id string) (tagKey *TagKey, err error) {
 	return tagKey, nil
 }
 
-// ListTagKeys lists all tag keys that apply to the specified network domain.
+// ListTagKeys lists all tag keys defined for the organisation.
 func (client *Client) ListTagKeys(paging *Paging) (tagKeys *TagKeys, err error) {
 	organizationID, err := client.getOrganizationID()
 	if err != nil {
 		return nil, err
 	}
 
+	err = paging.Validate()
+	if err != nil {
+		return nil, err
+	}
+
 	requestURI := fmt.Sprintf("%s/tag/tagKey?orderBy=name&%s",
 		url.QueryEscape(organizationID),
-		paging.toQueryParameters(),
+		paging.EnsurePaging().toQueryParameters(),
 	)
 	request, err := client.newRequestV22(requestURI, http.MethodGet, nil)
 	if err != nil {
@@ -301,7 +381,46 @@ func (client *Client) CreateTagKey(name string, description string, isValueRequi
 	return apiResponse.FieldMessages[0].Message, nil
 }
 
-// DeleteTagKey deletes the specified TagKey rule.
+// EditTagKey updates the configuration of an existing tag key.
+//
+// Pass nil for values you don't want to modify.
+func (client *Client) EditTagKey(id string, name *string, description *string, isValueRequired *bool, displayOnReports *bool) error {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return err
+	}
+
+	requestURI := fmt.Sprintf("%s/tag/editTagKey",
+		url.QueryEscape(organizationID),
+	)
+	request, err := client.newRequestV22(requestURI, http.MethodPost, &editTagKey{
+		ID:               id,
+		Name:             name,
+		Description:      description,
+		IsValueRequired:  isValueRequired,
+		DisplayOnReports: displayOnReports,
+	})
+	if err != nil {
+		return err
+	}
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return err
+	}
+
+	apiResponse, err := readAPIResponseAsJSON(responseBody, statusCode)
+	if err != nil {
+		return err
+	}
+
+	if apiResponse.ResponseCode != ResponseCodeOK {
+		return apiResponse.ToError("Request to edit tag key '%s' failed with unexpected status code %d (%s): %s", id, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	return nil
+}
+
+// DeleteTagKey deletes the specified tag key.
 func (client *Client) DeleteTagKey(id string) error {
 	organizationID, err := client.getOrganizationID()
 	if err != nil {
@@ -0,0 +1,119 @@
+package compute
+
+import (
+	"net/http"
+	"testing"
+)
+
+// List asset tags (successful).
+func TestClient_ListAssetTags_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			tags, err := client.ListAssetTags(AssetTypeServer, "5a32d6e4-9707-4813-a269-56ab4d989f4d")
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("Tags.Length", 1, len(tags))
+			expect.EqualsString("Tags[0].Name", "costCentre", tags[0].Name)
+			expect.EqualsString("Tags[0].Value", "CC-1234", tags[0].Value)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			query := request.URL.Query()
+			expect.EqualsString("Request.AssetType", AssetTypeServer, query.Get("assetType"))
+			expect.EqualsString("Request.AssetID", "5a32d6e4-9707-4813-a269-56ab4d989f4d", query.Get("assetId"))
+
+			return http.StatusOK, serverTagsForSchemaTestResponse
+		},
+	})
+}
+
+// Get resources with tags (successful).
+func TestClient_GetResourcesWithTags_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			resourcesWithTags, err := client.GetResourcesWithTags(&Server{
+				ID: "5a32d6e4-9707-4813-a269-56ab4d989f4d",
+			})
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("ResourcesWithTags.Length", 1, len(resourcesWithTags))
+			expect.EqualsString("ResourcesWithTags[0].Resource.ID", "5a32d6e4-9707-4813-a269-56ab4d989f4d", resourcesWithTags[0].Resource.GetID())
+			expect.EqualsInt("ResourcesWithTags[0].Tags.Length", 1, len(resourcesWithTags[0].Tags))
+			expect.EqualsString("ResourcesWithTags[0].Tags[0].Name", "costCentre", resourcesWithTags[0].Tags[0].Name)
+		},
+		Respond: testRespondOK(serverTagsForSchemaTestResponse),
+	})
+}
+
+// Get resource tags (resource type does not support tags).
+func TestClient_GetResourceTags_Unsupported(test *testing.T) {
+	client := NewClientWithBaseAddress("https://cloudcontrol.example.com", "user1", "password")
+
+	_, err := client.GetResourceTags(&FirewallRule{
+		ID: "7a2f4c1d-9e8b-4a3c-b6d5-e4f3a2b1c0d9",
+	})
+	expect(test).NotNil("Error", err)
+}
+
+// Edit tag key (successful).
+func TestClient_EditTagKey_Success(test *testing.T) {
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			err := client.EditTagKey("4a1c7e2d-3b5f-4c8a-9d6e-1f2a3b4c5d6e", stringToPtr("costCenter"), nil, boolToPtr(false), nil)
+			if err != nil {
+				test.Fatal(err)
+			}
+		},
+		Respond: testValidateJSONRequestAndRespondOK(editTagKeyTestResponse, &editTagKey{}, verifyEditTagKeyTestRequest),
+	})
+}
+
+/*
+ * Test requests.
+ */
+
+const editTagKeyTestRequest = `
+{
+	"id": "4a1c7e2d-3b5f-4c8a-9d6e-1f2a3b4c5d6e",
+	"name": "costCenter",
+	"valueRequired": false
+}
+`
+
+func verifyEditTagKeyTestRequest(test *testing.T, requestBody interface{}) {
+	expect := expect(test)
+
+	expect.NotNil("EditTagKey", requestBody)
+	request := requestBody.(*editTagKey)
+
+	expect.EqualsString("EditTagKey.ID", "4a1c7e2d-3b5f-4c8a-9d6e-1f2a3b4c5d6e", request.ID)
+	expect.NotNil("EditTagKey.Name", request.Name)
+	expect.EqualsString("EditTagKey.Name", "costCenter", *request.Name)
+	expect.IsTrue("EditTagKey.Description == nil", request.Description == nil)
+	expect.NotNil("EditTagKey.IsValueRequired", request.IsValueRequired)
+	expect.IsFalse("EditTagKey.IsValueRequired", *request.IsValueRequired)
+	expect.IsTrue("EditTagKey.DisplayOnReports == nil", request.DisplayOnReports == nil)
+}
+
+/*
+ * Test responses.
+ */
+
+const editTagKeyTestResponse = `
+{
+	"operation": "EDIT_TAG_KEY",
+	"responseCode": "OK",
+	"message": "Tag Key (Id:4a1c7e2d-3b5f-4c8a-9d6e-1f2a3b4c5d6e) has been edited.",
+	"info": [],
+	"warning": [],
+	"error": [],
+	"requestId": "na9_20161001T000000.000-0400_6b5a4c3d-2e1f-4a0b-9c8d-7e6f5a4b3c2d"
+}
+`
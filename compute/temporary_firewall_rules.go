@@ -0,0 +1,100 @@
+package compute
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The marker that separates a temporary firewall rule's name from its expiry time.
+//
+// CloudControl has no way to attach metadata (such as an expiry time) to a firewall rule, so the expiry is recorded in the rule name instead
+// (e.g. "SupportAccess.TMP.1475280000"); this allows any client to clean up expired rules.
+const temporaryFirewallRuleMarker = ".TMP."
+
+// MaxFirewallRuleNameLength is the maximum length of a firewall rule name accepted by CloudControl.
+const MaxFirewallRuleNameLength = 75
+
+// CreateTemporaryFirewallRule creates a firewall rule that expires after the specified period of time.
+//
+// The rule's expiry time (as a Unix timestamp) is appended to its name; call CleanupExpiredRules to delete temporary rules once they have expired.
+// The client does not keep a separate journal of temporary rules; the rule name is the only record of its expiry (CloudControl's audit log records its creation).
+func (client *Client) CreateTemporaryFirewallRule(configuration FirewallRuleConfiguration, ttl time.Duration) (firewallRuleID string, err error) {
+	if ttl <= 0 {
+		return "", fmt.Errorf("Invalid time-to-live for temporary firewall rule '%s' (must be greater than 0)", configuration.Name)
+	}
+
+	expiry := time.Now().Add(ttl)
+	name := getTemporaryFirewallRuleName(configuration.Name, expiry)
+	if len(name) > MaxFirewallRuleNameLength {
+		return "", fmt.Errorf("Name '%s' for temporary firewall rule is too long (with its expiry suffix, it must be at most %d characters)", configuration.Name, MaxFirewallRuleNameLength)
+	}
+	configuration.Name = name
+
+	return client.CreateFirewallRule(configuration)
+}
+
+// CleanupExpiredRules deletes all temporary firewall rules (created by CreateTemporaryFirewallRule) in the specified network domain that have expired.
+func (client *Client) CleanupExpiredRules(networkDomainID string) (deletedRuleIDs []string, err error) {
+	return client.cleanupExpiredRules(networkDomainID, time.Now())
+}
+
+func (client *Client) cleanupExpiredRules(networkDomainID string, now time.Time) (deletedRuleIDs []string, err error) {
+	// Find all the expired rules before deleting any of them (deleting rules while paging through them would shift subsequent pages).
+	var expiredRules []FirewallRule
+	err = ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		rules, err := client.ListFirewallRules(networkDomainID, paging)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, rule := range rules.Rules {
+			expiry, isTemporary := getTemporaryFirewallRuleExpiry(rule.Name)
+			if isTemporary && !expiry.After(now) {
+				expiredRules = append(expiredRules, rule)
+			}
+		}
+
+		return &rules.PagedResult, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rule := range expiredRules {
+		log.Printf("Deleting expired temporary firewall rule '%s' ('%s')...", rule.ID, rule.Name)
+
+		err = client.DeleteFirewallRule(rule.ID)
+		if err != nil {
+			return deletedRuleIDs, err
+		}
+
+		deletedRuleIDs = append(deletedRuleIDs, rule.ID)
+	}
+
+	return deletedRuleIDs, nil
+}
+
+// getTemporaryFirewallRuleName appends the specified expiry time to a firewall rule name.
+func getTemporaryFirewallRuleName(name string, expiry time.Time) string {
+	return fmt.Sprintf("%s%s%d", name, temporaryFirewallRuleMarker, expiry.Unix())
+}
+
+// getTemporaryFirewallRuleExpiry extracts the expiry time from a temporary firewall rule's name.
+//
+// Returns false if the name does not represent a temporary firewall rule.
+func getTemporaryFirewallRuleExpiry(name string) (expiry time.Time, isTemporary bool) {
+	markerIndex := strings.LastIndex(name, temporaryFirewallRuleMarker)
+	if markerIndex == -1 {
+		return
+	}
+
+	expiryTimestamp, err := strconv.ParseInt(name[markerIndex+len(temporaryFirewallRuleMarker):], 10, 64)
+	if err != nil {
+		return
+	}
+
+	return time.Unix(expiryTimestamp, 0), true
+}
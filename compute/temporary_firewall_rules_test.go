@@ -0,0 +1,140 @@
+package compute
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Clean up expired temporary firewall rules (successful).
+func TestClient_CleanupExpiredRules_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			deletedRuleIDs, err := client.cleanupExpiredRules("484174a2-ae74-4658-9e56-50fc90e086cf", time.Unix(1475280000, 0))
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsInt("DeletedRuleIDs.Length", 1, len(deletedRuleIDs))
+			expect.EqualsString("DeletedRuleIDs[0]", "b8f1a8d2-4c2e-4b1e-9a5f-1f2d3c4b5a69", deletedRuleIDs[0])
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			if strings.HasSuffix(request.URL.Path, "/network/deleteFirewallRule") {
+				requestBody := &deleteFirewallRule{}
+				err := readRequestBodyAsJSON(request, requestBody)
+				if err != nil {
+					test.Fatal(err)
+				}
+				expect.EqualsString("DeleteFirewallRule.ID", "b8f1a8d2-4c2e-4b1e-9a5f-1f2d3c4b5a69", requestBody.ID)
+
+				return http.StatusOK, deleteFirewallRuleTestResponse
+			}
+
+			return http.StatusOK, listTemporaryFirewallRulesTestResponse
+		},
+	})
+}
+
+// Create a temporary firewall rule (name too long once the expiry is appended).
+func TestClient_CreateTemporaryFirewallRule_NameTooLong(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			configuration := FirewallRuleConfiguration{
+				Name: strings.Repeat("A", MaxFirewallRuleNameLength-10),
+			}
+			_, err := client.CreateTemporaryFirewallRule(configuration, time.Hour)
+			expect.NotNil("Error", err)
+		},
+		Respond: func(test *testing.T, request *http.Request) (int, string) {
+			test.Fatalf("Unexpected request: %s %s", request.Method, request.URL.Path)
+
+			return http.StatusInternalServerError, ""
+		},
+	})
+}
+
+// Temporary firewall rule names.
+func TestTemporaryFirewallRuleName(test *testing.T) {
+	expect := expect(test)
+
+	name := getTemporaryFirewallRuleName("SupportAccess", time.Unix(1475280000, 0))
+	expect.EqualsString("Name", "SupportAccess.TMP.1475280000", name)
+
+	expiry, isTemporary := getTemporaryFirewallRuleExpiry(name)
+	expect.IsTrue("IsTemporary", isTemporary)
+	expect.IsTrue("Expiry", expiry.Equal(time.Unix(1475280000, 0)))
+
+	_, isTemporary = getTemporaryFirewallRuleExpiry("SupportAccess")
+	expect.IsFalse("IsTemporary(SupportAccess)", isTemporary)
+
+	_, isTemporary = getTemporaryFirewallRuleExpiry("SupportAccess.TMP.Forever")
+	expect.IsFalse("IsTemporary(SupportAccess.TMP.Forever)", isTemporary)
+}
+
+/*
+ * Test responses.
+ */
+
+const listTemporaryFirewallRulesTestResponse = `
+{
+	"firewallRule": [
+		{
+			"id": "b8f1a8d2-4c2e-4b1e-9a5f-1f2d3c4b5a69",
+			"name": "SupportAccess.TMP.1475276400",
+			"action": "ACCEPT_DECISIVELY",
+			"ipVersion": "IPv4",
+			"protocol": "TCP",
+			"enabled": true,
+			"state": "NORMAL",
+			"networkDomainId": "484174a2-ae74-4658-9e56-50fc90e086cf",
+			"datacenterId": "NA9",
+			"ruleType": "CLIENT_RULE"
+		},
+		{
+			"id": "0c3e9a6b-2d7f-4e1a-8b5c-6f4d2e1a9b87",
+			"name": "SupportAccess.TMP.1475283600",
+			"action": "ACCEPT_DECISIVELY",
+			"ipVersion": "IPv4",
+			"protocol": "TCP",
+			"enabled": true,
+			"state": "NORMAL",
+			"networkDomainId": "484174a2-ae74-4658-9e56-50fc90e086cf",
+			"datacenterId": "NA9",
+			"ruleType": "CLIENT_RULE"
+		},
+		{
+			"id": "7a2f4c1d-9e8b-4a3c-b6d5-e4f3a2b1c0d9",
+			"name": "AllowHTTPS",
+			"action": "ACCEPT_DECISIVELY",
+			"ipVersion": "IPv4",
+			"protocol": "TCP",
+			"enabled": true,
+			"state": "NORMAL",
+			"networkDomainId": "484174a2-ae74-4658-9e56-50fc90e086cf",
+			"datacenterId": "NA9",
+			"ruleType": "CLIENT_RULE"
+		}
+	],
+	"pageNumber": 1,
+	"pageCount": 3,
+	"totalCount": 3,
+	"pageSize": 250
+}
+`
+
+const deleteFirewallRuleTestResponse = `
+{
+	"operation": "DELETE_FIREWALL_RULE",
+	"responseCode": "OK",
+	"message": "Firewall Rule 'SupportAccess.TMP.1475276400' has been deleted.",
+	"info": [],
+	"warning": [],
+	"error": [],
+	"requestId": "na9_20161001T000000.000-0400_3f2e1d0c-b9a8-4765-8432-10fedcba9876"
+}
+`
@@ -0,0 +1,31 @@
+package compute
+
+import (
+	"fmt"
+	"time"
+)
+
+// IsOperationTimedOutError determines if an error is an OperationTimedOutError.
+func IsOperationTimedOutError(err error) bool {
+	_, isOperationTimedOutError := err.(*OperationTimedOutError)
+
+	return isOperationTimedOutError
+}
+
+// OperationTimedOutError is the error returned when the client gives up waiting for an operation to complete.
+//
+// Note that this does not mean the operation has failed; it may still complete on the server side.
+type OperationTimedOutError struct {
+	OperationDescription string
+	Timeout              time.Duration
+}
+
+// Get a string representation of the error.
+func (err *OperationTimedOutError) Error() string {
+	return fmt.Sprintf("Timed out after waiting %d seconds for %s to complete",
+		err.Timeout/time.Second,
+		err.OperationDescription,
+	)
+}
+
+var _ error = &OperationTimedOutError{}
@@ -0,0 +1,89 @@
+package compute
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Timestamp represents a date / time returned by the CloudControl API.
+//
+// CloudControl is not entirely consistent about timestamp formats (some resources include fractional seconds and / or a time zone, while others do not);
+// timestamps without a time zone are assumed to be UTC. The original value returned by CloudControl is always available via Raw.
+type Timestamp struct {
+	// The parsed date / time (zero if the raw value could not be parsed).
+	time.Time
+
+	// The raw value returned by CloudControl.
+	Raw string
+}
+
+// The formats used by CloudControl for timestamps.
+var timestampFormats = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+}
+
+// NewTimestamp creates a new Timestamp representing the specified date / time.
+func NewTimestamp(value time.Time) Timestamp {
+	return Timestamp{
+		Time: value,
+		Raw:  value.Format(time.RFC3339),
+	}
+}
+
+// ParseTimestamp parses a timestamp in one of the formats used by CloudControl.
+//
+// If the value cannot be parsed, an error is returned (but the returned Timestamp's Raw field is still populated).
+func ParseTimestamp(value string) (Timestamp, error) {
+	timestamp := Timestamp{
+		Raw: value,
+	}
+	if value == "" {
+		return timestamp, nil
+	}
+
+	var err error
+	for _, format := range timestampFormats {
+		var parsed time.Time
+		parsed, err = time.ParseInLocation(format, value, time.UTC)
+		if err == nil {
+			timestamp.Time = parsed
+
+			return timestamp, nil
+		}
+	}
+
+	return timestamp, err
+}
+
+// String returns the raw value of the timestamp (as returned by CloudControl).
+func (timestamp Timestamp) String() string {
+	return timestamp.Raw
+}
+
+// MarshalJSON serialises the timestamp as its raw value.
+func (timestamp Timestamp) MarshalJSON() ([]byte, error) {
+	return json.Marshal(timestamp.Raw)
+}
+
+// UnmarshalJSON deserialises the timestamp from JSON.
+//
+// Values that cannot be parsed do not cause an error; Raw is populated and Time is left as its zero value.
+func (timestamp *Timestamp) UnmarshalJSON(data []byte) error {
+	var raw *string
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+	if raw == nil {
+		*timestamp = Timestamp{}
+
+		return nil
+	}
+
+	*timestamp, _ = ParseTimestamp(*raw)
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package compute
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+// Parse timestamps in the formats used by CloudControl.
+func TestParseTimestamp(test *testing.T) {
+	expect := expect(test)
+
+	expected := time.Date(2016, 6, 9, 7, 21, 34, 0, time.UTC)
+
+	timestamp, err := ParseTimestamp("2016-06-09T07:21:34.000Z")
+	if err != nil {
+		test.Fatal(err)
+	}
+	expect.IsTrue("FractionalUTC", timestamp.Equal(expected))
+	expect.EqualsString("FractionalUTC.Raw", "2016-06-09T07:21:34.000Z", timestamp.Raw)
+
+	timestamp, err = ParseTimestamp("2016-06-09T17:21:34+10:00")
+	if err != nil {
+		test.Fatal(err)
+	}
+	expect.IsTrue("Offset", timestamp.Equal(expected))
+
+	timestamp, err = ParseTimestamp("2016-06-09T07:21:34")
+	if err != nil {
+		test.Fatal(err)
+	}
+	expect.IsTrue("NoTimeZone", timestamp.Equal(expected))
+
+	timestamp, err = ParseTimestamp("not a timestamp")
+	expect.NotNil("Invalid.Error", err)
+	expect.IsTrue("Invalid.IsZero", timestamp.IsZero())
+	expect.EqualsString("Invalid.Raw", "not a timestamp", timestamp.Raw)
+}
+
+// Timestamps round-trip via JSON.
+func TestTimestamp_JSON(test *testing.T) {
+	expect := expect(test)
+
+	var vlan VLAN
+	err := json.Unmarshal([]byte(`{"id": "vlan-1", "createTime": "2016-06-09T07:21:34.000Z"}`), &vlan)
+	if err != nil {
+		test.Fatal(err)
+	}
+	expect.IsTrue("VLAN.CreateTime", vlan.CreateTime.Equal(time.Date(2016, 6, 9, 7, 21, 34, 0, time.UTC)))
+
+	serialized, err := json.Marshal(vlan.CreateTime)
+	if err != nil {
+		test.Fatal(err)
+	}
+	expect.EqualsString("Serialized", `"2016-06-09T07:21:34.000Z"`, string(serialized))
+
+	err = json.Unmarshal([]byte(`{"id": "vlan-1", "createTime": "garbage"}`), &vlan)
+	if err != nil {
+		test.Fatal(err)
+	}
+	expect.IsTrue("Invalid.IsZero", vlan.CreateTime.IsZero())
+	expect.EqualsString("Invalid.Raw", "garbage", vlan.CreateTime.String())
+}
@@ -0,0 +1,120 @@
+package compute
+
+import (
+	"bytes"
+	"fmt"
+	"mime"
+	"net/http"
+)
+
+// The maximum number of bytes from the response body to capture in an UnexpectedContentError.
+const unexpectedContentExcerptLength = 256
+
+// IsUnexpectedContentError determines if an error is an UnexpectedContentError.
+func IsUnexpectedContentError(err error) bool {
+	_, isUnexpectedContentError := err.(*UnexpectedContentError)
+
+	return isUnexpectedContentError
+}
+
+// UnexpectedContentError is the error returned when the response from CloudControl is empty or does not have the expected content type
+// (e.g. an HTML error page returned by a proxy or load-balancer in front of the API).
+type UnexpectedContentError struct {
+	// The request method.
+	Method string
+
+	// The request URL.
+	URL string
+
+	// The HTTP status code of the response.
+	StatusCode int
+
+	// The value of the response's Content-Type header (if any).
+	ContentType string
+
+	// The first few bytes of the response body (if any).
+	BodyExcerpt string
+}
+
+// Get a string representation of the error.
+func (err *UnexpectedContentError) Error() string {
+	if len(err.BodyExcerpt) == 0 {
+		return fmt.Sprintf("Received an empty response body from '%s' request to '%s' (status code %d).",
+			err.Method,
+			err.URL,
+			err.StatusCode,
+		)
+	}
+
+	return fmt.Sprintf("Received unexpected content (type '%s') from '%s' request to '%s' (status code %d): %s",
+		err.ContentType,
+		err.Method,
+		err.URL,
+		err.StatusCode,
+		err.BodyExcerpt,
+	)
+}
+
+var _ error = &UnexpectedContentError{}
+
+// checkResponseContent verifies that the response body is not an HTML page (which CloudControl itself never returns) and, if the response is expected to have a body, that it is non-empty.
+//
+// Returns an UnexpectedContentError if the response content is unusable.
+func checkResponseContent(request *http.Request, response *http.Response, responseBody []byte) error {
+	// Authentication failures are handled by the caller (and are frequently served as HTML by the front-end web server).
+	if response.StatusCode == http.StatusUnauthorized {
+		return nil
+	}
+
+	contentType := response.Header.Get("Content-Type")
+
+	isEmpty := len(bytes.TrimSpace(responseBody)) == 0 && isResponseBodyExpected(request, response)
+	isHTML := false
+	if mediaType, _, err := mime.ParseMediaType(contentType); err == nil {
+		isHTML = mediaType == "text/html"
+	}
+
+	if !isEmpty && !isHTML {
+		return nil
+	}
+
+	return newUnexpectedContentError(request, response.StatusCode, contentType, responseBody)
+}
+
+// isResponseBodyExpected determines whether a response is expected to have a body.
+//
+// HEAD requests, and 1xx, 204 (No Content), 205 (Reset Content) and 304 (Not Modified) responses, never have a body.
+func isResponseBodyExpected(request *http.Request, response *http.Response) bool {
+	if request.Method == http.MethodHead {
+		return false
+	}
+
+	switch {
+	case response.StatusCode < http.StatusOK:
+		return false
+	case response.StatusCode == http.StatusNoContent:
+		return false
+	case response.StatusCode == http.StatusResetContent:
+		return false
+	case response.StatusCode == http.StatusNotModified:
+		return false
+	default:
+		return true
+	}
+}
+
+// newUnexpectedContentError creates a new UnexpectedContentError (capturing an excerpt from the response body).
+func newUnexpectedContentError(request *http.Request, statusCode int, contentType string, responseBody []byte) *UnexpectedContentError {
+	excerpt := bytes.TrimSpace(responseBody)
+	if len(excerpt) > unexpectedContentExcerptLength {
+		excerpt = excerpt[:unexpectedContentExcerptLength]
+	}
+
+	return &UnexpectedContentError{
+		Method:      request.Method,
+		URL:         request.URL.String(),
+		StatusCode:  statusCode,
+		ContentType: contentType,
+		BodyExcerpt: string(excerpt),
+	}
+}
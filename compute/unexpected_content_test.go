@@ -0,0 +1,99 @@
+package compute
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// Get server (empty response body).
+func TestClient_GetServer_EmptyResponse(test *testing.T) {
+	expect := expect(test)
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.Header().Set("Content-Type", "application/json")
+		writer.WriteHeader(http.StatusOK)
+	}))
+	defer testServer.Close()
+
+	client := NewClientWithBaseAddress(testServer.URL, "user1", "password")
+	client.setAccount(&Account{
+		OrganizationID: "dummy-organization-id",
+	})
+
+	server, err := client.GetServer("5a32d6e4-9707-4813-a269-56ab4d989f4d")
+	expect.IsTrue("IsUnexpectedContentError", IsUnexpectedContentError(err))
+	expect.IsNil("Server", server)
+
+	unexpectedContentError := err.(*UnexpectedContentError)
+	expect.EqualsInt("UnexpectedContentError.StatusCode", http.StatusOK, unexpectedContentError.StatusCode)
+	expect.EqualsString("UnexpectedContentError.BodyExcerpt", "", unexpectedContentError.BodyExcerpt)
+}
+
+// Execute request (204 and HEAD responses have no body, and are not treated as unexpected content).
+func TestClient_ExecuteRequest_NoContent(test *testing.T) {
+	expect := expect(test)
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.Method == http.MethodHead {
+			writer.WriteHeader(http.StatusOK)
+
+			return
+		}
+
+		writer.WriteHeader(http.StatusNoContent)
+	}))
+	defer testServer.Close()
+
+	client := NewClientWithBaseAddress(testServer.URL, "user1", "password")
+
+	for _, method := range []string{http.MethodPost, http.MethodHead} {
+		request, err := http.NewRequest(method, testServer.URL+"/caas/2.4/dummy-organization-id/server/server", nil)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		responseBody, _, err := client.executeRequest(request)
+		expect.IsFalse(method+".IsUnexpectedContentError", IsUnexpectedContentError(err))
+		if err != nil {
+			test.Fatal(err)
+		}
+		expect.EqualsInt(method+".ResponseBody.Length", 0, len(responseBody))
+	}
+}
+
+// Get server (HTML error page from an intermediary).
+func TestClient_GetServer_HTMLResponse(test *testing.T) {
+	expect := expect(test)
+
+	testServer := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.Header().Set("Content-Type", "text/html; charset=utf-8")
+		writer.WriteHeader(http.StatusBadGateway)
+
+		fmt.Fprint(writer, badGatewayTestResponse)
+	}))
+	defer testServer.Close()
+
+	client := NewClientWithBaseAddress(testServer.URL, "user1", "password")
+	client.setAccount(&Account{
+		OrganizationID: "dummy-organization-id",
+	})
+
+	server, err := client.GetServer("5a32d6e4-9707-4813-a269-56ab4d989f4d")
+	expect.IsTrue("IsUnexpectedContentError", IsUnexpectedContentError(err))
+	expect.IsNil("Server", server)
+
+	unexpectedContentError := err.(*UnexpectedContentError)
+	expect.EqualsInt("UnexpectedContentError.StatusCode", http.StatusBadGateway, unexpectedContentError.StatusCode)
+	expect.EqualsString("UnexpectedContentError.ContentType", "text/html; charset=utf-8", unexpectedContentError.ContentType)
+	expect.EqualsString("UnexpectedContentError.BodyExcerpt", "<html><body><h1>502 Bad Gateway</h1></body></html>", unexpectedContentError.BodyExcerpt)
+}
+
+/*
+ * Test responses.
+ */
+
+var badGatewayTestResponse = `
+<html><body><h1>502 Bad Gateway</h1></body></html>
+`
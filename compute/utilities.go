@@ -6,8 +6,16 @@ import (
 	"io"
 	"io/ioutil"
 	"net/http"
+	"regexp"
 )
 
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// isUUID determines whether the specified value is a UUID (the format used for most CloudControl resource Ids).
+func isUUID(value string) bool {
+	return uuidPattern.MatchString(value)
+}
+
 func stringToPtr(value string) *string {
 	return &value
 }
@@ -16,6 +24,18 @@ func intToPtr(value int) *int {
 	return &value
 }
 
+func boolToPtr(value bool) *bool {
+	return &value
+}
+
+func stringOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+
+	return *value
+}
+
 // Get the request body, replacing it with a copy of the original
 func getRequestBody(request *http.Request) (requestBody []byte, err error) {
 	if request.Body != nil {
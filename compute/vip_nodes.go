@@ -68,7 +68,7 @@ type VIPNode struct {
 	DataCenterID string `json:"datacenterId"`
 
 	// The node's creation timestamp.
-	CreateTime string `json:"createTime"`
+	CreateTime Timestamp `json:"createTime"`
 
 	// The node's current state.
 	State string `json:"state"`
@@ -79,6 +79,10 @@ type VIPNode struct {
 
 // GetID returns the node's Id.
 func (node *VIPNode) GetID() string {
+	if node == nil {
+		return ""
+	}
+
 	return node.ID
 }
 
@@ -89,11 +93,19 @@ func (node *VIPNode) GetResourceType() ResourceType {
 
 // GetName returns the node's name.
 func (node *VIPNode) GetName() string {
+	if node == nil {
+		return ""
+	}
+
 	return node.Name
 }
 
 // GetState returns the node's current state.
 func (node *VIPNode) GetState() string {
+	if node == nil {
+		return ""
+	}
+
 	return node.State
 }
 
@@ -106,6 +118,10 @@ var _ Resource = &VIPNode{}
 
 // ToEntityReference creates an EntityReference representing the VIPNode.
 func (node *VIPNode) ToEntityReference() EntityReference {
+	if node == nil {
+		return EntityReference{}
+	}
+
 	return EntityReference{
 		ID:   node.ID,
 		Name: node.Name,
@@ -186,6 +202,11 @@ func (client *Client) ListVIPNodesInNetworkDomain(networkDomainID string, paging
 		return nil, err
 	}
 
+	err = paging.Validate()
+	if err != nil {
+		return nil, err
+	}
+
 	requestURI := fmt.Sprintf("%s/networkDomainVip/node?networkDomainId=%s&%s",
 		url.QueryEscape(organizationID),
 		url.QueryEscape(networkDomainID),
@@ -17,7 +17,7 @@ type VIPPoolMember struct {
 	State           string           `json:"state"`
 	NetworkDomainID string           `json:"networkDomainId"`
 	DatacenterID    string           `json:"datacenterId"`
-	CreateTime      string           `json:"createTime"`
+	CreateTime      Timestamp        `json:"createTime"`
 }
 
 // VIPPoolMembers represents a page of VIPPoolMember results.
@@ -53,6 +53,11 @@ func (client *Client) ListVIPPoolMembers(poolID string, paging *Paging) (members
 		return nil, err
 	}
 
+	err = paging.Validate()
+	if err != nil {
+		return nil, err
+	}
+
 	requestURI := fmt.Sprintf("%s/networkDomainVip/poolMember?poolId=%s&%s",
 		url.QueryEscape(organizationID),
 		url.QueryEscape(poolID),
@@ -95,6 +100,11 @@ func (client *Client) ListVIPPoolMembershipsInNetworkDomain(networkDomainID stri
 		return nil, err
 	}
 
+	err = paging.Validate()
+	if err != nil {
+		return nil, err
+	}
+
 	requestURI := fmt.Sprintf("%s/networkDomainVip/poolMember?networkDomainId=%s&%s",
 		url.QueryEscape(organizationID),
 		url.QueryEscape(networkDomainID),
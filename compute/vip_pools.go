@@ -57,11 +57,15 @@ type VIPPool struct {
 	State             string            `json:"state"`
 	NetworkDomainID   string            `json:"networkDomainID"`
 	DataCenterID      string            `json:"datacenterId"`
-	CreateTime        string            `json:"createTime"`
+	CreateTime        Timestamp         `json:"createTime"`
 }
 
 // GetID returns the pool's Id.
 func (pool *VIPPool) GetID() string {
+	if pool == nil {
+		return ""
+	}
+
 	return pool.ID
 }
 
@@ -72,11 +76,19 @@ func (pool *VIPPool) GetResourceType() ResourceType {
 
 // GetName returns the pool's name.
 func (pool *VIPPool) GetName() string {
+	if pool == nil {
+		return ""
+	}
+
 	return pool.Name
 }
 
 // GetState returns the pool's current state.
 func (pool *VIPPool) GetState() string {
+	if pool == nil {
+		return ""
+	}
+
 	return pool.State
 }
 
@@ -89,6 +101,10 @@ var _ Resource = &VIPPool{}
 
 // ToEntityReference creates an EntityReference representing the VIPNode.
 func (pool *VIPPool) ToEntityReference() EntityReference {
+	if pool == nil {
+		return EntityReference{}
+	}
+
 	return EntityReference{
 		ID:   pool.ID,
 		Name: pool.Name,
@@ -99,7 +115,8 @@ var _ NamedEntity = &VIPNode{}
 
 // VIPPools represents a page of VIPPool results.
 type VIPPools struct {
-	Items []VIPPool
+	// The current page of pool results.
+	Items []VIPPool `json:"vipPool"`
 
 	PagedResult
 }
@@ -167,6 +184,11 @@ func (client *Client) ListVIPPoolsInNetworkDomain(networkDomainID string, paging
 		return nil, err
 	}
 
+	err = paging.Validate()
+	if err != nil {
+		return nil, err
+	}
+
 	requestURI := fmt.Sprintf("%s/networkDomainVip/pool?networkDomainId=%s&%s",
 		url.QueryEscape(organizationID),
 		url.QueryEscape(networkDomainID),
@@ -64,6 +64,30 @@ const (
 	SourcePortPreservationDisabled = "CHANGE"
 )
 
+// Optimization profiles supported by a virtual listener
+const (
+	// Optimization profile for TCP traffic (the default for a standard virtual listener).
+	VirtualListenerOptimizationProfileTCP = "TCP"
+
+	// Optimization profile for TCP traffic over a local area network.
+	VirtualListenerOptimizationProfileLAN = "LAN_OPT"
+
+	// Optimization profile for TCP traffic over a wide area network.
+	VirtualListenerOptimizationProfileWAN = "WAN_OPT"
+
+	// Optimization profile for TCP traffic from mobile clients.
+	VirtualListenerOptimizationProfileMobile = "MOBILE_OPT"
+
+	// Optimization profile for TCP traffic (legacy settings).
+	VirtualListenerOptimizationProfileTCPLegacy = "TCP_LEGACY"
+
+	// Optimization profile for SMTP traffic.
+	VirtualListenerOptimizationProfileSMTP = "SMTP"
+
+	// Optimization profile for SIP traffic.
+	VirtualListenerOptimizationProfileSIP = "SIP"
+)
+
 // VirtualListener represents a virtual listener.
 type VirtualListener struct {
 	ID                         string                    `json:"id"`
@@ -78,19 +102,23 @@ type VirtualListener struct {
 	ConnectionRateLimit        int                       `json:"connectionRateLimit"`
 	SourcePortPreservation     string                    `json:"sourcePortPreservation"`
 	Pool                       VirtualListenerVIPPoolRef `json:"pool"`
-	ClientClonePool            VirtualListenerVIPPoolRef `json:"ClientClonePool"`
+	ClientClonePool            VirtualListenerVIPPoolRef `json:"clientClonePool"`
 	PersistenceProfile         EntityReference           `json:"persistenceProfile"`
 	FallbackPersistenceProfile EntityReference           `json:"fallbackPersistenceProfile"`
 	OptimizationProfiles       []string                  `json:"optimizationProfile"`
 	IRules                     []EntityReference         `json:"irule"`
 	State                      string                    `json:"state"`
-	CreateTime                 string                    `json:"createTime"`
+	CreateTime                 Timestamp                 `json:"createTime"`
 	NetworkDomainID            string                    `json:"networkDomainId"`
 	DataCenterID               string                    `json:"datacenterId"`
 }
 
 // GetID returns the virtual listener's Id.
 func (virtualListener *VirtualListener) GetID() string {
+	if virtualListener == nil {
+		return ""
+	}
+
 	return virtualListener.ID
 }
 
@@ -101,11 +129,19 @@ func (virtualListener *VirtualListener) GetResourceType() ResourceType {
 
 // GetName returns the virtual listener's name.
 func (virtualListener *VirtualListener) GetName() string {
+	if virtualListener == nil {
+		return ""
+	}
+
 	return virtualListener.Name
 }
 
 // GetState returns the virtual listener's current state.
 func (virtualListener *VirtualListener) GetState() string {
+	if virtualListener == nil {
+		return ""
+	}
+
 	return virtualListener.State
 }
 
@@ -116,6 +152,10 @@ func (virtualListener *VirtualListener) IsDeleted() bool {
 
 // ToEntityReference creates an EntityReference representing the CustomerImage.
 func (virtualListener *VirtualListener) ToEntityReference() EntityReference {
+	if virtualListener == nil {
+		return EntityReference{}
+	}
+
 	return EntityReference{
 		ID:   virtualListener.ID,
 		Name: virtualListener.Name,
@@ -188,6 +228,11 @@ func (client *Client) ListVirtualListenersInNetworkDomain(networkDomainID string
 		return nil, err
 	}
 
+	err = paging.Validate()
+	if err != nil {
+		return nil, err
+	}
+
 	requestURI := fmt.Sprintf("%s/networkDomainVip/virtualListener?networkDomainId=%s&%s",
 		url.QueryEscape(organizationID),
 		url.QueryEscape(networkDomainID),
@@ -268,18 +313,30 @@ func (client *Client) GetVirtualListener(id string) (listener *VirtualListener,
 	return listener, nil
 }
 
-// CreateVirtualListener creates a new VIP node.
-// Returns the Id of the new node.
-func (client *Client) CreateVirtualListener(listenerConfiguration NewVirtualListenerConfiguration) (nodeID string, err error) {
+// CreateVirtualListener creates a new virtual listener.
+// Returns the Id of the new virtual listener.
+//
+// If the listener IP address is already used by a NAT rule (or by another virtual listener on the same port) in the same network domain, an AddressInUseError is returned.
+func (client *Client) CreateVirtualListener(listenerConfiguration NewVirtualListenerConfiguration) (virtualListenerID string, err error) {
 	organizationID, err := client.getOrganizationID()
 	if err != nil {
 		return "", err
 	}
 
+	if listenerConfiguration.ListenerIPAddress != nil {
+		err = client.checkExternalAddressNotInUse(listenerConfiguration.NetworkDomainID, *listenerConfiguration.ListenerIPAddress, &listenerConfiguration.Port)
+		if err != nil {
+			return "", err
+		}
+	}
+
 	requestURI := fmt.Sprintf("%s/networkDomainVip/createVirtualListener",
 		url.QueryEscape(organizationID),
 	)
 	request, err := client.newRequestV22(requestURI, http.MethodPost, &listenerConfiguration)
+	if err != nil {
+		return "", err
+	}
 	responseBody, statusCode, err := client.executeRequest(request)
 	if err != nil {
 		return "", err
@@ -317,6 +374,9 @@ func (client *Client) EditVirtualListener(id string, listenerConfiguration EditV
 		url.QueryEscape(organizationID),
 	)
 	request, err := client.newRequestV22(requestURI, http.MethodPost, editListenerConfiguration)
+	if err != nil {
+		return err
+	}
 	responseBody, statusCode, err := client.executeRequest(request)
 	if err != nil {
 		return err
@@ -327,7 +387,7 @@ func (client *Client) EditVirtualListener(id string, listenerConfiguration EditV
 		return err
 	}
 
-	if statusCode != http.StatusOK {
+	if apiResponse.ResponseCode != ResponseCodeOK {
 		return apiResponse.ToError("Request to edit virtual listener '%s' failed with status code %d (%s): %s", listenerConfiguration.ID, statusCode, apiResponse.ResponseCode, apiResponse.Message)
 	}
 
@@ -346,6 +406,9 @@ func (client *Client) DeleteVirtualListener(id string) (err error) {
 		url.QueryEscape(organizationID),
 	)
 	request, err := client.newRequestV22(requestURI, http.MethodPost, &deleteVirtualListener{id})
+	if err != nil {
+		return err
+	}
 	responseBody, statusCode, err := client.executeRequest(request)
 	if err != nil {
 		return err
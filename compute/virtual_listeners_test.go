@@ -17,6 +17,89 @@ func TestClient_GetVirtualListener_ById_Success(test *testing.T) {
 	})
 }
 
+// Create virtual listener (successful).
+func TestClient_CreateVirtualListener_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			virtualListenerID, err := client.CreateVirtualListener(NewVirtualListenerConfiguration{
+				NetworkDomainID:              "553f26b6-2a73-42c3-a78b-6116f11291d0",
+				Name:                         "Production.Load.Balancer",
+				Description:                  "Used as the load balancer for the production applications.",
+				Type:                         VirtualListenerTypeStandard,
+				Protocol:                     VirtualListenerStandardProtocolTCP,
+				ListenerIPAddress:            stringToPtr("165.180.12.22"),
+				Port:                         80,
+				Enabled:                      true,
+				ConnectionLimit:              25000,
+				ConnectionRateLimit:          2000,
+				SourcePortPreservation:       SourcePortPreservationEnabled,
+				PoolID:                       stringToPtr("afb1fb1a-eab9-43f4-95c2-36a4cdda6cb8"),
+				ClientClonePoolID:            stringToPtr("033a97dc-ee9b-4808-97ea-50b06624fd16"),
+				PersistenceProfileID:         stringToPtr("a34ca25c-f3db-11e4-b010-005056806999"),
+				FallbackPersistenceProfileID: stringToPtr("6f2f5d7b-cdd9-4d84-8ad7-999b64a87978"),
+				IRuleIDs: []string{
+					"2b20abd9-ffdc-11e4-b010-005056806999",
+				},
+				OptimizationProfiles: []string{
+					VirtualListenerOptimizationProfileTCP,
+				},
+			})
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsString("VirtualListenerID", "43a445f1-9ac9-4f13-8b0d-a2d1fad231c3", virtualListenerID)
+		},
+		Respond: testRespondNoAddressConflicts(
+			testValidateJSONRequestAndRespondOK(createVirtualListenerTestResponse, &NewVirtualListenerConfiguration{}, func(test *testing.T, requestBody interface{}) {
+				verifyCreateVirtualListenerTestRequest(test, requestBody.(*NewVirtualListenerConfiguration))
+			}),
+		),
+	})
+}
+
+// Edit virtual listener (successful).
+func TestClient_EditVirtualListener_Success(test *testing.T) {
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			description := "Used as the load balancer for the production applications."
+			enabled := false
+			optimizationProfiles := []string{
+				VirtualListenerOptimizationProfileLAN,
+			}
+
+			err := client.EditVirtualListener("6e42868b-e013-41c3-ac38-5f7b50d54808", EditVirtualListenerConfiguration{
+				Description:          &description,
+				Enabled:              &enabled,
+				OptimizationProfiles: &optimizationProfiles,
+			})
+			if err != nil {
+				test.Fatal(err)
+			}
+		},
+		Respond: testValidateJSONRequestAndRespondOK(editVirtualListenerTestResponse, &EditVirtualListenerConfiguration{}, func(test *testing.T, requestBody interface{}) {
+			verifyEditVirtualListenerTestRequest(test, requestBody.(*EditVirtualListenerConfiguration))
+		}),
+	})
+}
+
+// Delete virtual listener (successful).
+func TestClient_DeleteVirtualListener_Success(test *testing.T) {
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			err := client.DeleteVirtualListener("6e42868b-e013-41c3-ac38-5f7b50d54808")
+			if err != nil {
+				test.Fatal(err)
+			}
+		},
+		Respond: testValidateJSONRequestAndRespondOK(deleteVirtualListenerTestResponse, &deleteVirtualListener{}, func(test *testing.T, requestBody interface{}) {
+			expect(test).EqualsString("deleteVirtualListener.ID", "6e42868b-e013-41c3-ac38-5f7b50d54808", requestBody.(*deleteVirtualListener).ID)
+		}),
+	})
+}
+
 /*
  * Test requests.
  */
@@ -48,6 +131,40 @@ const createVirtualListenerTestRequest = `
 }
 `
 
+func verifyCreateVirtualListenerTestRequest(test *testing.T, request *NewVirtualListenerConfiguration) {
+	expect := expect(test)
+
+	expect.NotNil("NewVirtualListenerConfiguration", request)
+	expect.EqualsString("NewVirtualListenerConfiguration.NetworkDomainID", "553f26b6-2a73-42c3-a78b-6116f11291d0", request.NetworkDomainID)
+	expect.EqualsString("NewVirtualListenerConfiguration.Name", "Production.Load.Balancer", request.Name)
+	expect.EqualsString("NewVirtualListenerConfiguration.Type", VirtualListenerTypeStandard, request.Type)
+	expect.EqualsString("NewVirtualListenerConfiguration.Protocol", VirtualListenerStandardProtocolTCP, request.Protocol)
+	expect.NotNil("NewVirtualListenerConfiguration.ListenerIPAddress", request.ListenerIPAddress)
+	expect.EqualsString("NewVirtualListenerConfiguration.ListenerIPAddress", "165.180.12.22", *request.ListenerIPAddress)
+	expect.EqualsInt("NewVirtualListenerConfiguration.Port", 80, request.Port)
+	expect.IsTrue("NewVirtualListenerConfiguration.Enabled", request.Enabled)
+	expect.NotNil("NewVirtualListenerConfiguration.PoolID", request.PoolID)
+	expect.EqualsString("NewVirtualListenerConfiguration.PoolID", "afb1fb1a-eab9-43f4-95c2-36a4cdda6cb8", *request.PoolID)
+	expect.NotNil("NewVirtualListenerConfiguration.PersistenceProfileID", request.PersistenceProfileID)
+	expect.EqualsString("NewVirtualListenerConfiguration.PersistenceProfileID", "a34ca25c-f3db-11e4-b010-005056806999", *request.PersistenceProfileID)
+	expect.EqualsInt("NewVirtualListenerConfiguration.IRuleIDs.Length", 1, len(request.IRuleIDs))
+	expect.EqualsString("NewVirtualListenerConfiguration.IRuleIDs[0]", "2b20abd9-ffdc-11e4-b010-005056806999", request.IRuleIDs[0])
+	expect.EqualsInt("NewVirtualListenerConfiguration.OptimizationProfiles.Length", 1, len(request.OptimizationProfiles))
+	expect.EqualsString("NewVirtualListenerConfiguration.OptimizationProfiles[0]", VirtualListenerOptimizationProfileTCP, request.OptimizationProfiles[0])
+}
+
+func verifyEditVirtualListenerTestRequest(test *testing.T, request *EditVirtualListenerConfiguration) {
+	expect := expect(test)
+
+	expect.NotNil("EditVirtualListenerConfiguration", request)
+	expect.EqualsString("EditVirtualListenerConfiguration.ID", "6e42868b-e013-41c3-ac38-5f7b50d54808", request.ID)
+	expect.NotNil("EditVirtualListenerConfiguration.Enabled", request.Enabled)
+	expect.IsFalse("EditVirtualListenerConfiguration.Enabled", *request.Enabled)
+	expect.IsNil("EditVirtualListenerConfiguration.PoolID", request.PoolID)
+	expect.NotNil("EditVirtualListenerConfiguration.OptimizationProfiles", request.OptimizationProfiles)
+	expect.EqualsString("EditVirtualListenerConfiguration.OptimizationProfiles[0]", VirtualListenerOptimizationProfileLAN, (*request.OptimizationProfiles)[0])
+}
+
 /*
  * Test responses.
  */
@@ -56,7 +173,7 @@ const createVirtualListenerTestResponse = `
 {
 	"operation": "CREATE_VIRTUAL_LISTENER",
 	"responseCode": "OK",
-	"message": "Virtual Listener 'Production.Load.Balancer' has been created on	Public IP Address 165.180.12.22.",
+	"message": "Virtual Listener 'Production.Load.Balancer' has been created on Public IP Address 165.180.12.22.",
 	"info": [
 		{
 			"name": "virtualListenerId",
@@ -79,20 +196,25 @@ const createVirtualListenerTestResponse = `
 
 const editVirtualListenerTestResponse = `
 {
-	"id": "6e42868b-e013-41c3-ac38-5f7b50d54808",
-	"description": "Used as the load balancer for the production applications.",
-	"enabled": true,
-	"connectionLimit": 25000,
-	"connectionRateLimit": 2000,
-	"sourcePortPreservation": "PRESERVE",
-	"poolId": "afb1fb1a-eab9-43f4-95c2-36a4cdda6cb8",
-	"persistenceProfileId": "a34ca25c-f3db-11e4-b010-005056806999",
-	"iruleId": [
-		"2b20abd9-ffdc-11e4-b010-005056806999"
-	],
-	"optimizationProfile": [
-		"TCP"
-	]
+	"operation": "EDIT_VIRTUAL_LISTENER",
+	"responseCode": "OK",
+	"message": "Virtual Listener has been edited successfully.",
+	"info": [],
+	"warning": [],
+	"error": [],
+	"requestId": "na9_20160321T074626030-0400_7e9fffe7-190b-46f2-9107-9d52fe57d0ad"
+}
+`
+
+const deleteVirtualListenerTestResponse = `
+{
+	"operation": "DELETE_VIRTUAL_LISTENER",
+	"responseCode": "OK",
+	"message": "Virtual Listener (id:6e42868b-e013-41c3-ac38-5f7b50d54808) has been deleted.",
+	"info": [],
+	"warning": [],
+	"error": [],
+	"requestId": "na9_20160321T074626030-0400_7e9fffe7-190b-46f2-9107-9d52fe57d0ad"
 }
 `
 
@@ -186,4 +308,8 @@ func verifyGetVirtualListenerTestResponse(test *testing.T, response *VirtualList
 	expect.EqualsString("VirtualListener.SourcePortPreservation", SourcePortPreservationEnabled, response.SourcePortPreservation)
 	expect.EqualsString("VirtualListener.State", ResourceStatusNormal, response.State)
 	expect.EqualsString("VirtualListener.NetworkDomainID", "553f26b6-2a73-42c3-a78b-6116f11291d0", response.NetworkDomainID)
+	expect.EqualsString("VirtualListener.Pool.ID", "afb1fb1a-eab9-43f4-95c2-36a4cdda6cb8", response.Pool.ID)
+	expect.EqualsString("VirtualListener.ClientClonePool.ID", "6f2f5d7b-cdd9-4d84-8ad7-999b64a87978", response.ClientClonePool.ID)
+	expect.EqualsString("VirtualListener.PersistenceProfile.Name", "CCDEFAULT.DestinationAddress", response.PersistenceProfile.Name)
+	expect.EqualsInt("VirtualListener.IRules.Length", 2, len(response.IRules))
 }
@@ -0,0 +1,198 @@
+package compute
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"sort"
+)
+
+const (
+	// VLANAddressConflictReservedAndAssigned indicates that a reserved private IPv4 address is also assigned to a server network adapter.
+	VLANAddressConflictReservedAndAssigned = "RESERVED_AND_ASSIGNED"
+
+	// VLANAddressConflictDuplicateAssignment indicates that a private IPv4 address is assigned to more than one server network adapter.
+	VLANAddressConflictDuplicateAssignment = "DUPLICATE_ASSIGNMENT"
+)
+
+// VLANAddressConflict represents a private IPv4 address in a VLAN that is claimed more than once.
+type VLANAddressConflict struct {
+	// The conflicting address.
+	Address string
+
+	// The type of conflict (VLANAddressConflictReservedAndAssigned or VLANAddressConflictDuplicateAssignment).
+	Kind string
+
+	// The Ids of the network adapters to which the address is assigned.
+	NicIDs []string
+
+	// The Ids of the NAT rules that target the address.
+	NATRuleIDs []string
+}
+
+// VLANUnreservedAddress represents a private IPv4 address in a VLAN that is the target of one or more NAT rules, but is neither reserved nor assigned to a server network adapter.
+type VLANUnreservedAddress struct {
+	// The address.
+	Address string
+
+	// The Ids of the NAT rules that target the address.
+	NATRuleIDs []string
+}
+
+// VLANAddressReport is the result of cross-referencing the private IPv4 addresses used in a VLAN.
+type VLANAddressReport struct {
+	// The VLAN Id.
+	VLANID string
+
+	// Addresses that are claimed more than once.
+	Conflicts []VLANAddressConflict
+
+	// Addresses that are in use (by NAT rules) without being reserved or assigned to a server.
+	Unreserved []VLANUnreservedAddress
+}
+
+// HasProblems determines whether the report contains any conflicting or unreserved addresses.
+func (report *VLANAddressReport) HasProblems() bool {
+	return len(report.Conflicts) > 0 || len(report.Unreserved) > 0
+}
+
+// vlanAddressUsage tracks the ways in which a single private IPv4 address is used.
+type vlanAddressUsage struct {
+	reserved   bool
+	nicIDs     []string
+	natRuleIDs []string
+}
+
+// CheckVLANAddresses cross-references the reserved private IPv4 addresses, server network adapter addresses, and NAT rule internal addresses within a VLAN.
+//
+// This is intended as a pre-flight check before re-addressing (e.g. expanding) a VLAN or its servers.
+func (client *Client) CheckVLANAddresses(vlanID string) (*VLANAddressReport, error) {
+	vlan, err := client.GetVLAN(vlanID)
+	if err != nil {
+		return nil, err
+	}
+	if vlan == nil {
+		return nil, fmt.Errorf("No VLAN was found with Id '%s'", vlanID)
+	}
+
+	reservedAddresses, err := client.ListReservedPrivateIPv4AddressesInVLAN(vlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	var nics []VLANNic
+	err = ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		page, err := client.ListNicsInVLAN(vlanID, paging)
+		if err != nil {
+			return nil, err
+		}
+		nics = append(nics, page.Items...)
+
+		return &page.PagedResult, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var natRules []NATRule
+	err = ForEachPage(NewOffsetCursor(nil), func(paging *Paging) (*PagedResult, error) {
+		page, err := client.ListNATRules(vlan.NetworkDomain.ID, paging)
+		if err != nil {
+			return nil, err
+		}
+		natRules = append(natRules, page.Rules...)
+
+		return &page.PagedResult, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return AnalyzeVLANAddresses(vlan, reservedAddresses.Items, nics, natRules), nil
+}
+
+// AnalyzeVLANAddresses cross-references the reserved private IPv4 addresses, server network adapter addresses, and NAT rule internal addresses within a VLAN.
+//
+// NAT rules whose internal address lies outside the VLAN's IPv4 range are ignored (NAT rules belong to the network domain, not the VLAN).
+func AnalyzeVLANAddresses(vlan *VLAN, reservedAddresses []ReservedIPAddress, nics []VLANNic, natRules []NATRule) *VLANAddressReport {
+	usages := make(map[string]*vlanAddressUsage)
+	usageFor := func(address string) *vlanAddressUsage {
+		usage, ok := usages[address]
+		if !ok {
+			usage = &vlanAddressUsage{}
+			usages[address] = usage
+		}
+
+		return usage
+	}
+
+	for _, reservedAddress := range reservedAddresses {
+		usageFor(reservedAddress.IPAddress).reserved = true
+	}
+	for _, nic := range nics {
+		if nic.PrivateIPv4Address == "" {
+			continue
+		}
+
+		usage := usageFor(nic.PrivateIPv4Address)
+		usage.nicIDs = append(usage.nicIDs, nic.ID)
+	}
+	for _, natRule := range natRules {
+		if !vlan.IPv4Range.Contains(natRule.InternalIPAddress) {
+			continue
+		}
+
+		usage := usageFor(natRule.InternalIPAddress)
+		usage.natRuleIDs = append(usage.natRuleIDs, natRule.ID)
+	}
+
+	report := &VLANAddressReport{
+		VLANID: vlan.ID,
+	}
+	for _, address := range sortIPAddresses(usages) {
+		usage := usages[address]
+
+		switch {
+		case len(usage.nicIDs) > 1:
+			report.Conflicts = append(report.Conflicts, VLANAddressConflict{
+				Address:    address,
+				Kind:       VLANAddressConflictDuplicateAssignment,
+				NicIDs:     usage.nicIDs,
+				NATRuleIDs: usage.natRuleIDs,
+			})
+		case usage.reserved && len(usage.nicIDs) > 0:
+			report.Conflicts = append(report.Conflicts, VLANAddressConflict{
+				Address:    address,
+				Kind:       VLANAddressConflictReservedAndAssigned,
+				NicIDs:     usage.nicIDs,
+				NATRuleIDs: usage.natRuleIDs,
+			})
+		case !usage.reserved && len(usage.nicIDs) == 0 && len(usage.natRuleIDs) > 0:
+			report.Unreserved = append(report.Unreserved, VLANUnreservedAddress{
+				Address:    address,
+				NATRuleIDs: usage.natRuleIDs,
+			})
+		}
+	}
+
+	return report
+}
+
+// sortIPAddresses returns the keys of the specified address map, in numeric (rather than lexical) order.
+func sortIPAddresses(usages map[string]*vlanAddressUsage) []string {
+	addresses := make([]string, 0, len(usages))
+	for address := range usages {
+		addresses = append(addresses, address)
+	}
+	sort.Slice(addresses, func(index1 int, index2 int) bool {
+		ip1 := net.ParseIP(addresses[index1])
+		ip2 := net.ParseIP(addresses[index2])
+		if ip1 == nil || ip2 == nil {
+			return addresses[index1] < addresses[index2]
+		}
+
+		return bytes.Compare(ip1.To16(), ip2.To16()) < 0
+	})
+
+	return addresses
+}
@@ -0,0 +1,72 @@
+package compute
+
+import (
+	"testing"
+)
+
+// Analyze VLAN addresses (conflicts and unreserved addresses).
+func TestAnalyzeVLANAddresses(test *testing.T) {
+	expect := expect(test)
+
+	vlan := &VLAN{
+		ID: "0e56433f-d808-4669-821d-812769517ff8",
+		IPv4Range: IPv4Range{
+			BaseAddress: "10.0.3.0",
+			PrefixSize:  24,
+		},
+	}
+	reservedAddresses := []ReservedIPAddress{
+		{IPAddress: "10.0.3.10"},
+		{IPAddress: "10.0.3.12"},
+	}
+	nics := []VLANNic{
+		{ID: "nic1", PrivateIPv4Address: "10.0.3.12"},
+		{ID: "nic2", PrivateIPv4Address: "10.0.3.100"},
+		{ID: "nic3", PrivateIPv4Address: "10.0.3.100"},
+		{ID: "nic4", PrivateIPv4Address: "10.0.3.20"},
+	}
+	natRules := []NATRule{
+		{ID: "nat1", InternalIPAddress: "10.0.3.10"}, // Reserved; OK.
+		{ID: "nat2", InternalIPAddress: "10.0.3.20"}, // Assigned; OK.
+		{ID: "nat3", InternalIPAddress: "10.0.3.9"},  // Unreserved.
+		{ID: "nat4", InternalIPAddress: "10.0.4.9"},  // Different VLAN; ignored.
+	}
+
+	report := AnalyzeVLANAddresses(vlan, reservedAddresses, nics, natRules)
+	expect.IsTrue("Report.HasProblems", report.HasProblems())
+
+	expect.EqualsInt("Report.Conflicts.Length", 2, len(report.Conflicts))
+
+	conflict := report.Conflicts[0]
+	expect.EqualsString("Report.Conflicts[0].Address", "10.0.3.12", conflict.Address)
+	expect.EqualsString("Report.Conflicts[0].Kind", VLANAddressConflictReservedAndAssigned, conflict.Kind)
+	expect.EqualsInt("Report.Conflicts[0].NicIDs.Length", 1, len(conflict.NicIDs))
+
+	conflict = report.Conflicts[1]
+	expect.EqualsString("Report.Conflicts[1].Address", "10.0.3.100", conflict.Address)
+	expect.EqualsString("Report.Conflicts[1].Kind", VLANAddressConflictDuplicateAssignment, conflict.Kind)
+	expect.EqualsInt("Report.Conflicts[1].NicIDs.Length", 2, len(conflict.NicIDs))
+
+	expect.EqualsInt("Report.Unreserved.Length", 1, len(report.Unreserved))
+	expect.EqualsString("Report.Unreserved[0].Address", "10.0.3.9", report.Unreserved[0].Address)
+	expect.EqualsString("Report.Unreserved[0].NATRuleIDs[0]", "nat3", report.Unreserved[0].NATRuleIDs[0])
+}
+
+// Analyze VLAN addresses (no problems).
+func TestAnalyzeVLANAddresses_NoProblems(test *testing.T) {
+	expect := expect(test)
+
+	vlan := &VLAN{
+		IPv4Range: IPv4Range{
+			BaseAddress: "10.0.3.0",
+			PrefixSize:  24,
+		},
+	}
+
+	report := AnalyzeVLANAddresses(vlan,
+		[]ReservedIPAddress{{IPAddress: "10.0.3.10"}},
+		[]VLANNic{{ID: "nic1", PrivateIPv4Address: "10.0.3.12"}},
+		[]NATRule{{ID: "nat1", InternalIPAddress: "10.0.3.12"}},
+	)
+	expect.IsFalse("Report.HasProblems", report.HasProblems())
+}
@@ -7,6 +7,14 @@ import (
 	"net/url"
 )
 
+const (
+	// VLANGatewayAddressingLow indicates that an attached VLAN's gateway uses the lowest usable address in its IPv4 network range (e.g. 10.0.3.1 for 10.0.3.0/24).
+	VLANGatewayAddressingLow = "LOW"
+
+	// VLANGatewayAddressingHigh indicates that an attached VLAN's gateway uses the highest usable address in its IPv4 network range (e.g. 10.0.3.254 for 10.0.3.0/24).
+	VLANGatewayAddressingHigh = "HIGH"
+)
+
 // VLAN represents a compute VLAN.
 type VLAN struct {
 	// The VLAN Id.
@@ -34,17 +42,40 @@ type VLAN struct {
 	IPv6GatewayAddress string `json:"ipv6GatewayAddress"`
 
 	// The date / time that the VLAN was first created.
-	CreateTime string `json:"createTime"`
+	CreateTime Timestamp `json:"createTime"`
 
 	// The VLAN's current state.
 	State string `json:"state"`
 
 	// The ID of the data center in which the VLAN and its containing network domain are deployed.
 	DataCenterID string `json:"datacenterId"`
+
+	// Is the VLAN attached to its network domain's routing (CloudControl v2.7 and higher)?
+	//
+	// Use IsAttached rather than reading this field directly (it is not populated by earlier versions of CloudControl).
+	Attached *bool `json:"attached,omitempty"`
+
+	// The VLAN's gateway addressing mode (VLANGatewayAddressingLow or VLANGatewayAddressingHigh) if the VLAN is attached (CloudControl v2.7 and higher).
+	GatewayAddressing string `json:"gatewayAddressing,omitempty"`
+}
+
+// IsAttached determines whether the VLAN is attached to its network domain's routing.
+//
+// A detached VLAN is not routed by CloudControl; traffic is routed by the gateway (e.g. a customer firewall appliance) at its IPv4 gateway address.
+func (vlan *VLAN) IsAttached() bool {
+	if vlan.Attached == nil {
+		return true // Earlier versions of CloudControl only support attached VLANs.
+	}
+
+	return *vlan.Attached
 }
 
 // GetID returns the VLAN's Id.
 func (vlan *VLAN) GetID() string {
+	if vlan == nil {
+		return ""
+	}
+
 	return vlan.ID
 }
 
@@ -55,11 +86,19 @@ func (vlan *VLAN) GetResourceType() ResourceType {
 
 // GetName returns the VLAN's name.
 func (vlan *VLAN) GetName() string {
+	if vlan == nil {
+		return ""
+	}
+
 	return vlan.Name
 }
 
 // GetState returns the VLAN's current state.
 func (vlan *VLAN) GetState() string {
+	if vlan == nil {
+		return ""
+	}
+
 	return vlan.State
 }
 
@@ -72,6 +111,10 @@ var _ Resource = &VLAN{}
 
 // ToEntityReference creates an EntityReference representing the VLAN.
 func (vlan *VLAN) ToEntityReference() EntityReference {
+	if vlan == nil {
+		return EntityReference{}
+	}
+
 	return EntityReference{
 		ID:   vlan.ID,
 		Name: vlan.Name,
@@ -86,6 +129,36 @@ type VLANs struct {
 	VLANs []VLAN `json:"vlan"`
 
 	PagedResult
+
+	// Retrieves another page of results using the same criteria as this page (nil if the page was not retrieved via the CloudControl API).
+	listPage func(client *Client, paging *Paging) (*VLANs, error)
+}
+
+// ForEach calls fn for each VLAN in this page and each subsequent page of results (subsequent pages are retrieved using the specified client).
+//
+// Iteration stops at the first error returned by fn (or encountered while retrieving a page of results).
+func (page *VLANs) ForEach(client *Client, fn func(vlan *VLAN) error) error {
+	for {
+		for index := range page.VLANs {
+			err := fn(&page.VLANs[index])
+			if err != nil {
+				return err
+			}
+		}
+
+		if page.IsLastPage() || page.listPage == nil {
+			return nil
+		}
+
+		nextPage, err := page.listPage(client, page.NextPage())
+		if err != nil {
+			return err
+		}
+		if nextPage == nil {
+			return nil
+		}
+		page = nextPage
+	}
 }
 
 // DeployVLAN represents the request body when deploying a cloud compute VLAN.
@@ -104,6 +177,50 @@ type DeployVLAN struct {
 
 	// The private IPv4 prefix size (i.e. netmask) for the VLAN.
 	IPv4PrefixSize int `json:"privateIpv4PrefixSize"`
+
+	// Configuration for an attached VLAN (CloudControl v2.7 and higher).
+	Attached *AttachedVLANConfiguration `json:"attachedVlan,omitempty"`
+
+	// Configuration for a detached VLAN (CloudControl v2.7 and higher).
+	Detached *DetachedVLANConfiguration `json:"detachedVlan,omitempty"`
+}
+
+// AttachedVLANConfiguration represents the configuration for an attached VLAN (routed by its network domain).
+type AttachedVLANConfiguration struct {
+	// The VLAN's gateway addressing mode (VLANGatewayAddressingLow or VLANGatewayAddressingHigh).
+	GatewayAddressing string `json:"gatewayAddressing"`
+}
+
+// DetachedVLANConfiguration represents the configuration for a detached VLAN (not routed by its network domain).
+type DetachedVLANConfiguration struct {
+	// The IPv4 address (within the VLAN's network range) of the gateway that routes the VLAN's traffic.
+	IPv4GatewayAddress string `json:"ipv4GatewayAddress"`
+}
+
+// DeployVLANOptions represents additional options when deploying a VLAN (CloudControl v2.7 and higher).
+type DeployVLANOptions struct {
+	// The VLAN's gateway addressing mode (VLANGatewayAddressingLow or VLANGatewayAddressingHigh) if the VLAN is attached.
+	//
+	// If empty, CloudControl uses VLANGatewayAddressingLow.
+	GatewayAddressing string
+
+	// Deploy the VLAN detached from its network domain's routing?
+	Detached bool
+
+	// The IPv4 address of the gateway that routes the VLAN's traffic (required if the VLAN is detached).
+	IPv4GatewayAddress string
+}
+
+// Request body when attaching a VLAN to its network domain's routing.
+type attachVLAN struct {
+	VLANID            string `json:"vlanId"`
+	GatewayAddressing string `json:"gatewayAddressing"`
+}
+
+// Request body when detaching a VLAN from its network domain's routing.
+type detachVLAN struct {
+	VLANID             string `json:"vlanId"`
+	IPv4GatewayAddress string `json:"ipv4GatewayAddress"`
 }
 
 // EditVLAN represents the request body when editing a cloud compute VLAN.
@@ -137,7 +254,8 @@ func (client *Client) GetVLAN(id string) (vlan *VLAN, err error) {
 		url.QueryEscape(organizationID),
 		url.QueryEscape(id),
 	)
-	request, err := client.newRequestV22(requestURI, http.MethodGet, nil)
+	// VLAN responses are handled correctly up to v2.9, so a negotiated version may be used.
+	request, err := client.newRequestV2(APIVersion22, APIVersion29, requestURI, http.MethodGet, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -179,7 +297,8 @@ func (client *Client) GetVLANByName(name string, networkDomainID string) (*VLAN,
 		url.QueryEscape(name),
 		url.QueryEscape(networkDomainID),
 	)
-	request, err := client.newRequestV22(requestURI, http.MethodGet, nil)
+	// VLAN responses are handled correctly up to v2.9, so a negotiated version may be used.
+	request, err := client.newRequestV2(APIVersion22, APIVersion29, requestURI, http.MethodGet, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -224,12 +343,18 @@ func (client *Client) ListVLANs(networkDomainID string, paging *Paging) (vlans *
 		return nil, err
 	}
 
+	err = paging.Validate()
+	if err != nil {
+		return nil, err
+	}
+
 	requestURI := fmt.Sprintf("%s/network/vlan?networkDomainId=%s&%s",
 		url.QueryEscape(organizationID),
 		url.QueryEscape(networkDomainID),
 		paging.EnsurePaging().toQueryParameters(),
 	)
-	request, err := client.newRequestV22(requestURI, http.MethodGet, nil)
+	// VLAN responses are handled correctly up to v2.9, so a negotiated version may be used.
+	request, err := client.newRequestV2(APIVersion22, APIVersion29, requestURI, http.MethodGet, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -252,12 +377,58 @@ func (client *Client) ListVLANs(networkDomainID string, paging *Paging) (vlans *
 
 	vlans = &VLANs{}
 	err = json.Unmarshal(responseBody, vlans)
+	vlans.listPage = func(client *Client, paging *Paging) (*VLANs, error) {
+		return client.ListVLANs(networkDomainID, paging)
+	}
 
 	return vlans, err
 }
 
 // DeployVLAN deploys a new VLAN into a network domain.
 func (client *Client) DeployVLAN(networkDomainID string, name string, description string, ipv4BaseAddress string, ipv4PrefixSize int) (vlanID string, err error) {
+	return client.deployVLAN(APIVersion22, &DeployVLAN{
+		VLANID:          networkDomainID,
+		Name:            name,
+		Description:     description,
+		IPv4BaseAddress: ipv4BaseAddress,
+		IPv4PrefixSize:  ipv4PrefixSize,
+	})
+}
+
+// DeployVLANWithOptions deploys a new VLAN into a network domain, using the specified gateway addressing mode or as a detached VLAN (CloudControl v2.7 and higher).
+func (client *Client) DeployVLANWithOptions(networkDomainID string, name string, description string, ipv4BaseAddress string, ipv4PrefixSize int, options DeployVLANOptions) (vlanID string, err error) {
+	deployVLAN := &DeployVLAN{
+		VLANID:          networkDomainID,
+		Name:            name,
+		Description:     description,
+		IPv4BaseAddress: ipv4BaseAddress,
+		IPv4PrefixSize:  ipv4PrefixSize,
+	}
+
+	if options.Detached {
+		if options.IPv4GatewayAddress == "" {
+			return "", fmt.Errorf("Must specify an IPv4 gateway address when deploying detached VLAN '%s'", name)
+		}
+
+		deployVLAN.Detached = &DetachedVLANConfiguration{
+			IPv4GatewayAddress: options.IPv4GatewayAddress,
+		}
+	} else {
+		gatewayAddressing := options.GatewayAddressing
+		if gatewayAddressing == "" {
+			gatewayAddressing = VLANGatewayAddressingLow
+		}
+
+		deployVLAN.Attached = &AttachedVLANConfiguration{
+			GatewayAddressing: gatewayAddressing,
+		}
+	}
+
+	return client.deployVLAN(APIVersion27, deployVLAN)
+}
+
+// deployVLAN deploys a new VLAN using the specified (minimum) API version.
+func (client *Client) deployVLAN(minimumVersion APIVersion, deployVLAN *DeployVLAN) (vlanID string, err error) {
 	organizationID, err := client.getOrganizationID()
 	if err != nil {
 		return "", err
@@ -266,13 +437,10 @@ func (client *Client) DeployVLAN(networkDomainID string, name string, descriptio
 	requestURI := fmt.Sprintf("%s/network/deployVlan",
 		url.QueryEscape(organizationID),
 	)
-	request, err := client.newRequestV22(requestURI, http.MethodPost, &DeployVLAN{
-		VLANID:          networkDomainID,
-		Name:            name,
-		Description:     description,
-		IPv4BaseAddress: ipv4BaseAddress,
-		IPv4PrefixSize:  ipv4PrefixSize,
-	})
+	request, err := client.newRequestV2(minimumVersion, minimumVersion, requestURI, http.MethodPost, deployVLAN)
+	if err != nil {
+		return "", err
+	}
 	responseBody, statusCode, err := client.executeRequest(request)
 	if err != nil {
 		return "", err
@@ -284,7 +452,7 @@ func (client *Client) DeployVLAN(networkDomainID string, name string, descriptio
 	}
 
 	if apiResponse.ResponseCode != ResponseCodeInProgress {
-		return "", apiResponse.ToError("Request to deploy VLAN '%s' failed with status code %d (%s): %s", name, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+		return "", apiResponse.ToError("Request to deploy VLAN '%s' failed with status code %d (%s): %s", deployVLAN.Name, statusCode, apiResponse.ResponseCode, apiResponse.Message)
 	}
 
 	// Expected: "info" { "name": "vlanId", "value": "the-Id-of-the-new-VLAN" }
@@ -296,6 +464,56 @@ func (client *Client) DeployVLAN(networkDomainID string, name string, descriptio
 	return *vlanIDMessage, nil
 }
 
+// AttachVLAN attaches a detached VLAN to its network domain's routing, using the specified gateway addressing mode (VLANGatewayAddressingLow or VLANGatewayAddressingHigh).
+// Requires CloudControl v2.7 or higher.
+func (client *Client) AttachVLAN(vlanID string, gatewayAddressing string) error {
+	return client.postVLANRoutingRequest("attachVlan", "attach", vlanID, &attachVLAN{
+		VLANID:            vlanID,
+		GatewayAddressing: gatewayAddressing,
+	})
+}
+
+// DetachVLAN detaches a VLAN from its network domain's routing; its traffic will then be routed by the gateway at the specified IPv4 address.
+// Requires CloudControl v2.7 or higher.
+func (client *Client) DetachVLAN(vlanID string, ipv4GatewayAddress string) error {
+	return client.postVLANRoutingRequest("detachVlan", "detach", vlanID, &detachVLAN{
+		VLANID:             vlanID,
+		IPv4GatewayAddress: ipv4GatewayAddress,
+	})
+}
+
+// postVLANRoutingRequest performs a request to attach / detach a VLAN.
+func (client *Client) postVLANRoutingRequest(operationName string, operationDescription string, vlanID string, requestBody interface{}) error {
+	organizationID, err := client.getOrganizationID()
+	if err != nil {
+		return err
+	}
+
+	requestURI := fmt.Sprintf("%s/network/%s",
+		url.QueryEscape(organizationID),
+		operationName,
+	)
+	request, err := client.newRequestV27(requestURI, http.MethodPost, requestBody)
+	if err != nil {
+		return err
+	}
+	responseBody, statusCode, err := client.executeRequest(request)
+	if err != nil {
+		return err
+	}
+
+	apiResponse, err := readAPIResponseAsJSON(responseBody, statusCode)
+	if err != nil {
+		return err
+	}
+
+	if apiResponse.ResponseCode != ResponseCodeInProgress {
+		return apiResponse.ToError("Request to %s VLAN '%s' failed with unexpected status code %d (%s): %s", operationDescription, vlanID, statusCode, apiResponse.ResponseCode, apiResponse.Message)
+	}
+
+	return nil
+}
+
 // EditVLAN updates an existing VLAN.
 // Pass an empty string for any field to retain its existing value.
 // Returns an error if the operation was not successful.
@@ -57,6 +57,72 @@ func TestClient_DeployVlan_Success(test *testing.T) {
 	})
 }
 
+// Deploy detached VLAN (successful).
+func TestClient_DeployVLANWithOptions_Detached_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			vlanID, err := client.DeployVLANWithOptions(
+				"484174a2-ae74-4658-9e56-50fc90e086cf",
+				"Production VLAN",
+				"For hosting our Production Cloud Servers",
+				"10.0.3.0",
+				23,
+				DeployVLANOptions{
+					Detached:           true,
+					IPv4GatewayAddress: "10.0.3.254",
+				},
+			)
+			if err != nil {
+				test.Fatal(err)
+			}
+
+			expect.EqualsString("VLANID", "0e56433f-d808-4669-821d-812769517ff8", vlanID)
+		},
+		Respond: testValidateJSONRequestAndRespondOK(deployVLANTestResponse, &DeployVLAN{}, func(test *testing.T, requestBody interface{}) {
+			request := requestBody.(*DeployVLAN)
+			verifyDeployVLANTestRequest(test, request)
+
+			expect.IsTrue("DeployVLAN.Attached == nil", request.Attached == nil)
+			expect.NotNil("DeployVLAN.Detached", request.Detached)
+			expect.EqualsString("DeployVLAN.Detached.IPv4GatewayAddress", "10.0.3.254", request.Detached.IPv4GatewayAddress)
+		}),
+	})
+}
+
+// Deploy detached VLAN (no gateway address).
+func TestClient_DeployVLANWithOptions_Detached_NoGateway(test *testing.T) {
+	client := NewClientWithBaseAddress("https://api.example.com", "user", "password")
+
+	_, err := client.DeployVLANWithOptions("484174a2-ae74-4658-9e56-50fc90e086cf", "Production VLAN", "", "10.0.3.0", 23, DeployVLANOptions{
+		Detached: true,
+	})
+	if err == nil {
+		test.Fatal("Expected an error when deploying a detached VLAN without a gateway address.")
+	}
+}
+
+// Attach VLAN (successful).
+func TestClient_AttachVLAN_Success(test *testing.T) {
+	expect := expect(test)
+
+	testClientRequest(test, &ClientTestConfig{
+		Request: func(test *testing.T, client *Client) {
+			err := client.AttachVLAN("0e56433f-d808-4669-821d-812769517ff8", VLANGatewayAddressingHigh)
+			if err != nil {
+				test.Fatal(err)
+			}
+		},
+		Respond: testValidateJSONRequestAndRespondOK(attachVLANTestResponse, &attachVLAN{}, func(test *testing.T, requestBody interface{}) {
+			request := requestBody.(*attachVLAN)
+
+			expect.EqualsString("AttachVLAN.VLANID", "0e56433f-d808-4669-821d-812769517ff8", request.VLANID)
+			expect.EqualsString("AttachVLAN.GatewayAddressing", VLANGatewayAddressingHigh, request.GatewayAddressing)
+		}),
+	})
+}
+
 // Edit VLAN (successful).
 func TestClient_EditVlan_Success(test *testing.T) {
 	testClientRequest(test, &ClientTestConfig{
@@ -192,7 +258,7 @@ func verifyGetVLANTestResponse(test *testing.T, vlan *VLAN) {
 	expect.EqualsString("VLAN.IPv6Range.BaseAddress", "2607:f480:1111:1153:0:0:0:0", vlan.IPv6Range.BaseAddress)
 	expect.EqualsInt("VLAN.IPv6Range.PrefixSize", 64, vlan.IPv6Range.PrefixSize)
 	expect.EqualsString("VLAN.IPv6GatewayAddress", "2607:f480:1111:1153:0:0:0:1", vlan.IPv6GatewayAddress)
-	expect.EqualsString("VLAN.CreateTime", "2016-06-09T07:21:34.000Z", vlan.CreateTime)
+	expect.EqualsString("VLAN.CreateTime", "2016-06-09T07:21:34.000Z", vlan.CreateTime.Raw)
 	expect.EqualsString("VLAN.State", "NORMAL", vlan.State)
 	expect.EqualsString("VLAN.DataCenterID", "NA9", vlan.DataCenterID)
 }
@@ -255,7 +321,7 @@ func verifyListVLANsTestResponse(test *testing.T, vlans *VLANs) {
 	expect.EqualsInt("VLANs.VLANs[0].IPv6Range.PrefixSize", 64, vlan1.IPv6Range.PrefixSize)
 	expect.EqualsString("VLANs.VLANs[0].IPv6GatewayAddress", "2607:f480:1111:1153:0:0:0:1", vlan1.IPv6GatewayAddress)
 
-	expect.EqualsString("VLANs.VLANs[0].CreateTime", "2016-06-09T07:21:34.000Z", vlan1.CreateTime)
+	expect.EqualsString("VLANs.VLANs[0].CreateTime", "2016-06-09T07:21:34.000Z", vlan1.CreateTime.Raw)
 }
 
 var deployVLANTestResponse = `
@@ -285,6 +351,18 @@ func verifyDeployVLANTestResponse(test *testing.T, response *APIResponseV2) {
 	expect.EqualsString("Response.RequestID", "na9_20160321T074626030-0400_7e9fffe7-190b-46f2-9107-9d52fe57d0ad", response.RequestID)
 }
 
+var attachVLANTestResponse = `
+	{
+		"operation": "ATTACH_VLAN",
+		"responseCode": "IN_PROGRESS",
+		"message": "Request to Attach VLAN (Id: 0e56433f-d808-4669-821d-812769517ff8) has been accepted and is being processed.",
+		"info": [],
+		"warning": [],
+		"error": [],
+		"requestId": "na9_20160321T074626030-0400_7e9fffe7-190b-46f2-9107-9d52fe57d0ad"
+	}
+`
+
 var editVLANTestResponse = `
 	{
 		"operation": "EDIT_VLAN",
@@ -327,3 +405,19 @@ func verifyDeleteVLANTestResponse(test *testing.T, response *APIResponseV2) {
 	expect.EqualsString("Response.Message", "Request to VLAN (Id: 0e56433f-d808-4669-821d-812769517ff8) has been accepted and is being processed.", response.Message)
 	expect.EqualsString("Response.RequestID", "na9_20160321T074626030-0400_7e9fffe7-190b-46f2-9107-9d52fe57d0ad", response.RequestID)
 }
+
+// VLAN IPv4 / IPv6 ranges.
+func TestVLAN_IPRanges_Contains(test *testing.T) {
+	expect := expect(test)
+
+	vlan := &VLAN{
+		IPv4Range: IPv4Range{BaseAddress: "10.0.3.0", PrefixSize: 24},
+		IPv6Range: IPv6Range{BaseAddress: "2607:f480:1111:1153:0:0:0:0", PrefixSize: 64},
+	}
+
+	expect.IsTrue("IPv4Range.Contains(10.0.3.11)", vlan.IPv4Range.Contains("10.0.3.11"))
+	expect.IsFalse("IPv4Range.Contains(10.0.4.11)", vlan.IPv4Range.Contains("10.0.4.11"))
+	expect.IsTrue("IPv6Range.Contains(2607:f480:1111:1153:8c76:adf6:4f3c:1c1f)", vlan.IPv6Range.Contains("2607:f480:1111:1153:8c76:adf6:4f3c:1c1f"))
+	expect.IsFalse("IPv6Range.Contains(2607:f480:1111:1154::1)", vlan.IPv6Range.Contains("2607:f480:1111:1154::1"))
+	expect.IsFalse("IPv6Range.Contains(invalid)", vlan.IPv6Range.Contains("not-an-address"))
+}
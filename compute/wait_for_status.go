@@ -1,11 +1,15 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 )
 
+// The interval at which resources are polled while waiting for their pending operations to complete.
+var resourceStatusPollInterval = 5 * time.Second
+
 // WaitForDeploy waits for a resource's pending deployment operation to complete.
 func (client *Client) WaitForDeploy(resourceType ResourceType, id string, timeout time.Duration) (resource Resource, err error) {
 	return client.waitForPendingOperation(resourceType, id, "Deploy", ResourceStatusPendingAdd, false, timeout)
@@ -18,6 +22,77 @@ func (client *Client) WaitForServerClone(customerImageID string, timeout time.Du
 	return client.waitForPendingOperation(ResourceTypeCustomerImage, customerImageID, "Clone", ResourceStatusPendingAdd, false, timeout)
 }
 
+// WaitForCustomerImageCopy waits for a customer image's pending copy operation to complete.
+//
+// Pass the Id of the new customer image (in the target datacenter), not the Id of the source image.
+func (client *Client) WaitForCustomerImageCopy(customerImageID string, timeout time.Duration) (resource Resource, err error) {
+	return client.waitForPendingOperation(ResourceTypeCustomerImage, customerImageID, "Copy", ResourceStatusPendingAdd, false, timeout)
+}
+
+// WaitForCustomerImageExport waits for a customer image's pending export operation to complete.
+//
+// If confirmWithExportHistory is true, the image export history is checked once the wait has completed (or timed out) to confirm that the export actually completed
+// (this avoids triggering a duplicate export when the client gives up waiting before the export has finished, and avoids treating a failed export as successful).
+// In that case, the export is only considered to have completed if its export history record has the state ImageExportStateCompleted;
+// if the record has the state ImageExportStateFailed, an ImageExportFailedError is returned, and if there is no record, an error is returned.
+func (client *Client) WaitForCustomerImageExport(imageID string, exportID string, timeout time.Duration, confirmWithExportHistory bool) (resource Resource, err error) {
+	resource, err = client.waitForPendingOperation(ResourceTypeCustomerImage, imageID, "Export", ResourceStatusPendingChange, false, timeout)
+	if !confirmWithExportHistory || (err != nil && !IsOperationTimedOutError(err)) {
+		return
+	}
+
+	return client.confirmCustomerImageExport(imageID, exportID, resource, err)
+}
+
+// confirmCustomerImageExport checks the image export history to confirm that a customer image export has completed.
+//
+// resource and waitErr are the outcome of waiting for the export (waitErr, if not nil, is returned if the export has not yet completed).
+func (client *Client) confirmCustomerImageExport(imageID string, exportID string, resource Resource, waitErr error) (Resource, error) {
+	log.Printf("Checking export history for export '%s' of customer image '%s'...", exportID, imageID)
+
+	export, err := client.GetCustomerImageExport(exportID)
+	if err != nil {
+		return nil, err
+	}
+	if export == nil {
+		if waitErr != nil {
+			return nil, waitErr // Export may still be in progress.
+		}
+
+		return nil, fmt.Errorf("Export '%s' of customer image '%s' does not appear in the export history", exportID, imageID)
+	}
+
+	switch export.State {
+	case ImageExportStateCompleted:
+		log.Printf("Export history indicates that export '%s' of customer image '%s' has completed.", exportID, imageID)
+	case ImageExportStateFailed:
+		return nil, &ImageExportFailedError{
+			ImageID:  imageID,
+			ExportID: exportID,
+		}
+	default:
+		if waitErr != nil {
+			return nil, waitErr // Export has not (yet) completed.
+		}
+
+		return nil, fmt.Errorf("Export '%s' of customer image '%s' has unexpected state '%s' in the export history", exportID, imageID, export.State)
+	}
+
+	if resource != nil {
+		return resource, nil
+	}
+
+	image, err := client.GetCustomerImage(imageID)
+	if err != nil {
+		return nil, err
+	}
+	if image == nil {
+		return nil, fmt.Errorf("No customer image was found with Id '%s'", imageID)
+	}
+
+	return image, nil
+}
+
 // WaitForEdit waits for a resource's pending edit operation to complete.
 func (client *Client) WaitForEdit(resourceType ResourceType, id string, timeout time.Duration) (resource Resource, err error) {
 	return client.WaitForChange(resourceType, id, "Edit", timeout)
@@ -54,10 +129,29 @@ func (client *Client) waitForPendingOperation(resourceType ResourceType, id stri
 // getResource is a function that, given the resource Id, will retrieve the resource.
 // timeout is the length of time before the wait times out.
 func (client *Client) waitForResourceStatus(resourceType ResourceType, id string, actionDescription string, expectedStatus string, targetStatus string, isDelete bool, timeout time.Duration) (resource Resource, err error) {
-	waitTimeout := time.NewTimer(timeout)
-	defer waitTimeout.Stop()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	resource, err = client.waitForResourceStatusContext(ctx, resourceType, id, actionDescription, isDelete)
+	if err == context.DeadlineExceeded {
+		resourceDescription, _ := GetResourceDescription(resourceType)
+
+		return nil, &OperationTimedOutError{
+			OperationDescription: fmt.Sprintf("%s of %s '%s'",
+				actionDescription,
+				resourceDescription,
+				id,
+			),
+			Timeout: timeout,
+		}
+	}
+
+	return
+}
 
-	pollTicker := time.NewTicker(5 * time.Second)
+// waitForResourceStatusContext polls a resource for its status until its pending operation is complete (or the context is done, in which case the context's error is returned).
+func (client *Client) waitForResourceStatusContext(ctx context.Context, resourceType ResourceType, id string, actionDescription string, isDelete bool) (resource Resource, err error) {
+	pollTicker := time.NewTicker(resourceStatusPollInterval)
 	defer pollTicker.Stop()
 
 	resourceDescription, err := GetResourceDescription(resourceType)
@@ -65,19 +159,17 @@ func (client *Client) waitForResourceStatus(resourceType ResourceType, id string
 		return nil, err
 	}
 
+	// Cached responses may be stale; always retrieve the resource's current status.
+	defer client.bypassResponseCache(id)()
+
 	for {
 		select {
-		case <-waitTimeout.C:
-			return nil, fmt.Errorf("Timed out after waiting %d seconds for %s of %s '%s' to complete",
-				timeout/time.Second,
-				actionDescription,
-				resourceDescription,
-				id,
-			)
+		case <-ctx.Done():
+			return nil, ctx.Err()
 
 		case <-pollTicker.C:
 			log.Printf("Polling status for %s '%s'...", resourceDescription, id)
-			if client.isCancellationRequested {
+			if client.IsCancellationRequested() {
 				log.Printf("Client indicates that cancellation of pending requests has been requested.")
 
 				return nil, &OperationCancelledError{
@@ -93,9 +185,6 @@ func (client *Client) waitForResourceStatus(resourceType ResourceType, id string
 			if err != nil {
 				return nil, err
 			}
-			if err != nil {
-				return nil, err
-			}
 
 			if resource == nil || resource.IsDeleted() {
 				if isDelete {
@@ -107,28 +196,25 @@ func (client *Client) waitForResourceStatus(resourceType ResourceType, id string
 				return nil, fmt.Errorf("No %s was found with Id '%s'", resourceDescription, id)
 			}
 
-			switch resource.GetState() {
-			case ResourceStatusNormal:
+			state := resource.GetState()
+			switch {
+			case state == ResourceStatusNormal:
 				log.Printf("%s of %s '%s' has successfully completed.", actionDescription, resourceDescription, id)
 
 				return resource, nil
 
-			case ResourceStatusPendingAdd:
-				log.Printf("%s of %s '%s' is still in progress...", actionDescription, resourceDescription, id)
-
-				continue
-			case ResourceStatusPendingChange:
+			case IsPendingStatus(state):
 				log.Printf("%s of %s '%s' is still in progress...", actionDescription, resourceDescription, id)
 
 				continue
-			case ResourceStatusPendingDelete:
-				log.Printf("%s of %s '%s' is still in progress...", actionDescription, resourceDescription, id)
+			case IsFailedStatus(state):
+				log.Printf("%s of %s '%s' has failed ('%s').", actionDescription, resourceDescription, id, state)
 
-				continue
+				return nil, fmt.Errorf("%s failed for %s '%s' ('%s'): resource is in state '%s'", actionDescription, resourceDescription, id, resource.GetName(), state)
 			default:
-				log.Printf("Unexpected status for %s '%s' ('%s').", resourceDescription, id, resource.GetState())
+				log.Printf("Unexpected status for %s '%s' ('%s').", resourceDescription, id, state)
 
-				return nil, fmt.Errorf("%s failed for %s '%s' ('%s'): encountered unexpected state '%s'", actionDescription, resourceDescription, id, resource.GetName(), resource.GetState())
+				return nil, fmt.Errorf("%s failed for %s '%s' ('%s'): encountered unexpected state '%s'", actionDescription, resourceDescription, id, resource.GetName(), state)
 			}
 		}
 	}
@@ -0,0 +1,261 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/DimensionDataResearch/go-dd-cloud-compute/compute"
+)
+
+const (
+	// BakeTagName is the name of the tag that identifies images produced by the bake (its value is the image name prefix).
+	BakeTagName = "imageBake"
+
+	// BakeVersionTagName is the name of the tag that records the version (timestamp) of a baked image.
+	BakeVersionTagName = "imageBakeVersion"
+
+	// bakeVersionFormat is the format used for image versions (and the suffix of image names).
+	bakeVersionFormat = "20060102-1504"
+)
+
+// BakeConfiguration represents the configuration for a nightly image bake.
+type BakeConfiguration struct {
+	// The Id of the "golden" server to clone.
+	ServerID string
+
+	// The prefix for baked image names (the version is appended, e.g. "golden-20170301-0200").
+	ImageNamePrefix string
+
+	// The Ids of the datacenters to which the baked image is replicated.
+	TargetDatacenterIDs []string
+
+	// The number of baked images to keep in each datacenter (older versions are pruned); must be at least 1 (the image that was just baked).
+	KeepVersions int
+
+	// The maximum amount of time to wait for each clone / copy operation to complete.
+	Timeout time.Duration
+
+	// The time that identifies the bake's version (if zero, the current time is used).
+	Version time.Time
+}
+
+// BakedImage represents a customer image produced by a bake.
+type BakedImage struct {
+	ImageID      string
+	DatacenterID string
+}
+
+// BakeResult represents the outcome of a bake.
+type BakeResult struct {
+	// The name of the baked images.
+	ImageName string
+
+	// The image cloned from the golden server.
+	Source BakedImage
+
+	// The replicas of the source image (one per target datacenter).
+	Replicas []BakedImage
+
+	// Older baked images that were deleted.
+	Pruned []BakedImage
+}
+
+// Bake clones the golden server to a customer image, replicates that image to the target datacenters, tags each copy, and then prunes old versions.
+//
+// The source image's datacenter is skipped if it also appears in the target datacenters.
+func Bake(client *compute.Client, configuration BakeConfiguration) (*BakeResult, error) {
+	if configuration.KeepVersions < 1 {
+		return nil, fmt.Errorf("Invalid number of versions to keep (%d); must keep at least 1 version (the image being baked)", configuration.KeepVersions)
+	}
+
+	version := configuration.Version
+	if version.IsZero() {
+		version = time.Now()
+	}
+	versionName := version.UTC().Format(bakeVersionFormat)
+
+	result := &BakeResult{
+		ImageName: fmt.Sprintf("%s-%s", configuration.ImageNamePrefix, versionName),
+	}
+	tags := []compute.Tag{
+		{Name: BakeTagName, Value: configuration.ImageNamePrefix},
+		{Name: BakeVersionTagName, Value: versionName},
+	}
+
+	log.Printf("Cloning server '%s' to customer image '%s'...", configuration.ServerID, result.ImageName)
+	imageID, err := client.CloneServer(configuration.ServerID, result.ImageName, "Nightly image bake", false)
+	if err != nil {
+		return nil, err
+	}
+	resource, err := client.WaitForServerClone(imageID, configuration.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	image := resource.(*compute.CustomerImage)
+	result.Source = BakedImage{
+		ImageID:      image.ID,
+		DatacenterID: image.DataCenterID,
+	}
+
+	err = tagImage(client, image.ID, tags)
+	if err != nil {
+		return nil, err
+	}
+
+	var targetDatacenterIDs []string
+	for _, datacenterID := range configuration.TargetDatacenterIDs {
+		if datacenterID == result.Source.DatacenterID || containsDatacenterID(targetDatacenterIDs, datacenterID) {
+			continue
+		}
+
+		targetDatacenterIDs = append(targetDatacenterIDs, datacenterID)
+	}
+
+	result.Replicas, err = replicateImage(client, image.ID, result.ImageName, targetDatacenterIDs, configuration.Timeout, tags)
+	if err != nil {
+		return nil, err
+	}
+
+	datacenterIDs := append([]string{result.Source.DatacenterID}, targetDatacenterIDs...)
+	for _, datacenterID := range datacenterIDs {
+		var pruned []BakedImage
+		pruned, err = pruneImages(client, datacenterID, configuration.ImageNamePrefix, configuration.KeepVersions)
+		if err != nil {
+			return result, err
+		}
+
+		result.Pruned = append(result.Pruned, pruned...)
+	}
+
+	return result, nil
+}
+
+// containsDatacenterID determines whether the specified datacenter Id appears in a list of datacenter Ids.
+func containsDatacenterID(datacenterIDs []string, datacenterID string) bool {
+	for _, id := range datacenterIDs {
+		if id == datacenterID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// replicateImage copies the specified image to each of the target datacenters (in parallel) and tags the copies.
+func replicateImage(client *compute.Client, imageID string, imageName string, targetDatacenterIDs []string, timeout time.Duration, tags []compute.Tag) ([]BakedImage, error) {
+	replicas := make([]BakedImage, len(targetDatacenterIDs))
+	errors := make([]error, len(targetDatacenterIDs))
+
+	var waitGroup sync.WaitGroup
+	for index, datacenterID := range targetDatacenterIDs {
+		waitGroup.Add(1)
+
+		go func(index int, datacenterID string) {
+			defer waitGroup.Done()
+
+			log.Printf("Copying customer image '%s' to datacenter '%s'...", imageID, datacenterID)
+			copyID, err := client.CopyCustomerImage(imageID, datacenterID, imageName)
+			if err != nil {
+				errors[index] = err
+
+				return
+			}
+
+			_, err = client.WaitForCustomerImageCopy(copyID, timeout)
+			if err != nil {
+				errors[index] = err
+
+				return
+			}
+
+			replicas[index] = BakedImage{
+				ImageID:      copyID,
+				DatacenterID: datacenterID,
+			}
+			errors[index] = tagImage(client, copyID, tags)
+		}(index, datacenterID)
+	}
+	waitGroup.Wait()
+
+	for _, err := range errors {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return replicas, nil
+}
+
+// tagImage applies the specified tags to a customer image.
+func tagImage(client *compute.Client, imageID string, tags []compute.Tag) error {
+	response, err := client.ApplyAssetTags(imageID, compute.AssetTypeCustomerImage, tags...)
+	if err != nil {
+		return err
+	}
+	if response.ResponseCode != compute.ResponseCodeOK {
+		return response.ToError("Failed to tag customer image '%s' (%s): %s", imageID, response.ResponseCode, response.Message)
+	}
+
+	return nil
+}
+
+// pruneImages deletes all but the newest keepVersions baked images in the specified datacenter.
+//
+// Only images tagged as produced by a bake with the specified image name prefix are considered.
+func pruneImages(client *compute.Client, datacenterID string, imageNamePrefix string, keepVersions int) (pruned []BakedImage, err error) {
+	var bakedImages []compute.CustomerImage
+	err = compute.ForEachPage(compute.NewOffsetCursor(nil), func(paging *compute.Paging) (*compute.PagedResult, error) {
+		images, err := client.ListCustomerImagesInDatacenter(datacenterID, paging)
+		if err != nil {
+			return nil, err
+		}
+
+		for index := range images.Images {
+			image := &images.Images[index]
+			tags, err := client.GetResourceTags(image)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, tag := range tags {
+				if tag.Name == BakeTagName && tag.Value == imageNamePrefix {
+					bakedImages = append(bakedImages, *image)
+
+					break
+				}
+			}
+		}
+
+		return &images.PagedResult, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bakedImages) <= keepVersions {
+		return nil, nil
+	}
+
+	// Newest first.
+	sort.Slice(bakedImages, func(index1 int, index2 int) bool {
+		return bakedImages[index1].CreateTime.After(bakedImages[index2].CreateTime.Time)
+	})
+
+	for _, image := range bakedImages[keepVersions:] {
+		log.Printf("Pruning customer image '%s' ('%s') in datacenter '%s'...", image.ID, image.Name, datacenterID)
+		err = client.DeleteCustomerImage(image.ID)
+		if err != nil {
+			return pruned, err
+		}
+
+		pruned = append(pruned, BakedImage{
+			ImageID:      image.ID,
+			DatacenterID: datacenterID,
+		})
+	}
+
+	return pruned, nil
+}
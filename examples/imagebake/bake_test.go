@@ -0,0 +1,316 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DimensionDataResearch/go-dd-cloud-compute/compute"
+)
+
+// Bake a golden image, replicate it to 2 datacenters, and prune old versions (against a fake CloudControl API).
+func TestBake(test *testing.T) {
+	if testing.Short() {
+		test.Skip("Skipping image bake test (waits for several status polls).")
+	}
+
+	cloudControl := newFakeCloudControl()
+	cloudControl.addImage("old-au-1", "AU9", "golden-20170227-0200", "2017-02-27T02:00:00.000Z", "golden")
+	cloudControl.addImage("old-au-2", "AU9", "golden-20170228-0200", "2017-02-28T02:00:00.000Z", "golden")
+	cloudControl.addImage("other-au", "AU9", "not-baked", "2017-01-01T00:00:00.000Z", "")
+	cloudControl.addImage("old-au10-1", "AU10", "golden-20170228-0200", "2017-02-28T02:00:00.000Z", "golden")
+	cloudControl.addImage("old-au10-2", "AU10", "golden-20170227-0200", "2017-02-27T02:00:00.000Z", "golden")
+
+	server := httptest.NewServer(cloudControl)
+	defer server.Close()
+
+	client := compute.NewClientWithBaseAddress(server.URL, "user", "password")
+
+	result, err := Bake(client, BakeConfiguration{
+		ServerID:            "golden-server",
+		ImageNamePrefix:     "golden",
+		TargetDatacenterIDs: []string{"AU10", "AU11"},
+		KeepVersions:        2,
+		Timeout:             30 * time.Second,
+		Version:             time.Date(2017, 3, 1, 2, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	if result.ImageName != "golden-20170301-0200" {
+		test.Fatalf("Unexpected image name '%s'.", result.ImageName)
+	}
+	if result.Source.DatacenterID != "AU9" {
+		test.Fatalf("Unexpected source datacenter '%s'.", result.Source.DatacenterID)
+	}
+	if len(result.Replicas) != 2 || result.Replicas[0].DatacenterID != "AU10" || result.Replicas[1].DatacenterID != "AU11" {
+		test.Fatalf("Unexpected replicas: %v", result.Replicas)
+	}
+
+	// Each replica should be tagged with the bake version.
+	for _, replica := range append(result.Replicas, result.Source) {
+		if cloudControl.getTag(replica.ImageID, BakeVersionTagName) != "20170301-0200" {
+			test.Fatalf("Image '%s' was not tagged with its version.", replica.ImageID)
+		}
+	}
+
+	// Only the oldest baked image in each datacenter should be pruned (untagged images are left alone).
+	if len(result.Pruned) != 2 {
+		test.Fatalf("Expected 2 pruned images but found %d: %v", len(result.Pruned), result.Pruned)
+	}
+	for _, imageID := range []string{"old-au-1", "old-au10-2"} {
+		if cloudControl.hasImage(imageID) {
+			test.Fatalf("Image '%s' was not pruned.", imageID)
+		}
+	}
+	for _, imageID := range []string{"old-au-2", "other-au", "old-au10-1"} {
+		if !cloudControl.hasImage(imageID) {
+			test.Fatalf("Image '%s' should not have been pruned.", imageID)
+		}
+	}
+}
+
+// Bake a golden image when the source datacenter is also listed as a replication target (it is not copied to itself).
+func TestBake_SourceDatacenterIsTarget(test *testing.T) {
+	if testing.Short() {
+		test.Skip("Skipping image bake test (waits for several status polls).")
+	}
+
+	cloudControl := newFakeCloudControl()
+	cloudControl.addImage("old-au-1", "AU9", "golden-20170228-0200", "2017-02-28T02:00:00.000Z", "golden")
+
+	server := httptest.NewServer(cloudControl)
+	defer server.Close()
+
+	client := compute.NewClientWithBaseAddress(server.URL, "user", "password")
+
+	result, err := Bake(client, BakeConfiguration{
+		ServerID:            "golden-server",
+		ImageNamePrefix:     "golden",
+		TargetDatacenterIDs: []string{"AU9", "AU10"},
+		KeepVersions:        1,
+		Timeout:             30 * time.Second,
+		Version:             time.Date(2017, 3, 1, 2, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	if len(result.Replicas) != 1 || result.Replicas[0].DatacenterID != "AU10" {
+		test.Fatalf("Unexpected replicas: %v", result.Replicas)
+	}
+
+	// Only the previous version should be pruned; the newly-baked image must survive.
+	if len(result.Pruned) != 1 || result.Pruned[0].ImageID != "old-au-1" {
+		test.Fatalf("Unexpected pruned images: %v", result.Pruned)
+	}
+	if !cloudControl.hasImage(result.Source.ImageID) {
+		test.Fatalf("Newly-baked image '%s' was pruned.", result.Source.ImageID)
+	}
+}
+
+// Bake with an invalid number of versions to keep (fails before anything is cloned).
+func TestBake_InvalidKeepVersions(test *testing.T) {
+	cloudControl := newFakeCloudControl()
+
+	server := httptest.NewServer(cloudControl)
+	defer server.Close()
+
+	client := compute.NewClientWithBaseAddress(server.URL, "user", "password")
+
+	_, err := Bake(client, BakeConfiguration{
+		ServerID:            "golden-server",
+		ImageNamePrefix:     "golden",
+		TargetDatacenterIDs: []string{"AU10"},
+		KeepVersions:        0,
+	})
+	if err == nil {
+		test.Fatal("Expected an error for KeepVersions = 0.")
+	}
+	if cloudControl.nextIndex != 0 {
+		test.Fatal("Server was cloned despite invalid configuration.")
+	}
+}
+
+// fakeCloudControl is a minimal in-memory implementation of the CloudControl APIs used by the image bake.
+type fakeCloudControl struct {
+	lock      sync.Mutex
+	images    map[string]*compute.CustomerImage
+	tags      map[string][]compute.Tag
+	nextIndex int
+}
+
+func newFakeCloudControl() *fakeCloudControl {
+	return &fakeCloudControl{
+		images: make(map[string]*compute.CustomerImage),
+		tags:   make(map[string][]compute.Tag),
+	}
+}
+
+func (fake *fakeCloudControl) addImage(id string, datacenterID string, name string, createTime string, bakeTag string) {
+	timestamp, err := compute.ParseTimestamp(createTime)
+	if err != nil {
+		panic(err)
+	}
+
+	fake.images[id] = &compute.CustomerImage{
+		ID:           id,
+		Name:         name,
+		DataCenterID: datacenterID,
+		CreateTime:   timestamp,
+		State:        compute.ResourceStatusNormal,
+	}
+	if bakeTag != "" {
+		fake.tags[id] = []compute.Tag{
+			{Name: BakeTagName, Value: bakeTag},
+		}
+	}
+}
+
+func (fake *fakeCloudControl) hasImage(id string) bool {
+	fake.lock.Lock()
+	defer fake.lock.Unlock()
+
+	_, ok := fake.images[id]
+
+	return ok
+}
+
+func (fake *fakeCloudControl) getTag(id string, name string) string {
+	fake.lock.Lock()
+	defer fake.lock.Unlock()
+
+	for _, tag := range fake.tags[id] {
+		if tag.Name == name {
+			return tag.Value
+		}
+	}
+
+	return ""
+}
+
+func (fake *fakeCloudControl) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	fake.lock.Lock()
+	defer fake.lock.Unlock()
+
+	if strings.HasSuffix(request.URL.Path, "/myaccount") {
+		writer.Header().Set("Content-Type", "text/xml")
+		fmt.Fprint(writer, `<Account><userName>user</userName><orgId>my-org</orgId></Account>`)
+
+		return
+	}
+
+	// Strip "/caas/2.x/my-org/".
+	segments := strings.SplitN(strings.TrimPrefix(request.URL.Path, "/"), "/", 4)
+	if len(segments) < 4 {
+		http.NotFound(writer, request)
+
+		return
+	}
+	operation := segments[3]
+	query := request.URL.Query()
+
+	var requestBody map[string]interface{}
+	if request.Method == http.MethodPost {
+		body, _ := ioutil.ReadAll(request.Body)
+		json.Unmarshal(body, &requestBody)
+	}
+
+	switch {
+	case operation == "server/cloneServer":
+		imageID := fake.createImage("AU9", requestBody["imageName"].(string))
+		fake.respondInProgress(writer, "imageId", imageID)
+
+	case operation == "image/copyImage":
+		imageID := fake.createImage(requestBody["targetDatacenterId"].(string), requestBody["name"].(string))
+		fake.respondInProgress(writer, "imageId", imageID)
+
+	case operation == "image/deleteImage":
+		delete(fake.images, requestBody["id"].(string))
+		fake.respondInProgress(writer, "", "")
+
+	case operation == "image/customerImage":
+		images := &compute.CustomerImages{}
+		for _, image := range fake.images {
+			if image.DataCenterID == query.Get("datacenterId") {
+				images.Images = append(images.Images, *image)
+			}
+		}
+		images.PageNumber = 1
+		images.PageCount = len(images.Images)
+		images.PageSize = 250
+		fake.respondJSON(writer, http.StatusOK, images)
+
+	case strings.HasPrefix(operation, "image/customerImage/"):
+		image, ok := fake.images[path.Base(operation)]
+		if !ok {
+			fake.respondJSON(writer, http.StatusBadRequest, &compute.APIResponseV2{ResponseCode: compute.ResponseCodeResourceNotFound})
+
+			return
+		}
+		fake.respondJSON(writer, http.StatusOK, image)
+
+	case operation == "tag/applyTags":
+		assetID := requestBody["assetId"].(string)
+		for _, tag := range requestBody["tag"].([]interface{}) {
+			tagData := tag.(map[string]interface{})
+			fake.tags[assetID] = append(fake.tags[assetID], compute.Tag{
+				Name:  tagData["tagKeyName"].(string),
+				Value: tagData["value"].(string),
+			})
+		}
+		fake.respondJSON(writer, http.StatusOK, &compute.APIResponseV2{ResponseCode: compute.ResponseCodeOK})
+
+	case operation == "tag/tag":
+		tagDetails := &compute.TagDetails{}
+		for _, tag := range fake.tags[query.Get("assetId")] {
+			tagDetails.Items = append(tagDetails.Items, compute.TagDetail{
+				AssetID: query.Get("assetId"),
+				Name:    tag.Name,
+				Value:   tag.Value,
+			})
+		}
+		tagDetails.PageNumber = 1
+		tagDetails.PageCount = len(tagDetails.Items)
+		tagDetails.PageSize = 250
+		fake.respondJSON(writer, http.StatusOK, tagDetails)
+
+	default:
+		http.NotFound(writer, request)
+	}
+}
+
+func (fake *fakeCloudControl) createImage(datacenterID string, name string) string {
+	fake.nextIndex++
+	imageID := fmt.Sprintf("new-image-%d", fake.nextIndex)
+	fake.addImage(imageID, datacenterID, name, "2017-03-01T02:00:00.000Z", "")
+
+	return imageID
+}
+
+func (fake *fakeCloudControl) respondInProgress(writer http.ResponseWriter, fieldName string, fieldValue string) {
+	response := &compute.APIResponseV2{
+		ResponseCode: compute.ResponseCodeInProgress,
+	}
+	if fieldName != "" {
+		response.FieldMessages = []compute.FieldMessage{
+			{FieldName: fieldName, Message: fieldValue},
+		}
+	}
+
+	fake.respondJSON(writer, http.StatusAccepted, response)
+}
+
+func (fake *fakeCloudControl) respondJSON(writer http.ResponseWriter, statusCode int, body interface{}) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(statusCode)
+	json.NewEncoder(writer).Encode(body)
+}
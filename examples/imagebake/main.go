@@ -0,0 +1,57 @@
+// Command imagebake is an example program that performs a nightly "image bake".
+//
+// It clones a golden server to a customer image, replicates that image to other datacenters,
+// tags each copy (so baked images can be identified), and then prunes old versions of the image.
+//
+// Usage:
+//
+//	MCP_USER=user MCP_PASSWORD=password imagebake -region au -server <server-id> -name golden -replicate-to AU10,AU11 -keep 3
+//
+// Note that the tag keys used to identify baked images ("imageBake" and "imageBakeVersion") must already exist.
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/DimensionDataResearch/go-dd-cloud-compute/compute"
+)
+
+func main() {
+	region := flag.String("region", "au", "The CloudControl region (e.g. au, na, eu)")
+	serverID := flag.String("server", "", "The Id of the golden server to clone")
+	imageNamePrefix := flag.String("name", "golden", "The prefix for baked image names")
+	replicateTo := flag.String("replicate-to", "", "A comma-separated list of datacenters to replicate the image to")
+	keepVersions := flag.Int("keep", 3, "The number of image versions to keep in each datacenter")
+	timeout := flag.Duration("timeout", 2*time.Hour, "The maximum time to wait for each clone / copy operation")
+	flag.Parse()
+
+	if *serverID == "" || *replicateTo == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	client := compute.NewClient(*region, os.Getenv("MCP_USER"), os.Getenv("MCP_PASSWORD"))
+
+	result, err := Bake(client, BakeConfiguration{
+		ServerID:            *serverID,
+		ImageNamePrefix:     *imageNamePrefix,
+		TargetDatacenterIDs: strings.Split(*replicateTo, ","),
+		KeepVersions:        *keepVersions,
+		Timeout:             *timeout,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("Baked image '%s' ('%s' in '%s').", result.ImageName, result.Source.ImageID, result.Source.DatacenterID)
+	for _, replica := range result.Replicas {
+		log.Printf("Replicated to '%s' in '%s'.", replica.ImageID, replica.DatacenterID)
+	}
+	for _, pruned := range result.Pruned {
+		log.Printf("Pruned '%s' in '%s'.", pruned.ImageID, pruned.DatacenterID)
+	}
+}